@@ -33,13 +33,13 @@ func resolveArchitectPath(name string) (string, error) {
 				return a.Path, nil
 			}
 		}
-		return "", fmt.Errorf("architect %q not found", name)
+		return "", notFoundErrorf("architect %q not found", name)
 	}
 
 	if architectPath := os.Getenv("CORTEX_ARCHITECT_PATH"); architectPath != "" {
 		root, err := architectconfig.FindArchitectRoot(architectPath)
 		if err != nil {
-			return "", fmt.Errorf("CORTEX_ARCHITECT_PATH does not point to a cortex architect")
+			return "", notFoundErrorf("CORTEX_ARCHITECT_PATH does not point to a cortex architect")
 		}
 		return root, nil
 	}
@@ -51,7 +51,7 @@ func resolveArchitectPath(name string) (string, error) {
 
 	root, err := architectconfig.FindArchitectRoot(cwd)
 	if err != nil {
-		return "", fmt.Errorf("not in a cortex architect (no cortex.yaml found)")
+		return "", notFoundErrorf("not in a cortex architect (no cortex.yaml found)")
 	}
 	return root, nil
 }
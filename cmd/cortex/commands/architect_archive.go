@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/architect/archive"
+	"github.com/kareemaly/cortex/internal/install"
+	"github.com/kareemaly/cortex/internal/storage"
+	"github.com/kareemaly/cortex/pkg/version"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+var (
+	architectExportFile  string
+	architectImportRepos []string
+	architectImportName  string
+)
+
+var architectExportCmd = &cobra.Command{
+	Use:   "export [name]",
+	Short: "Pack an architect workspace into a portable archive",
+	Long: `Pack an architect workspace into a versioned tar.gz with a manifest.
+
+The archive holds tickets, conclusions, collabs, architect sessions,
+prompts, templates, notes and cortex.yaml. Machine-local state is left out:
+the daemon's dotfiles (sessions, spawn queue, event journal) and the
+worktrees/ directory. Hand it to a teammate and they can run:
+
+  cortex architect import <archive>`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := ""
+		if len(args) > 0 {
+			name = args[0]
+		}
+		architectPath, err := resolveArchitectPath(name)
+		if err != nil {
+			return err
+		}
+
+		output := architectExportFile
+		if output == "" {
+			output = fmt.Sprintf("%s-%s.tar.gz", filepath.Base(architectPath), time.Now().Format("2006-01-02"))
+		}
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		manifest, err := archive.Export(architectPath, f, version.Get().Version)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(output)
+			return fmt.Errorf("export failed: %w", err)
+		}
+
+		printOutput(manifest, func() {
+			fmt.Printf("Exported %s (%d files) to %s\n", architectPath, manifest.Files, output)
+			for _, key := range manifest.RepoKeys() {
+				fmt.Printf("  %s %s: %s\n", bullet(), key, manifest.Repos[key])
+			}
+		})
+		return nil
+	},
+}
+
+var architectImportCmd = &cobra.Command{
+	Use:   "import <archive> [directory]",
+	Short: "Unpack an exported architect workspace and register it",
+	Long: `Unpack an archive made by "cortex architect export" into directory
+(default: ./<architect name>) and register it as an architect.
+
+Repo paths in cortex.yaml are machine-specific. Map each repo key to its
+path on this machine with --repo, once per repo:
+
+  cortex architect import shop.tar.gz --repo api=~/code/api --repo web=~/code/web
+
+Repos without --repo are asked for interactively, or keep their exported
+path when stdin is not a terminal. The imported cortex.yaml is validated
+before the architect is registered.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repoPaths, err := parseRepoMappings(architectImportRepos)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		r, err := archive.Open(f)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = r.Close() }()
+		manifest := r.Manifest
+
+		name := architectImportName
+		if name == "" {
+			name = manifest.Name
+		}
+		dest := ""
+		if len(args) == 2 {
+			dest = args[1]
+		} else {
+			if name == "" {
+				return fmt.Errorf("the archive has no architect name; pass a directory or --name")
+			}
+			dest = name
+		}
+		dest, err = filepath.Abs(dest)
+		if err != nil {
+			return err
+		}
+
+		interactive := isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())
+		reader := bufio.NewReader(os.Stdin)
+		for _, key := range manifest.RepoKeys() {
+			if _, ok := repoPaths[key]; ok || !interactive {
+				continue
+			}
+			fmt.Fprintf(os.Stderr, "Path for repo %s [%s]: ", key, manifest.Repos[key])
+			input, err := reader.ReadString('\n')
+			if err != nil {
+				return fmt.Errorf("read repo path: %w", err)
+			}
+			if input = strings.TrimSpace(input); input != "" {
+				repoPaths[key] = input
+			}
+		}
+
+		if err := r.Extract(dest, repoPaths); err != nil {
+			return fmt.Errorf("import failed: %w", err)
+		}
+
+		result := architectImportOutput{Name: name, Path: dest, Manifest: manifest, Repos: map[string]string{}}
+		for _, key := range manifest.RepoKeys() {
+			repoPath, ok := repoPaths[key]
+			if !ok {
+				repoPath = manifest.Repos[key]
+			}
+			result.Repos[key] = repoPath
+		}
+
+		// A teammate importing may never have run cortex init.
+		if _, err := install.SetupGlobal(false); err != nil {
+			return fmt.Errorf("global setup failed: %w", err)
+		}
+		registered, regErr := install.RegisterArchitect(dest, name)
+		result.Registered = regErr == nil
+
+		printOutput(result, func() {
+			fmt.Printf("Imported %s (%d files, exported %s) into %s\n", manifest.Name, manifest.Files, manifest.ExportedAt.Local().Format("2006-01-02 15:04"), dest)
+			for _, key := range manifest.RepoKeys() {
+				repoPath := result.Repos[key]
+				mark := checkMark()
+				if _, err := os.Stat(storage.ExpandHome(repoPath)); err != nil {
+					mark = crossMark() + " (not found on this machine)"
+				}
+				fmt.Printf("  %s: %s %s\n", key, repoPath, mark)
+			}
+			switch {
+			case regErr != nil:
+				fmt.Printf("Warning: failed to register architect: %v\n", regErr)
+			case registered:
+				fmt.Printf("Registered architect %q\n", name)
+			default:
+				fmt.Printf("Architect %q is already registered\n", name)
+			}
+		})
+		return nil
+	},
+}
+
+// architectImportOutput is the --output json|yaml form of architect import.
+// Repos holds each repo's path on this machine.
+type architectImportOutput struct {
+	Name       string            `json:"name"`
+	Path       string            `json:"path"`
+	Registered bool              `json:"registered"`
+	Repos      map[string]string `json:"repos,omitempty"`
+	Manifest   *archive.Manifest `json:"manifest"`
+}
+
+// parseRepoMappings turns key=path flags into a map.
+func parseRepoMappings(pairs []string) (map[string]string, error) {
+	paths := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, path, ok := strings.Cut(pair, "=")
+		if !ok || key == "" || path == "" {
+			return nil, usageErrorf("invalid --repo %q: expected key=path", pair)
+		}
+		paths[key] = path
+	}
+	return paths, nil
+}
+
+func init() {
+	architectExportCmd.Flags().StringVarP(&architectExportFile, "file", "f", "", "Archive path (default: <architect>-<date>.tar.gz)")
+	architectImportCmd.Flags().StringArrayVar(&architectImportRepos, "repo", nil, "Repo path on this machine as key=path (repeatable)")
+	architectImportCmd.Flags().StringVar(&architectImportName, "name", "", "Name to register the architect under (default: from the archive)")
+	architectCmd.AddCommand(architectExportCmd)
+	architectCmd.AddCommand(architectImportCmd)
+}
@@ -19,31 +19,34 @@ var architectListCmd = &cobra.Command{
 			return fmt.Errorf("failed to list architects: %w", err)
 		}
 
-		if len(resp.Architects) == 0 {
-			fmt.Println("No architects registered. Use 'cortex init <name>' to create one.")
-			return nil
-		}
-
-		for _, a := range resp.Architects {
-			status := ""
-			if !a.Exists {
-				status = " (missing)"
-			}
-			title := a.Title
-			if title == "" {
-				title = a.Path
-			}
-			fmt.Printf("  %s%s\n    %s\n", title, status, a.Path)
-			if a.Counts != nil {
-				fmt.Printf("    Tickets: %d backlog, %d in progress, %d done\n",
-					a.Counts.Backlog, a.Counts.Progress, a.Counts.Done)
-			}
-		}
-
+		printOutput(resp, func() { printArchitectList(resp) })
 		return nil
 	},
 }
 
+func printArchitectList(resp *sdk.ListArchitectsResponse) {
+	if len(resp.Architects) == 0 {
+		fmt.Println("No architects registered. Use 'cortex init <name>' to create one.")
+		return
+	}
+
+	for _, a := range resp.Architects {
+		status := ""
+		if !a.Exists {
+			status = " (missing)"
+		}
+		title := a.Title
+		if title == "" {
+			title = a.Path
+		}
+		fmt.Printf("  %s%s\n    %s\n", title, status, a.Path)
+		if a.Counts != nil {
+			fmt.Printf("    Tickets: %d backlog, %d in progress, %d done\n",
+				a.Counts.Backlog, a.Counts.Progress, a.Counts.Done)
+		}
+	}
+}
+
 func init() {
 	architectCmd.AddCommand(architectListCmd)
 }
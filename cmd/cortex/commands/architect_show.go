@@ -2,7 +2,6 @@ package commands
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -16,7 +15,11 @@ import (
 var architectShowCmd = &cobra.Command{
 	Use:   "show [name]",
 	Short: "Open architect TUI",
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Open the project TUI (kanban / sessions / config).
+
+With --no-tui, --output json|yaml or when stdout is not a terminal, print
+the ticket board instead.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		name := ""
 		if len(args) > 0 {
@@ -27,8 +30,7 @@ var architectShowCmd = &cobra.Command{
 
 		architectPath, err := resolveArchitectPath(name)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 
 		projectName := filepath.Base(architectPath)
@@ -36,15 +38,32 @@ var architectShowCmd = &cobra.Command{
 			projectName = cfg.Name
 		}
 
-		client := sdk.DefaultClient(architectPath)
+		client := sdk.DefaultClient(architectPath).WithActor(sdk.ActorTUI)
+		if !useTUI() {
+			resp, err := client.ListAllTickets("", nil)
+			if err != nil {
+				exitWithError(err)
+			}
+			printOutput(resp, func() {
+				fmt.Printf("# %s\n\n", projectName)
+				for _, col := range resp.Columns {
+					fmt.Printf("## %s (%d)\n", col.Status, len(col.Tickets))
+					if len(col.Tickets) > 0 {
+						printTicketTable(col.Tickets)
+					}
+					fmt.Println()
+				}
+			})
+			return
+		}
+
 		logBuf := tuilog.NewBuffer(tuilog.DefaultCapacity)
 		p := tea.NewProgram(
 			views.New(client, logBuf, projectName),
 			tea.WithAltScreen(),
 		)
 		if _, err := p.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 	},
 }
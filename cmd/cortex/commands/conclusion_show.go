@@ -14,32 +14,39 @@ import (
 var conclusionShowCmd = &cobra.Command{
 	Use:   "show <conclusion-id>",
 	Short: "Open a read-only conclusion detail viewer",
-	Args:  cobra.ExactArgs(1),
+	Long: `Open a read-only conclusion detail viewer.
+
+With --no-tui, --output json|yaml or when stdout is not a terminal, print
+the conclusion instead.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		ensureDaemon()
 
 		architectPath, err := resolveArchitectPath("")
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 
 		client := sdk.DefaultClient(architectPath)
 		conclusionResp, err := client.GetConclusion(args[0])
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 
 		tabs := []detail.Tab{
 			{Label: "Overview", Content: buildConclusionOverview(conclusionResp)},
 			{Label: "Body", Content: bodyContent(conclusionResp.Body, "conclusion")},
 		}
+		if !useTUI() {
+			printOutput(conclusionResp, func() {
+				printDetailTabs(os.Stdout, conclusionTitle(conclusionResp), "", tabs)
+			})
+			return
+		}
 
 		program := tea.NewProgram(detail.New(conclusionTitle(conclusionResp), "", tabs), tea.WithAltScreen())
 		if _, err := program.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 	},
 }
@@ -27,8 +27,7 @@ Examples:
 	Run: func(cmd *cobra.Command, args []string) {
 		logPath, err := autostart.LogFilePath()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 
 		// Check if log file exists
@@ -56,8 +55,7 @@ func init() {
 func showLastLines(path string, n int) {
 	file, err := os.Open(path)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError(err)
 	}
 	defer func() { _ = file.Close() }()
 
@@ -69,8 +67,7 @@ func showLastLines(path string, n int) {
 	}
 
 	if err := scanner.Err(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading log file: %v\n", err)
-		os.Exit(1)
+		exitWithError(fmt.Errorf("reading log file: %w", err))
 	}
 
 	// Print last n lines
@@ -95,8 +92,7 @@ func tailLogs(path string) {
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 	if err := cmd.Start(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError(err)
 	}
 
 	// Wait for signal or command to finish
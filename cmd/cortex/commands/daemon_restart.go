@@ -2,7 +2,6 @@ package commands
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/kareemaly/cortex/internal/daemon/autostart"
 	"github.com/spf13/cobra"
@@ -18,8 +17,7 @@ var daemonRestartCmd = &cobra.Command{
 
 		// Start daemon
 		if err := autostart.EnsureDaemonRunning(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 		fmt.Println("Daemon restarted")
 	},
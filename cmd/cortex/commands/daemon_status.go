@@ -2,7 +2,6 @@ package commands
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/kareemaly/cortex/internal/daemon/autostart"
@@ -16,25 +15,46 @@ var daemonStatusCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		status, err := autostart.GetStatus()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 
-		if !status.Running {
-			fmt.Println("Daemon is not running")
-			return
-		}
+		printOutput(daemonStatusOutput{
+			Running:       status.Running,
+			PID:           status.PID,
+			Port:          status.Port,
+			Socket:        status.Socket,
+			Version:       status.Version,
+			UptimeSeconds: int64(status.Uptime.Seconds()),
+		}, func() {
+			if !status.Running {
+				fmt.Println("Daemon is not running")
+				return
+			}
 
-		fmt.Println("Daemon is running")
-		fmt.Printf("  PID:     %d\n", status.PID)
-		fmt.Printf("  Port:    %d\n", status.Port)
-		if status.Version != "" {
-			fmt.Printf("  Version: %s\n", status.Version)
-		}
-		fmt.Printf("  Uptime:  %s\n", formatUptime(status.Uptime))
+			fmt.Println("Daemon is running")
+			fmt.Printf("  PID:     %d\n", status.PID)
+			fmt.Printf("  Port:    %d\n", status.Port)
+			if status.Socket != "" {
+				fmt.Printf("  Socket:  %s\n", status.Socket)
+			}
+			if status.Version != "" {
+				fmt.Printf("  Version: %s\n", status.Version)
+			}
+			fmt.Printf("  Uptime:  %s\n", formatUptime(status.Uptime))
+		})
 	},
 }
 
+// daemonStatusOutput is the --output json|yaml form of daemon status.
+type daemonStatusOutput struct {
+	Running       bool   `json:"running"`
+	PID           int    `json:"pid,omitempty"`
+	Port          int    `json:"port,omitempty"`
+	Socket        string `json:"socket,omitempty"`
+	Version       string `json:"version,omitempty"`
+	UptimeSeconds int64  `json:"uptime_seconds,omitempty"`
+}
+
 func init() {
 	daemonCmd.AddCommand(daemonStatusCmd)
 }
@@ -2,7 +2,6 @@ package commands
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/kareemaly/cortex/internal/daemon/autostart"
 	"github.com/spf13/cobra"
@@ -14,8 +13,7 @@ var daemonStopCmd = &cobra.Command{
 	Long:  `Stop the running Cortex daemon.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := autostart.StopDaemon(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 		fmt.Println("Daemon stopped")
 	},
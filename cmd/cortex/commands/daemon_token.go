@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/kareemaly/cortex/internal/daemon/auth"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tokenRoleFlag      string
+	tokenArchitectFlag string
+)
+
+var daemonTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage daemon API tokens",
+	Long: `Manage the API tokens stored under auth.tokens in ~/.cortex/settings.yaml.
+
+Tokens only take effect when auth.enabled is true. Restart the daemon after
+changing them.
+
+Examples:
+  cortex daemon token list
+  cortex daemon token create ci --role read-only --architect myproject
+  cortex daemon token revoke ci`,
+}
+
+var daemonTokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API tokens",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := daemonconfig.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load global config: %w", err)
+		}
+		if !cfg.Auth.Enabled {
+			fmt.Println("Token authentication is disabled (auth.enabled: false).")
+		}
+		if len(cfg.Auth.Tokens) == 0 {
+			fmt.Println("No tokens.")
+			return nil
+		}
+		for _, t := range cfg.Auth.Tokens {
+			scope := t.Architect
+			if scope == "" {
+				scope = "all architects"
+			}
+			fmt.Printf("  %s\n    Role: %s\n    Scope: %s\n", t.Name, t.Role, scope)
+		}
+		return nil
+	},
+}
+
+var daemonTokenCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create an API token and print it",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := auth.ParseRole(tokenRoleFlag); err != nil {
+			return err
+		}
+		architect := ""
+		if tokenArchitectFlag != "" {
+			path, err := resolveArchitectPath(tokenArchitectFlag)
+			if err != nil {
+				return err
+			}
+			architect = path
+		}
+
+		cfg, err := daemonconfig.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load global config: %w", err)
+		}
+		t, err := cfg.Auth.AddToken(args[0], tokenRoleFlag, architect)
+		if err != nil {
+			return err
+		}
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save global config: %w", err)
+		}
+
+		fmt.Println(t.Token)
+		if !cfg.Auth.Enabled {
+			fmt.Println("Note: set auth.enabled: true in ~/.cortex/settings.yaml for tokens to be required.")
+		}
+		return nil
+	},
+}
+
+var daemonTokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Delete an API token",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := daemonconfig.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load global config: %w", err)
+		}
+		if !cfg.Auth.RemoveToken(args[0]) {
+			return fmt.Errorf("token %q not found", args[0])
+		}
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save global config: %w", err)
+		}
+		fmt.Printf("Token %s revoked. Restart the daemon to apply.\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	daemonTokenCreateCmd.Flags().StringVar(&tokenRoleFlag, "role", string(auth.RoleArchitect), "Token role: architect, read-only, worker, collab, reviewer or hook")
+	daemonTokenCreateCmd.Flags().StringVar(&tokenArchitectFlag, "architect", "", "Limit the token to one architect (name)")
+
+	daemonTokenCmd.AddCommand(daemonTokenListCmd, daemonTokenCreateCmd, daemonTokenRevokeCmd)
+	daemonCmd.AddCommand(daemonTokenCmd)
+}
@@ -1,9 +1,6 @@
 package commands
 
 import (
-	"fmt"
-	"os"
-
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kareemaly/cortex/internal/cli/sdk"
 	"github.com/kareemaly/cortex/internal/cli/tui/dashboard"
@@ -19,14 +16,22 @@ var dashboardCmd = &cobra.Command{
 		ensureDaemon()
 
 		client := sdk.DefaultClient("")
+		if !useTUI() {
+			resp, err := client.ListArchitects()
+			if err != nil {
+				exitWithError(err)
+			}
+			printOutput(resp, func() { printArchitectList(resp) })
+			return
+		}
+
 		logBuf := tuilog.NewBuffer(tuilog.DefaultCapacity)
 		p := tea.NewProgram(
 			dashboard.New(client, logBuf),
 			tea.WithAltScreen(),
 		)
 		if _, err := p.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 	},
 }
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+)
+
+// Exit codes. They are part of the CLI's scripting interface: keep them
+// stable.
+const (
+	// exitFailure is any error without a more specific code.
+	exitFailure = 1
+	// exitValidation is an invalid flag, argument or request field.
+	exitValidation = 2
+	// exitNotFound is a missing ticket, session, conclusion or architect.
+	exitNotFound = 3
+	// exitConflict is an action the current state forbids, such as
+	// spawning a blocked ticket or reviewing a ticket not in review.
+	exitConflict = 4
+	// exitDaemonUnreachable is a daemon that could not be started or
+	// reached.
+	exitDaemonUnreachable = 5
+)
+
+// apiErrorExitCodes maps ErrorResponse codes to exit codes. Codes not
+// listed fall back on the HTTP status.
+var apiErrorExitCodes = map[string]int{
+	"not_found":           exitNotFound,
+	"architect_not_found": exitNotFound,
+	"no_active_session":   exitNotFound,
+	"prompt_not_found":    exitNotFound,
+	"window_not_found":    exitNotFound,
+	"validation_error":    exitValidation,
+	"variant_required":    exitValidation,
+	"invalid_json":        exitValidation,
+	"invalid_status":      exitValidation,
+	"invalid_transition":  exitValidation,
+	"session_orphaned":    exitConflict,
+	"ticket_blocked":      exitConflict,
+	"state_conflict":      exitConflict,
+	"not_in_review":       exitConflict,
+	"reviewer_active":     exitConflict,
+	"criteria_unchecked":  exitConflict,
+	"headless_session":    exitConflict,
+	"not_headless":        exitConflict,
+}
+
+// cliError is an error the CLI detected itself, with its exit code.
+type cliError struct {
+	code    int
+	errCode string
+	err     error
+}
+
+func (e *cliError) Error() string { return e.err.Error() }
+func (e *cliError) Unwrap() error { return e.err }
+
+// usageErrorf reports an invalid flag or argument.
+func usageErrorf(format string, args ...any) error {
+	return &cliError{code: exitValidation, errCode: "validation_error", err: fmt.Errorf(format, args...)}
+}
+
+// notFoundErrorf reports something the CLI looked up and did not find.
+func notFoundErrorf(format string, args ...any) error {
+	return &cliError{code: exitNotFound, errCode: "not_found", err: fmt.Errorf(format, args...)}
+}
+
+// daemonUnreachable wraps a failure to start or reach the daemon.
+func daemonUnreachable(err error) error {
+	return &cliError{code: exitDaemonUnreachable, errCode: "daemon_unreachable", err: err}
+}
+
+// exitCode returns the exit code for err, and the ErrorResponse code that
+// describes it.
+func exitCode(err error) (int, string) {
+	var cerr *cliError
+	if errors.As(err, &cerr) {
+		return cerr.code, cerr.errCode
+	}
+	var apiErr *sdk.APIError
+	if errors.As(err, &apiErr) {
+		if code, ok := apiErrorExitCodes[apiErr.Code]; ok {
+			return code, apiErr.Code
+		}
+		switch apiErr.Status {
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return exitValidation, apiErr.Code
+		case http.StatusNotFound:
+			return exitNotFound, apiErr.Code
+		case http.StatusConflict:
+			return exitConflict, apiErr.Code
+		}
+		return exitFailure, apiErr.Code
+	}
+	// The SDK wraps transport failures, which net/http reports as
+	// *url.Error.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return exitDaemonUnreachable, "daemon_unreachable"
+	}
+	return exitFailure, ""
+}
+
+// reportError prints err to stderr, as an ErrorResponse in JSON output
+// mode, and returns its exit code.
+func reportError(err error) int {
+	code, errCode := exitCode(err)
+	if outputFormat == outputJSON {
+		data, _ := json.Marshal(sdk.ErrorResponse{Error: err.Error(), Code: errCode})
+		fmt.Fprintln(os.Stderr, string(data))
+	} else {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	}
+	return code
+}
+
+// exitWithError reports err and exits with its code.
+func exitWithError(err error) {
+	os.Exit(reportError(err))
+}
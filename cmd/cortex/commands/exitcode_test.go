@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"ticket not found", &sdk.APIError{Code: "not_found", Status: http.StatusNotFound}, exitNotFound},
+		{"blocked ticket", &sdk.APIError{Code: "ticket_blocked", Status: http.StatusConflict}, exitConflict},
+		{"unlisted bad request", &sdk.APIError{Code: "invalid_repo", Status: http.StatusBadRequest}, exitValidation},
+		{"unlisted conflict", &sdk.APIError{Code: "worktree_error", Status: http.StatusConflict}, exitConflict},
+		{"server error", &sdk.APIError{Code: "store_error", Status: http.StatusInternalServerError}, exitFailure},
+		{"daemon down", fmt.Errorf("failed to connect to daemon: %w", &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")}), exitDaemonUnreachable},
+		{"bad flag", usageErrorf("invalid --mode %q", "x"), exitValidation},
+		{"missing architect", notFoundErrorf("architect %q not found", "shop"), exitNotFound},
+		{"other", errors.New("boom"), exitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kareemaly/cortex/internal/cli/tui/detail"
+	"github.com/mattn/go-isatty"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats accepted by --output.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+var (
+	// outputFormat is the global --output flag.
+	outputFormat string
+	// noTUI is the global --no-tui flag.
+	noTUI bool
+)
+
+// checkOutputFormat rejects an unknown --output value.
+func checkOutputFormat(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	}
+	return usageErrorf("invalid --output %q: expected table, json or yaml", format)
+}
+
+// printOutput prints v to stdout in the --output format, calling table
+// for the human-readable one. It exits on failure.
+func printOutput(v any, table func()) {
+	if err := writeOutput(os.Stdout, outputFormat, v, table); err != nil {
+		exitWithError(err)
+	}
+}
+
+// useTUI reports whether a command should open its Bubbletea viewer:
+// not with --no-tui or a structured --output, and only on a terminal.
+func useTUI() bool {
+	if noTUI || outputFormat != outputTable {
+		return false
+	}
+	fd := os.Stdout.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// printDetailTabs prints a detail viewer's markdown tabs one after the
+// other, for use without the TUI.
+func printDetailTabs(w io.Writer, title, subtitle string, tabs []detail.Tab) {
+	_, _ = fmt.Fprintf(w, "# %s\n", title)
+	if subtitle != "" {
+		_, _ = fmt.Fprintln(w, subtitle)
+	}
+	for _, tab := range tabs {
+		content := strings.TrimSpace(tab.Content)
+		if tab.Kind == detail.TabKindChanges || content == "" {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "\n## %s\n\n%s\n", tab.Label, content)
+	}
+}
+
+// writeOutput writes v to w as JSON or YAML, or calls table for the
+// human-readable format. YAML uses the same keys as the JSON API.
+func writeOutput(w io.Writer, format string, v any, table func()) error {
+	switch format {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case outputYAML:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		// JSON is YAML: decoding it into a node keeps the field order.
+		var doc yaml.Node
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		blockStyle(&doc)
+		var out bytes.Buffer
+		enc := yaml.NewEncoder(&out)
+		enc.SetIndent(2)
+		if err := enc.Encode(&doc); err != nil {
+			return err
+		}
+		if err := enc.Close(); err != nil {
+			return err
+		}
+		_, err = w.Write(out.Bytes())
+		return err
+	}
+	table()
+	return nil
+}
+
+// blockStyle clears the flow and quoting styles a JSON document decodes
+// with, so the node encodes as plain block YAML.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
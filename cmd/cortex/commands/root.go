@@ -12,13 +12,38 @@ var rootCmd = &cobra.Command{
 	Use:   "cortex",
 	Short: "Cortex - AI-powered development workflow",
 	Long: `Cortex is an AI-powered development workflow tool that helps you
-manage tickets, sessions, and project architecture with intelligent assistance.`,
+manage tickets, sessions, and project architecture with intelligent assistance.
+
+Exit codes: 0 success, 1 failure, 2 invalid flag, argument or request,
+3 not found, 4 state conflict, 5 daemon unreachable.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return checkOutputFormat(outputFormat)
+	},
+	// Execute reports errors itself, with their exit code.
+	SilenceErrors: true,
 }
 
 // Execute runs the root command.
 func Execute() {
+	usageArgs(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(reportError(err))
+	}
+}
+
+// usageArgs makes the argument validators of cmd and its subcommands
+// report usage errors.
+func usageArgs(cmd *cobra.Command) {
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			if err := validate(cmd, args); err != nil {
+				return usageErrorf("%v", err)
+			}
+			return nil
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		usageArgs(sub)
 	}
 }
 
@@ -26,7 +51,14 @@ func Execute() {
 // If the daemon cannot be started, it prints an error and exits.
 func ensureDaemon() {
 	if err := autostart.EnsureDaemonRunning(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: failed to start daemon: %v\n", err)
-		os.Exit(1)
+		exitWithError(daemonUnreachable(fmt.Errorf("failed to start daemon: %w", err)))
 	}
 }
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputTable, "Output format: table, json or yaml")
+	rootCmd.PersistentFlags().BoolVar(&noTUI, "no-tui", false, "Print plain text instead of opening interactive viewers")
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return usageErrorf("%v", err)
+	})
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scheduleAddCron       string
+	scheduleAddTitle      string
+	scheduleAddBody       string
+	scheduleAddBodyFile   string
+	scheduleAddRepo       string
+	scheduleAddType       string
+	scheduleAddReferences []string
+	scheduleAddDue        string
+	scheduleAddVariant    string
+	scheduleAddPaused     bool
+)
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Manage recurring tickets",
+	Long: `Manage recurring tickets.
+
+A schedule is a markdown file under the architect's schedules/ directory.
+While the daemon runs, each schedule creates a backlog ticket from its
+title and body whenever its cron expression falls due, and spawns it when
+the schedule names a variant. Titles and bodies may use {{.date}} and
+{{.schedule}}. Runs missed while the daemon was stopped are made up once.`,
+}
+
+var scheduleListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List schedules with their next and last runs",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp, err := ticketClient().ListSchedules()
+		if err != nil {
+			exitWithError(err)
+		}
+
+		printOutput(resp, func() {
+			for _, bad := range resp.Invalid {
+				fmt.Fprintf(os.Stderr, "%s skipping invalid schedule %s: %s\n", crossMark(), bad.Name, bad.Error)
+			}
+			if len(resp.Schedules) == 0 {
+				fmt.Println("No schedules. Add one with: cortex schedule add")
+				return
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(tw, "NAME\tCRON\tTITLE\tREPO\tVARIANT\tNEXT RUN\tLAST TICKET")
+			for _, s := range resp.Schedules {
+				next := formatDetailOptionalTime(s.NextRun)
+				if s.Paused {
+					next = "paused"
+				}
+				last := orDash(s.LastTicketID)
+				if s.LastError != "" {
+					last = crossMark() + " " + s.LastError
+				}
+				_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					s.Name, s.Cron, s.Title, orDash(s.Repo), orDash(s.Variant), next, last)
+			}
+			_ = tw.Flush()
+		})
+	},
+}
+
+var scheduleAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a recurring ticket schedule",
+	Long: `Add a recurring ticket schedule, saved as schedules/<name>.md.
+
+--cron takes five fields (minute hour day-of-month month day-of-week) in
+the daemon's local time, or @hourly, @daily, @weekly or @monthly:
+
+  cortex schedule add dep-audit --cron "0 9 * * mon" --repo api \
+    --title "Dependency audit {{.date}}" --body-file audit.md --variant fast`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if scheduleAddCron == "" || scheduleAddTitle == "" {
+			exitWithError(usageErrorf("--cron and --title are required"))
+		}
+		if scheduleAddBody != "" && scheduleAddBodyFile != "" {
+			exitWithError(usageErrorf("use --body or --body-file, not both"))
+		}
+		body := scheduleAddBody
+		if scheduleAddBodyFile != "" {
+			var err error
+			if body, err = readBodyFile(scheduleAddBodyFile); err != nil {
+				exitWithError(err)
+			}
+		}
+
+		s, err := ticketClient().CreateSchedule(sdk.ScheduleParams{
+			Name:       args[0],
+			Cron:       scheduleAddCron,
+			Title:      scheduleAddTitle,
+			Body:       body,
+			Type:       scheduleAddType,
+			Repo:       scheduleAddRepo,
+			References: scheduleAddReferences,
+			Due:        scheduleAddDue,
+			Variant:    scheduleAddVariant,
+			Paused:     scheduleAddPaused,
+		})
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(s, func() {
+			fmt.Printf("%s Added schedule %s (%s)\n", checkMark(), s.Name, s.Cron)
+			if s.NextRun != nil {
+				fmt.Printf("  next run: %s\n", formatDetailTime(*s.NextRun))
+			}
+		})
+	},
+}
+
+var scheduleRemoveCmd = &cobra.Command{
+	Use:     "remove <name>",
+	Aliases: []string{"rm"},
+	Short:   "Remove a schedule, keeping the tickets it created",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ticketClient().DeleteSchedule(args[0]); err != nil {
+			exitWithError(err)
+		}
+		result := struct {
+			Name    string `json:"name"`
+			Removed bool   `json:"removed"`
+		}{Name: args[0], Removed: true}
+		printOutput(result, func() {
+			fmt.Printf("Removed schedule %s\n", args[0])
+		})
+	},
+}
+
+func init() {
+	scheduleAddCmd.Flags().StringVar(&scheduleAddCron, "cron", "", "Cron expression or macro such as @weekly (required)")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddTitle, "title", "", "Title of each created ticket (required)")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddBody, "body", "", "Body of each created ticket")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddBodyFile, "body-file", "", "Read the body from a file (- for stdin)")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddRepo, "repo", "", "Repo key from cortex.yaml")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddType, "type", "", "Ticket type from cortex.yaml")
+	scheduleAddCmd.Flags().StringSliceVar(&scheduleAddReferences, "references", nil, "Ticket references (comma-separated)")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddDue, "due", "", "Due offset from each run, such as 3d, 2w or 48h")
+	scheduleAddCmd.Flags().StringVar(&scheduleAddVariant, "variant", "", "Agent variant to spawn each created ticket with")
+	scheduleAddCmd.Flags().BoolVar(&scheduleAddPaused, "paused", false, "Add the schedule without running it")
+
+	scheduleCmd.AddCommand(scheduleListCmd)
+	scheduleCmd.AddCommand(scheduleAddCmd)
+	scheduleCmd.AddCommand(scheduleRemoveCmd)
+	rootCmd.AddCommand(scheduleCmd)
+}
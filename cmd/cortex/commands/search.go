@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var searchLimit int
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query...>",
+	Short: "Search tickets, conclusions, collabs and notes",
+	Long: `Run a ranked full-text search over the current architect workspace.
+
+Filters can be mixed with free text:
+  repo:KEY               tickets and conclusions for a repo
+  status:STATUS          tickets in a status column
+  type:TYPE              ticket, conclusion, collab, note, or a ticket type
+  after:DATE             updated on or after DATE (YYYY-MM-DD)
+  before:DATE            updated before DATE
+  updated:FROM..TO       updated within a date range
+
+Example:
+  cortex search login timeout repo:api type:conclusion after:2026-01-01`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ensureDaemon()
+
+		architectPath, err := resolveArchitectPath("")
+		if err != nil {
+			exitWithError(err)
+		}
+
+		client := sdk.DefaultClient(architectPath)
+		resp, err := client.Search(strings.Join(args, " "), searchLimit)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		printOutput(resp, func() {
+			if len(resp.Results) == 0 {
+				fmt.Println("No results.")
+				return
+			}
+
+			for _, r := range resp.Results {
+				var meta []string
+				for _, v := range []string{r.Type, r.Status, r.Repo} {
+					if v != "" {
+						meta = append(meta, v)
+					}
+				}
+				fmt.Printf("[%s] %s\n", r.Kind, r.Title)
+				fmt.Printf("    %s", r.ID)
+				if len(meta) > 0 {
+					fmt.Printf(" (%s)", strings.Join(meta, ", "))
+				}
+				fmt.Printf(" · %s\n", r.Updated.Local().Format("2006-01-02"))
+				if r.Snippet != "" {
+					fmt.Printf("    %s\n", r.Snippet)
+				}
+			}
+			if resp.Total > len(resp.Results) {
+				fmt.Printf("\nShowing %d of %d results. Use --limit to see more.\n", len(resp.Results), resp.Total)
+			}
+		})
+	},
+}
+
+func init() {
+	searchCmd.Flags().IntVarP(&searchLimit, "limit", "n", 20, "Maximum number of results")
+	rootCmd.AddCommand(searchCmd)
+}
@@ -1,10 +1,25 @@
 package commands
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
 
 var ticketCmd = &cobra.Command{
 	Use:   "ticket",
-	Short: "Inspect tickets",
+	Short: "Create, inspect and manage tickets",
+}
+
+// ticketClient starts the daemon if needed and returns a CLI client for
+// the current architect. It exits on failure.
+func ticketClient() *sdk.Client {
+	ensureDaemon()
+
+	architectPath, err := resolveArchitectPath("")
+	if err != nil {
+		exitWithError(err)
+	}
+	return sdk.DefaultClient(architectPath).WithActor(sdk.ActorCLI)
 }
 
 func init() {
@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ticketEditTitle      string
+	ticketEditBody       string
+	ticketEditBodyFile   string
+	ticketEditReferences []string
+	ticketEditBlockedBy  []string
+	ticketEditPriority   string
+	ticketEditLabels     []string
+	ticketEditAssignee   string
+
+	ticketDueClear bool
+
+	ticketDeleteCleanupWorktree bool
+)
+
+var ticketEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Change a ticket's title, body, references, blockers or triage",
+	Long: `Change a ticket's fields. Only the flags given are changed; an empty
+--references, --blocked-by, --labels, --priority or --assignee clears it.
+--body-file - reads the body from stdin:
+
+  ./gen-spec.sh | cortex ticket edit <id> --body-file -
+
+Without any flag, the ticket file opens in $EDITOR.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := cmd.Flags()
+		if flags.Changed("body") && flags.Changed("body-file") {
+			exitWithError(usageErrorf("--body and --body-file are mutually exclusive"))
+		}
+
+		var params sdk.UpdateTicketParams
+		if flags.Changed("title") {
+			params.Title = &ticketEditTitle
+		}
+		if flags.Changed("body") {
+			params.Body = &ticketEditBody
+		}
+		if flags.Changed("body-file") {
+			content, err := readBodyFile(ticketEditBodyFile)
+			if err != nil {
+				exitWithError(err)
+			}
+			params.Body = &content
+		}
+		if flags.Changed("references") {
+			params.References = &ticketEditReferences
+		}
+		if flags.Changed("blocked-by") {
+			params.BlockedBy = &ticketEditBlockedBy
+		}
+		if flags.Changed("priority") {
+			params.Priority = &ticketEditPriority
+		}
+		if flags.Changed("labels") {
+			params.Labels = &ticketEditLabels
+		}
+		if flags.Changed("assignee") {
+			params.Assignee = &ticketEditAssignee
+		}
+
+		client := ticketClient()
+
+		if params == (sdk.UpdateTicketParams{}) {
+			t, err := client.GetTicketByID(args[0])
+			if err != nil {
+				exitWithError(err)
+			}
+			if err := openEditor(t.FilePath); err != nil {
+				exitWithError(err)
+			}
+			return
+		}
+
+		t, err := client.UpdateTicketWithParams(args[0], params)
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(t, func() {
+			fmt.Printf("Updated %s: %s\n", t.ID, t.Title)
+		})
+	},
+}
+
+var ticketMoveCmd = &cobra.Command{
+	Use:   "move <id> <status>",
+	Short: "Move a ticket to another status",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := ticketClient()
+
+		t, err := client.MoveTicket(args[0], args[1])
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(t, func() {
+			fmt.Printf("Moved %s to %s\n", t.ID, t.Status)
+		})
+	},
+}
+
+var ticketDueCmd = &cobra.Command{
+	Use:   "due <id> [date]",
+	Short: "Set or clear a ticket's due date",
+	Long: `Set a ticket's due date (RFC3339 or YYYY-MM-DD), or clear it with --clear.
+
+  cortex ticket due <id> 2026-03-01
+  cortex ticket due <id> --clear`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if ticketDueClear == (len(args) == 2) {
+			exitWithError(usageErrorf("pass either a date or --clear"))
+		}
+		var due time.Time
+		if !ticketDueClear {
+			parsed, err := parseDueFlag(args[1])
+			if err != nil {
+				exitWithError(err)
+			}
+			due = parsed
+		}
+
+		client := ticketClient()
+
+		var t *sdk.TicketResponse
+		var err error
+		if ticketDueClear {
+			t, err = client.ClearDueDate(args[0])
+		} else {
+			t, err = client.SetDueDate(args[0], due)
+		}
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(t, func() {
+			if t.Due == nil {
+				fmt.Printf("Cleared due date of %s\n", t.ID)
+				return
+			}
+			fmt.Printf("%s is due %s\n", t.ID, formatDetailTime(*t.Due))
+		})
+	},
+}
+
+var ticketDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a ticket",
+	Long: `Delete a ticket. Its revision history is kept. With --cleanup-worktree,
+the ticket's isolated git worktree is removed first.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := ticketClient()
+
+		if err := client.DeleteTicket(args[0], ticketDeleteCleanupWorktree); err != nil {
+			exitWithError(err)
+		}
+		result := struct {
+			ID      string `json:"id"`
+			Deleted bool   `json:"deleted"`
+		}{ID: args[0], Deleted: true}
+		printOutput(result, func() {
+			fmt.Printf("Deleted %s\n", args[0])
+		})
+	},
+}
+
+// readBodyFile reads a ticket body from path, or from stdin for "-".
+func readBodyFile(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read body: %w", err)
+	}
+	return string(data), nil
+}
+
+func init() {
+	ticketEditCmd.Flags().StringVar(&ticketEditTitle, "title", "", "New title")
+	ticketEditCmd.Flags().StringVar(&ticketEditBody, "body", "", "New body")
+	ticketEditCmd.Flags().StringVar(&ticketEditBodyFile, "body-file", "", "Read the new body from a file, or stdin with -")
+	ticketEditCmd.Flags().StringSliceVar(&ticketEditReferences, "references", nil, "Replace the references (comma-separated)")
+	ticketEditCmd.Flags().StringSliceVar(&ticketEditBlockedBy, "blocked-by", nil, "Replace the blocking ticket IDs (comma-separated)")
+	ticketEditCmd.Flags().StringVar(&ticketEditPriority, "priority", "", "New priority: urgent, high, medium or low")
+	ticketEditCmd.Flags().StringSliceVar(&ticketEditLabels, "labels", nil, "Replace the labels (comma-separated)")
+	ticketEditCmd.Flags().StringVar(&ticketEditAssignee, "assignee", "", "New assignee, a person or agent variant")
+
+	ticketDueCmd.Flags().BoolVar(&ticketDueClear, "clear", false, "Clear the due date")
+
+	ticketDeleteCmd.Flags().BoolVar(&ticketDeleteCleanupWorktree, "cleanup-worktree", false, "Remove the ticket's isolated git worktree")
+
+	ticketCmd.AddCommand(ticketEditCmd)
+	ticketCmd.AddCommand(ticketMoveCmd)
+	ticketCmd.AddCommand(ticketDueCmd)
+	ticketCmd.AddCommand(ticketDeleteCmd)
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var ticketHistoryShowDiffs bool
+
+var ticketHistoryCmd = &cobra.Command{
+	Use:   "history <id>",
+	Short: "Show a ticket's revision history",
+	Long: `Show every recorded change to a ticket, oldest first: who made it, which
+fields changed, and (with --diff) the body diff.
+
+Restore an earlier body with: cortex ticket restore <id> <revision>`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ensureDaemon()
+
+		architectPath, err := resolveArchitectPath("")
+		if err != nil {
+			exitWithError(err)
+		}
+
+		client := sdk.DefaultClient(architectPath).WithActor(sdk.ActorCLI)
+		resp, err := client.GetTicketHistory(args[0])
+		if err != nil {
+			exitWithError(err)
+		}
+
+		printOutput(resp, func() {
+			if len(resp.Revisions) == 0 {
+				fmt.Println("No history recorded.")
+				return
+			}
+
+			for _, rev := range resp.Revisions {
+				actor := rev.Actor
+				if rev.ActorSessionID != "" {
+					actor += " " + rev.ActorSessionID
+				}
+				action := rev.Action
+				if rev.RestoredFrom > 0 {
+					action += fmt.Sprintf(" from #%d", rev.RestoredFrom)
+				}
+				fmt.Printf("#%d  %s  %s  (%s)\n", rev.Seq, rev.Time.Local().Format("2006-01-02 15:04:05"), action, actor)
+				for _, c := range rev.Changes {
+					fmt.Printf("    %s: %s → %s\n", c.Field, orDash(c.Old), orDash(c.New))
+				}
+				if rev.BodyDiff == "" {
+					continue
+				}
+				if !ticketHistoryShowDiffs {
+					added, removed := diffStat(rev.BodyDiff)
+					fmt.Printf("    body: +%d -%d\n", added, removed)
+					continue
+				}
+				for _, line := range strings.Split(strings.TrimSuffix(rev.BodyDiff, "\n"), "\n") {
+					fmt.Printf("    %s\n", line)
+				}
+			}
+		})
+	},
+}
+
+var ticketRestoreCmd = &cobra.Command{
+	Use:   "restore <id> <revision>",
+	Short: "Restore a ticket's body from an earlier revision",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		revision, err := strconv.Atoi(args[1])
+		if err != nil || revision < 1 {
+			exitWithError(usageErrorf("revision must be a positive integer"))
+		}
+
+		ensureDaemon()
+
+		architectPath, err := resolveArchitectPath("")
+		if err != nil {
+			exitWithError(err)
+		}
+
+		client := sdk.DefaultClient(architectPath).WithActor(sdk.ActorCLI)
+		t, err := client.RestoreTicketBody(args[0], revision)
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(t, func() {
+			fmt.Printf("Restored body of %s from revision #%d\n", t.ID, revision)
+		})
+	},
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// diffStat counts added and removed lines in a unified diff.
+func diffStat(diff string) (added, removed int) {
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			removed++
+		}
+	}
+	return added, removed
+}
+
+func init() {
+	ticketHistoryCmd.Flags().BoolVar(&ticketHistoryShowDiffs, "diff", false, "Show full body diffs")
+	ticketCmd.AddCommand(ticketHistoryCmd)
+	ticketCmd.AddCommand(ticketRestoreCmd)
+}
@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ticketListStatus    string
+	ticketListRepo      string
+	ticketListQuery     string
+	ticketListDueBefore string
+	ticketListLabels    []string
+	ticketListPriority  []string
+	ticketListAssignee  string
+	ticketListSort      string
+)
+
+var ticketListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List tickets",
+	Long: `List tickets across all statuses, in board order.
+
+Filters combine: --status limits to one column, --repo to tickets touching
+a repo key, --query to titles and bodies containing the text, --due-before
+to tickets due before a date, --label to tickets carrying every label,
+--priority to any of the priorities and --assignee to one assignee.
+--sort orders each column by created (the default), updated, priority or
+due.
+
+  cortex ticket list --status progress --output json
+  cortex ticket list --label bug --priority urgent,high --sort priority`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var dueBefore *time.Time
+		if ticketListDueBefore != "" {
+			parsed, err := parseDueFlag(ticketListDueBefore)
+			if err != nil {
+				exitWithError(err)
+			}
+			dueBefore = &parsed
+		}
+
+		filter := sdk.TicketFilter{
+			Query:      ticketListQuery,
+			DueBefore:  dueBefore,
+			Labels:     ticketListLabels,
+			Priorities: ticketListPriority,
+			Assignee:   ticketListAssignee,
+			Sort:       ticketListSort,
+		}
+		client := ticketClient()
+
+		var tickets []sdk.TicketSummary
+		if ticketListStatus != "" {
+			resp, err := client.FilterTicketsByStatus(ticketListStatus, filter)
+			if err != nil {
+				exitWithError(err)
+			}
+			tickets = resp.Tickets
+		} else {
+			resp, err := client.FilterTickets(filter)
+			if err != nil {
+				exitWithError(err)
+			}
+			tickets = flattenTicketColumns(resp)
+		}
+		tickets = filterTicketsByRepo(tickets, ticketListRepo)
+		if tickets == nil {
+			tickets = []sdk.TicketSummary{}
+		}
+
+		printOutput(sdk.ListTicketsResponse{Tickets: tickets}, func() {
+			if len(tickets) == 0 {
+				fmt.Println("No tickets.")
+				return
+			}
+			printTicketTable(tickets)
+		})
+	},
+}
+
+// flattenTicketColumns returns the tickets of every column in board order.
+func flattenTicketColumns(resp *sdk.ListAllTicketsResponse) []sdk.TicketSummary {
+	if len(resp.Columns) == 0 {
+		return slices.Concat(resp.Backlog, resp.Progress, resp.Done)
+	}
+	var tickets []sdk.TicketSummary
+	for _, col := range resp.Columns {
+		tickets = append(tickets, col.Tickets...)
+	}
+	return tickets
+}
+
+// filterTicketsByRepo keeps the tickets that touch repo. An empty repo
+// keeps them all.
+func filterTicketsByRepo(tickets []sdk.TicketSummary, repo string) []sdk.TicketSummary {
+	if repo == "" {
+		return tickets
+	}
+	var kept []sdk.TicketSummary
+	for _, t := range tickets {
+		if t.Repo == repo || slices.Contains(t.Repos, repo) {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func printTicketTable(tickets []sdk.TicketSummary) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ID\tSTATUS\tPRIORITY\tTITLE\tREPO\tASSIGNEE\tDUE\tSESSION")
+	for _, t := range tickets {
+		repo := t.Repo
+		if len(t.Repos) > 0 {
+			repo = fmt.Sprintf("%s +%d", t.Repos[0], len(t.Repos)-1)
+		}
+		due := "-"
+		if t.Due != nil {
+			due = t.Due.Local().Format(time.DateOnly)
+		}
+		session := "-"
+		switch {
+		case t.IsOrphaned:
+			session = "orphaned"
+		case t.AgentStatus != nil:
+			session = *t.AgentStatus
+		case t.HasActiveSession:
+			session = "active"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			t.ID, t.Status, orDash(t.Priority), t.Title, orDash(repo), orDash(t.Assignee), due, session)
+	}
+	_ = tw.Flush()
+}
+
+func init() {
+	ticketListCmd.Flags().StringVar(&ticketListStatus, "status", "", "Only list tickets with this status")
+	ticketListCmd.Flags().StringVar(&ticketListRepo, "repo", "", "Only list tickets touching this repo key")
+	ticketListCmd.Flags().StringVarP(&ticketListQuery, "query", "q", "", "Only list tickets whose title or body contains the text")
+	ticketListCmd.Flags().StringVar(&ticketListDueBefore, "due-before", "", "Only list tickets due before this date (RFC3339 or YYYY-MM-DD)")
+	ticketListCmd.Flags().StringSliceVar(&ticketListLabels, "label", nil, "Only list tickets carrying every label (comma-separated)")
+	ticketListCmd.Flags().StringSliceVar(&ticketListPriority, "priority", nil, "Only list tickets with one of these priorities (comma-separated)")
+	ticketListCmd.Flags().StringVar(&ticketListAssignee, "assignee", "", "Only list tickets assigned to this person or agent variant")
+	ticketListCmd.Flags().StringVar(&ticketListSort, "sort", "", "Order by created, updated, priority or due")
+	ticketCmd.AddCommand(ticketListCmd)
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+)
+
+func TestFilterTicketsByRepo(t *testing.T) {
+	tickets := []sdk.TicketSummary{
+		{ID: "a", Repo: "api"},
+		{ID: "b", Repo: "web"},
+		{ID: "c", Repos: []string{"web", "api"}},
+	}
+
+	got := filterTicketsByRepo(tickets, "api")
+	if len(got) != 2 || got[0].ID != "a" || got[1].ID != "c" {
+		t.Fatalf("unexpected tickets for api: %+v", got)
+	}
+	if got := filterTicketsByRepo(tickets, ""); len(got) != 3 {
+		t.Fatalf("expected no filtering without a repo, got %d tickets", len(got))
+	}
+}
+
+func TestWriteOutputYAMLUsesJSONKeys(t *testing.T) {
+	resp := sdk.ListTicketsResponse{Tickets: []sdk.TicketSummary{{ID: "a", Title: "Fix: login", Status: "backlog"}}}
+
+	var buf bytes.Buffer
+	if err := writeOutput(&buf, outputYAML, resp, func() { t.Fatal("table called for yaml") }); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"tickets:\n", "  - id: a\n", `title: 'Fix: login'`, "has_active_session: false"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in YAML output:\n%s", want, out)
+		}
+	}
+
+	if err := checkOutputFormat("xml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ticketNewTemplate string
+	ticketNewVars     []string
+	ticketNewRepo     string
+	ticketNewType     string
+	ticketNewBody     string
+	ticketNewDue      string
+	ticketNewPriority string
+	ticketNewLabels   []string
+	ticketNewAssignee string
+)
+
+var ticketNewCmd = &cobra.Command{
+	Use:     "new [title]",
+	Aliases: []string{"create"},
+	Short:   "Create a ticket, optionally from a template",
+	Long: `Create a ticket in backlog.
+
+With --template, the named file under the architect's templates/ directory
+supplies the defaults: title, body, type, repo, references and due date.
+Flags and the title argument override them. Template variables are set
+with --var, once per variable:
+
+  cortex ticket new --template bug --var component=parser --var steps="run it"
+
+List templates and their variables with: cortex ticket templates`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var title string
+		if len(args) == 1 {
+			title = args[0]
+		}
+		if title == "" && ticketNewTemplate == "" {
+			exitWithError(usageErrorf("a title is required without --template"))
+		}
+
+		vars, err := parseTemplateVars(ticketNewVars)
+		if err != nil {
+			exitWithError(err)
+		}
+		var due *time.Time
+		if ticketNewDue != "" {
+			parsed, err := parseDueFlag(ticketNewDue)
+			if err != nil {
+				exitWithError(err)
+			}
+			due = &parsed
+		}
+
+		ensureDaemon()
+
+		architectPath, err := resolveArchitectPath("")
+		if err != nil {
+			exitWithError(err)
+		}
+
+		client := sdk.DefaultClient(architectPath).WithActor(sdk.ActorCLI)
+		t, err := client.CreateTicketWithParams(sdk.CreateTicketParams{
+			Template: ticketNewTemplate,
+			Vars:     vars,
+			Title:    title,
+			Body:     ticketNewBody,
+			Type:     ticketNewType,
+			Repo:     ticketNewRepo,
+			DueDate:  due,
+			Priority: ticketNewPriority,
+			Labels:   ticketNewLabels,
+			Assignee: ticketNewAssignee,
+		})
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(t, func() {
+			fmt.Printf("Created %s: %s\n", t.ID, t.Title)
+		})
+	},
+}
+
+var ticketTemplatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List ticket templates",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ensureDaemon()
+
+		architectPath, err := resolveArchitectPath("")
+		if err != nil {
+			exitWithError(err)
+		}
+
+		client := sdk.DefaultClient(architectPath)
+		resp, err := client.ListTemplates()
+		if err != nil {
+			exitWithError(err)
+		}
+
+		printOutput(resp, func() {
+			if len(resp.Templates) == 0 {
+				fmt.Printf("No templates. Add markdown files to %s\n", resp.Dir)
+				return
+			}
+			for _, t := range resp.Templates {
+				fmt.Printf("%s %s", bullet(), t.Name)
+				if t.Description != "" {
+					fmt.Printf("  %s", t.Description)
+				}
+				fmt.Println()
+				if len(t.Variables) > 0 {
+					fmt.Printf("    vars: %s\n", strings.Join(t.Variables, ", "))
+				}
+			}
+		})
+	},
+}
+
+// parseTemplateVars turns key=value flags into a map.
+func parseTemplateVars(pairs []string) (map[string]string, error) {
+	vars := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, usageErrorf("invalid --var %q: expected key=value", pair)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// parseDueFlag accepts an RFC3339 timestamp or a plain date.
+func parseDueFlag(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		return time.Time{}, usageErrorf("invalid date %q: expected RFC3339 or YYYY-MM-DD", s)
+	}
+	return t, nil
+}
+
+func init() {
+	ticketNewCmd.Flags().StringVarP(&ticketNewTemplate, "template", "t", "", "Template name from the architect's templates/ directory")
+	ticketNewCmd.Flags().StringArrayVar(&ticketNewVars, "var", nil, "Template variable as key=value (repeatable)")
+	ticketNewCmd.Flags().StringVar(&ticketNewRepo, "repo", "", "Repo key from cortex.yaml")
+	ticketNewCmd.Flags().StringVar(&ticketNewType, "type", "", "Ticket type from cortex.yaml")
+	ticketNewCmd.Flags().StringVar(&ticketNewBody, "body", "", "Ticket body, replacing the template's")
+	ticketNewCmd.Flags().StringVar(&ticketNewDue, "due", "", "Due date (RFC3339 or YYYY-MM-DD)")
+	ticketNewCmd.Flags().StringVar(&ticketNewPriority, "priority", "", "Priority: urgent, high, medium or low")
+	ticketNewCmd.Flags().StringSliceVar(&ticketNewLabels, "labels", nil, "Labels (comma-separated)")
+	ticketNewCmd.Flags().StringVar(&ticketNewAssignee, "assignee", "", "Person or agent variant the ticket is assigned to")
+	ticketCmd.AddCommand(ticketNewCmd)
+	ticketCmd.AddCommand(ticketTemplatesCmd)
+}
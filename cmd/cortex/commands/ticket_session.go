@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ticketSpawnVariant string
+	ticketSpawnMode    string
+	ticketSpawnBackend string
+	ticketSpawnForce   bool
+
+	ticketConcludeBody            string
+	ticketConcludeBodyFile        string
+	ticketConcludeCommits         []string
+	ticketConcludeReject          string
+	ticketConcludeRepo            string
+	ticketConcludeCleanupWorktree bool
+)
+
+var ticketSpawnCmd = &cobra.Command{
+	Use:   "spawn <id>",
+	Short: "Spawn a worker session for a ticket",
+	Long: `Spawn a worker agent session for a ticket, like the kanban's spawn key.
+
+--mode picks what happens to an existing session: normal refuses an
+orphaned one, resume continues it and fresh starts over. When a repo's
+max_concurrent limit is reached, the spawn is queued instead.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		switch ticketSpawnMode {
+		case "normal", "resume", "fresh":
+		default:
+			exitWithError(usageErrorf("invalid --mode %q: expected normal, resume or fresh", ticketSpawnMode))
+		}
+
+		client := ticketClient()
+
+		t, err := client.GetTicketByID(args[0])
+		if err != nil {
+			exitWithError(err)
+		}
+		result, err := client.SpawnSession(t.Status, t.ID, ticketSpawnMode, ticketSpawnVariant, ticketSpawnBackend, ticketSpawnForce)
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(result, func() {
+			if result.Queue != nil {
+				fmt.Printf("Queued %s at position %d (%s)\n", t.ID, result.Queue.Position, result.Queue.Reason)
+				return
+			}
+			sessions := result.Sessions
+			if len(sessions) == 0 && result.Session != nil {
+				sessions = []sdk.SessionResponse{*result.Session}
+			}
+			fmt.Printf("Spawned %s\n", t.ID)
+			for _, s := range sessions {
+				line := fmt.Sprintf("  %s %s (%s)", bullet(), s.TmuxWindow, s.Agent)
+				if s.Repo != "" {
+					line += " in " + s.Repo
+				}
+				fmt.Println(line)
+			}
+		})
+	},
+}
+
+var ticketKillCmd = &cobra.Command{
+	Use:   "kill <id>",
+	Short: "Stop a ticket's worker sessions",
+	Long: `Stop every worker session of a ticket, or cancel its queued spawn.
+The ticket keeps its status.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := ticketClient()
+
+		t, err := client.GetTicketByID(args[0])
+		if err != nil {
+			exitWithError(err)
+		}
+		resp, err := client.ListSessions()
+		if err != nil {
+			exitWithError(err)
+		}
+
+		var killed []sdk.SessionListItem
+		for _, s := range resp.Sessions {
+			if s.TicketID != t.ID || s.SessionType != "ticket" {
+				continue
+			}
+			if s.Status == sdk.SessionStatusQueued {
+				// Each call drops one queued entry of the ticket.
+				err = client.CancelQueuedSpawn(t.ID)
+			} else {
+				err = client.KillSession(s.SessionID)
+			}
+			if err != nil {
+				exitWithError(err)
+			}
+			killed = append(killed, s)
+		}
+		if len(killed) == 0 {
+			exitWithError(notFoundErrorf("ticket %s has no running or queued session", t.ID))
+		}
+
+		printOutput(sdk.ListSessionsResponse{Sessions: killed, Total: len(killed)}, func() {
+			for _, s := range killed {
+				if s.Status == sdk.SessionStatusQueued {
+					fmt.Printf("Cancelled queued spawn of %s\n", t.ID)
+					continue
+				}
+				fmt.Printf("Killed %s (%s)\n", s.TmuxWindow, s.Agent)
+			}
+		})
+	},
+}
+
+var ticketConcludeCmd = &cobra.Command{
+	Use:   "conclude <id>",
+	Short: "Record a ticket's conclusion and move it on",
+	Long: `Conclude a ticket the way a worker does: record the conclusion body and
+the commits it produced, end its session and move it to review or done.
+With --reject, the conclusion is recorded as rejected with the reason,
+and the ticket skips review.
+
+  cortex ticket conclude <id> --body-file summary.md --commit abc123`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := cmd.Flags()
+		if flags.Changed("body") == flags.Changed("body-file") {
+			exitWithError(usageErrorf("pass exactly one of --body or --body-file"))
+		}
+		body := ticketConcludeBody
+		if flags.Changed("body-file") {
+			content, err := readBodyFile(ticketConcludeBodyFile)
+			if err != nil {
+				exitWithError(err)
+			}
+			body = content
+		}
+
+		client := ticketClient()
+
+		resp, err := client.ConcludeSession(sdk.ConcludeSessionParams{
+			TicketID:        args[0],
+			Body:            body,
+			Commits:         ticketConcludeCommits,
+			Rejected:        ticketConcludeReject != "",
+			RejectionReason: ticketConcludeReject,
+			CleanupWorktree: ticketConcludeCleanupWorktree,
+			Repo:            ticketConcludeRepo,
+		})
+		if err != nil {
+			exitWithError(err)
+		}
+		printOutput(resp, func() {
+			fmt.Println(resp.Message)
+		})
+	},
+}
+
+func init() {
+	ticketSpawnCmd.Flags().StringVar(&ticketSpawnVariant, "variant", "", "Agent variant from cortex.yaml")
+	ticketSpawnCmd.Flags().StringVar(&ticketSpawnMode, "mode", "normal", "Existing session handling: normal, resume or fresh")
+	ticketSpawnCmd.Flags().StringVar(&ticketSpawnBackend, "backend", "", "Session backend: tmux or headless (default: the architect's session_backend)")
+	ticketSpawnCmd.Flags().BoolVar(&ticketSpawnForce, "force", false, "Spawn even if the ticket has open blockers")
+
+	ticketConcludeCmd.Flags().StringVar(&ticketConcludeBody, "body", "", "Conclusion body")
+	ticketConcludeCmd.Flags().StringVar(&ticketConcludeBodyFile, "body-file", "", "Read the conclusion body from a file, or stdin with -")
+	ticketConcludeCmd.Flags().StringArrayVar(&ticketConcludeCommits, "commit", nil, "Commit produced by the ticket (repeatable)")
+	ticketConcludeCmd.Flags().StringVar(&ticketConcludeReject, "reject", "", "Conclude without changes, recording this reason")
+	ticketConcludeCmd.Flags().StringVar(&ticketConcludeRepo, "repo", "", "Repo concluded, for multi-repo tickets")
+	ticketConcludeCmd.Flags().BoolVar(&ticketConcludeCleanupWorktree, "cleanup-worktree", false, "Remove the ticket's isolated git worktree")
+
+	ticketCmd.AddCommand(ticketSpawnCmd)
+	ticketCmd.AddCommand(ticketKillCmd)
+	ticketCmd.AddCommand(ticketConcludeCmd)
+}
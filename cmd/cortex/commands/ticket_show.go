@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kareemaly/cortex/internal/cli/sdk"
@@ -15,23 +16,31 @@ import (
 var ticketShowCmd = &cobra.Command{
 	Use:   "show <ticket-id>",
 	Short: "Open a read-only ticket detail viewer",
-	Args:  cobra.ExactArgs(1),
+	Long: `Open a read-only ticket detail viewer.
+
+With --no-tui, --output json|yaml or when stdout is not a terminal, print
+the ticket instead.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		ensureDaemon()
 
 		architectPath, err := resolveArchitectPath("")
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 
-		client := sdk.DefaultClient(architectPath)
+		client := sdk.DefaultClient(architectPath).WithActor(sdk.ActorTUI)
 		ticketID := args[0]
 
 		initial, err := loadTicketDetail(client, ticketID)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
+		}
+		if !useTUI() {
+			printOutput(initial.Ticket, func() {
+				printDetailTabs(os.Stdout, initial.Title, initial.Subtitle, initial.Tabs)
+			})
+			return
 		}
 
 		var program *tea.Program
@@ -73,11 +82,11 @@ var ticketShowCmd = &cobra.Command{
 				}
 				return detail.ChangesLoaded(buildChangesData(diffsResp), nil)
 			}),
+			detail.WithReview(reviewActions(client, ticketID)),
 		)
 		program = tea.NewProgram(model, tea.WithAltScreen())
 		if _, err := program.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			exitWithError(err)
 		}
 	},
 }
@@ -100,6 +109,7 @@ func fetchTicketSummary(client *sdk.Client, ticketResp *sdk.TicketResponse) (*sd
 }
 
 type ticketDetailData struct {
+	Ticket   *sdk.TicketResponse
 	Title    string
 	Subtitle string
 	Tabs     []detail.Tab
@@ -123,9 +133,25 @@ func loadTicketDetail(client *sdk.Client, ticketID string) (ticketDetailData, er
 		}
 	}
 
+	tabs := buildTicketTabs(ticketResp, ticketSummary, conclusionResp, conclusionWarning)
+
+	// The active session's timeline, or else the one that concluded.
+	var sessionID string
+	if ticketSummary != nil && ticketSummary.SessionID != "" {
+		sessionID = ticketSummary.SessionID
+	} else if conclusionResp != nil {
+		sessionID = conclusionResp.SessionID
+	}
+	if sessionID != "" {
+		if timeline, err := client.GetSessionTimeline(sessionID); err == nil && len(timeline.Entries) > 0 {
+			tabs = append(tabs, detail.Tab{Label: "Timeline", Content: buildTimelineTab(timeline), Kind: detail.TabKindMarkdown})
+		}
+	}
+
 	return ticketDetailData{
+		Ticket:   ticketResp,
 		Title:    ticketResp.Title,
-		Tabs:     buildTicketTabs(ticketResp, ticketSummary, conclusionResp, conclusionWarning),
+		Tabs:     tabs,
 		FilePath: ticketResp.FilePath,
 	}, nil
 }
@@ -161,16 +187,47 @@ func buildTicketOverview(ticketResp *sdk.TicketResponse, ticketSummary *sdk.Tick
 	var b strings.Builder
 	b.WriteString("## Ticket\n")
 	b.WriteString(fmt.Sprintf("- ID: `%s`\n", ticketResp.ID))
+	b.WriteString(fmt.Sprintf("- Type: `%s`\n", emptyDash(ticketResp.Type)))
 	b.WriteString(fmt.Sprintf("- Status: `%s`\n", ticketResp.Status))
 	b.WriteString(fmt.Sprintf("- Repo: `%s`\n", emptyDash(ticketResp.Repo)))
 	b.WriteString(fmt.Sprintf("- Created: %s\n", formatDetailTime(ticketResp.Created)))
 	b.WriteString(fmt.Sprintf("- Updated: %s\n", formatDetailTime(ticketResp.Updated)))
 	b.WriteString(fmt.Sprintf("- Due: %s\n", formatDetailOptionalTime(ticketResp.Due)))
 
+	if len(ticketResp.Criteria) > 0 {
+		done := 0
+		for _, c := range ticketResp.Criteria {
+			if c.Checked {
+				done++
+			}
+		}
+		b.WriteString(fmt.Sprintf("\n## Acceptance Criteria (%d/%d)\n", done, len(ticketResp.Criteria)))
+		for _, c := range ticketResp.Criteria {
+			mark := " "
+			if c.Checked {
+				mark = "x"
+			}
+			b.WriteString(fmt.Sprintf("- [%s] %d. %s\n", mark, c.Index, c.Text))
+			if c.Evidence != "" {
+				b.WriteString(fmt.Sprintf("  - Evidence: %s\n", c.Evidence))
+			}
+		}
+	}
+
 	b.WriteString("\n## References\n")
 	b.WriteString(markdownList(ticketResp.References, "- none"))
 	b.WriteString("\n")
 
+	b.WriteString("\n## Dependencies\n")
+	if ticketSummary != nil && ticketSummary.IsBlocked {
+		b.WriteString("- Blocked: yes\n")
+	}
+	b.WriteString("### Blocked by\n")
+	b.WriteString(markdownList(ticketResp.BlockedBy, "- none"))
+	b.WriteString("\n### Blocks\n")
+	b.WriteString(markdownList(ticketResp.Blocks, "- none"))
+	b.WriteString("\n")
+
 	b.WriteString("\n## Active Session\n")
 	if ticketSummary == nil {
 		b.WriteString("- State: unavailable\n")
@@ -187,6 +244,9 @@ func buildTicketOverview(ticketResp *sdk.TicketResponse, ticketSummary *sdk.Tick
 		}
 		b.WriteString(fmt.Sprintf("- Orphaned: %s\n", yesNo(ticketSummary.IsOrphaned)))
 		b.WriteString(fmt.Sprintf("- Started: %s\n", formatDetailOptionalTime(ticketSummary.SessionStartedAt)))
+		if ticketSummary.WorktreePath != "" {
+			b.WriteString(fmt.Sprintf("- Worktree: `%s`\n", ticketSummary.WorktreePath))
+		}
 	}
 
 	b.WriteString("\n## Linked Conclusion\n")
@@ -205,6 +265,9 @@ func buildTicketOverview(ticketResp *sdk.TicketResponse, ticketSummary *sdk.Tick
 			if len(conclusionResp.Commits) > 0 {
 				b.WriteString(fmt.Sprintf("- Commits: %d\n", len(conclusionResp.Commits)))
 			}
+			for _, v := range conclusionResp.Verifications {
+				b.WriteString(fmt.Sprintf("- Verification (%s): %s\n", v.Repo, v.Status))
+			}
 		} else if conclusionWarning != "" {
 			b.WriteString(fmt.Sprintf("- Load error: %s\n", conclusionWarning))
 		}
@@ -232,12 +295,74 @@ func buildLinkedConclusionTab(ticketResp *sdk.TicketResponse, conclusionResp *sd
 
 	b.WriteString("\n## Commits\n")
 	b.WriteString(markdownList(conclusionResp.Commits, "- none"))
+	if len(conclusionResp.Verifications) > 0 {
+		b.WriteString("\n\n## Verification\n")
+		b.WriteString(formatVerifications(conclusionResp.Verifications))
+	}
 	b.WriteString("\n\n## Body\n\n")
 	b.WriteString(bodyContent(conclusionResp.Body, "conclusion"))
 
 	return b.String()
 }
 
+// formatVerifications lists each verify run with its commands, and the
+// output of the command that failed.
+func formatVerifications(verifications []sdk.VerificationResponse) string {
+	var b strings.Builder
+	for i, v := range verifications {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(fmt.Sprintf("\n### %s — %s\n", v.Repo, v.Status))
+		b.WriteString(fmt.Sprintf("- Commit: `%s`\n", v.Commit))
+		if v.Error != "" {
+			b.WriteString(fmt.Sprintf("- Error: %s\n", v.Error))
+		}
+		var failed *sdk.VerifyCommandResponse
+		for j, c := range v.Commands {
+			result := "ok"
+			switch {
+			case c.TimedOut:
+				result = "timed out"
+			case c.ExitCode != 0:
+				result = fmt.Sprintf("exit %d", c.ExitCode)
+			}
+			if result != "ok" {
+				failed = &v.Commands[j]
+			}
+			b.WriteString(fmt.Sprintf("- `%s` — %s in %s\n", c.Command, result, time.Duration(c.DurationMS)*time.Millisecond))
+		}
+		if failed != nil && failed.Output != "" {
+			b.WriteString("\n```\n" + strings.TrimRight(failed.Output, "\n") + "\n```\n")
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func buildTimelineTab(timeline *sdk.SessionTimelineResponse) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("## Session `%s`\n", shortID(timeline.SessionID)))
+	if n := len(timeline.Entries); n > 1 {
+		b.WriteString(fmt.Sprintf("- Duration: %s\n", formatDetailDuration(timeline.Entries[0].Time, timeline.Entries[n-1].Time)))
+	}
+	b.WriteString(fmt.Sprintf("- Events: %d\n\n", len(timeline.Entries)))
+
+	for _, e := range timeline.Entries {
+		b.WriteString(fmt.Sprintf("- %s `%s`", e.Time.Local().Format("15:04:05"), emptyDash(e.Status)))
+		if e.Tool != "" {
+			b.WriteString(fmt.Sprintf(" **%s**", e.Tool))
+		}
+		if msg := strings.Join(strings.Fields(e.Message), " "); msg != "" {
+			if r := []rune(msg); len(r) > 120 {
+				msg = string(r[:117]) + "..."
+			}
+			b.WriteString(" — " + msg)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 func bodyContent(content, label string) string {
 	trimmed := strings.TrimSpace(content)
 	if trimmed == "" {
@@ -258,12 +383,30 @@ func buildChangesData(resp *sdk.DiffsResponse) *detail.ChangesData {
 		return nil
 	}
 
-	changes := &detail.ChangesData{
-		Repo:    resp.Repo,
-		Commits: make([]detail.ChangeCommit, 0, len(resp.Commits)),
+	// A multi-repo ticket's commits are listed repo by repo, each subject
+	// tagged with its repo.
+	groups := []sdk.RepoDiffsResponse{{Path: resp.Repo, Commits: resp.Commits}}
+	if len(resp.Repos) > 1 {
+		groups = resp.Repos
 	}
 
-	for _, commit := range resp.Commits {
+	changes := &detail.ChangesData{Repo: resp.Repo}
+	var repos []string
+	for _, g := range groups {
+		if len(groups) > 1 {
+			repos = append(repos, g.Repo)
+		}
+		changes.Commits = append(changes.Commits, changeCommits(g, len(groups) > 1)...)
+	}
+	if len(repos) > 0 {
+		changes.Repo = strings.Join(repos, ", ")
+	}
+	return changes
+}
+
+func changeCommits(g sdk.RepoDiffsResponse, tagRepo bool) []detail.ChangeCommit {
+	commits := make([]detail.ChangeCommit, 0, len(g.Commits))
+	for _, commit := range g.Commits {
 		files := make([]detail.ChangeFile, 0, len(commit.Files))
 		for _, file := range commit.Files {
 			files = append(files, detail.ChangeFile{
@@ -277,16 +420,20 @@ func buildChangesData(resp *sdk.DiffsResponse) *detail.ChangesData {
 			})
 		}
 
-		changes.Commits = append(changes.Commits, detail.ChangeCommit{
+		subject := commit.Subject
+		if tagRepo {
+			subject = "[" + g.Repo + "] " + subject
+		}
+		commits = append(commits, detail.ChangeCommit{
+			Repo:       g.Repo,
 			SHA:        commit.SHA,
-			Subject:    commit.Subject,
+			Subject:    subject,
 			AuthorName: commit.AuthorName,
 			AuthoredAt: commit.AuthoredAt,
 			Files:      files,
 		})
 	}
-
-	return changes
+	return commits
 }
 
 func stringOrEmpty(value *string) string {
@@ -295,3 +442,71 @@ func stringOrEmpty(value *string) string {
 	}
 	return *value
 }
+
+// reviewActions wires the Changes tab's review keys to the daemon.
+func reviewActions(client *sdk.Client, ticketID string) detail.ReviewActions {
+	return detail.ReviewActions{
+		Load: func() tea.Msg {
+			resp, err := client.GetReview(ticketID)
+			if err != nil {
+				return detail.ReviewLoaded(nil, err)
+			}
+			return detail.ReviewLoaded(buildReviewData(resp), nil)
+		},
+		Comment: func(c detail.ReviewComment) tea.Cmd {
+			return func() tea.Msg {
+				resp, err := client.AddReviewComment(sdk.ReviewCommentParams{
+					TicketID: ticketID,
+					Repo:     c.Repo,
+					Commit:   c.Commit,
+					File:     c.File,
+					Line:     c.Line,
+					Body:     c.Body,
+				})
+				if err != nil {
+					return detail.ReviewActionFinished("", err)
+				}
+				return detail.ReviewActionFinished("Added comment "+resp.ID, nil)
+			}
+		},
+		Accept: func(summary string) tea.Cmd {
+			return func() tea.Msg {
+				resp, err := client.AcceptReview(ticketID, summary)
+				if err != nil {
+					return detail.ReviewActionFinished("", err)
+				}
+				return detail.ReviewActionFinished(resp.Message, nil)
+			}
+		},
+		RequestChanges: func(summary string) tea.Cmd {
+			return func() tea.Msg {
+				resp, err := client.RequestChanges(sdk.RequestChangesParams{TicketID: ticketID, Summary: summary})
+				if err != nil {
+					return detail.ReviewActionFinished("", err)
+				}
+				return detail.ReviewActionFinished(resp.Message, nil)
+			}
+		},
+	}
+}
+
+func buildReviewData(resp *sdk.ReviewResponse) *detail.ReviewData {
+	review := &detail.ReviewData{
+		State:   resp.State,
+		Round:   resp.Round,
+		Summary: resp.Summary,
+	}
+	for _, c := range resp.Comments {
+		review.Comments = append(review.Comments, detail.ReviewComment{
+			ID:     c.ID,
+			Round:  c.Round,
+			Repo:   c.Repo,
+			Commit: c.Commit,
+			File:   c.File,
+			Line:   c.Line,
+			Body:   c.Body,
+			Author: c.Author,
+		})
+	}
+	return review
+}
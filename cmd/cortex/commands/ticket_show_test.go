@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -22,6 +23,21 @@ func TestBuildTicketTabsIncludesChangesOnlyWhenConclusionHasCommits(t *testing.T
 	}
 }
 
+func TestBuildTicketOverviewShowsWorktree(t *testing.T) {
+	ticket := &sdk.TicketResponse{ID: "t1", Title: "Ticket", Status: "progress"}
+	summary := &sdk.TicketSummary{ID: "t1", HasActiveSession: true, WorktreePath: "/work/worktrees/api/t1"}
+
+	overview := buildTicketOverview(ticket, summary, nil, "")
+	if !strings.Contains(overview, "- Worktree: `/work/worktrees/api/t1`") {
+		t.Fatalf("expected worktree line in overview, got:\n%s", overview)
+	}
+
+	summary.WorktreePath = ""
+	if strings.Contains(buildTicketOverview(ticket, summary, nil, ""), "Worktree") {
+		t.Fatal("expected no worktree line without an isolated worktree")
+	}
+}
+
 func TestBuildChangesDataMapsResponse(t *testing.T) {
 	oldPath := "old.txt"
 	authoredAt := time.Date(2026, 5, 9, 12, 34, 56, 0, time.UTC)
@@ -71,3 +87,35 @@ func hasTabKind(tabs []detail.Tab, kind detail.TabKind) bool {
 	}
 	return false
 }
+
+func TestBuildTimelineTab(t *testing.T) {
+	start := time.Date(2026, 5, 9, 12, 0, 0, 0, time.UTC)
+	timeline := &sdk.SessionTimelineResponse{
+		SessionID: "0123456789abcdef",
+		Entries: []sdk.TimelineEntryResponse{
+			{Time: start, Status: "working", Tool: "Bash", Message: "go test\n./..."},
+			{Time: start.Add(90 * time.Second), Status: "idle"},
+		},
+	}
+
+	content := buildTimelineTab(timeline)
+	for _, want := range []string{"## Session `01234567`", "- Duration: 1m 30s", "- Events: 2", "**Bash** — go test ./...", "`idle`"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in timeline tab, got:\n%s", want, content)
+		}
+	}
+}
+
+func TestBuildTicketOverviewShowsCriteria(t *testing.T) {
+	ticket := &sdk.TicketResponse{ID: "t1", Title: "Ticket", Criteria: []sdk.CriterionResponse{
+		{Index: 1, Text: "parses input", Checked: true, Evidence: "TestParse"},
+		{Index: 2, Text: "reports errors"},
+	}}
+
+	overview := buildTicketOverview(ticket, nil, nil, "")
+	for _, want := range []string{"## Acceptance Criteria (1/2)", "- [x] 1. parses input\n  - Evidence: TestParse", "- [ ] 2. reports errors"} {
+		if !strings.Contains(overview, want) {
+			t.Errorf("expected %q in overview, got:\n%s", want, overview)
+		}
+	}
+}
@@ -84,7 +84,7 @@ func runUpgradeDryRunAll(homeDir string) error {
 	}
 
 	// Show project migration preview
-	results, err := install.MigrateAllProjects()
+	results, err := install.MigrateAllProjects(true)
 	if err == nil && len(results) > 0 {
 		needsMigration := false
 		for _, r := range results {
@@ -106,7 +106,7 @@ func runUpgradeDryRunAll(homeDir string) error {
 				} else if r.Skipped {
 					fmt.Printf("  %s %s — %s\n", bullet(), name, r.SkipReason)
 				} else {
-					fmt.Printf("  %s %s — would migrate (agent: %s)\n", bullet(), name, r.DetectedAgent)
+					fmt.Printf("  %s %s — would migrate (%s)\n", bullet(), name, r.Summary())
 				}
 			}
 			fmt.Println()
@@ -253,7 +253,7 @@ func runUpgradeApplyAll(homeDir string) error {
 	}
 
 	// Migrate project configs before removing legacy directories
-	migrationResults, migErr := install.MigrateAllProjects()
+	migrationResults, migErr := install.MigrateAllProjects(false)
 	if migErr == nil && len(migrationResults) > 0 {
 		var migrated, skipped, errored int
 		for _, r := range migrationResults {
@@ -277,7 +277,7 @@ func runUpgradeApplyAll(homeDir string) error {
 				if r.Error != nil {
 					fmt.Printf("  %s %s — error: %v\n", crossMark(), name, r.Error)
 				} else if r.Migrated {
-					fmt.Printf("  %s %s — migrated (agent: %s)\n", checkMark(), name, r.DetectedAgent)
+					fmt.Printf("  %s %s — migrated (%s)\n", checkMark(), name, r.Summary())
 				}
 			}
 			if skipped > 0 {
@@ -13,25 +13,49 @@ var versionCmd = &cobra.Command{
 	Short: "Print version information",
 	Run: func(cmd *cobra.Command, args []string) {
 		info := version.Get()
-		fmt.Printf("cortex %s\n", info.Version)
-		fmt.Printf("  Commit:     %s\n", info.Commit)
-		fmt.Printf("  Built:      %s\n", info.BuildDate)
-		fmt.Printf("  Go version: %s\n", info.GoVersion)
-		fmt.Printf("  Platform:   %s\n", info.Platform)
 
 		// Try to get daemon version (health doesn't need project path)
 		client := sdk.DefaultClient("")
 		health, err := client.HealthWithVersion()
 		if err != nil {
-			fmt.Println()
-			fmt.Println("daemon: not running")
-		} else {
-			fmt.Println()
-			fmt.Printf("daemon: %s (status: %s)\n", health.Version, health.Status)
+			health = nil
 		}
+
+		printOutput(versionOutput{
+			Version:   info.Version,
+			Commit:    info.Commit,
+			BuildDate: info.BuildDate,
+			GoVersion: info.GoVersion,
+			Platform:  info.Platform,
+			Daemon:    health,
+		}, func() {
+			fmt.Printf("cortex %s\n", info.Version)
+			fmt.Printf("  Commit:     %s\n", info.Commit)
+			fmt.Printf("  Built:      %s\n", info.BuildDate)
+			fmt.Printf("  Go version: %s\n", info.GoVersion)
+			fmt.Printf("  Platform:   %s\n", info.Platform)
+
+			fmt.Println()
+			if health == nil {
+				fmt.Println("daemon: not running")
+			} else {
+				fmt.Printf("daemon: %s (status: %s)\n", health.Version, health.Status)
+			}
+		})
 	},
 }
 
+// versionOutput is the --output json|yaml form of version. Daemon is
+// omitted when the daemon is not running.
+type versionOutput struct {
+	Version   string              `json:"version"`
+	Commit    string              `json:"commit"`
+	BuildDate string              `json:"build_date"`
+	GoVersion string              `json:"go_version"`
+	Platform  string              `json:"platform"`
+	Daemon    *sdk.HealthResponse `json:"daemon,omitempty"`
+}
+
 func init() {
 	rootCmd.AddCommand(versionCmd)
 }
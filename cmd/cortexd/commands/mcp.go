@@ -7,6 +7,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
 	"github.com/kareemaly/cortex/internal/daemon/mcp"
 	"github.com/spf13/cobra"
 )
@@ -47,19 +48,23 @@ func runMCP(cmd *cobra.Command, args []string) error {
 	// CORTEX_REPO is a stable repo key for ticket sessions.
 	projectPath := os.Getenv("CORTEX_ARCHITECT_PATH")
 	tmuxSession := os.Getenv("CORTEX_TMUX_SESSION")
-	daemonURL := os.Getenv("CORTEX_DAEMON_URL")
+	daemonURL := os.Getenv(daemonconfig.DaemonURLEnvVar)
 	collabID := os.Getenv("CORTEX_COLLAB_ID")
+	reviewTicketID := os.Getenv("CORTEX_REVIEW_TICKET_ID")
 	repo := os.Getenv("CORTEX_REPO")
+	token := os.Getenv(daemonconfig.TokenEnvVar)
 
 	// Create MCP server config
 	cfg := &mcp.Config{
-		TicketID:      ticketID,
-		TicketType:    mcpTicketType,
-		CollabID:      collabID,
-		ArchitectPath: projectPath,
-		TmuxSession:   tmuxSession,
-		DaemonURL:     daemonURL,
-		Repo:          repo,
+		TicketID:       ticketID,
+		TicketType:     mcpTicketType,
+		CollabID:       collabID,
+		ReviewTicketID: reviewTicketID,
+		ArchitectPath:  projectPath,
+		TmuxSession:    tmuxSession,
+		DaemonURL:      daemonURL,
+		Repo:           repo,
+		Token:          token,
 	}
 
 	// Create MCP server
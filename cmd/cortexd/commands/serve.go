@@ -3,15 +3,18 @@ package commands
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
 
 	"github.com/kareemaly/cortex/internal/daemon/api"
+	"github.com/kareemaly/cortex/internal/daemon/auth"
 	"github.com/kareemaly/cortex/internal/daemon/config"
 	"github.com/kareemaly/cortex/internal/daemon/logging"
 	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/headless"
 	"github.com/kareemaly/cortex/internal/tmux"
 	"github.com/kareemaly/cortex/pkg/version"
 	"github.com/spf13/cobra"
@@ -56,6 +59,10 @@ func runServe(cmd *cobra.Command, args []string) error {
 
 	logger.Info("starting cortexd", "version", version.Version)
 
+	if cfg.DisableTCP && cfg.Socket == "" {
+		return fmt.Errorf("disable_tcp requires socket to be set in settings.yaml")
+	}
+
 	// Create context that cancels on SIGINT or SIGTERM
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -70,7 +77,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}()
 
 	// Create event bus and store manager
-	bus := events.NewBus()
+	bus := events.NewBusWithJournal(events.NewJournal())
 	storeManager := api.NewStoreManager(logger, bus)
 
 	// Initialize tmux manager (nil if not installed)
@@ -91,25 +98,99 @@ func runServe(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get home directory: %w", err)
 	}
 
+	// Bearer-token auth, off unless auth.enabled is set in settings.yaml.
+	var authenticator *auth.Authenticator
+	if cfg.Auth.Enabled {
+		authenticator, err = setupAuth(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to set up auth: %w", err)
+		}
+		logger.Info("token authentication enabled", "tokens", len(cfg.Auth.Tokens))
+	} else if !cfg.DisableTCP && !isLoopback(cfg.BindAddress) {
+		logger.Warn("daemon listens beyond localhost without authentication; set auth.enabled in settings.yaml",
+			"bind_address", cfg.BindAddress)
+	}
+
 	// Start receiver-based agent status. Non-fatal.
 	receiverManager := api.NewReceiverManager(logger)
 	receiverManager.StartEventLoop(ctx)
 
+	// Full-text search indexes, refreshed from bus events.
+	searchManager := api.NewSearchManager(logger, bus)
+	searchManager.StartEventLoop(ctx)
+
 	deps := &api.Dependencies{
 		StoreManager:    storeManager,
 		SessionManager:  sessionManager,
 		TmuxManager:     tmuxManager,
+		Headless:        headless.NewManager(filepath.Join(homeDir, ".cortex", "logs", "sessions")),
+		Auth:            authenticator,
 		Bus:             bus,
 		Logger:          logger,
 		SupervisorCtx:   ctx,
 		DefaultsDir:     filepath.Join(homeDir, ".cortex", "defaults", "main"),
 		ReceiverManager: receiverManager,
-		DaemonEndpoint:  fmt.Sprintf("http://%s:%d", cfg.BindAddress, cfg.Port),
+		SearchManager:   searchManager,
+		DaemonEndpoint:  cfg.ListenURL(),
 	}
 
+	// Per-session timelines, persisted next to conclusions.
+	api.NewTimelineManager(logger, deps).StartEventLoop(ctx)
+
+	// Spawns held back by max_concurrent limits, persisted per architect.
+	deps.SpawnQueue = api.NewSpawnQueueManager(logger, deps)
+	deps.SpawnQueue.StartEventLoop(ctx)
+
+	// cortex.yaml pipeline rules: automatic spawns and follow-up tickets.
+	api.NewPipelineManager(logger, deps).StartEventLoop(ctx)
+
+	// Recurring tickets from each architect's schedules/ definitions.
+	deps.Scheduler = api.NewScheduleManager(logger, deps)
+	deps.Scheduler.Start(ctx)
+
+	// Due-soon and overdue reminders for open tickets.
+	api.NewDueWatcher(logger, deps).Start(ctx)
+
 	// Create and run server
-	server := api.NewServer(cfg.Port, cfg.BindAddress, logger, deps)
+	bindAddress := cfg.BindAddress
+	if cfg.DisableTCP {
+		bindAddress = ""
+	}
+	server := api.NewServer(cfg.Port, bindAddress, cfg.SocketPath(), logger, deps)
 	err = server.Run(ctx)
 
+	// Write out the events still queued for the journal.
+	bus.Journal().Flush()
 	return err
 }
+
+// setupAuth generates the signing secret and local token on first use,
+// saving them to settings.yaml, and returns the authenticator.
+func setupAuth(cfg *config.Config) (*auth.Authenticator, error) {
+	changed, err := cfg.Auth.EnsureDefaults()
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		// Reload so flag overrides such as --port are not persisted.
+		saved, err := config.Load()
+		if err != nil {
+			return nil, err
+		}
+		saved.Auth = cfg.Auth
+		if err := saved.Save(); err != nil {
+			return nil, fmt.Errorf("save generated tokens: %w", err)
+		}
+	}
+
+	return auth.New(cfg.Auth)
+}
+
+// isLoopback reports whether addr only accepts local connections.
+func isLoopback(addr string) bool {
+	if addr == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.IsLoopback()
+}
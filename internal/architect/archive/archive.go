@@ -0,0 +1,333 @@
+// Package archive packs an architect workspace into a portable tar.gz and
+// unpacks it on another machine, remapping its repo paths.
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/storage"
+	"gopkg.in/yaml.v3"
+)
+
+// FormatVersion is the archive layout this build writes. Import refuses
+// archives with a newer version.
+const FormatVersion = 1
+
+// ManifestName is the first entry of every archive.
+const ManifestName = "cortex-manifest.json"
+
+// workspaceDir prefixes every workspace file in the archive, so the
+// manifest cannot collide with a workspace file.
+const workspaceDir = "workspace"
+
+// Manifest describes an exported workspace.
+type Manifest struct {
+	Version       int       `json:"version"`
+	Name          string    `json:"name"`
+	ExportedAt    time.Time `json:"exported_at"`
+	CortexVersion string    `json:"cortex_version,omitempty"`
+	// Repos maps each repo key to its path on the exporting machine.
+	Repos map[string]string `json:"repos,omitempty"`
+	Files int               `json:"files"`
+}
+
+// RepoKeys returns the manifest's repo keys in sorted order.
+func (m *Manifest) RepoKeys() []string {
+	keys := make([]string, 0, len(m.Repos))
+	for key := range m.Repos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// excluded reports whether a top-level workspace entry is machine-local
+// state rather than project context: the daemon's dotfiles (sessions,
+// spawn queue, event journal) and the managed git worktrees.
+func excluded(name string) bool {
+	return strings.HasPrefix(name, ".") || name == "worktrees"
+}
+
+// Export writes the workspace at root to w as a gzipped tar whose first
+// entry is the manifest. Only regular files are archived.
+func Export(root string, w io.Writer, cortexVersion string) (*Manifest, error) {
+	cfg, err := architectconfig.Load(root)
+	if err != nil {
+		return nil, fmt.Errorf("load cortex.yaml: %w", err)
+	}
+
+	var files []string
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		if !strings.Contains(rel, string(filepath.Separator)) && excluded(rel) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Type().IsRegular() {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk workspace: %w", err)
+	}
+
+	manifest := &Manifest{
+		Version:       FormatVersion,
+		Name:          cfg.Name,
+		ExportedAt:    time.Now().UTC(),
+		CortexVersion: cortexVersion,
+		Repos:         make(map[string]string, len(cfg.Repos)),
+		Files:         len(files),
+	}
+	for key, repo := range cfg.Repos {
+		manifest.Repos[key] = repo.Path
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeEntry(tw, ManifestName, data, 0644, manifest.ExportedAt); err != nil {
+		return nil, err
+	}
+	for _, rel := range files {
+		if err := addFile(tw, root, rel); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func addFile(tw *tar.Writer, root, rel string) error {
+	p := filepath.Join(root, rel)
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	return writeEntry(tw, path.Join(workspaceDir, filepath.ToSlash(rel)), data, info.Mode().Perm(), info.ModTime())
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, mode fs.FileMode, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     int64(mode),
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// Reader reads an archive written by Export.
+type Reader struct {
+	Manifest *Manifest
+
+	gz *gzip.Reader
+	tr *tar.Reader
+}
+
+// Open reads the manifest at the start of r and checks its version.
+func Open(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a cortex archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != ManifestName {
+		return nil, errors.New("not a cortex archive: missing " + ManifestName)
+	}
+	var m Manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	if m.Version < 1 || m.Version > FormatVersion {
+		return nil, fmt.Errorf("archive format version %d is not supported by this cortex (supports up to %d); upgrade cortex to import it", m.Version, FormatVersion)
+	}
+	return &Reader{Manifest: &m, gz: gz, tr: tr}, nil
+}
+
+// Extract writes the workspace files into dest, which must not exist or
+// be empty, then points each repo key in repoPaths at its new path in the
+// extracted cortex.yaml and validates the result. Repo keys missing from
+// repoPaths keep their exported path. On failure the extracted files are
+// removed again.
+func (r *Reader) Extract(dest string, repoPaths map[string]string) (err error) {
+	for key := range repoPaths {
+		if _, ok := r.Manifest.Repos[key]; !ok {
+			return fmt.Errorf("unknown repo key %q: the archive has %s", key, strings.Join(r.Manifest.RepoKeys(), ", "))
+		}
+	}
+	entries, readErr := os.ReadDir(dest)
+	if readErr == nil && len(entries) > 0 {
+		return fmt.Errorf("%s already exists and is not empty", dest)
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if readErr != nil {
+			_ = os.RemoveAll(dest)
+			return
+		}
+		// dest existed empty: empty it again.
+		leftovers, _ := os.ReadDir(dest)
+		for _, e := range leftovers {
+			_ = os.RemoveAll(filepath.Join(dest, e.Name()))
+		}
+	}()
+
+	for {
+		hdr, err := r.tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		rel, ok := strings.CutPrefix(hdr.Name, workspaceDir+"/")
+		if !ok || !filepath.IsLocal(filepath.FromSlash(rel)) {
+			return fmt.Errorf("archive entry %q escapes the workspace", hdr.Name)
+		}
+		target := filepath.Join(dest, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, fs.FileMode(hdr.Mode).Perm())
+		if err != nil {
+			return err
+		}
+		_, copyErr := io.Copy(f, r.tr)
+		closeErr := f.Close()
+		if copyErr != nil {
+			return fmt.Errorf("extract %s: %w", rel, copyErr)
+		}
+		if closeErr != nil {
+			return closeErr
+		}
+		_ = os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+	}
+
+	if len(repoPaths) > 0 {
+		if err := RewriteRepoPaths(architectconfig.ConfigPath(dest), repoPaths); err != nil {
+			return err
+		}
+	}
+	if _, err := architectconfig.Load(dest); err != nil {
+		return fmt.Errorf("imported cortex.yaml is invalid: %w", err)
+	}
+	return nil
+}
+
+// Close releases the archive's decompressor.
+func (r *Reader) Close() error {
+	return r.gz.Close()
+}
+
+// RewriteRepoPaths sets the path of each repo key in paths in the
+// cortex.yaml at configPath, keeping its comments and layout.
+func RewriteRepoPaths(configPath string, paths map[string]string) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return &architectconfig.ConfigParseError{Path: configPath, Err: err}
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: expected a mapping", configPath)
+	}
+
+	repos := mappingValue(doc.Content[0], "repos")
+	if repos == nil || repos.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: no repos to rewrite", configPath)
+	}
+	for i := 0; i+1 < len(repos.Content); i += 2 {
+		newPath, ok := paths[repos.Content[i].Value]
+		if !ok {
+			continue
+		}
+		value := repos.Content[i+1]
+		if value.Kind == yaml.MappingNode {
+			value = mappingValue(value, "path")
+			if value == nil {
+				return fmt.Errorf("%s: repo %s has no path", configPath, repos.Content[i].Value)
+			}
+		}
+		value.Kind = yaml.ScalarNode
+		value.Tag = "!!str"
+		value.Style = 0
+		value.Value = newPath
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return storage.AtomicWriteFile(configPath, out.Bytes())
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil.
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
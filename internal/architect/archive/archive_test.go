@@ -0,0 +1,125 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+)
+
+func writeFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	p := filepath.Join(root, rel)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	src := t.TempDir()
+	writeFile(t, src, "cortex.yaml", `name: shop
+# Repos on the exporting machine.
+repos:
+  api: /home/alice/api
+  web:
+    path: /home/alice/web
+    isolation: worktree
+`)
+	writeFile(t, src, "tickets/backlog/fix-login-abc123/ticket.md", "---\ntitle: Fix login\n---\nbody\n")
+	writeFile(t, src, "prompts/work/KICKOFF.md", "kickoff\n")
+	writeFile(t, src, ".sessions.json", "{}")
+	writeFile(t, src, "worktrees/api/t1/file.go", "package x\n")
+
+	var buf bytes.Buffer
+	manifest, err := Export(src, &buf, "v1.2.3")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if manifest.Name != "shop" || manifest.Files != 3 || manifest.Repos["web"] != "/home/alice/web" {
+		t.Errorf("unexpected manifest: %+v", manifest)
+	}
+
+	r, err := Open(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer func() { _ = r.Close() }()
+	if r.Manifest.Version != FormatVersion || r.Manifest.CortexVersion != "v1.2.3" {
+		t.Errorf("unexpected manifest: %+v", r.Manifest)
+	}
+
+	dest := filepath.Join(t.TempDir(), "shop")
+	if err := r.Extract(dest, map[string]string{"api": "/home/bob/code/api", "web": "~/web"}); err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dest, "tickets/backlog/fix-login-abc123/ticket.md")); err != nil || !strings.Contains(string(data), "Fix login") {
+		t.Errorf("ticket not extracted: %v", err)
+	}
+	for _, rel := range []string{".sessions.json", "worktrees"} {
+		if _, err := os.Stat(filepath.Join(dest, rel)); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be left out of the archive", rel)
+		}
+	}
+
+	cfg, err := architectconfig.Load(dest)
+	if err != nil {
+		t.Fatalf("load imported config: %v", err)
+	}
+	if cfg.Repos["api"].Path != "/home/bob/code/api" || cfg.Repos["web"].Path != "~/web" || cfg.Repos["web"].Isolation != "worktree" {
+		t.Errorf("repos not remapped: %+v", cfg.Repos)
+	}
+	data, _ := os.ReadFile(filepath.Join(dest, "cortex.yaml"))
+	if !strings.Contains(string(data), "# Repos on the exporting machine.") {
+		t.Errorf("expected comments to survive the rewrite:\n%s", data)
+	}
+}
+
+func TestExtractRefusesNonEmptyDestination(t *testing.T) {
+	src := t.TempDir()
+	writeFile(t, src, "cortex.yaml", "name: shop\nrepos:\n  api: /srv/api\n")
+
+	var buf bytes.Buffer
+	if _, err := Export(src, &buf, ""); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	r, err := Open(&buf)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	if err := r.Extract(t.TempDir(), map[string]string{"db": "/srv/db"}); err == nil || !strings.Contains(err.Error(), "unknown repo key") {
+		t.Errorf("expected an unknown repo key error, got %v", err)
+	}
+	dest := t.TempDir()
+	writeFile(t, dest, "notes.md", "mine")
+	if err := r.Extract(dest, nil); err == nil {
+		t.Error("expected extracting into a non-empty directory to fail")
+	}
+}
+
+func TestOpenRejectsNewerVersions(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	manifest := []byte(`{"version": 99, "name": "future"}`)
+	_ = tw.WriteHeader(&tar.Header{Name: ManifestName, Mode: 0644, Size: int64(len(manifest)), Typeflag: tar.TypeReg})
+	_, _ = tw.Write(manifest)
+	_ = tw.Close()
+	_ = gz.Close()
+
+	if _, err := Open(&buf); err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Errorf("expected a version error, got %v", err)
+	}
+	if _, err := Open(strings.NewReader("not an archive")); err == nil {
+		t.Error("expected an error for a non-archive")
+	}
+}
@@ -26,14 +26,44 @@ type AgentVariant struct {
 	Agent AgentType         `yaml:"agent"`
 	Args  []string          `yaml:"args,omitempty"`
 	Env   map[string]string `yaml:"env,omitempty"`
+	// MaxConcurrent caps the ticket sessions running this variant across
+	// all architects; further spawns are queued. Zero means unlimited.
+	MaxConcurrent int `yaml:"max_concurrent,omitempty"`
 }
 
 // Config holds the architect configuration.
 type Config struct {
-	Name      string                  `yaml:"name"`
-	Repos     map[string]string       `yaml:"repos,omitempty"`
-	Companion string                  `yaml:"companion,omitempty"`
-	Agents    map[string]AgentVariant `yaml:"agents,omitempty"`
+	Name      string                   `yaml:"name"`
+	Repos     map[string]RepoConfig    `yaml:"repos,omitempty"`
+	Companion string                   `yaml:"companion,omitempty"`
+	Agents    map[string]AgentVariant  `yaml:"agents,omitempty"`
+	Types     map[string]TicketTypeDef `yaml:"types,omitempty"`
+	Statuses  []StatusDef              `yaml:"statuses,omitempty"`
+	Pipelines PipelinesConfig          `yaml:"pipelines,omitempty"`
+	Reviewer  ReviewerConfig           `yaml:"reviewer,omitempty"`
+	Reminders RemindersConfig          `yaml:"reminders,omitempty"`
+
+	// SessionBackend runs ticket sessions in tmux (the default) or, when
+	// "headless", as daemon child processes. Spawn requests may override it.
+	SessionBackend string `yaml:"session_backend,omitempty"`
+
+	// AcceptanceCriteria decides what concluding a ticket with unchecked
+	// acceptance criteria does: "warn" (the default) concludes with a
+	// warning, "enforce" refuses. Rejections are never checked.
+	AcceptanceCriteria string `yaml:"acceptance_criteria,omitempty"`
+}
+
+// ReviewerConfig configures the reviewer agents that audit concluded
+// tickets:
+//
+//	reviewer:
+//	  variant: careful
+//	  auto: true
+type ReviewerConfig struct {
+	// Variant is the agent variant reviewers run when a spawn names none.
+	Variant string `yaml:"variant,omitempty"`
+	// Auto spawns a reviewer for every conclusion that is not rejected.
+	Auto bool `yaml:"auto,omitempty"`
 }
 
 // TicketsPath returns the tickets directory path for the given architect root.
@@ -47,6 +77,24 @@ func (c *Config) SessionsPath(architectRoot string) string {
 	return filepath.Join(architectRoot, "sessions")
 }
 
+// TemplatesPath returns the ticket templates directory path for the given
+// architect root.
+func (c *Config) TemplatesPath(architectRoot string) string {
+	return filepath.Join(architectRoot, "templates")
+}
+
+// SchedulesPath returns the recurring ticket schedules directory path for
+// the given architect root.
+func (c *Config) SchedulesPath(architectRoot string) string {
+	return filepath.Join(architectRoot, "schedules")
+}
+
+// WorktreesPath returns the directory holding cortex-managed git worktrees
+// for repos with isolation: worktree. Defaults to {architectRoot}/worktrees.
+func (c *Config) WorktreesPath(architectRoot string) string {
+	return filepath.Join(architectRoot, "worktrees")
+}
+
 // GetTmuxSessionName returns the tmux session name for this architect.
 // Uses Config.Name if set, otherwise defaults to "cortex".
 func (c *Config) GetTmuxSessionName() string {
@@ -81,9 +129,10 @@ func (c *Config) MergeAgents(global map[string]daemonconfig.AgentVariant) {
 	for k, v := range global {
 		if _, exists := c.Agents[k]; !exists {
 			c.Agents[k] = AgentVariant{
-				Agent: AgentType(v.Agent),
-				Args:  v.Args,
-				Env:   v.Env,
+				Agent:         AgentType(v.Agent),
+				Args:          v.Args,
+				Env:           v.Env,
+				MaxConcurrent: v.MaxConcurrent,
 			}
 		}
 	}
@@ -130,7 +179,7 @@ func (c *Config) ResolveRepoPath(repoKey string) (string, error) {
 		return "", err
 	}
 
-	path := storage.ExpandHome(strings.TrimSpace(c.Repos[repoKey]))
+	path := storage.ExpandHome(strings.TrimSpace(c.Repos[repoKey].Path))
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return "", fmt.Errorf("resolve repo path for key %q: %w", repoKey, err)
@@ -193,6 +242,9 @@ func Load(architectRoot string) (*Config, error) {
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.checkStatusDirs(absPath); err != nil {
+		return nil, err
+	}
 
 	return cfg, nil
 }
@@ -225,7 +277,7 @@ func ConfigPath(architectRoot string) string {
 // Validate checks that the config is valid.
 func (c *Config) Validate() error {
 	for _, key := range c.RepoKeys() {
-		path := strings.TrimSpace(c.Repos[key])
+		path := strings.TrimSpace(c.Repos[key].Path)
 		if key == "" {
 			return &ValidationError{Field: "repos", Message: "repo key cannot be empty"}
 		}
@@ -235,6 +287,27 @@ func (c *Config) Validate() error {
 		if path == "" {
 			return &ValidationError{Field: fmt.Sprintf("repos.%s", key), Message: "repo path cannot be empty"}
 		}
+		if err := validateRepoIsolation(key, c.Repos[key]); err != nil {
+			return err
+		}
+		if err := validateRepoVerify(key, c.Repos[key]); err != nil {
+			return err
+		}
+		if c.Repos[key].MaxConcurrent < 0 {
+			return &ValidationError{Field: fmt.Sprintf("repos.%s.max_concurrent", key), Message: "cannot be negative"}
+		}
+	}
+
+	switch c.SessionBackend {
+	case "", "tmux", "headless":
+	default:
+		return &ValidationError{Field: "session_backend", Message: "must be 'tmux' or 'headless'"}
+	}
+
+	switch c.AcceptanceCriteria {
+	case "", "warn", "enforce":
+	default:
+		return &ValidationError{Field: "acceptance_criteria", Message: "must be 'warn' or 'enforce'"}
 	}
 
 	for name, variant := range c.Agents {
@@ -244,6 +317,21 @@ func (c *Config) Validate() error {
 				Message: "must be 'claude', 'opencode', or 'codex'",
 			}
 		}
+		if variant.MaxConcurrent < 0 {
+			return &ValidationError{Field: fmt.Sprintf("agents.%s.max_concurrent", name), Message: "cannot be negative"}
+		}
+	}
+	if c.Reviewer.Auto && strings.TrimSpace(c.Reviewer.Variant) == "" {
+		return &ValidationError{Field: "reviewer.variant", Message: "is required when reviewer.auto is set"}
+	}
+	if err := c.Reminders.validate(); err != nil {
+		return err
+	}
+	if err := c.validateStatuses(); err != nil {
+		return err
+	}
+	if err := c.validateTypes(); err != nil {
+		return err
 	}
-	return nil
+	return c.validatePipelines()
 }
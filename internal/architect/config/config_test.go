@@ -3,7 +3,12 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+	"gopkg.in/yaml.v3"
 )
 
 // setupTestProject creates a temp directory with cortex.yaml at root.
@@ -363,7 +368,7 @@ func TestValidateRepo(t *testing.T) {
 
 	t.Run("repo key in map is allowed", func(t *testing.T) {
 		cfg := &Config{
-			Repos: map[string]string{"repo-a": "~/work/repo-a", "repo-b": "~/work/repo-b", "repo-c": "~/work/repo-c"},
+			Repos: map[string]RepoConfig{"repo-a": {Path: "~/work/repo-a"}, "repo-b": {Path: "~/work/repo-b"}, "repo-c": {Path: "~/work/repo-c"}},
 		}
 		if err := cfg.ValidateRepo("repo-b"); err != nil {
 			t.Fatalf("expected no error for valid repo, got: %v", err)
@@ -372,7 +377,7 @@ func TestValidateRepo(t *testing.T) {
 
 	t.Run("repo key not in map is rejected", func(t *testing.T) {
 		cfg := &Config{
-			Repos: map[string]string{"repo-a": "~/work/repo-a", "repo-b": "~/work/repo-b"},
+			Repos: map[string]RepoConfig{"repo-a": {Path: "~/work/repo-a"}, "repo-b": {Path: "~/work/repo-b"}},
 		}
 		err := cfg.ValidateRepo("repo-c")
 		if err == nil {
@@ -402,8 +407,8 @@ repos:
 	if len(cfg.Repos) != 3 {
 		t.Fatalf("expected 3 repos, got %d", len(cfg.Repos))
 	}
-	if cfg.Repos["frontend"] != "~/work/frontend" {
-		t.Errorf("expected frontend repo path '~/work/frontend', got %q", cfg.Repos["frontend"])
+	if cfg.Repos["frontend"].Path != "~/work/frontend" {
+		t.Errorf("expected frontend repo path '~/work/frontend', got %q", cfg.Repos["frontend"].Path)
 	}
 	if err := cfg.ValidateRepo("backend"); err != nil {
 		t.Errorf("expected 'backend' to be valid, got: %v", err)
@@ -413,6 +418,186 @@ repos:
 	}
 }
 
+func TestLoad_WithRepoIsolation(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	writeConfig(t, projectRoot, `
+name: multi-repo
+repos:
+  frontend: ~/work/frontend
+  backend:
+    path: ~/work/backend
+    isolation: worktree
+`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Repos["backend"].Path != "~/work/backend" {
+		t.Errorf("expected backend path '~/work/backend', got %q", cfg.Repos["backend"].Path)
+	}
+	if got := cfg.RepoIsolation("backend"); got != IsolationWorktree {
+		t.Errorf("RepoIsolation(backend) = %q, want %q", got, IsolationWorktree)
+	}
+	if got := cfg.RepoIsolation("frontend"); got != "" {
+		t.Errorf("RepoIsolation(frontend) = %q, want empty", got)
+	}
+
+	out, err := yaml.Marshal(cfg.Repos)
+	if err != nil {
+		t.Fatalf("marshal repos: %v", err)
+	}
+	if !strings.Contains(string(out), "frontend: ~/work/frontend") {
+		t.Errorf("expected plain repo to marshal as a bare path, got:\n%s", out)
+	}
+}
+
+func TestValidate_InvalidRepoIsolation(t *testing.T) {
+	cfg := &Config{Repos: map[string]RepoConfig{"api": {Path: "~/work/api", Isolation: "container"}}}
+	err := cfg.Validate()
+	vErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if vErr.Field != "repos.api.isolation" {
+		t.Errorf("field = %q, want repos.api.isolation", vErr.Field)
+	}
+}
+
+func TestLoad_WithRepoVerify(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	writeConfig(t, projectRoot, `
+name: verify
+repos:
+  frontend: ~/work/frontend
+  backend:
+    path: ~/work/backend
+    verify:
+      commands: [go build ./..., go test ./...]
+      timeout: 2m
+      on_failure: bounce
+`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v := cfg.RepoVerify("backend")
+	if v == nil || len(v.Commands) != 2 || !v.Bounce() || v.TimeoutDuration() != 2*time.Minute {
+		t.Fatalf("unexpected backend verify config: %+v", v)
+	}
+	if cfg.RepoVerify("frontend") != nil {
+		t.Error("expected no verify config for frontend")
+	}
+
+	out, err := yaml.Marshal(cfg.Repos)
+	if err != nil {
+		t.Fatalf("marshal repos: %v", err)
+	}
+	if !strings.Contains(string(out), "on_failure: bounce") {
+		t.Errorf("expected verify block to survive marshalling, got:\n%s", out)
+	}
+}
+
+func TestValidate_InvalidRepoVerify(t *testing.T) {
+	tests := []struct {
+		verify VerifyConfig
+		field  string
+	}{
+		{VerifyConfig{Commands: []string{"make", " "}}, "repos.api.verify.commands[1]"},
+		{VerifyConfig{Commands: []string{"make"}, Timeout: "soon"}, "repos.api.verify.timeout"},
+		{VerifyConfig{Commands: []string{"make"}, OnFailure: "retry"}, "repos.api.verify.on_failure"},
+	}
+	for _, tt := range tests {
+		cfg := &Config{Repos: map[string]RepoConfig{"api": {Path: "~/work/api", Verify: &tt.verify}}}
+		vErr, ok := cfg.Validate().(*ValidationError)
+		if !ok {
+			t.Fatalf("expected ValidationError for %+v", tt.verify)
+		}
+		if vErr.Field != tt.field {
+			t.Errorf("field = %q, want %q", vErr.Field, tt.field)
+		}
+	}
+}
+
+func TestLoad_WithConcurrencyLimits(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	writeConfig(t, projectRoot, `
+name: limits
+repos:
+  frontend: ~/work/frontend
+  backend:
+    path: ~/work/backend
+    max_concurrent: 2
+agents:
+  fast:
+    agent: claude
+    max_concurrent: 1
+`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := cfg.Repos["backend"].MaxConcurrent; got != 2 {
+		t.Errorf("backend max_concurrent = %d, want 2", got)
+	}
+	if got := cfg.Repos["frontend"].MaxConcurrent; got != 0 {
+		t.Errorf("frontend max_concurrent = %d, want 0", got)
+	}
+	if got := cfg.Agents["fast"].MaxConcurrent; got != 1 {
+		t.Errorf("fast max_concurrent = %d, want 1", got)
+	}
+
+	out, err := yaml.Marshal(cfg.Repos)
+	if err != nil {
+		t.Fatalf("marshal repos: %v", err)
+	}
+	if !strings.Contains(string(out), "max_concurrent: 2") {
+		t.Errorf("expected repo limit to survive marshalling, got:\n%s", out)
+	}
+
+	cfg.MergeAgents(map[string]daemonconfig.AgentVariant{"slow": {Agent: "codex", MaxConcurrent: 3}})
+	if got := cfg.Agents["slow"].MaxConcurrent; got != 3 {
+		t.Errorf("merged slow max_concurrent = %d, want 3", got)
+	}
+}
+
+func TestValidate_NegativeConcurrencyLimits(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   *Config
+		field string
+	}{
+		{"repo", &Config{Repos: map[string]RepoConfig{"api": {Path: "~/work/api", MaxConcurrent: -1}}}, "repos.api.max_concurrent"},
+		{"variant", &Config{Agents: map[string]AgentVariant{"fast": {Agent: AgentClaude, MaxConcurrent: -1}}}, "agents.fast.max_concurrent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vErr, ok := tt.cfg.Validate().(*ValidationError)
+			if !ok {
+				t.Fatalf("expected ValidationError, got %v", tt.cfg.Validate())
+			}
+			if vErr.Field != tt.field {
+				t.Errorf("field = %q, want %q", vErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+func TestValidate_SessionBackend(t *testing.T) {
+	for _, backend := range []string{"", "tmux", "headless"} {
+		if err := (&Config{SessionBackend: backend}).Validate(); err != nil {
+			t.Errorf("session_backend %q: unexpected error: %v", backend, err)
+		}
+	}
+	vErr, ok := (&Config{SessionBackend: "screen"}).Validate().(*ValidationError)
+	if !ok || vErr.Field != "session_backend" {
+		t.Errorf("expected session_backend ValidationError, got %v", vErr)
+	}
+}
+
 func TestResolveRepoPath(t *testing.T) {
 	home, err := os.UserHomeDir()
 	if err != nil {
@@ -420,7 +605,7 @@ func TestResolveRepoPath(t *testing.T) {
 	}
 
 	cfg := &Config{
-		Repos: map[string]string{"frontend": "~/work/frontend"},
+		Repos: map[string]RepoConfig{"frontend": {Path: "~/work/frontend"}},
 	}
 
 	resolved, err := cfg.ResolveRepoPath("frontend")
@@ -460,3 +645,320 @@ func TestGetTmuxSessionName(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_WithTypes(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	writeConfig(t, projectRoot, `name: test
+types:
+  bug:
+    required_fields: [body, references]
+  spike:
+    prompts: research
+    allowed_statuses: [backlog, progress]
+`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := cfg.TicketTypeNames()
+	if len(names) != 3 || names[0] != "bug" || names[1] != "spike" || names[2] != "work" {
+		t.Errorf("TicketTypeNames() = %v, want [bug spike work]", names)
+	}
+
+	spike, err := cfg.ResolveTicketType("spike")
+	if err != nil {
+		t.Fatalf("ResolveTicketType(spike) failed: %v", err)
+	}
+	if spike.AllowsStatus("done") || !spike.AllowsStatus("progress") {
+		t.Errorf("unexpected allowed statuses: %v", spike.AllowedStatuses)
+	}
+	if got := cfg.TicketPromptDir("spike"); got != "research" {
+		t.Errorf("TicketPromptDir(spike) = %q, want research", got)
+	}
+	if got := cfg.TicketPromptDir("bug"); got != "bug" {
+		t.Errorf("TicketPromptDir(bug) = %q, want bug", got)
+	}
+
+	work, err := cfg.ResolveTicketType("")
+	if err != nil {
+		t.Fatalf("ResolveTicketType(\"\") failed: %v", err)
+	}
+	if !work.AllowsStatus("done") {
+		t.Error("work type should allow every status")
+	}
+
+	if _, err := cfg.ResolveTicketType("chore"); err == nil {
+		t.Error("expected error for unknown type")
+	}
+}
+
+func TestValidate_InvalidTypes(t *testing.T) {
+	tests := []struct {
+		name  string
+		def   TicketTypeDef
+		field string
+	}{
+		{"unknown field", TicketTypeDef{RequiredFields: []string{"owner"}}, "types.bug.required_fields"},
+		{"unknown status", TicketTypeDef{AllowedStatuses: []string{"backlog", "review"}}, "types.bug.allowed_statuses"},
+		{"missing backlog", TicketTypeDef{AllowedStatuses: []string{"progress"}}, "types.bug.allowed_statuses"},
+		{"nested prompt dir", TicketTypeDef{Prompts: "a/b"}, "types.bug.prompts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Types: map[string]TicketTypeDef{"bug": tt.def}}
+			err := cfg.Validate()
+			valErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if valErr.Field != tt.field {
+				t.Errorf("field = %q, want %q", valErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+func TestLoad_WithStatuses(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	writeConfig(t, projectRoot, `name: test
+statuses:
+  - name: backlog
+    transitions: [progress]
+  - name: progress
+    transitions: [review, backlog]
+  - name: review
+    transitions: [progress, done]
+  - name: done
+`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := cfg.StatusNames()
+	if len(names) != 4 || names[2] != "review" {
+		t.Errorf("StatusNames() = %v, want [backlog progress review done]", names)
+	}
+
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"backlog", "progress", true},
+		{"backlog", "done", false},
+		{"progress", "review", true},
+		{"review", "done", true},
+		{"done", "backlog", true},
+		{"review", "review", true},
+		{"backlog", "blocked", false},
+	}
+	for _, tt := range tests {
+		if got := cfg.CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestLoad_DroppedStatusWithTickets(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	if err := os.MkdirAll(filepath.Join(projectRoot, "tickets", "review", "2026-01-01-0000-fix"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(projectRoot, "tickets", "blocked"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	writeConfig(t, projectRoot, "name: test\n")
+	_, err := Load(projectRoot)
+	if _, ok := err.(*ValidationError); !ok || !strings.Contains(err.Error(), "2026-01-01-0000-fix") {
+		t.Fatalf("expected ValidationError naming the ticket, got %v", err)
+	}
+
+	// An empty leftover directory does not hide anything.
+	writeConfig(t, projectRoot, `name: test
+statuses:
+  - name: backlog
+  - name: progress
+  - name: review
+  - name: done
+`)
+	if _, err := Load(projectRoot); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestStatusNames_Default(t *testing.T) {
+	cfg := DefaultConfig()
+	names := cfg.StatusNames()
+	if len(names) != 3 || names[0] != "backlog" || names[1] != "progress" || names[2] != "done" {
+		t.Errorf("StatusNames() = %v, want defaults", names)
+	}
+	if !cfg.CanTransition("done", "backlog") {
+		t.Error("default config should allow any transition")
+	}
+}
+
+func TestValidate_InvalidStatuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []StatusDef
+	}{
+		{"missing done", []StatusDef{{Name: "backlog"}, {Name: "progress"}}},
+		{"duplicate", []StatusDef{{Name: "backlog"}, {Name: "progress"}, {Name: "done"}, {Name: "done"}}},
+		{"empty name", []StatusDef{{Name: "backlog"}, {Name: "progress"}, {Name: "done"}, {Name: " "}}},
+		{"unknown transition", []StatusDef{{Name: "backlog", Transitions: []string{"review"}}, {Name: "progress"}, {Name: "done"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Statuses: tt.statuses}
+			if _, ok := cfg.Validate().(*ValidationError); !ok {
+				t.Errorf("expected ValidationError for %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestLoad_WithPipelines(t *testing.T) {
+	projectRoot := setupTestProject(t)
+	writeConfig(t, projectRoot, `name: test
+repos:
+  web: ~/web
+types:
+  review: {}
+pipelines:
+  max_concurrent: 2
+  max_concurrent_per_repo: 1
+  rules:
+    - name: auto-spawn
+      on: ticket_created
+      when: {type: review, status: backlog}
+      spawn: {variant: fast}
+    - name: review-followup
+      on: conclusion_created
+      when: {has_commits: true}
+      create_ticket:
+        repo: web
+        type: review
+        title: "Review: {title}"
+`)
+
+	cfg, err := Load(projectRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p := cfg.Pipelines
+	if p.MaxConcurrent != 2 || p.MaxConcurrentPerRepo != 1 {
+		t.Errorf("caps = %d/%d, want 2/1", p.MaxConcurrent, p.MaxConcurrentPerRepo)
+	}
+	if len(p.Rules) != 2 {
+		t.Fatalf("len(Rules) = %d, want 2", len(p.Rules))
+	}
+	if got := p.Rules[0].Action(); got != "spawn" || p.Rules[0].Spawn.Variant != "fast" {
+		t.Errorf("rule 0 action = %q (%+v), want spawn fast", got, p.Rules[0].Spawn)
+	}
+	followup := p.Rules[1]
+	if followup.Action() != "create_ticket" || followup.CreateTicket.Title != "Review: {title}" {
+		t.Errorf("rule 1 = %+v, want create_ticket", followup)
+	}
+	if followup.When.HasCommits == nil || !*followup.When.HasCommits {
+		t.Error("expected has_commits: true")
+	}
+}
+
+func TestValidate_InvalidPipelines(t *testing.T) {
+	yes := true
+	spawn := &PipelineSpawn{Variant: "fast"}
+	tests := []struct {
+		name  string
+		p     PipelinesConfig
+		field string
+	}{
+		{"negative cap", PipelinesConfig{MaxConcurrent: -1}, "pipelines.max_concurrent"},
+		{"empty name", PipelinesConfig{Rules: []PipelineRule{{On: "ticket_created", Spawn: spawn}}}, "pipelines.rules[0]"},
+		{"duplicate name", PipelinesConfig{Rules: []PipelineRule{
+			{Name: "a", On: "ticket_created", Spawn: spawn},
+			{Name: "a", On: "ticket_moved", Spawn: spawn},
+		}}, "pipelines.rules[1]"},
+		{"unknown trigger", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_deleted", Spawn: spawn}}}, "pipelines.rules.a.on"},
+		{"unknown status", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_moved", When: PipelineMatch{Status: "review"}, Spawn: spawn}}}, "pipelines.rules.a.when.status"},
+		{"unknown type", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_moved", When: PipelineMatch{Type: "bug"}, Spawn: spawn}}}, "pipelines.rules.a.when.type"},
+		{"conclusion match on ticket event", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_moved", When: PipelineMatch{HasCommits: &yes}, Spawn: spawn}}}, "pipelines.rules.a.when"},
+		{"no action", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_created"}}}, "pipelines.rules.a"},
+		{"two actions", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_created", Spawn: spawn, CreateTicket: &PipelineCreateTicket{Title: "x"}}}}, "pipelines.rules.a"},
+		{"missing variant", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_created", Spawn: &PipelineSpawn{}}}}, "pipelines.rules.a.spawn.variant"},
+		{"bad mode", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "ticket_created", Spawn: &PipelineSpawn{Variant: "fast", Mode: "later"}}}}, "pipelines.rules.a.spawn.mode"},
+		{"missing title", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "conclusion_created", CreateTicket: &PipelineCreateTicket{}}}}, "pipelines.rules.a.create_ticket.title"},
+		{"unknown repo", PipelinesConfig{Rules: []PipelineRule{{Name: "a", On: "conclusion_created", CreateTicket: &PipelineCreateTicket{Title: "x", Repo: "api"}}}}, "pipelines.rules.a.create_ticket.repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Pipelines: tt.p}
+			err := cfg.Validate()
+			valErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if valErr.Field != tt.field {
+				t.Errorf("field = %q, want %q", valErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+func TestValidate_ReviewerAutoNeedsVariant(t *testing.T) {
+	cfg := &Config{Reviewer: ReviewerConfig{Auto: true}}
+	valErr, ok := cfg.Validate().(*ValidationError)
+	if !ok || valErr.Field != "reviewer.variant" {
+		t.Fatalf("expected reviewer.variant ValidationError, got %v", cfg.Validate())
+	}
+
+	cfg.Reviewer.Variant = "careful"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidate_AcceptanceCriteria(t *testing.T) {
+	cfg := &Config{AcceptanceCriteria: "strict"}
+	valErr, ok := cfg.Validate().(*ValidationError)
+	if !ok || valErr.Field != "acceptance_criteria" {
+		t.Fatalf("expected acceptance_criteria ValidationError, got %v", cfg.Validate())
+	}
+
+	cfg.AcceptanceCriteria = "enforce"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidate_Reminders(t *testing.T) {
+	cfg := &Config{Reminders: RemindersConfig{DueSoon: "tomorrow"}}
+	valErr, ok := cfg.Validate().(*ValidationError)
+	if !ok || valErr.Field != "reminders.due_soon" {
+		t.Fatalf("expected reminders.due_soon ValidationError, got %v", cfg.Validate())
+	}
+
+	cfg.Reminders = RemindersConfig{DueSoon: "48h", Alert: "bell"}
+	valErr, ok = cfg.Validate().(*ValidationError)
+	if !ok || valErr.Field != "reminders.alert" {
+		t.Fatalf("expected reminders.alert ValidationError, got %v", cfg.Validate())
+	}
+
+	cfg.Reminders.Alert = AlertPopup
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := cfg.Reminders.DueSoonDuration(); got != 48*time.Hour {
+		t.Errorf("DueSoonDuration() = %s, want 48h", got)
+	}
+	if got := (&RemindersConfig{}).DueSoonDuration(); got != DefaultDueSoon {
+		t.Errorf("default DueSoonDuration() = %s, want %s", got, DefaultDueSoon)
+	}
+}
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Pipeline triggers. They mirror the events.EventType values a rule can
+// react to.
+const (
+	TriggerTicketCreated     = "ticket_created"
+	TriggerTicketMoved       = "ticket_moved"
+	TriggerTicketUnblocked   = "ticket_unblocked"
+	TriggerConclusionCreated = "conclusion_created"
+)
+
+// PipelineTriggers lists the events a pipeline rule may react to.
+var PipelineTriggers = []string{TriggerTicketCreated, TriggerTicketMoved, TriggerTicketUnblocked, TriggerConclusionCreated}
+
+// PipelinesConfig holds the automation rules the daemon runs for an
+// architect:
+//
+//	pipelines:
+//	  max_concurrent: 3
+//	  max_concurrent_per_repo: 1
+//	  rules:
+//	    - name: auto-spawn
+//	      on: ticket_created
+//	      when: {type: auto, status: backlog}
+//	      spawn: {variant: fast}
+//	    - name: review-followup
+//	      on: conclusion_created
+//	      when: {has_commits: true}
+//	      create_ticket:
+//	        repo: web
+//	        type: review
+//	        title: "Review: {title}"
+//
+// The caps count every running ticket session; zero means unlimited.
+type PipelinesConfig struct {
+	MaxConcurrent        int            `yaml:"max_concurrent,omitempty"`
+	MaxConcurrentPerRepo int            `yaml:"max_concurrent_per_repo,omitempty"`
+	Rules                []PipelineRule `yaml:"rules,omitempty"`
+}
+
+// PipelineRule runs one action when a matching event arrives.
+type PipelineRule struct {
+	Name         string                `yaml:"name"`
+	On           string                `yaml:"on"`
+	When         PipelineMatch         `yaml:"when,omitempty"`
+	Spawn        *PipelineSpawn        `yaml:"spawn,omitempty"`
+	CreateTicket *PipelineCreateTicket `yaml:"create_ticket,omitempty"`
+}
+
+// PipelineMatch filters the ticket an event is about. Empty fields match
+// anything.
+type PipelineMatch struct {
+	Status string `yaml:"status,omitempty"`
+	Type   string `yaml:"type,omitempty"`
+	Repo   string `yaml:"repo,omitempty"`
+	// HasCommits and Rejected match the conclusion; conclusion_created only.
+	HasCommits *bool `yaml:"has_commits,omitempty"`
+	Rejected   *bool `yaml:"rejected,omitempty"`
+}
+
+// PipelineSpawn starts a worker session for the ticket.
+type PipelineSpawn struct {
+	Variant string `yaml:"variant"`
+	Mode    string `yaml:"mode,omitempty"`
+}
+
+// PipelineCreateTicket creates a follow-up ticket in backlog. Title and body
+// may use {id}, {title}, {type}, {repo} and {conclusion} placeholders, which
+// refer to the ticket that triggered the rule.
+type PipelineCreateTicket struct {
+	Repo  string `yaml:"repo,omitempty"`
+	Type  string `yaml:"type,omitempty"`
+	Title string `yaml:"title"`
+	Body  string `yaml:"body,omitempty"`
+	// Spawn starts the new ticket right away.
+	Spawn *PipelineSpawn `yaml:"spawn,omitempty"`
+}
+
+// Action returns the name of the rule's action.
+func (r PipelineRule) Action() string {
+	if r.Spawn != nil {
+		return "spawn"
+	}
+	return "create_ticket"
+}
+
+// validatePipelines checks the pipelines section of cortex.yaml.
+func (c *Config) validatePipelines() error {
+	p := c.Pipelines
+	if p.MaxConcurrent < 0 {
+		return &ValidationError{Field: "pipelines.max_concurrent", Message: "cannot be negative"}
+	}
+	if p.MaxConcurrentPerRepo < 0 {
+		return &ValidationError{Field: "pipelines.max_concurrent_per_repo", Message: "cannot be negative"}
+	}
+
+	seen := make(map[string]bool, len(p.Rules))
+	for i, rule := range p.Rules {
+		field := fmt.Sprintf("pipelines.rules[%d]", i)
+		if strings.TrimSpace(rule.Name) == "" {
+			return &ValidationError{Field: field, Message: "rule name cannot be empty"}
+		}
+		if seen[rule.Name] {
+			return &ValidationError{Field: field, Message: fmt.Sprintf("duplicate rule %q", rule.Name)}
+		}
+		seen[rule.Name] = true
+
+		field = fmt.Sprintf("pipelines.rules.%s", rule.Name)
+		if !slices.Contains(PipelineTriggers, rule.On) {
+			return &ValidationError{
+				Field:   field + ".on",
+				Message: fmt.Sprintf("must be one of: %s", strings.Join(PipelineTriggers, ", ")),
+			}
+		}
+		if err := c.validatePipelineMatch(field+".when", rule.On, rule.When); err != nil {
+			return err
+		}
+
+		switch {
+		case (rule.Spawn == nil) == (rule.CreateTicket == nil):
+			return &ValidationError{Field: field, Message: "must set exactly one of spawn or create_ticket"}
+		case rule.Spawn != nil:
+			if err := validatePipelineSpawn(field+".spawn", rule.Spawn); err != nil {
+				return err
+			}
+		default:
+			if err := c.validatePipelineCreateTicket(field+".create_ticket", rule.CreateTicket); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) validatePipelineMatch(field, on string, m PipelineMatch) error {
+	if m.Status != "" && !c.HasStatus(m.Status) {
+		return &ValidationError{Field: field + ".status", Message: fmt.Sprintf("unknown status %q", m.Status)}
+	}
+	if m.Type != "" {
+		if _, err := c.ResolveTicketType(m.Type); err != nil {
+			return &ValidationError{Field: field + ".type", Message: err.Error()}
+		}
+	}
+	if m.Repo != "" {
+		if err := c.ValidateRepo(m.Repo); err != nil {
+			return &ValidationError{Field: field + ".repo", Message: err.Error()}
+		}
+	}
+	if (m.HasCommits != nil || m.Rejected != nil) && on != TriggerConclusionCreated {
+		return &ValidationError{Field: field, Message: "has_commits and rejected require on: " + TriggerConclusionCreated}
+	}
+	return nil
+}
+
+func validatePipelineSpawn(field string, s *PipelineSpawn) error {
+	if strings.TrimSpace(s.Variant) == "" {
+		return &ValidationError{Field: field + ".variant", Message: "variant cannot be empty"}
+	}
+	switch s.Mode {
+	case "", "normal", "resume", "fresh":
+		return nil
+	default:
+		return &ValidationError{Field: field + ".mode", Message: "must be 'normal', 'resume', 'fresh', or omitted"}
+	}
+}
+
+func (c *Config) validatePipelineCreateTicket(field string, t *PipelineCreateTicket) error {
+	if strings.TrimSpace(t.Title) == "" {
+		return &ValidationError{Field: field + ".title", Message: "title cannot be empty"}
+	}
+	if t.Repo != "" {
+		if err := c.ValidateRepo(t.Repo); err != nil {
+			return &ValidationError{Field: field + ".repo", Message: err.Error()}
+		}
+	}
+	if _, err := c.ResolveTicketType(t.Type); err != nil {
+		return &ValidationError{Field: field + ".type", Message: err.Error()}
+	}
+	if t.Spawn != nil {
+		return validatePipelineSpawn(field+".spawn", t.Spawn)
+	}
+	return nil
+}
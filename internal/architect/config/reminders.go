@@ -0,0 +1,50 @@
+package config
+
+import "time"
+
+// DefaultDueSoon is how long before its due date a ticket counts as due
+// soon when the reminders set no window.
+const DefaultDueSoon = 24 * time.Hour
+
+// Alerts the daemon can raise in the architect's tmux session when a
+// ticket becomes overdue.
+const (
+	AlertMessage = "message" // a tmux display-message in the status line
+	AlertPopup   = "popup"   // the ticket's detail viewer in a tmux popup
+)
+
+// RemindersConfig configures the daemon's due-date reminders:
+//
+//	reminders:
+//	  due_soon: 48h
+//	  alert: popup
+type RemindersConfig struct {
+	// DueSoon is how long before its due date an open ticket is reported
+	// due soon, as a Go duration. Defaults to DefaultDueSoon.
+	DueSoon string `yaml:"due_soon,omitempty"`
+	// Alert is AlertMessage or AlertPopup to raise a tmux alert when a
+	// ticket becomes overdue. Empty raises none.
+	Alert string `yaml:"alert,omitempty"`
+}
+
+// DueSoonDuration returns the configured due-soon window, or the default.
+func (r *RemindersConfig) DueSoonDuration() time.Duration {
+	if d, err := time.ParseDuration(r.DueSoon); err == nil && d > 0 {
+		return d
+	}
+	return DefaultDueSoon
+}
+
+func (r *RemindersConfig) validate() error {
+	if r.DueSoon != "" {
+		if d, err := time.ParseDuration(r.DueSoon); err != nil || d <= 0 {
+			return &ValidationError{Field: "reminders.due_soon", Message: "must be a positive duration such as 24h"}
+		}
+	}
+	switch r.Alert {
+	case "", AlertMessage, AlertPopup:
+	default:
+		return &ValidationError{Field: "reminders.alert", Message: "must be message or popup"}
+	}
+	return nil
+}
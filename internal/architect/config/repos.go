@@ -0,0 +1,158 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IsolationWorktree gives each ticket its own git worktree instead of
+// spawning workers directly in the repo checkout.
+const IsolationWorktree = "worktree"
+
+// RepoConfig is a repo entry in cortex.yaml.
+//
+// Entries are usually a bare path:
+//
+//	repos:
+//	  service-a: ~/projects/service-a
+//
+// A mapping form adds per-repo options:
+//
+//	repos:
+//	  service-b:
+//	    path: ~/projects/service-b
+//	    isolation: worktree
+//	    max_concurrent: 2
+//	    verify:
+//	      commands: [make build, make test]
+//	      timeout: 15m
+//	      on_failure: bounce
+type RepoConfig struct {
+	Path      string `yaml:"path"`
+	Isolation string `yaml:"isolation,omitempty"`
+	// MaxConcurrent caps the ticket sessions running in this repo; further
+	// spawns are queued. Zero means unlimited.
+	MaxConcurrent int `yaml:"max_concurrent,omitempty"`
+	// Verify lists the commands run against a conclusion's commits.
+	Verify *VerifyConfig `yaml:"verify,omitempty"`
+}
+
+// Verification failure policies.
+const (
+	// VerifyReport records a failed verification on the conclusion.
+	VerifyReport = "report"
+	// VerifyBounce also moves the ticket back to progress and respawns
+	// the worker with the failure output.
+	VerifyBounce = "bounce"
+)
+
+// DefaultVerifyTimeout bounds a verification run when the repo sets none.
+const DefaultVerifyTimeout = 10 * time.Minute
+
+// VerifyConfig holds the verification commands of a repo. After a worker
+// concludes with commits, the daemon checks the last commit out in a
+// scratch worktree and runs the commands there in order, through sh -c,
+// stopping at the first failure.
+type VerifyConfig struct {
+	Commands []string `yaml:"commands"`
+	// Timeout bounds the whole run, as a Go duration. Defaults to
+	// DefaultVerifyTimeout.
+	Timeout string `yaml:"timeout,omitempty"`
+	// OnFailure is VerifyReport (the default) or VerifyBounce.
+	OnFailure string `yaml:"on_failure,omitempty"`
+}
+
+// TimeoutDuration returns the configured timeout, or the default.
+func (v *VerifyConfig) TimeoutDuration() time.Duration {
+	if d, err := time.ParseDuration(v.Timeout); err == nil && d > 0 {
+		return d
+	}
+	return DefaultVerifyTimeout
+}
+
+// Bounce reports whether a failed verification sends the ticket back to
+// its worker.
+func (v *VerifyConfig) Bounce() bool {
+	return v.OnFailure == VerifyBounce
+}
+
+// UnmarshalYAML accepts either a bare path or a mapping.
+func (r *RepoConfig) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*r = RepoConfig{Path: node.Value}
+		return nil
+	}
+	type plain RepoConfig
+	var p plain
+	if err := node.Decode(&p); err != nil {
+		return err
+	}
+	*r = RepoConfig(p)
+	return nil
+}
+
+// MarshalYAML writes entries without options back as a bare path.
+func (r RepoConfig) MarshalYAML() (any, error) {
+	if r.Isolation == "" && r.MaxConcurrent == 0 && r.Verify == nil {
+		return r.Path, nil
+	}
+	type plain RepoConfig
+	return plain(r), nil
+}
+
+// RepoIsolation returns the isolation mode configured for a repo key,
+// or "" when workers run directly in the repo checkout.
+func (c *Config) RepoIsolation(repoKey string) string {
+	return c.Repos[repoKey].Isolation
+}
+
+// RepoVerify returns the verification config of a repo key, or nil when
+// it declares no commands.
+func (c *Config) RepoVerify(repoKey string) *VerifyConfig {
+	v := c.Repos[repoKey].Verify
+	if v == nil || len(v.Commands) == 0 {
+		return nil
+	}
+	return v
+}
+
+// validateRepoVerify checks the verify block of a repo entry.
+func validateRepoVerify(repoKey string, repo RepoConfig) error {
+	v := repo.Verify
+	if v == nil {
+		return nil
+	}
+	field := fmt.Sprintf("repos.%s.verify", repoKey)
+	for i, cmd := range v.Commands {
+		if strings.TrimSpace(cmd) == "" {
+			return &ValidationError{Field: fmt.Sprintf("%s.commands[%d]", field, i), Message: "command cannot be empty"}
+		}
+	}
+	if v.Timeout != "" {
+		if d, err := time.ParseDuration(v.Timeout); err != nil || d <= 0 {
+			return &ValidationError{Field: field + ".timeout", Message: "must be a positive duration such as 10m"}
+		}
+	}
+	switch v.OnFailure {
+	case "", VerifyReport, VerifyBounce:
+	default:
+		return &ValidationError{Field: field + ".on_failure", Message: fmt.Sprintf("must be %q or %q", VerifyReport, VerifyBounce)}
+	}
+	return nil
+}
+
+// validateRepoIsolation checks the isolation mode of a repo entry.
+func validateRepoIsolation(repoKey string, repo RepoConfig) error {
+	switch repo.Isolation {
+	case "", IsolationWorktree:
+		return nil
+	default:
+		return &ValidationError{
+			Field:   fmt.Sprintf("repos.%s.isolation", repoKey),
+			Message: fmt.Sprintf("must be %q or omitted", IsolationWorktree),
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// DefaultStatuses is the status list used when cortex.yaml declares none.
+var DefaultStatuses = []string{"backlog", "progress", "done"}
+
+// StatusDef is a kanban status (column) declared in cortex.yaml.
+type StatusDef struct {
+	Name string `yaml:"name"`
+	// Transitions lists the statuses a ticket may move to from this one.
+	// When omitted, any configured status is allowed.
+	Transitions []string `yaml:"transitions,omitempty"`
+}
+
+// StatusNames returns the configured statuses in board order,
+// or DefaultStatuses when none are configured.
+func (c *Config) StatusNames() []string {
+	if len(c.Statuses) == 0 {
+		return slices.Clone(DefaultStatuses)
+	}
+	names := make([]string, len(c.Statuses))
+	for i, s := range c.Statuses {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// HasStatus reports whether status is one of the configured statuses.
+func (c *Config) HasStatus(status string) bool {
+	return slices.Contains(c.StatusNames(), status)
+}
+
+// CanTransition reports whether a ticket may move from one status to another.
+// Moving to the current status is always allowed.
+func (c *Config) CanTransition(from, to string) bool {
+	if !c.HasStatus(to) {
+		return false
+	}
+	if from == to {
+		return true
+	}
+	for _, s := range c.Statuses {
+		if s.Name == from {
+			return s.Transitions == nil || slices.Contains(s.Transitions, to)
+		}
+	}
+	return true
+}
+
+// validateStatuses checks the statuses section of cortex.yaml.
+func (c *Config) validateStatuses() error {
+	if len(c.Statuses) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(c.Statuses))
+	for i, s := range c.Statuses {
+		field := fmt.Sprintf("statuses[%d]", i)
+		if strings.TrimSpace(s.Name) == "" {
+			return &ValidationError{Field: field, Message: "status name cannot be empty"}
+		}
+		if strings.ContainsRune(s.Name, os.PathSeparator) {
+			return &ValidationError{Field: field, Message: "status name cannot contain path separators"}
+		}
+		if seen[s.Name] {
+			return &ValidationError{Field: field, Message: fmt.Sprintf("duplicate status %q", s.Name)}
+		}
+		seen[s.Name] = true
+	}
+
+	for _, required := range DefaultStatuses {
+		if !seen[required] {
+			return &ValidationError{Field: "statuses", Message: fmt.Sprintf("must include %s", required)}
+		}
+	}
+
+	for _, s := range c.Statuses {
+		for _, to := range s.Transitions {
+			if !seen[to] {
+				return &ValidationError{
+					Field:   fmt.Sprintf("statuses.%s.transitions", s.Name),
+					Message: fmt.Sprintf("unknown status %q", to),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkStatusDirs refuses a statuses list that drops a status whose
+// directory under tickets/ still holds tickets, which would otherwise
+// vanish from every view.
+func (c *Config) checkStatusDirs(architectRoot string) error {
+	ticketsDir := c.TicketsPath(architectRoot)
+	entries, err := os.ReadDir(ticketsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") || c.HasStatus(name) {
+			continue
+		}
+		tickets, err := os.ReadDir(filepath.Join(ticketsDir, name))
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for _, t := range tickets {
+			if t.IsDir() && !strings.HasPrefix(t.Name(), ".") {
+				ids = append(ids, t.Name())
+			}
+		}
+		if len(ids) > 0 {
+			return &ValidationError{
+				Field:   "statuses",
+				Message: fmt.Sprintf("status %q is not configured but still holds tickets %s; keep it until they are moved", name, strings.Join(ids, ", ")),
+			}
+		}
+	}
+	return nil
+}
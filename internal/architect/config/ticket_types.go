@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// DefaultTicketType is the built-in ticket type used when a ticket declares none.
+// It mirrors ticket.DefaultTicketType.
+const DefaultTicketType = "work"
+
+// TicketFields lists the frontmatter fields a ticket type may require.
+var TicketFields = []string{"body", "due", "references", "blocked_by", "blocks"}
+
+// TicketTypeDef describes a custom ticket type declared in cortex.yaml.
+type TicketTypeDef struct {
+	// Prompts is the directory under prompts/ holding SYSTEM.md and KICKOFF.md.
+	// Defaults to the type name.
+	Prompts         string   `yaml:"prompts,omitempty"`
+	RequiredFields  []string `yaml:"required_fields,omitempty"`
+	AllowedStatuses []string `yaml:"allowed_statuses,omitempty"`
+}
+
+// AllowsStatus reports whether tickets of this type may be in status.
+// An empty allowed_statuses list permits every status.
+func (d TicketTypeDef) AllowsStatus(status string) bool {
+	return len(d.AllowedStatuses) == 0 || slices.Contains(d.AllowedStatuses, status)
+}
+
+// TicketTypeNames returns the sorted list of known ticket types,
+// always including the built-in work type.
+func (c *Config) TicketTypeNames() []string {
+	names := []string{DefaultTicketType}
+	for name := range c.Types {
+		if name != DefaultTicketType {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveTicketType looks up a ticket type definition by name.
+// An empty name resolves to the built-in work type, which needs no entry in cortex.yaml.
+func (c *Config) ResolveTicketType(name string) (TicketTypeDef, error) {
+	if name == "" {
+		name = DefaultTicketType
+	}
+	if def, ok := c.Types[name]; ok {
+		return def, nil
+	}
+	if name == DefaultTicketType {
+		return TicketTypeDef{}, nil
+	}
+	return TicketTypeDef{}, fmt.Errorf("unknown ticket type %q (available: %v)", name, c.TicketTypeNames())
+}
+
+// TicketPromptDir returns the prompts/ subdirectory used for the given ticket type.
+// Unknown types fall back to their own name so the resolver can still try them.
+func (c *Config) TicketPromptDir(name string) string {
+	if name == "" {
+		name = DefaultTicketType
+	}
+	if def, ok := c.Types[name]; ok && def.Prompts != "" {
+		return def.Prompts
+	}
+	return name
+}
+
+// validateTypes checks the types section of cortex.yaml.
+func (c *Config) validateTypes() error {
+	for name, def := range c.Types {
+		field := fmt.Sprintf("types.%s", name)
+		if strings.TrimSpace(name) == "" {
+			return &ValidationError{Field: "types", Message: "type name cannot be empty"}
+		}
+		if strings.ContainsRune(name, os.PathSeparator) {
+			return &ValidationError{Field: field, Message: "type name cannot contain path separators"}
+		}
+		if strings.ContainsRune(def.Prompts, os.PathSeparator) {
+			return &ValidationError{Field: field + ".prompts", Message: "prompt directory cannot contain path separators"}
+		}
+		for _, f := range def.RequiredFields {
+			if !slices.Contains(TicketFields, f) {
+				return &ValidationError{
+					Field:   field + ".required_fields",
+					Message: fmt.Sprintf("unknown field %q (must be one of: %s)", f, strings.Join(TicketFields, ", ")),
+				}
+			}
+		}
+		for _, s := range def.AllowedStatuses {
+			if !c.HasStatus(s) {
+				return &ValidationError{
+					Field:   field + ".allowed_statuses",
+					Message: fmt.Sprintf("unknown status %q (must be one of: %s)", s, strings.Join(c.StatusNames(), ", ")),
+				}
+			}
+		}
+		if len(def.AllowedStatuses) > 0 && !slices.Contains(def.AllowedStatuses, "backlog") {
+			return &ValidationError{Field: field + ".allowed_statuses", Message: "must include backlog, where new tickets are created"}
+		}
+	}
+	return nil
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"time"
 
 	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
@@ -14,47 +13,85 @@ import (
 
 const ArchitectHeader = "X-Cortex-Architect"
 
+// ActorHeader tells the daemon who is making a request, for ticket history.
+const ActorHeader = "X-Cortex-Actor"
+
+// Actors for clients that are not agent sessions.
+const (
+	ActorCLI = "cli"
+	ActorTUI = "tui"
+)
+
 type Client struct {
 	baseURL       string
 	httpClient    *http.Client
 	architectPath string
+	actor         string
+	token         string
 }
 
-func NewClient(baseURL, architectPath string) *Client {
+// NewClient returns a client for the daemon at daemonURL, which may be a
+// unix:// socket URL.
+func NewClient(daemonURL, architectPath string) *Client {
+	httpClient, baseURL := daemonconfig.NewHTTPClient(daemonURL, 10*time.Second)
 	return &Client{
 		baseURL:       baseURL,
 		architectPath: architectPath,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		httpClient:    httpClient,
 	}
 }
 
 func DefaultClient(architectPath string) *Client {
-	baseURL := os.Getenv("CORTEX_DAEMON_URL")
-	if baseURL == "" {
-		baseURL = daemonconfig.DefaultDaemonURL
-	}
-	return NewClient(baseURL, architectPath)
+	return NewClient(daemonconfig.ClientDaemonURL(), architectPath).WithToken(daemonconfig.ClientToken())
+}
+
+// WithActor sets the actor sent with each request and returns the client.
+// Valid values are ActorCLI, ActorTUI, "architect", "worker:<ticket-id>" and
+// "collab:<collab-id>".
+func (c *Client) WithActor(actor string) *Client {
+	c.actor = actor
+	return c
+}
+
+// WithToken sets the bearer token sent with each request and returns the
+// client. An empty token sends none.
+func (c *Client) WithToken(token string) *Client {
+	c.token = token
+	return c
 }
 
 func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
 	if c.architectPath != "" {
 		req.Header.Set(ArchitectHeader, c.architectPath)
 	}
+	if c.actor != "" {
+		req.Header.Set(ActorHeader, c.actor)
+	}
+	c.setAuth(req)
 	return c.httpClient.Do(req)
 }
 
+// setAuth adds the bearer token, if any, to a request.
+func (c *Client) setAuth(req *http.Request) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+}
+
 type (
 	ErrorResponse            = types.ErrorResponse
 	SessionResponse          = types.SessionResponse
 	TicketResponse           = types.TicketResponse
 	TicketSummary            = types.TicketSummary
+	CriterionResponse        = types.CriterionResponse
 	ListTicketsResponse      = types.ListTicketsResponse
 	ListAllTicketsResponse   = types.ListAllTicketsResponse
+	TicketColumn             = types.TicketColumn
+	TicketGraphResponse      = types.TicketGraphResponse
 	DiffFileResponse         = types.DiffFileResponse
 	CommitDiffResponse       = types.CommitDiffResponse
 	DiffsResponse            = types.DiffsResponse
+	RepoDiffsResponse        = types.RepoDiffsResponse
 	ArchitectSessionResponse = types.ArchitectSessionResponse
 	ArchitectStateResponse   = types.ArchitectStateResponse
 	ArchitectSpawnResponse   = types.ArchitectSpawnResponse
@@ -63,13 +100,35 @@ type (
 	ArchitectResponse        = types.ArchitectResponse
 	ConcludeSessionResponse  = types.ConcludeSessionResponse
 	ConclusionResponse       = types.ConclusionResponse
+	VerificationResponse     = types.VerificationResponse
+	VerifyCommandResponse    = types.VerifyCommandResponse
 	ConclusionSummary        = types.ConclusionSummary
 	ListConclusionsResponse  = types.ListConclusionsResponse
+	SearchResult             = types.SearchResult
+	SearchResponse           = types.SearchResponse
+	EventResponse            = types.EventResponse
+	EventHistoryResponse     = types.EventHistoryResponse
+	FieldChangeResponse      = types.FieldChangeResponse
+	TicketRevisionResponse   = types.TicketRevisionResponse
+	TicketHistoryResponse    = types.TicketHistoryResponse
+	TimelineEntryResponse    = types.TimelineEntryResponse
+	QueuedSpawnResponse      = types.QueuedSpawnResponse
+	SessionTimelineResponse  = types.SessionTimelineResponse
+	SessionLogResponse       = types.SessionLogResponse
 	ResolvePromptResponse    = types.ResolvePromptResponse
 	PromptFileInfo           = types.PromptFileInfo
 	PromptGroupInfo          = types.PromptGroupInfo
 	ListPromptsResponse      = types.ListPromptsResponse
 	SpawnCollabResponse      = types.SpawnCollabResponse
+	ReviewResponse           = types.ReviewResponse
+	ReviewCommentResponse    = types.ReviewCommentResponse
+	ReviewActionResponse     = types.ReviewActionResponse
+	SpawnReviewerResponse    = types.SpawnReviewerResponse
+	TemplateResponse         = types.TemplateResponse
+	ListTemplatesResponse    = types.ListTemplatesResponse
+	ScheduleResponse         = types.ScheduleResponse
+	ListSchedulesResponse    = types.ListSchedulesResponse
+	InvalidScheduleResponse  = types.InvalidScheduleResponse
 )
 
 type APIError struct {
@@ -86,6 +145,10 @@ func (e *APIError) IsOrphanedSession() bool {
 	return e.Code == "session_orphaned"
 }
 
+func (e *APIError) IsTicketBlocked() bool {
+	return e.Code == "ticket_blocked"
+}
+
 func (e *APIError) IsVariantRequired() bool {
 	return e.Code == "variant_required"
 }
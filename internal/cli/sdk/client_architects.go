@@ -19,6 +19,7 @@ func (c *Client) ListArchitects() (*ListArchitectsResponse, error) {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	c.setAuth(req)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
@@ -45,6 +46,7 @@ func (c *Client) UnlinkArchitect(architectPath string) error {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
+	c.setAuth(req)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to connect to daemon: %w", err)
@@ -75,6 +77,7 @@ func (c *Client) RegisterArchitect(path, title string) error {
 	}
 	req.Header.Set("Content-Type", "application/json")
 
+	c.setAuth(req)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to connect to daemon: %w", err)
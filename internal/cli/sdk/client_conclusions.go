@@ -16,6 +16,9 @@ type ConcludeSessionParams struct {
 	Commits         []string
 	Rejected        bool
 	RejectionReason string
+	CleanupWorktree bool
+	// Repo is the repo concluded by one worker of a multi-repo ticket.
+	Repo string
 }
 
 // ConcludeSession concludes a ticket session.
@@ -33,6 +36,12 @@ func (c *Client) ConcludeSession(p ConcludeSessionParams) (*ConcludeSessionRespo
 	if p.RejectionReason != "" {
 		reqBody["rejection_reason"] = p.RejectionReason
 	}
+	if p.CleanupWorktree {
+		reqBody["cleanup_worktree"] = true
+	}
+	if p.Repo != "" {
+		reqBody["repo"] = p.Repo
+	}
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode request: %w", err)
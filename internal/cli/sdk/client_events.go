@@ -6,20 +6,75 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// EventResyncRequired is sent when the daemon could not deliver or replay
+// every event; clients should reload their state.
+const EventResyncRequired = "resync_required"
+
+// EventPipelineAction reports an action taken by a cortex.yaml pipeline
+// rule. Its payload holds rule, action, result and message.
+const EventPipelineAction = "pipeline_action"
+
+// EventVerificationFinished reports the verdict of a repo's verify
+// commands on a conclusion. Its payload holds repo, commit, status (passed
+// or failed) and message.
+const EventVerificationFinished = "verification_finished"
+
+// Due-date reminders, each sent once per due date of an open ticket.
+// Their payload holds title and due.
+const (
+	EventTicketDueSoon = "ticket_due_soon"
+	EventTicketOverdue = "ticket_overdue"
+)
+
+// Spawn queue events. spawn_queued carries variant, position and reason;
+// spawn_dequeued carries variant, result (started, skipped or failed) and
+// message.
+const (
+	EventSpawnQueued   = "spawn_queued"
+	EventSpawnDequeued = "spawn_dequeued"
+)
+
+// PipelineActionSummary formats a pipeline_action event for display, e.g.
+// "auto-spawn: spawn done (spawned with variant fast)".
+func PipelineActionSummary(e Event) string {
+	payload, _ := e.Payload.(map[string]any)
+	str := func(key string) string {
+		s, _ := payload[key].(string)
+		return s
+	}
+	summary := fmt.Sprintf("%s: %s %s", str("rule"), str("action"), str("result"))
+	if msg := str("message"); msg != "" {
+		summary += " (" + msg + ")"
+	}
+	return summary
+}
+
 // Event represents an SSE event from the daemon.
 type Event struct {
-	Type          string `json:"type"`
-	ArchitectPath string `json:"architect_path"`
-	TicketID      string `json:"ticket_id"`
-	Payload       any    `json:"payload,omitempty"`
+	ID            uint64    `json:"id,omitempty"`
+	Type          string    `json:"type"`
+	ArchitectPath string    `json:"architect_path"`
+	TicketID      string    `json:"ticket_id"`
+	SessionID     string    `json:"session_id,omitempty"`
+	Payload       any       `json:"payload,omitempty"`
+	Time          time.Time `json:"time,omitzero"`
 }
 
 // SubscribeEvents opens an SSE connection and returns a channel of events.
 // The channel is closed when the context is cancelled or the connection drops.
 func (c *Client) SubscribeEvents(ctx context.Context) (<-chan Event, error) {
+	return c.SubscribeEventsFrom(ctx, 0)
+}
+
+// SubscribeEventsFrom is like SubscribeEvents but resumes after
+// lastEventID: events the client missed since then are replayed first.
+// A lastEventID of zero starts with new events only.
+func (c *Client) SubscribeEventsFrom(ctx context.Context, lastEventID uint64) (<-chan Event, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/events", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -28,8 +83,13 @@ func (c *Client) SubscribeEvents(ctx context.Context) (<-chan Event, error) {
 	if c.architectPath != "" {
 		req.Header.Set(ArchitectHeader, c.architectPath)
 	}
+	if lastEventID > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatUint(lastEventID, 10))
+	}
+	c.setAuth(req)
 
-	sseClient := &http.Client{}
+	// Same transport (so unix sockets work), but no timeout for the stream.
+	sseClient := &http.Client{Transport: c.httpClient.Transport}
 	resp, err := sseClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to event stream: %w", err)
@@ -67,3 +127,34 @@ func (c *Client) SubscribeEvents(ctx context.Context) (<-chan Event, error) {
 
 	return ch, nil
 }
+
+// GetEventHistory returns journaled events after since, oldest first.
+// A limit of zero uses the server default.
+func (c *Client) GetEventHistory(since uint64, limit int) (*EventHistoryResponse, error) {
+	url := fmt.Sprintf("%s/events/history?since=%d", c.baseURL, since)
+	if limit > 0 {
+		url += fmt.Sprintf("&limit=%d", limit)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result EventHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
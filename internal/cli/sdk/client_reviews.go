@@ -0,0 +1,200 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReviewCommentParams holds parameters for commenting on a ticket's diffs.
+type ReviewCommentParams struct {
+	TicketID string
+	// Repo picks the repo of a multi-repo ticket the file belongs to.
+	Repo   string
+	Commit string
+	File   string
+	// Line is the line in the changed file; 0 comments on the whole file.
+	Line int
+	Body string
+}
+
+// RequestChangesParams holds parameters for sending a ticket back to its
+// worker from review.
+type RequestChangesParams struct {
+	TicketID string
+	Summary  string
+	// Variant defaults to the variant of the session that concluded.
+	Variant string
+	Backend string
+}
+
+// SubmitReviewParams holds a reviewer agent's verdict on a ticket.
+type SubmitReviewParams struct {
+	TicketID string
+	// Verdict is "approve" or "request_changes".
+	Verdict  string
+	Summary  string
+	Findings []ReviewCommentParams
+}
+
+// GetReview returns the review of a ticket.
+func (c *Client) GetReview(ticketID string) (*ReviewResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/tickets/"+ticketID+"/review", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result ReviewResponse
+	if err := c.doReviewRequest(req, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddReviewComment leaves a comment on a file of a ticket in review.
+func (c *Client) AddReviewComment(p ReviewCommentParams) (*ReviewCommentResponse, error) {
+	reqBody := map[string]interface{}{"file": p.File, "body": p.Body}
+	if p.Repo != "" {
+		reqBody["repo"] = p.Repo
+	}
+	if p.Commit != "" {
+		reqBody["commit"] = p.Commit
+	}
+	if p.Line > 0 {
+		reqBody["line"] = p.Line
+	}
+	req, err := newJSONRequest(http.MethodPost, c.baseURL+"/tickets/"+p.TicketID+"/review/comments", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ReviewCommentResponse
+	if err := c.doReviewRequest(req, http.StatusCreated, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AcceptReview accepts a ticket in review and moves it to done.
+func (c *Client) AcceptReview(ticketID, summary string) (*ReviewActionResponse, error) {
+	reqBody := map[string]interface{}{}
+	if summary != "" {
+		reqBody["summary"] = summary
+	}
+	req, err := newJSONRequest(http.MethodPost, c.baseURL+"/tickets/"+ticketID+"/review/accept", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ReviewActionResponse
+	if err := c.doReviewRequest(req, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestChanges sends a ticket in review back to progress and spawns a
+// worker with the review comments in its prompt.
+func (c *Client) RequestChanges(p RequestChangesParams) (*ReviewActionResponse, error) {
+	reqBody := map[string]interface{}{}
+	if p.Summary != "" {
+		reqBody["summary"] = p.Summary
+	}
+	if p.Variant != "" {
+		reqBody["variant"] = p.Variant
+	}
+	if p.Backend != "" {
+		reqBody["backend"] = p.Backend
+	}
+	req, err := newJSONRequest(http.MethodPost, c.baseURL+"/tickets/"+p.TicketID+"/review/request-changes", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ReviewActionResponse
+	if err := c.doReviewRequest(req, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SpawnReviewer spawns a reviewer agent that audits the commits of a
+// concluded ticket. An empty variant uses reviewer.variant from cortex.yaml.
+func (c *Client) SpawnReviewer(ticketID, variant string) (*SpawnReviewerResponse, error) {
+	reqBody := map[string]interface{}{}
+	if variant != "" {
+		reqBody["variant"] = variant
+	}
+	req, err := newJSONRequest(http.MethodPost, c.baseURL+"/tickets/"+ticketID+"/review/spawn", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SpawnReviewerResponse
+	if err := c.doReviewRequest(req, http.StatusCreated, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SubmitReview records a reviewer agent's verdict and findings.
+func (c *Client) SubmitReview(p SubmitReviewParams) (*ReviewActionResponse, error) {
+	findings := make([]map[string]interface{}, 0, len(p.Findings))
+	for _, f := range p.Findings {
+		finding := map[string]interface{}{"file": f.File, "body": f.Body}
+		if f.Repo != "" {
+			finding["repo"] = f.Repo
+		}
+		if f.Commit != "" {
+			finding["commit"] = f.Commit
+		}
+		if f.Line > 0 {
+			finding["line"] = f.Line
+		}
+		findings = append(findings, finding)
+	}
+	reqBody := map[string]interface{}{"verdict": p.Verdict, "findings": findings}
+	if p.Summary != "" {
+		reqBody["summary"] = p.Summary
+	}
+	req, err := newJSONRequest(http.MethodPost, c.baseURL+"/tickets/"+p.TicketID+"/review/submit", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ReviewActionResponse
+	if err := c.doReviewRequest(req, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func newJSONRequest(method, url string, body any) (*http.Request, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	req, err := http.NewRequest(method, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func (c *Client) doReviewRequest(req *http.Request, wantStatus int, result any) error {
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != wantStatus {
+		return c.parseError(resp)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ScheduleParams holds parameters for adding a recurring ticket schedule.
+type ScheduleParams struct {
+	Name       string   `json:"name"`
+	Cron       string   `json:"cron"`
+	Title      string   `json:"title"`
+	Body       string   `json:"body"`
+	Type       string   `json:"type,omitempty"`
+	Repo       string   `json:"repo"`
+	References []string `json:"references,omitempty"`
+	Due        string   `json:"due,omitempty"`
+	Variant    string   `json:"variant,omitempty"`
+	Paused     bool     `json:"paused,omitempty"`
+}
+
+// ListSchedules returns the recurring ticket schedules of the architect.
+func (c *Client) ListSchedules() (*ListSchedulesResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/schedules", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result ListSchedulesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateSchedule adds a recurring ticket schedule.
+func (c *Client) CreateSchedule(p ScheduleParams) (*ScheduleResponse, error) {
+	jsonBody, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/schedules", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var result ScheduleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteSchedule removes a recurring ticket schedule. Tickets it created
+// are kept.
+func (c *Client) DeleteSchedule(name string) error {
+	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/schedules/"+url.PathEscape(name), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return c.parseError(resp)
+	}
+
+	return nil
+}
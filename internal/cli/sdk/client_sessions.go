@@ -4,15 +4,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 )
 
+// SpawnResult is the outcome of a spawn request. Queue is set, and Session
+// nil, when a concurrency limit queued the spawn.
 type SpawnResult struct {
-	Session *SessionResponse
-	Ticket  *TicketResponse
+	Session *SessionResponse `json:"session,omitempty"`
+	// Sessions lists every repo's session of a multi-repo ticket.
+	Sessions []SessionResponse    `json:"sessions,omitempty"`
+	Ticket   *TicketResponse      `json:"ticket"`
+	Queue    *QueuedSpawnResponse `json:"queue,omitempty"`
 }
 
-func (c *Client) SpawnSession(status, id, mode, variant string) (*SpawnResult, error) {
+// SpawnSession spawns a ticket agent session. force bypasses open blockers.
+// An empty backend uses the architect's session_backend.
+func (c *Client) SpawnSession(status, id, mode, variant, backend string, force bool) (*SpawnResult, error) {
 	url := c.baseURL + "/tickets/" + status + "/" + id + "/spawn"
 	sep := "?"
 	if mode != "" {
@@ -21,6 +29,14 @@ func (c *Client) SpawnSession(status, id, mode, variant string) (*SpawnResult, e
 	}
 	if variant != "" {
 		url += sep + "variant=" + variant
+		sep = "&"
+	}
+	if backend != "" {
+		url += sep + "backend=" + backend
+		sep = "&"
+	}
+	if force {
+		url += sep + "force=true"
 	}
 
 	req, err := http.NewRequest(http.MethodPost, url, nil)
@@ -35,24 +51,50 @@ func (c *Client) SpawnSession(status, id, mode, variant string) (*SpawnResult, e
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
 		return nil, c.parseError(resp)
 	}
 
 	var result struct {
-		Session SessionResponse `json:"session"`
-		Ticket  TicketResponse  `json:"ticket"`
+		Session  SessionResponse      `json:"session"`
+		Sessions []SessionResponse    `json:"sessions"`
+		Ticket   TicketResponse       `json:"ticket"`
+		Queue    *QueuedSpawnResponse `json:"queue"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	if result.Queue != nil {
+		return &SpawnResult{Ticket: &result.Ticket, Queue: result.Queue}, nil
+	}
 	return &SpawnResult{
-		Session: &result.Session,
-		Ticket:  &result.Ticket,
+		Session:  &result.Session,
+		Sessions: result.Sessions,
+		Ticket:   &result.Ticket,
 	}, nil
 }
 
+// CancelQueuedSpawn removes a ticket's spawn from the queue.
+func (c *Client) CancelQueuedSpawn(ticketID string) error {
+	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/sessions/queue/"+ticketID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return c.parseError(resp)
+	}
+
+	return nil
+}
+
 // KillSession kills a session by ID.
 func (c *Client) KillSession(id string) error {
 	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/sessions/"+id, nil)
@@ -100,12 +142,26 @@ type SessionListItem struct {
 	TicketID    string    `json:"ticket_id"`
 	TicketTitle string    `json:"ticket_title"`
 	Agent       string    `json:"agent"`
+	Variant     string    `json:"variant,omitempty"`
 	TmuxWindow  string    `json:"tmux_window"`
 	StartedAt   time.Time `json:"started_at"`
 	Status      string    `json:"status"`
 	Tool        *string   `json:"tool,omitempty"`
+
+	// Backend is "headless" for sessions run without tmux, whose output
+	// goes to LogPath.
+	Backend string `json:"backend,omitempty"`
+	LogPath string `json:"log_path,omitempty"`
+
+	// Set on queued spawns (Status "queued"), which have no SessionID.
+	QueuePosition int        `json:"queue_position,omitempty"`
+	QueueReason   string     `json:"queue_reason,omitempty"`
+	QueuedAt      *time.Time `json:"queued_at,omitempty"`
 }
 
+// SessionStatusQueued is the status of a spawn waiting in the queue.
+const SessionStatusQueued = "queued"
+
 // TicketTitle is reused for non-ticket sessions (collab sessions show "Collab: {prompt}")
 
 // ListSessionsResponse is the response from GET /sessions.
@@ -138,3 +194,58 @@ func (c *Client) ListSessions() (*ListSessionsResponse, error) {
 
 	return &result, nil
 }
+
+// GetSessionTimeline returns the recorded hook events of a session.
+func (c *Client) GetSessionTimeline(sessionID string) (*SessionTimelineResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/sessions/"+sessionID+"/timeline", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result SessionTimelineResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetSessionLog returns the output of a headless session, limited to the
+// last lines lines when lines > 0.
+func (c *Client) GetSessionLog(sessionID string, lines int) (*SessionLogResponse, error) {
+	url := c.baseURL + "/sessions/" + sessionID + "/log"
+	if lines > 0 {
+		url += "?lines=" + strconv.Itoa(lines)
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result SessionLogResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
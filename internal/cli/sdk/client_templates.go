@@ -0,0 +1,42 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TemplateTicketParams holds parameters for creating a ticket from a
+// template. Fields left empty take the template's defaults.
+type TemplateTicketParams = CreateTicketParams
+
+// ListTemplates returns the ticket templates of the architect.
+func (c *Client) ListTemplates() (*ListTemplatesResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/templates", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result ListTemplatesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateTicketFromTemplate creates a ticket from the template p.Template
+// rendered with p.Vars.
+func (c *Client) CreateTicketFromTemplate(p TemplateTicketParams) (*TicketResponse, error) {
+	return c.CreateTicketWithParams(p)
+}
@@ -427,7 +427,7 @@ func TestCreateTicket_Success(t *testing.T) {
 	})
 
 	c := NewClient(srv.URL, "/p")
-	resp, err := c.CreateTicket("New Ticket", "body", "", nil, nil)
+	resp, err := c.CreateTicket("New Ticket", "body", "", nil, nil, nil, "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -447,7 +447,7 @@ func TestCreateTicket_WithAllFields(t *testing.T) {
 
 	c := NewClient(srv.URL, "/p")
 	due := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
-	_, err := c.CreateTicket("T", "B", "", &due, []string{"ref1"})
+	_, err := c.CreateTicket("T", "B", "", &due, []string{"ref1"}, nil, "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -468,7 +468,7 @@ func TestCreateTicket_Error(t *testing.T) {
 	})
 
 	c := NewClient(srv.URL, "/p")
-	_, err := c.CreateTicket("", "", "", nil, nil)
+	_, err := c.CreateTicket("", "", "", nil, nil, nil, "")
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -489,7 +489,7 @@ func TestUpdateTicket_Success(t *testing.T) {
 
 	c := NewClient(srv.URL, "/p")
 	title := "Updated"
-	resp, err := c.UpdateTicket("abc123", &title, nil, nil)
+	resp, err := c.UpdateTicket("abc123", &title, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -507,7 +507,7 @@ func TestDeleteTicket_Success(t *testing.T) {
 	rs.setRoute("DELETE", "/tickets/backlog/abc123", http.StatusNoContent, nil)
 
 	c := NewClient(srv.URL, "/p")
-	err := c.DeleteTicket("abc123")
+	err := c.DeleteTicket("abc123", false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -590,7 +590,7 @@ func TestSpawnSession_Success(t *testing.T) {
 	})
 
 	c := NewClient(srv.URL, "/p")
-	resp, err := c.SpawnSession("backlog", "abc123", "", "")
+	resp, err := c.SpawnSession("backlog", "abc123", "", "", "", false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -599,6 +599,28 @@ func TestSpawnSession_Success(t *testing.T) {
 	}
 }
 
+func TestSpawnSession_Queued(t *testing.T) {
+	srv, rs := newRoutedServer(t)
+	rs.setRoute("POST", "/tickets/backlog/abc123/spawn", http.StatusAccepted, map[string]interface{}{
+		"ticket": map[string]interface{}{"id": "abc123", "title": "Test", "status": "backlog"},
+		"queue": map[string]interface{}{
+			"ticket_id": "abc123",
+			"variant":   "fast",
+			"position":  2,
+			"reason":    "variant fast at max_concurrent (1/1)",
+		},
+	})
+
+	c := NewClient(srv.URL, "/p")
+	resp, err := c.SpawnSession("backlog", "abc123", "", "fast", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Session != nil || resp.Queue == nil || resp.Queue.Position != 2 {
+		t.Errorf("expected queued result, got %+v", resp)
+	}
+}
+
 func TestKillSession_Success(t *testing.T) {
 	srv, rs := newRoutedServer(t)
 	rs.setRoute("DELETE", "/sessions/sess1", http.StatusNoContent, nil)
@@ -5,27 +5,65 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
 
+// TicketFilter narrows and orders a ticket listing. Empty fields do not
+// filter.
+type TicketFilter struct {
+	// Query matches a substring of the title or body, case-insensitively.
+	Query string
+	// DueBefore keeps tickets due before the time.
+	DueBefore *time.Time
+	// Labels keeps tickets carrying every label.
+	Labels []string
+	// Priorities keeps tickets with any of the priorities.
+	Priorities []string
+	Assignee   string
+	// Sort is created (default), updated, priority or due.
+	Sort string
+}
+
+func (f TicketFilter) values() url.Values {
+	params := url.Values{}
+	if f.Query != "" {
+		params.Set("query", f.Query)
+	}
+	if f.DueBefore != nil {
+		params.Set("due_before", f.DueBefore.Format(time.RFC3339))
+	}
+	if len(f.Labels) > 0 {
+		params.Set("label", strings.Join(f.Labels, ","))
+	}
+	if len(f.Priorities) > 0 {
+		params.Set("priority", strings.Join(f.Priorities, ","))
+	}
+	if f.Assignee != "" {
+		params.Set("assignee", f.Assignee)
+	}
+	if f.Sort != "" {
+		params.Set("sort", f.Sort)
+	}
+	return params
+}
+
 // ListAllTickets returns all tickets grouped by status.
 // If query is non-empty, filters tickets by title or body (case-insensitive).
 // If dueBefore is non-nil, filters tickets with due date before the specified time.
 func (c *Client) ListAllTickets(query string, dueBefore *time.Time) (*ListAllTicketsResponse, error) {
-	url := c.baseURL + "/tickets"
-	params := []string{}
-	if query != "" {
-		params = append(params, "query="+query)
-	}
-	if dueBefore != nil {
-		params = append(params, "due_before="+dueBefore.Format(time.RFC3339))
-	}
-	if len(params) > 0 {
-		url += "?" + strings.Join(params, "&")
+	return c.FilterTickets(TicketFilter{Query: query, DueBefore: dueBefore})
+}
+
+// FilterTickets returns the tickets passing f, grouped by status.
+func (c *Client) FilterTickets(f TicketFilter) (*ListAllTicketsResponse, error) {
+	endpoint := c.baseURL + "/tickets"
+	if params := f.values(); len(params) > 0 {
+		endpoint += "?" + params.Encode()
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -52,19 +90,18 @@ func (c *Client) ListAllTickets(query string, dueBefore *time.Time) (*ListAllTic
 // If query is non-empty, filters tickets by title or body (case-insensitive).
 // If dueBefore is non-nil, filters tickets with due date before the specified time.
 func (c *Client) ListTicketsByStatus(status, query string, dueBefore *time.Time) (*ListTicketsResponse, error) {
-	url := c.baseURL + "/tickets/" + status
-	params := []string{}
-	if query != "" {
-		params = append(params, "query="+query)
-	}
-	if dueBefore != nil {
-		params = append(params, "due_before="+dueBefore.Format(time.RFC3339))
-	}
-	if len(params) > 0 {
-		url += "?" + strings.Join(params, "&")
+	return c.FilterTicketsByStatus(status, TicketFilter{Query: query, DueBefore: dueBefore})
+}
+
+// FilterTicketsByStatus returns the tickets with a specific status that
+// pass f.
+func (c *Client) FilterTicketsByStatus(status string, f TicketFilter) (*ListTicketsResponse, error) {
+	endpoint := c.baseURL + "/tickets/" + status
+	if params := f.values(); len(params) > 0 {
+		endpoint += "?" + params.Encode()
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -112,18 +149,91 @@ func (c *Client) GetTicket(status, id string) (*TicketResponse, error) {
 	return &result, nil
 }
 
-// CreateTicket creates a new ticket.
-func (c *Client) CreateTicket(title, body, repo string, dueDate *time.Time, references []string) (*TicketResponse, error) {
-	reqBody := map[string]any{"title": title, "body": body}
-	if repo != "" {
-		reqBody["repo"] = repo
+// CreateTicketParams holds parameters for creating a ticket. When
+// Template is set, the fields left empty take its defaults.
+type CreateTicketParams struct {
+	Template   string
+	Vars       map[string]string
+	Title      string
+	Body       string
+	Type       string
+	Repo       string
+	Repos      []string
+	DueDate    *time.Time
+	References []string
+	BlockedBy  []string
+	Blocks     []string
+	// Priority is urgent, high, medium or low.
+	Priority string
+	Labels   []string
+	// Assignee is a person or the name of an agent variant.
+	Assignee string
+	// FollowUpOf links the new ticket with an existing one both ways.
+	FollowUpOf string
+}
+
+// CreateTicket creates a new ticket. Extra repos make it span several
+// repos, with repo first.
+func (c *Client) CreateTicket(title, body, repo string, dueDate *time.Time, references, blockedBy []string, ticketType string, repos ...string) (*TicketResponse, error) {
+	return c.CreateTicketWithParams(CreateTicketParams{
+		Title:      title,
+		Body:       body,
+		Type:       ticketType,
+		Repo:       repo,
+		Repos:      repos,
+		DueDate:    dueDate,
+		References: references,
+		BlockedBy:  blockedBy,
+	})
+}
+
+// CreateTicketWithParams creates a new ticket from p.
+func (c *Client) CreateTicketWithParams(p CreateTicketParams) (*TicketResponse, error) {
+	reqBody := map[string]any{"title": p.Title, "body": p.Body}
+	if p.Template != "" {
+		reqBody["template"] = p.Template
+	}
+	if len(p.Vars) > 0 {
+		reqBody["vars"] = p.Vars
+	}
+	if p.Type != "" {
+		reqBody["type"] = p.Type
+	}
+	if p.Repo != "" {
+		reqBody["repo"] = p.Repo
+	}
+	if len(p.Repos) > 0 {
+		reqBody["repos"] = p.Repos
+	}
+	if p.DueDate != nil {
+		reqBody["due_date"] = p.DueDate.Format(time.RFC3339)
+	}
+	if p.References != nil {
+		reqBody["references"] = p.References
+	}
+	if p.BlockedBy != nil {
+		reqBody["blocked_by"] = p.BlockedBy
+	}
+	if p.Blocks != nil {
+		reqBody["blocks"] = p.Blocks
 	}
-	if dueDate != nil {
-		reqBody["due_date"] = dueDate.Format(time.RFC3339)
+	if p.Priority != "" {
+		reqBody["priority"] = p.Priority
 	}
-	if references != nil {
-		reqBody["references"] = references
+	if len(p.Labels) > 0 {
+		reqBody["labels"] = p.Labels
 	}
+	if p.Assignee != "" {
+		reqBody["assignee"] = p.Assignee
+	}
+	if p.FollowUpOf != "" {
+		reqBody["follow_up_of"] = p.FollowUpOf
+	}
+	return c.postTicket(reqBody)
+}
+
+// postTicket sends a create request to POST /tickets.
+func (c *Client) postTicket(reqBody map[string]any) (*TicketResponse, error) {
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode request: %w", err)
@@ -153,23 +263,79 @@ func (c *Client) CreateTicket(title, body, repo string, dueDate *time.Time, refe
 	return &result, nil
 }
 
-// UpdateTicket updates a ticket's title, body, and/or references by ID (status-agnostic).
-func (c *Client) UpdateTicket(id string, title, body *string, references *[]string) (*TicketResponse, error) {
-	current, err := c.GetTicketByID(id)
-	if err != nil {
-		return nil, err
-	}
+// UpdateTicketParams holds the ticket fields to change. Nil fields are
+// left as they are; an empty priority or assignee, or empty labels, clear
+// them.
+type UpdateTicketParams struct {
+	Title      *string
+	Body       *string
+	References *[]string
+	BlockedBy  *[]string
+	Blocks     *[]string
+	Priority   *string
+	Labels     *[]string
+	Assignee   *string
+}
 
+// UpdateTicket updates a ticket's title, body, references, and/or blockers by ID (status-agnostic).
+func (c *Client) UpdateTicket(id string, title, body *string, references, blockedBy *[]string) (*TicketResponse, error) {
+	return c.UpdateTicketWithParams(id, UpdateTicketParams{
+		Title:      title,
+		Body:       body,
+		References: references,
+		BlockedBy:  blockedBy,
+	})
+}
+
+// UpdateTicketTriage changes a ticket's priority, labels and/or assignee
+// by ID (status-agnostic).
+func (c *Client) UpdateTicketTriage(id string, priority *string, labels *[]string, assignee *string) (*TicketResponse, error) {
+	return c.UpdateTicketWithParams(id, UpdateTicketParams{
+		Priority: priority,
+		Labels:   labels,
+		Assignee: assignee,
+	})
+}
+
+// UpdateTicketWithParams changes the fields of p in a single request by
+// ID (status-agnostic). A title change renames the ticket; the returned
+// ticket carries the new ID.
+func (c *Client) UpdateTicketWithParams(id string, p UpdateTicketParams) (*TicketResponse, error) {
 	reqBody := map[string]any{}
-	if title != nil {
-		reqBody["title"] = *title
+	if p.Title != nil {
+		reqBody["title"] = *p.Title
+	}
+	if p.Body != nil {
+		reqBody["body"] = *p.Body
+	}
+	if p.References != nil {
+		reqBody["references"] = *p.References
+	}
+	if p.BlockedBy != nil {
+		reqBody["blocked_by"] = *p.BlockedBy
+	}
+	if p.Blocks != nil {
+		reqBody["blocks"] = *p.Blocks
+	}
+	if p.Priority != nil {
+		reqBody["priority"] = *p.Priority
+	}
+	if p.Labels != nil {
+		reqBody["labels"] = *p.Labels
 	}
-	if body != nil {
-		reqBody["body"] = *body
+	if p.Assignee != nil {
+		reqBody["assignee"] = *p.Assignee
 	}
-	if references != nil {
-		reqBody["references"] = *references
+	return c.putTicket(id, reqBody)
+}
+
+// putTicket sends an update request to PUT /tickets/{status}/{id}.
+func (c *Client) putTicket(id string, reqBody map[string]any) (*TicketResponse, error) {
+	current, err := c.GetTicketByID(id)
+	if err != nil {
+		return nil, err
 	}
+
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode request: %w", err)
@@ -238,14 +404,57 @@ func (c *Client) EditTicketBody(id, oldString, newString string, replaceAll bool
 	return &result, nil
 }
 
+// CheckCriterion checks or unchecks acceptance criterion index (1-based)
+// of a ticket, recording evidence under it when set.
+func (c *Client) CheckCriterion(id string, index int, checked bool, evidence string) (*TicketResponse, error) {
+	reqBody := map[string]any{"checked": checked}
+	if evidence != "" {
+		reqBody["evidence"] = evidence
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/tickets/%s/criteria/%d", c.baseURL, id, index), bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result TicketResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // DeleteTicket deletes a ticket by ID (status-agnostic).
-func (c *Client) DeleteTicket(id string) error {
+// When cleanupWorktree is set, the ticket's isolated git worktree is removed first.
+func (c *Client) DeleteTicket(id string, cleanupWorktree bool) error {
 	current, err := c.GetTicketByID(id)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, c.baseURL+"/tickets/"+current.Status+"/"+id, nil)
+	path := c.baseURL + "/tickets/" + current.Status + "/" + id
+	if cleanupWorktree {
+		path += "?cleanup_worktree=true"
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -382,6 +591,31 @@ func (c *Client) GetTicketByID(id string) (*TicketResponse, error) {
 	return &result, nil
 }
 
+// GetTicketGraph returns the blocked-by dependency graph connected to a ticket.
+func (c *Client) GetTicketGraph(ticketID string) (*TicketGraphResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/tickets/"+ticketID+"/graph", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result TicketGraphResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // GetTicketDiffs returns structured git diffs for the commits attached to a ticket conclusion.
 func (c *Client) GetTicketDiffs(ticketID string) (*DiffsResponse, error) {
 	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/tickets/"+ticketID+"/diffs", nil)
@@ -466,3 +700,59 @@ func (c *Client) EditTicket(ticketID string) error {
 
 	return nil
 }
+
+// GetTicketHistory returns a ticket's revisions, oldest first.
+func (c *Client) GetTicketHistory(id string) (*TicketHistoryResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/tickets/"+id+"/history", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result TicketHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RestoreTicketBody sets a ticket's body back to how it was at a revision.
+func (c *Client) RestoreTicketBody(id string, revision int) (*TicketResponse, error) {
+	jsonBody, err := json.Marshal(map[string]int{"revision": revision})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/tickets/"+id+"/restore", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var result TicketResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
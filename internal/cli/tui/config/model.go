@@ -500,6 +500,11 @@ func (m Model) handleResetAction() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if isTemplate(item.promptFile) {
+		m.statusMsg = "Templates have no default"
+		m.statusIsError = false
+		return m, m.clearStatusAfterDelay()
+	}
 	if !item.promptFile.Ejected {
 		m.statusMsg = "Already using default"
 		m.statusIsError = false
@@ -529,6 +534,12 @@ func (m Model) handleResetModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// isTemplate reports whether the file is a ticket template rather than a
+// prompt.
+func isTemplate(f *sdk.PromptFileInfo) bool {
+	return f.Group == "templates"
+}
+
 // resetPrompt returns a command to reset an ejected prompt to default.
 func (m Model) resetPrompt(path string) tea.Cmd {
 	client := m.client
@@ -764,7 +775,9 @@ func (m Model) renderListItem(item listItem, width int, selected, unfocused bool
 			connector := style.Render("  ├─ ")
 			filename := item.promptFile.Stage + ".md"
 			var badgeText string
-			if item.promptFile.Ejected {
+			if isTemplate(item.promptFile) {
+				badgeText = "◆ template"
+			} else if item.promptFile.Ejected {
 				badgeText = "● ejected"
 			} else {
 				badgeText = "○ default"
@@ -784,7 +797,9 @@ func (m Model) renderListItem(item listItem, width int, selected, unfocused bool
 		connector := treeConnector.Render("  ├─ ")
 		filename := item.promptFile.Stage + ".md"
 		var badge string
-		if item.promptFile.Ejected {
+		if isTemplate(item.promptFile) {
+			badge = templateBadgeStyle.Render("◆ template")
+		} else if item.promptFile.Ejected {
 			badge = ejectedBadgeStyle.Render("● ejected")
 		} else {
 			badge = defaultBadgeStyle.Render("○ default")
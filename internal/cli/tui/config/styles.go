@@ -29,6 +29,9 @@ var (
 	ejectedBadgeStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("82")) // green
 
+	templateBadgeStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("75")) // blue
+
 	// Preview pane styles.
 	emptyPreviewStyle = lipgloss.NewStyle().
 				Foreground(mutedColor).
@@ -21,7 +21,7 @@ func (m Model) loadProjects() tea.Cmd {
 
 func (m Model) loadProjectDetail(projectPath string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 
 		tickets, err := client.ListAllTickets("", nil)
 		if err != nil {
@@ -42,7 +42,7 @@ func (m Model) loadProjectDetail(projectPath string) tea.Cmd {
 
 func (m Model) subscribeProjectEvents(projectPath string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		ctx, cancel := context.WithCancel(context.Background())
 		ch, err := client.SubscribeEvents(ctx)
 		if err != nil {
@@ -93,7 +93,7 @@ func (m Model) startPollTicker() tea.Cmd {
 
 func (m Model) loadVariants(projectPath string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		variants, err := client.GetVariants()
 		if err != nil {
 			return VariantsErrorMsg{Err: err}
@@ -107,7 +107,7 @@ func (m Model) loadVariants(projectPath string) tea.Cmd {
 // where the variant choice is irrelevant.
 func (m Model) loadVariantsAutoSelect(projectPath, mode string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		variants, err := client.GetVariants()
 		if err != nil {
 			return VariantsErrorMsg{Err: err}
@@ -122,7 +122,7 @@ func (m Model) loadVariantsAutoSelect(projectPath, mode string) tea.Cmd {
 
 func (m Model) spawnArchitectWithVariant(projectPath, mode, variantName string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		_, err := client.SpawnArchitect(mode, variantName)
 		return SpawnArchitectMsg{ArchitectPath: projectPath, Err: err}
 	}
@@ -130,7 +130,7 @@ func (m Model) spawnArchitectWithVariant(projectPath, mode, variantName string)
 
 func (m Model) focusTicket(projectPath, ticketID string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		if err := client.FocusTicket(ticketID); err != nil {
 			return FocusErrorMsg{Err: err}
 		}
@@ -140,7 +140,7 @@ func (m Model) focusTicket(projectPath, ticketID string) tea.Cmd {
 
 func (m Model) focusCollabSession(projectPath, sessionID, name string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		if err := client.FocusCollabSession(sessionID); err != nil {
 			return FocusErrorMsg{Err: err}
 		}
@@ -161,7 +161,7 @@ func (m Model) unlinkProject(projectPath string) tea.Cmd {
 
 func (m Model) killSession(projectPath, sessionID string) tea.Cmd {
 	return func() tea.Msg {
-		client := sdk.DefaultClient(projectPath)
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
 		err := client.KillSession(sessionID)
 		if err != nil {
 			return SessionKillErrorMsg{Err: err}
@@ -170,6 +170,14 @@ func (m Model) killSession(projectPath, sessionID string) tea.Cmd {
 	}
 }
 
+func (m Model) cancelQueuedSpawn(projectPath, ticketID string) tea.Cmd {
+	return func() tea.Msg {
+		client := sdk.DefaultClient(projectPath).WithActor(sdk.ActorTUI)
+		err := client.CancelQueuedSpawn(ticketID)
+		return QueuedSpawnCancelledMsg{ArchitectPath: projectPath, Err: err}
+	}
+}
+
 func (m Model) clearStatusAfterDelay() tea.Cmd {
 	return tea.Tick(3*time.Second, func(time.Time) tea.Msg {
 		return ClearStatusMsg{}
@@ -267,6 +267,16 @@ func (m Model) handleFocusCurrentRow() (tea.Model, tea.Cmd) {
 		return m, m.clearStatusAfterDelay()
 	}
 
+	if r.kind == rowSession && r.sessionType == "queued" {
+		msg := "Spawn is queued"
+		if queued := m.findQueued(pd, r.ticketID); queued != nil && queued.QueueReason != "" {
+			msg += ": " + queued.QueueReason
+		}
+		m.statusMsg = msg
+		m.statusIsError = false
+		return m, m.clearStatusAfterDelay()
+	}
+
 	if r.kind == rowSession && r.sessionType == "collab" {
 		session := m.findSession(pd, r.sessionID)
 		if session == nil {
@@ -279,6 +289,17 @@ func (m Model) handleFocusCurrentRow() (tea.Model, tea.Cmd) {
 		return m, m.focusCollabSession(pd.project.Path, session.SessionID, session.TicketTitle)
 	}
 
+	// Headless sessions have no window to focus; point at their log instead.
+	if t := m.findTicket(pd, r.ticketID); t != nil && t.SessionBackend == "headless" {
+		msg := "Headless session has no tmux window"
+		if session := m.findSession(pd, t.SessionID); session != nil && session.LogPath != "" {
+			msg = "Headless session, output in " + session.LogPath
+		}
+		m.statusMsg = msg
+		m.statusIsError = false
+		return m, m.clearStatusAfterDelay()
+	}
+
 	m.statusMsg = "Focusing session..."
 	m.statusIsError = false
 	return m, m.focusTicket(pd.project.Path, r.ticketID)
@@ -340,6 +361,11 @@ func (m Model) handleKillSession() (tea.Model, tea.Cmd) {
 	}
 
 	if r.kind == rowSession {
+		if r.sessionType == "queued" {
+			m.statusMsg = "Cancelling queued spawn..."
+			m.statusIsError = false
+			return m, m.cancelQueuedSpawn(pd.project.Path, r.ticketID)
+		}
 		if r.sessionType == "collab" {
 			session := m.findSession(pd, r.sessionID)
 			if session == nil {
@@ -84,6 +84,10 @@ var (
 	warnBadgeStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("214"))
 
+	// Overdue ticket badge style.
+	overdueBadgeStyle = lipgloss.NewStyle().
+				Foreground(errorColor)
+
 	// Orphaned session icon style (warning color).
 	orphanedIconStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("214")) // yellow/orange
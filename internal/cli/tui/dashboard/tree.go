@@ -66,6 +66,15 @@ func (m *Model) rebuildRows() {
 				}
 			}
 
+			// Queued spawns follow the running ones, in queue order.
+			if pd.sessions != nil {
+				for _, s := range pd.sessions.Sessions {
+					if s.Status == sdk.SessionStatusQueued {
+						rows = append(rows, row{kind: rowSession, projectIndex: i, ticketID: s.TicketID, sessionType: "queued", groupName: groupName})
+					}
+				}
+			}
+
 			if pd.sessions != nil {
 				var collabSessions []sdk.SessionListItem
 				for _, s := range pd.sessions.Sessions {
@@ -184,6 +193,20 @@ func (m Model) findSession(pd projectData, sessionID string) *sdk.SessionListIte
 	return nil
 }
 
+// findQueued returns the queued spawn of a ticket, if any.
+func (m Model) findQueued(pd projectData, ticketID string) *sdk.SessionListItem {
+	if pd.sessions == nil {
+		return nil
+	}
+	for i := range pd.sessions.Sessions {
+		s := &pd.sessions.Sessions[i]
+		if s.Status == sdk.SessionStatusQueued && s.TicketID == ticketID {
+			return s
+		}
+	}
+	return nil
+}
+
 func newestSessionTime(pd projectData) time.Time {
 	var newest time.Time
 	if pd.architect != nil && pd.architect.Session != nil {
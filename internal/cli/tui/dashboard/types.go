@@ -153,6 +153,12 @@ type SessionKilledMsg struct {
 	ArchitectPath string
 }
 
+// QueuedSpawnCancelledMsg is sent after removing a spawn from the queue.
+type QueuedSpawnCancelledMsg struct {
+	ArchitectPath string
+	Err           error
+}
+
 type SessionKillErrorMsg struct {
 	Err error
 }
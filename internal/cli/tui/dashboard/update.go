@@ -191,6 +191,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, m.clearStatusAfterDelay()
 
+	case QueuedSpawnCancelledMsg:
+		if msg.Err != nil {
+			m.statusMsg = fmt.Sprintf("Cancel error: %s", msg.Err)
+			m.statusIsError = true
+			m.logBuf.Errorf("queue", "cancel queued spawn failed: %s", msg.Err)
+			return m, m.clearStatusAfterDelay()
+		}
+		m.statusMsg = "Queued spawn cancelled"
+		m.statusIsError = false
+		m.logBuf.Infof("queue", "queued spawn cancelled: %s", filepath.Base(msg.ArchitectPath))
+		return m, tea.Batch(m.loadProjectDetail(msg.ArchitectPath), m.clearStatusAfterDelay())
+
 	case SessionKillErrorMsg:
 		m.killing = false
 		m.showKillConfirm = false
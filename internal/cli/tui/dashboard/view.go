@@ -202,6 +202,7 @@ func (m Model) renderProjectRow(r row, selected bool) string {
 	}
 
 	actBadge := activityBadge(pd)
+	due := dueBadge(pd)
 
 	counts := ""
 	if pd.project.Counts != nil {
@@ -228,20 +229,23 @@ func (m Model) renderProjectRow(r row, selected bool) string {
 	isActive := pd.isActive()
 
 	if selected {
-		plainLine := fmt.Sprintf("%s%s %s%s%s %s", indent, indicator, title, archBadge, actBadge, counts)
+		plainLine := fmt.Sprintf("%s%s %s%s%s%s %s", indent, indicator, title, archBadge, actBadge, due, counts)
 		return selectedStyle.Render(plainLine)
 	}
+	if due != "" {
+		due = overdueBadgeStyle.Render(due)
+	}
 
 	if architectOrphaned {
-		return indent + orphanedIconStyle.Render(indicator) + " " + projectStyle.Render(title) + orphanedIconStyle.Render(archBadge) + orphanedIconStyle.Render(actBadge) + " " + countsStyle.Render(counts)
+		return indent + orphanedIconStyle.Render(indicator) + " " + projectStyle.Render(title) + orphanedIconStyle.Render(archBadge) + orphanedIconStyle.Render(actBadge) + due + " " + countsStyle.Render(counts)
 	}
 	if architectActive {
-		return indent + activeIconStyle.Render(indicator) + " " + projectStyle.Render(title) + activeIconStyle.Render(archBadge) + activeIconStyle.Render(actBadge) + " " + countsStyle.Render(counts)
+		return indent + activeIconStyle.Render(indicator) + " " + projectStyle.Render(title) + activeIconStyle.Render(archBadge) + activeIconStyle.Render(actBadge) + due + " " + countsStyle.Render(counts)
 	}
 	if isActive {
-		return indent + activeIconStyle.Render(indicator) + " " + projectStyle.Render(title) + activeIconStyle.Render(actBadge) + " " + countsStyle.Render(counts)
+		return indent + activeIconStyle.Render(indicator) + " " + projectStyle.Render(title) + activeIconStyle.Render(actBadge) + due + " " + countsStyle.Render(counts)
 	}
-	return indent + mutedStyleRender.Render(indicator) + " " + dimmedProjectStyle.Render(title) + " " + countsStyle.Render(counts)
+	return indent + mutedStyleRender.Render(indicator) + " " + dimmedProjectStyle.Render(title) + due + " " + countsStyle.Render(counts)
 }
 
 func (m Model) renderGroupRow(r row, selected bool) string {
@@ -280,12 +284,32 @@ func architectStatusBadge(sess *sdk.ArchitectSessionResponse) string {
 	return fmt.Sprintf(" [arch: %s]", dur)
 }
 
+// dueBadge returns a badge counting the project's overdue tickets, or ""
+// when none are.
+func dueBadge(pd projectData) string {
+	if pd.tickets == nil {
+		return ""
+	}
+	overdue := 0
+	for _, col := range pd.tickets.Columns {
+		for _, t := range col.Tickets {
+			if t.DueState == "overdue" {
+				overdue++
+			}
+		}
+	}
+	if overdue == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" [%d overdue]", overdue)
+}
+
 func activityBadge(pd projectData) string {
 	if pd.tickets == nil && pd.sessions == nil {
 		return ""
 	}
 
-	var workerCount, collabCount int
+	var workerCount, collabCount, queuedCount int
 	if pd.tickets != nil {
 		for _, t := range pd.tickets.Progress {
 			if t.HasActiveSession {
@@ -298,10 +322,13 @@ func activityBadge(pd projectData) string {
 			if s.SessionType == "collab" && s.Status != "ended" {
 				collabCount++
 			}
+			if s.Status == sdk.SessionStatusQueued {
+				queuedCount++
+			}
 		}
 	}
 
-	if workerCount == 0 && collabCount == 0 {
+	if workerCount == 0 && collabCount == 0 && queuedCount == 0 {
 		return ""
 	}
 
@@ -320,6 +347,9 @@ func activityBadge(pd projectData) string {
 			parts = append(parts, fmt.Sprintf("%d collabs", collabCount))
 		}
 	}
+	if queuedCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d queued", queuedCount))
+	}
 
 	return fmt.Sprintf(" [%s]", strings.Join(parts, " · "))
 }
@@ -373,6 +403,37 @@ func (m Model) renderSessionRow(r row, selected bool) string {
 		return fmt.Sprintf("%s%s %s %s %s", indent, styledIcon, sessionStyle.Render(name), badgeStyled, durationStyle.Render(dur))
 	}
 
+	if r.sessionType == "queued" {
+		queued := m.findQueued(pd, r.ticketID)
+		if queued == nil {
+			return indent + "???"
+		}
+
+		icon := status.Icon(queued.Status)
+		badge := fmt.Sprintf("queued #%d", queued.QueuePosition)
+		var dur string
+		if queued.QueuedAt != nil {
+			dur = formatDuration(time.Since(*queued.QueuedAt))
+		}
+		label := queued.Variant
+		labelLen := len(label)
+		if labelLen > 0 {
+			labelLen++ // extra space
+		}
+
+		nameWidth := m.width - len(indent) - 2 - 1 - labelLen - 1 - len(badge) - 1 - len(dur)
+		name := truncateToWidth(queued.TicketTitle, nameWidth)
+
+		if selected {
+			plain := fmt.Sprintf("%s%s %s %s %s %s", indent, icon, label, name, badge, dur)
+			return selectedStyle.Render(plain)
+		}
+		if labelLen > 0 {
+			return fmt.Sprintf("%s%s %s %s %s %s", indent, durationStyle.Render(icon), durationStyle.Render(label), sessionStyle.Render(name), progressBadgeStyle.Render(badge), durationStyle.Render(dur))
+		}
+		return fmt.Sprintf("%s%s %s %s %s", indent, durationStyle.Render(icon), sessionStyle.Render(name), progressBadgeStyle.Render(badge), durationStyle.Render(dur))
+	}
+
 	ticket := m.findTicket(pd, r.ticketID)
 	if ticket == nil {
 		return indent + "???"
@@ -389,6 +450,9 @@ func (m Model) renderSessionRow(r row, selected bool) string {
 	if ticket.IsOrphaned {
 		badge = "orphaned"
 	}
+	if ticket.SessionBackend == "headless" {
+		badge += " (headless)"
+	}
 	badgeStyled := progressBadgeStyle.Render(badge)
 	if ticket.IsOrphaned {
 		badgeStyled = orphanedIconStyle.Render(badge)
@@ -46,6 +46,8 @@ type ChangeFile struct {
 }
 
 type ChangeCommit struct {
+	// Repo is the repo key of a multi-repo ticket's commit.
+	Repo       string
 	SHA        string
 	Subject    string
 	AuthorName string
@@ -76,6 +78,14 @@ type Model struct {
 	changesLoadErr error
 	selectedCommit int
 
+	reviewActions *ReviewActions
+	review        *ReviewData
+	reviewLoading bool
+	reviewLoadErr error
+	diffAnchors   []diffAnchor
+	cursor        int
+	input         *textPrompt
+
 	viewport   viewport.Model
 	mdRenderer *glamour.TermRenderer
 	pendingG   bool
@@ -232,6 +242,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.renderActiveTab()
 		return m, m.ensureChangesLoaded()
 
+	case reviewLoadedMsg:
+		m.reviewLoading = false
+		m.review = msg.review
+		m.reviewLoadErr = msg.err
+		if msg.err != nil {
+			m.status = fmt.Sprintf("Unable to load review: %v", msg.err)
+		}
+		m.renderActiveTab()
+		return m, nil
+
+	case reviewActionMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("Review failed: %v", msg.err)
+			return m, nil
+		}
+		m.status = msg.status
+		m.review = nil
+		m.reviewLoadErr = nil
+		return m, m.ensureReviewLoaded()
+
 	case editFinishedMsg:
 		if msg.err != nil {
 			m.status = fmt.Sprintf("Edit failed: %v", msg.err)
@@ -255,6 +285,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		m.syncViewportSize()
 
+		if m.input != nil {
+			return m.updateInput(msg)
+		}
 		if m.isChangesTabActive() {
 			return m.updateChangesTab(msg)
 		}
@@ -290,7 +323,11 @@ func (m Model) View() string {
 		b.WriteString(helpStyle.Render(m.status))
 		b.WriteString("\n")
 	}
-	b.WriteString(helpStyle.Render(m.helpText()))
+	if m.input != nil {
+		b.WriteString(m.renderInput())
+	} else {
+		b.WriteString(helpStyle.Render(m.helpText()))
+	}
 
 	return b.String()
 }
@@ -300,6 +337,11 @@ func (m Model) canEdit() bool {
 }
 
 func (m *Model) updateChangesTab(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if cmd, ok := m.updateReviewKeys(msg); ok {
+		m.pendingG = false
+		return m, cmd
+	}
+
 	switch msg.String() {
 	case "q", "ctrl+c", "esc":
 		return m, tea.Quit
@@ -432,6 +474,10 @@ func (m *Model) applyEditResult(result EditResult) {
 	m.changesLoading = false
 	m.changesLoadErr = nil
 	m.selectedCommit = 0
+	m.review = nil
+	m.reviewLoading = false
+	m.reviewLoadErr = nil
+	m.cursor = 0
 	m.renderActiveTab()
 }
 
@@ -485,11 +531,18 @@ func (m *Model) switchTab(delta int) (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) ensureChangesLoaded() tea.Cmd {
-	if !m.isChangesTabActive() || m.loadChanges == nil || m.changesLoading || m.changes != nil || m.changesLoadErr != nil {
+	if !m.isChangesTabActive() {
 		return nil
 	}
+	reviewCmd := m.ensureReviewLoaded()
+	if m.loadChanges == nil || m.changesLoading || m.changes != nil || m.changesLoadErr != nil {
+		return reviewCmd
+	}
 	m.changesLoading = true
 	m.renderActiveTab()
+	if reviewCmd != nil {
+		return tea.Batch(m.loadChanges, reviewCmd)
+	}
 	return m.loadChanges
 }
 
@@ -544,6 +597,15 @@ func (m Model) renderTabBar() string {
 func (m Model) helpText() string {
 	if m.isChangesTabActive() {
 		parts := []string{"tab/h/l tabs", "j/k commits", "↑/↓ diff", "ctrl+d/u page", "gg/G jump"}
+		if m.reviewing() {
+			parts[2] = "↑/↓ line"
+			if state := formatReviewState(m.review); state != "" {
+				parts = append([]string{state}, parts...)
+			}
+			if m.reviewOpen() {
+				parts = append(parts, "c comment", "a accept", "r request changes")
+			}
+		}
 		if m.canEdit() {
 			parts = append(parts, "e edit")
 		}
@@ -619,18 +681,22 @@ func (m *Model) moveCommit(delta int) {
 	}
 	m.selectedCommit = next
 	m.offsets[m.active] = 0
+	m.cursor = 0
 	m.renderChangesContent()
 }
 
 func (m *Model) renderChangesContent() {
 	switch {
 	case m.changesLoading:
+		m.diffAnchors = nil
 		m.viewport.SetContent(emptyStyle.Render("Loading changes..."))
 		m.viewport.SetYOffset(0)
 	case m.changesLoadErr != nil:
+		m.diffAnchors = nil
 		m.viewport.SetContent(emptyStyle.Render(fmt.Sprintf("Unable to load changes.\n\n%s", m.changesLoadErr.Error())))
 		m.viewport.SetYOffset(0)
 	case m.changes == nil || len(m.changes.Commits) == 0:
+		m.diffAnchors = nil
 		m.viewport.SetContent(emptyStyle.Render("No commit diffs available"))
 		m.viewport.SetYOffset(0)
 	default:
@@ -718,19 +784,20 @@ func (m Model) renderCommitSidebar(width int) string {
 	return strings.Join(lines, "\n")
 }
 
-func (m Model) renderCommitDiff(commit ChangeCommit) string {
+func (m *Model) renderCommitDiff(commit ChangeCommit) string {
 	if len(commit.Files) == 0 {
+		m.diffAnchors = nil
 		return emptyStyle.Render("No file diffs for this commit")
 	}
 
-	var b strings.Builder
+	var lines []diffLine
 	for i, file := range commit.Files {
-		b.WriteString(diffHeaderStyle.Render(formatFileHeader(file)))
-		b.WriteString("\n")
-		b.WriteString(diffMetaStyle.Render(fmt.Sprintf("%s  (+%d -%d)", formatFileStatus(file), file.Additions, file.Deletions)))
-		b.WriteString("\n")
-		b.WriteString(diffRuleStyle.Render(strings.Repeat("─", max(m.viewport.Width-2, 10))))
-		b.WriteString("\n")
+		header := diffAnchor{file: file.Path}
+		lines = append(lines,
+			diffLine{text: diffHeaderStyle.Render(formatFileHeader(file)), anchor: header},
+			diffLine{text: diffMetaStyle.Render(fmt.Sprintf("%s  (+%d -%d)", formatFileStatus(file), file.Additions, file.Deletions)), anchor: header},
+			diffLine{text: diffRuleStyle.Render(strings.Repeat("─", max(m.viewport.Width-2, 10))), anchor: header},
+		)
 
 		patch := strings.TrimRight(file.Patch, "\n")
 		if file.IsBinary && patch == "" {
@@ -739,13 +806,33 @@ func (m Model) renderCommitDiff(commit ChangeCommit) string {
 		if patch == "" {
 			patch = "(no patch available)"
 		}
-		b.WriteString(colorizePatch(patch))
+		lines = append(lines, patchLines(file.Path, patch)...)
 
 		if i < len(commit.Files)-1 {
-			b.WriteString("\n\n")
+			lines = append(lines, diffLine{})
 		}
 	}
-	return b.String()
+	if m.reviewing() {
+		lines = m.withReviewComments(commit, lines)
+	}
+
+	m.diffAnchors = make([]diffAnchor, len(lines))
+	if m.cursor >= len(lines) {
+		m.cursor = len(lines) - 1
+	}
+	texts := make([]string, len(lines))
+	for i, l := range lines {
+		m.diffAnchors[i] = l.anchor
+		texts[i] = l.text
+		if m.reviewing() {
+			marker := "  "
+			if i == m.cursor {
+				marker = cursorLineStyle.Render("▶ ")
+			}
+			texts[i] = marker + l.text
+		}
+	}
+	return strings.Join(texts, "\n")
 }
 
 func formatCommitMeta(commit ChangeCommit) string {
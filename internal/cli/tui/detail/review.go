@@ -0,0 +1,393 @@
+package detail
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ReviewComment is a review comment anchored to a file of the changes.
+// Line 0 comments on the whole file.
+type ReviewComment struct {
+	ID     string
+	Round  int
+	Repo   string
+	Commit string
+	File   string
+	Line   int
+	Body   string
+	Author string
+}
+
+// ReviewData is the review of the ticket whose changes are shown.
+type ReviewData struct {
+	State    string
+	Round    int
+	Summary  string
+	Comments []ReviewComment
+}
+
+// ReviewActions are the commands the Changes tab runs to review a ticket.
+// Each returns a message built with ReviewLoaded or ReviewActionFinished.
+type ReviewActions struct {
+	Load           tea.Cmd
+	Comment        func(ReviewComment) tea.Cmd
+	Accept         func(summary string) tea.Cmd
+	RequestChanges func(summary string) tea.Cmd
+}
+
+// reviewStateOpen is the review state that accepts comments and verdicts.
+const reviewStateOpen = "open"
+
+type reviewLoadedMsg struct {
+	review *ReviewData
+	err    error
+}
+
+type reviewActionMsg struct {
+	status string
+	err    error
+}
+
+func ReviewLoaded(review *ReviewData, err error) tea.Msg {
+	return reviewLoadedMsg{review: review, err: err}
+}
+
+// ReviewActionFinished reports the result of a comment or verdict; the
+// review is reloaded afterwards.
+func ReviewActionFinished(status string, err error) tea.Msg {
+	return reviewActionMsg{status: status, err: err}
+}
+
+// WithReview turns the Changes tab into a review screen: a cursor walks
+// the diff lines, and comments, accepts and change requests go through
+// actions.
+func WithReview(actions ReviewActions) Option {
+	return func(m *Model) {
+		m.reviewActions = &actions
+	}
+}
+
+// diffAnchor is the file and changed-file line a rendered diff line
+// belongs to. Line is 0 for file headers.
+type diffAnchor struct {
+	file string
+	line int
+}
+
+type diffLine struct {
+	text   string
+	anchor diffAnchor
+}
+
+type inputKind string
+
+const (
+	inputComment        inputKind = "comment"
+	inputAccept         inputKind = "accept"
+	inputRequestChanges inputKind = "request changes"
+)
+
+// textPrompt is the single-line input used to write comments and verdict
+// summaries.
+type textPrompt struct {
+	kind   inputKind
+	anchor diffAnchor
+	value  []rune
+}
+
+var (
+	cursorLineStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+
+	reviewCommentStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("220"))
+
+	reviewOldCommentStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("243"))
+
+	promptStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("255"))
+)
+
+func (m Model) reviewing() bool {
+	return m.reviewActions != nil
+}
+
+func (m Model) reviewOpen() bool {
+	return m.review != nil && m.review.State == reviewStateOpen
+}
+
+func (m *Model) ensureReviewLoaded() tea.Cmd {
+	if !m.reviewing() || m.reviewActions.Load == nil || m.reviewLoading || m.review != nil || m.reviewLoadErr != nil {
+		return nil
+	}
+	m.reviewLoading = true
+	return m.reviewActions.Load
+}
+
+// updateReviewKeys handles the review keys of the Changes tab. It reports
+// whether the key was one of them.
+func (m *Model) updateReviewKeys(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if !m.reviewing() {
+		return nil, false
+	}
+
+	switch msg.String() {
+	case "down":
+		m.moveCursor(1)
+		return nil, true
+	case "up":
+		m.moveCursor(-1)
+		return nil, true
+	case "c", "a", "r":
+	default:
+		return nil, false
+	}
+
+	if !m.reviewOpen() {
+		m.status = "Ticket has no open review"
+		return nil, true
+	}
+	switch msg.String() {
+	case "c":
+		anchor := m.cursorAnchor()
+		if anchor.file == "" {
+			m.status = "Move the cursor onto a file to comment"
+			return nil, true
+		}
+		m.input = &textPrompt{kind: inputComment, anchor: anchor}
+	case "a":
+		m.input = &textPrompt{kind: inputAccept}
+	case "r":
+		m.input = &textPrompt{kind: inputRequestChanges}
+	}
+	m.status = ""
+	return nil, true
+}
+
+// updateInput edits the text prompt; enter submits it and esc cancels.
+func (m *Model) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.input = nil
+		return m, nil
+	case tea.KeyEnter:
+		return m, m.submitInput()
+	case tea.KeyBackspace:
+		if n := len(m.input.value); n > 0 {
+			m.input.value = m.input.value[:n-1]
+		}
+	case tea.KeySpace:
+		m.input.value = append(m.input.value, ' ')
+	case tea.KeyRunes:
+		m.input.value = append(m.input.value, msg.Runes...)
+	}
+	return m, nil
+}
+
+func (m *Model) submitInput() tea.Cmd {
+	in := m.input
+	text := strings.TrimSpace(string(in.value))
+	switch in.kind {
+	case inputComment:
+		if text == "" {
+			m.status = "Comment cannot be empty"
+			return nil
+		}
+		m.input = nil
+		if m.reviewActions.Comment == nil {
+			return nil
+		}
+		commit := m.changeCommits()[m.selectedCommit]
+		return m.reviewActions.Comment(ReviewComment{
+			Repo:   commit.Repo,
+			Commit: commit.SHA,
+			File:   in.anchor.file,
+			Line:   in.anchor.line,
+			Body:   text,
+		})
+	case inputAccept:
+		m.input = nil
+		if m.reviewActions.Accept == nil {
+			return nil
+		}
+		return m.reviewActions.Accept(text)
+	case inputRequestChanges:
+		if text == "" && len(m.roundComments()) == 0 {
+			m.status = "Leave a comment or a summary before requesting changes"
+			return nil
+		}
+		m.input = nil
+		if m.reviewActions.RequestChanges == nil {
+			return nil
+		}
+		return m.reviewActions.RequestChanges(text)
+	}
+	m.input = nil
+	return nil
+}
+
+func (m Model) renderInput() string {
+	label := "Comment"
+	switch m.input.kind {
+	case inputComment:
+		label = fmt.Sprintf("Comment on %s", formatAnchor(m.input.anchor))
+	case inputAccept:
+		label = "Accept (optional summary)"
+	case inputRequestChanges:
+		label = "Request changes (summary)"
+	}
+	return promptStyle.Render(label+": "+string(m.input.value)+"█") + helpStyle.Render("  enter submit  esc cancel")
+}
+
+func (m Model) roundComments() []ReviewComment {
+	if m.review == nil {
+		return nil
+	}
+	var comments []ReviewComment
+	for _, c := range m.review.Comments {
+		if c.Round == m.review.Round {
+			comments = append(comments, c)
+		}
+	}
+	return comments
+}
+
+func (m *Model) moveCursor(delta int) {
+	if len(m.diffAnchors) == 0 {
+		return
+	}
+	m.cursor = min(max(m.cursor+delta, 0), len(m.diffAnchors)-1)
+	m.renderChangesContent()
+	switch {
+	case m.cursor < m.viewport.YOffset:
+		m.viewport.SetYOffset(m.cursor)
+	case m.cursor >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(m.cursor - m.viewport.Height + 1)
+	}
+	m.offsets[m.active] = m.viewport.YOffset
+}
+
+func (m Model) cursorAnchor() diffAnchor {
+	if m.cursor < 0 || m.cursor >= len(m.diffAnchors) {
+		return diffAnchor{}
+	}
+	return m.diffAnchors[m.cursor]
+}
+
+// commentsFor returns the review comments anchored at a of commit. Comments
+// from other commits of the same file are shown too, as they still apply
+// to the file.
+func (m Model) commentsFor(commit ChangeCommit, a diffAnchor) []ReviewComment {
+	if m.review == nil {
+		return nil
+	}
+	var comments []ReviewComment
+	for _, c := range m.review.Comments {
+		if c.File != a.file || c.Line != a.line {
+			continue
+		}
+		if commit.Repo != "" && c.Repo != "" && c.Repo != commit.Repo {
+			continue
+		}
+		if c.Commit != "" && c.Commit != commit.SHA {
+			continue
+		}
+		comments = append(comments, c)
+	}
+	return comments
+}
+
+// withReviewComments inserts the comments anchored to each line after the
+// last diff line carrying that anchor, and returns the lines with their
+// anchors.
+func (m Model) withReviewComments(commit ChangeCommit, lines []diffLine) []diffLine {
+	if m.review == nil || len(m.review.Comments) == 0 {
+		return lines
+	}
+
+	last := make(map[diffAnchor]int, len(lines))
+	for i, l := range lines {
+		if l.anchor.file != "" {
+			last[l.anchor] = i
+		}
+	}
+
+	out := make([]diffLine, 0, len(lines))
+	for i, l := range lines {
+		out = append(out, l)
+		if l.anchor.file == "" || last[l.anchor] != i {
+			continue
+		}
+		for _, c := range m.commentsFor(commit, l.anchor) {
+			style := reviewCommentStyle
+			if c.Round != m.review.Round {
+				style = reviewOldCommentStyle
+			}
+			author := c.Author
+			if author == "" {
+				author = "reviewer"
+			}
+			for j, text := range strings.Split(c.Body, "\n") {
+				prefix := fmt.Sprintf("  ┃ %s: ", author)
+				if j > 0 {
+					prefix = "  ┃ " + strings.Repeat(" ", len(author)+2)
+				}
+				out = append(out, diffLine{text: style.Render(prefix + text), anchor: l.anchor})
+			}
+		}
+	}
+	return out
+}
+
+// patchLines splits a file's patch into lines anchored to the line numbers
+// of the changed file. Removed lines anchor to the line they sat before.
+func patchLines(file string, patch string) []diffLine {
+	split := strings.Split(patch, "\n")
+	lines := make([]diffLine, 0, len(split))
+	next := 0
+	for _, raw := range split {
+		anchor := diffAnchor{file: file}
+		switch {
+		case strings.HasPrefix(raw, "@@"):
+			next = hunkNewStart(raw)
+		case next == 0:
+		case strings.HasPrefix(raw, "+"), strings.HasPrefix(raw, " "):
+			anchor.line = next
+			next++
+		case strings.HasPrefix(raw, "-"):
+			anchor.line = next
+		}
+		lines = append(lines, diffLine{text: stylePatchLine(raw), anchor: anchor})
+	}
+	return lines
+}
+
+// hunkNewStart returns the first changed-file line of a "@@ -a,b +c,d @@"
+// hunk header, or 0 when it cannot be parsed.
+func hunkNewStart(header string) int {
+	fields := strings.Fields(header)
+	if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+		return 0
+	}
+	start, _, _ := strings.Cut(strings.TrimPrefix(fields[2], "+"), ",")
+	n, err := strconv.Atoi(start)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func formatAnchor(a diffAnchor) string {
+	if a.line == 0 {
+		return a.file
+	}
+	return fmt.Sprintf("%s:%d", a.file, a.line)
+}
+
+func formatReviewState(review *ReviewData) string {
+	if review == nil || review.State == "" {
+		return ""
+	}
+	return fmt.Sprintf("review round %d: %s", review.Round, strings.ReplaceAll(review.State, "_", " "))
+}
@@ -3,7 +3,6 @@ package kanban
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
@@ -28,6 +27,14 @@ func NewColumn(title, status string) Column {
 	}
 }
 
+// columnTitle derives a column title from a status name, e.g. "review" -> "Review".
+func columnTitle(status string) string {
+	if status == "" {
+		return status
+	}
+	return strings.ToUpper(status[:1]) + status[1:]
+}
+
 // Title returns the column title.
 func (c *Column) Title() string {
 	return c.title
@@ -102,7 +109,7 @@ func (c *Column) Len() int {
 }
 
 // renderAllTickets renders all tickets into a single string for the viewport.
-func (c *Column) renderAllTickets(width int, isActive bool, repoColors map[string]string) string {
+func (c *Column) renderAllTickets(width int, isActive bool, repoColors map[string]string, unblocked map[string]bool) string {
 	if len(c.tickets) == 0 {
 		emptyText := lipgloss.NewStyle().
 			Foreground(mutedColor).
@@ -119,24 +126,22 @@ func (c *Column) renderAllTickets(width int, isActive bool, repoColors map[strin
 
 		// Build due date indicator
 		dueDateIndicator := ""
-		if t.Due != nil && c.status == "backlog" {
-			now := time.Now()
-			if t.Due.Before(now) {
-				if isSelected {
-					dueDateIndicator = " " + inlineFgColorChange(dueDateColorCode(true)) +
-						"[OVERDUE]" +
-						inlineFgColorChange(selectedFgColor)
-				} else {
-					dueDateIndicator = overdueStyle.Render(" [OVERDUE]")
-				}
-			} else if t.Due.Before(now.Add(24 * time.Hour)) {
-				if isSelected {
-					dueDateIndicator = " " + inlineFgColorChange(dueDateColorCode(false)) +
-						"[DUE SOON]" +
-						inlineFgColorChange(selectedFgColor)
-				} else {
-					dueDateIndicator = dueSoonStyle.Render(" [DUE SOON]")
-				}
+		switch t.DueState {
+		case "overdue":
+			if isSelected {
+				dueDateIndicator = " " + inlineFgColorChange(dueDateColorCode(true)) +
+					"[OVERDUE]" +
+					inlineFgColorChange(selectedFgColor)
+			} else {
+				dueDateIndicator = overdueStyle.Render(" [OVERDUE]")
+			}
+		case "due_soon":
+			if isSelected {
+				dueDateIndicator = " " + inlineFgColorChange(dueDateColorCode(false)) +
+					"[DUE SOON]" +
+					inlineFgColorChange(selectedFgColor)
+			} else {
+				dueDateIndicator = dueSoonStyle.Render(" [DUE SOON]")
 			}
 		}
 
@@ -168,11 +173,26 @@ func (c *Column) renderAllTickets(width int, isActive bool, repoColors map[strin
 				b.WriteString(selectedTicketStyle.Width(width - 2).Render(line))
 				b.WriteString("\n")
 			}
-			// Metadata line: agent status + date
+			// Metadata line: priority + agent status + dependency state + owner + date
 			meta := ""
+			if t.Priority != "" {
+				meta += t.Priority + " · "
+			}
 			if t.HasActiveSession {
 				meta += agentStatusLabel(t) + " · "
 			}
+			if label := dependencyLabel(t, unblocked); label != "" {
+				meta += label + " · "
+			}
+			if label := criteriaLabel(t); label != "" {
+				meta += label + " · "
+			}
+			if label := verificationLabel(t); label != "" {
+				meta += label + " · "
+			}
+			if label := ownerLabel(t); label != "" {
+				meta += label + " · "
+			}
 			meta += dateStr
 			b.WriteString(selectedTicketStyle.Width(width - 2).Render(meta))
 		} else {
@@ -181,8 +201,17 @@ func (c *Column) renderAllTickets(width int, isActive bool, repoColors map[strin
 				b.WriteString(ticketStyle.Width(width - 2).Render(line))
 				b.WriteString("\n")
 			}
-			// Metadata line: agent status + date
+			// Metadata line: priority + agent status + dependency state + owner + date
 			meta := ""
+			switch t.Priority {
+			case "":
+			case "urgent":
+				meta += urgentStyle.Render(t.Priority) + " · "
+			case "high":
+				meta += highPriorityStyle.Render(t.Priority) + " · "
+			default:
+				meta += mutedStyle.Render(t.Priority) + " · "
+			}
 			if t.HasActiveSession {
 				if t.IsOrphaned {
 					meta += orphanedStyle.Render(agentStatusLabel(t)) + " · "
@@ -190,6 +219,33 @@ func (c *Column) renderAllTickets(width int, isActive bool, repoColors map[strin
 					meta += activeSessionStyle.Render(agentStatusLabel(t)) + " · "
 				}
 			}
+			if label := dependencyLabel(t, unblocked); label != "" {
+				if t.IsBlocked {
+					meta += blockedStyle.Render(label) + " · "
+				} else {
+					meta += unblockedStyle.Render(label) + " · "
+				}
+			}
+			if label := criteriaLabel(t); label != "" {
+				if t.CriteriaDone == t.CriteriaTotal {
+					meta += unblockedStyle.Render(label) + " · "
+				} else {
+					meta += label + " · "
+				}
+			}
+			if label := verificationLabel(t); label != "" {
+				switch t.Verification {
+				case "passed":
+					meta += unblockedStyle.Render(label) + " · "
+				case "failed":
+					meta += overdueStyle.Render(label) + " · "
+				default:
+					meta += mutedStyle.Render(label) + " · "
+				}
+			}
+			if label := ownerLabel(t); label != "" {
+				meta += mutedStyle.Render(label) + " · "
+			}
 			meta += dateStr
 			b.WriteString(ticketDateStyle.Width(width - 2).Render(meta))
 		}
@@ -213,7 +269,7 @@ func (c *Column) cursorYOffset(titleWidth int, repoColors map[string]string) int
 }
 
 // View renders the column.
-func (c *Column) View(width int, isActive bool, maxHeight int, repoColors map[string]string) string {
+func (c *Column) View(width int, isActive bool, maxHeight int, repoColors map[string]string, unblocked map[string]bool) string {
 	var b strings.Builder
 
 	// Header takes ~2 lines (text + border)
@@ -239,7 +295,7 @@ func (c *Column) View(width int, isActive bool, maxHeight int, repoColors map[st
 	c.vp.Height = vpHeight
 
 	// Render all tickets and set as viewport content
-	content := c.renderAllTickets(width, isActive, repoColors)
+	content := c.renderAllTickets(width, isActive, repoColors, unblocked)
 
 	// Preserve scroll position across re-renders
 	savedYOffset := c.vp.YOffset
@@ -311,6 +367,54 @@ func agentStatusLabel(t sdk.TicketSummary) string {
 	return label
 }
 
+// dependencyLabel describes a ticket's blocked-by state: blocked tickets are
+// flagged, and tickets whose last blocker just finished are marked ready.
+func dependencyLabel(t sdk.TicketSummary, unblocked map[string]bool) string {
+	if t.IsBlocked {
+		return "⛔ blocked"
+	}
+	if unblocked[t.ID] && t.Status == "backlog" {
+		return "✓ ready"
+	}
+	return ""
+}
+
+// criteriaLabel shows acceptance criteria progress, e.g. "☑ 3/5", for
+// tickets that have any.
+func criteriaLabel(t sdk.TicketSummary) string {
+	if t.CriteriaTotal == 0 {
+		return ""
+	}
+	return fmt.Sprintf("☑ %d/%d", t.CriteriaDone, t.CriteriaTotal)
+}
+
+// verificationLabel shows the verdict of the verify commands run on the
+// ticket's last conclusion.
+func verificationLabel(t sdk.TicketSummary) string {
+	switch t.Verification {
+	case "passed":
+		return "✓ verified"
+	case "failed":
+		return "✗ verify failed"
+	case "pending":
+		return "… verifying"
+	}
+	return ""
+}
+
+// ownerLabel returns the assignee and labels of a ticket, such as
+// "@alice #bug #ui", or "" when it has neither.
+func ownerLabel(t sdk.TicketSummary) string {
+	var parts []string
+	if t.Assignee != "" {
+		parts = append(parts, "@"+t.Assignee)
+	}
+	for _, l := range t.Labels {
+		parts = append(parts, "#"+l)
+	}
+	return strings.Join(parts, " ")
+}
+
 // wrapText wraps text to fit within width, returning all wrapped lines.
 func wrapText(text string, width int) []string {
 	if width <= 0 {
@@ -0,0 +1,114 @@
+package kanban
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+)
+
+// boardSorts are the column orders the sort key cycles through.
+var boardSorts = []string{"created", "priority", "due", "updated"}
+
+// nextSort returns the sort after current in boardSorts.
+func nextSort(current string) string {
+	for i, s := range boardSorts {
+		if s == current {
+			return boardSorts[(i+1)%len(boardSorts)]
+		}
+	}
+	return boardSorts[1]
+}
+
+// parseFilterQuery turns the filter prompt into a ticket filter. Words
+// of the form label:x or #x, priority:x or !x, and assignee:x or @x set
+// those filters; the remaining words search titles and bodies.
+func parseFilterQuery(text string) sdk.TicketFilter {
+	var f sdk.TicketFilter
+	var words []string
+	for _, word := range strings.Fields(text) {
+		key, value, ok := strings.Cut(word, ":")
+		switch {
+		case ok && key == "label":
+			f.Labels = append(f.Labels, value)
+		case ok && key == "priority":
+			f.Priorities = append(f.Priorities, value)
+		case ok && key == "assignee":
+			f.Assignee = value
+		case strings.HasPrefix(word, "#") && len(word) > 1:
+			f.Labels = append(f.Labels, word[1:])
+		case strings.HasPrefix(word, "!") && len(word) > 1:
+			f.Priorities = append(f.Priorities, word[1:])
+		case strings.HasPrefix(word, "@") && len(word) > 1:
+			f.Assignee = word[1:]
+		default:
+			words = append(words, word)
+		}
+	}
+	f.Query = strings.Join(words, " ")
+	return f
+}
+
+// filterActive reports whether f narrows the board.
+func filterActive(f sdk.TicketFilter) bool {
+	return f.Query != "" || len(f.Labels) > 0 || len(f.Priorities) > 0 || f.Assignee != ""
+}
+
+// filterAppliedMsg is sent when the board reloaded with a new filter.
+type filterAppliedMsg struct {
+	filter   sdk.TicketFilter
+	text     string
+	response *sdk.ListAllTicketsResponse
+}
+
+// filterErrMsg is sent when the daemon rejected a new filter. The board
+// keeps its previous one.
+type filterErrMsg struct{ err error }
+
+// updateFilterInput edits the filter prompt; enter applies it and esc
+// cancels.
+func (m Model) updateFilterInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.showFilterInput = false
+		return m, nil
+	case tea.KeyEnter:
+		m.showFilterInput = false
+		text := strings.TrimSpace(string(m.filterInput))
+		f := parseFilterQuery(text)
+		f.Sort = m.filter.Sort
+		return m, m.applyFilter(f, text)
+	case tea.KeyBackspace:
+		if n := len(m.filterInput); n > 0 {
+			m.filterInput = m.filterInput[:n-1]
+		}
+	case tea.KeySpace:
+		m.filterInput = append(m.filterInput, ' ')
+	case tea.KeyRunes:
+		m.filterInput = append(m.filterInput, msg.Runes...)
+	}
+	return m, nil
+}
+
+// applyFilter returns a command that loads the board with f.
+func (m Model) applyFilter(f sdk.TicketFilter, text string) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.client.FilterTickets(f)
+		if err != nil {
+			return filterErrMsg{err: err}
+		}
+		return filterAppliedMsg{filter: f, text: text, response: resp}
+	}
+}
+
+// filterStatus describes the board's sort and filter for the help bar.
+func (m Model) filterStatus() string {
+	var parts []string
+	if m.filter.Sort != "" && m.filter.Sort != boardSorts[0] {
+		parts = append(parts, "sort:"+m.filter.Sort)
+	}
+	if filterActive(m.filter) {
+		parts = append(parts, "filter:"+m.filterText)
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,36 @@
+package kanban
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseFilterQuery(t *testing.T) {
+	f := parseFilterQuery("  login #bug label:ui !urgent priority:high @alice crash ")
+	if f.Query != "login crash" {
+		t.Errorf("query = %q, want %q", f.Query, "login crash")
+	}
+	if !slices.Equal(f.Labels, []string{"bug", "ui"}) {
+		t.Errorf("labels = %v", f.Labels)
+	}
+	if !slices.Equal(f.Priorities, []string{"urgent", "high"}) {
+		t.Errorf("priorities = %v", f.Priorities)
+	}
+	if f.Assignee != "alice" {
+		t.Errorf("assignee = %q", f.Assignee)
+	}
+	if filterActive(parseFilterQuery("  ")) {
+		t.Error("expected an empty prompt to clear the filter")
+	}
+}
+
+func TestNextSort(t *testing.T) {
+	got := []string{nextSort("")}
+	for len(got) < len(boardSorts)+1 {
+		got = append(got, nextSort(got[len(got)-1]))
+	}
+	want := []string{"priority", "due", "updated", "created", "priority"}
+	if !slices.Equal(got, want) {
+		t.Errorf("sort cycle = %v, want %v", got, want)
+	}
+}
@@ -33,6 +33,8 @@ const (
 	KeyYes          Key = "y"
 	KeyNo           Key = "n"
 	KeyOpenEditor   Key = "o"
+	KeyFilter       Key = "/"
+	KeySort         Key = "S"
 )
 
 // isKey checks if a key message matches a key constant.
@@ -47,5 +49,5 @@ func isKey(msg tea.KeyMsg, keys ...Key) bool {
 
 // helpText returns the help bar text for the kanban board.
 func helpText() string {
-	return "h/l cols  j/k nav  s spawn  o/↵ open  f focus  r refresh  ! logs  q quit"
+	return "h/l cols  j/k nav  s spawn  o/↵ open  f focus  / filter  S sort  r refresh  ! logs  q quit"
 }
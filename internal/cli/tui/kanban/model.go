@@ -23,7 +23,7 @@ const (
 
 // Model is the main Bubbletea model for the kanban board.
 type Model struct {
-	columns       [3]Column
+	columns       []Column
 	client        *sdk.Client
 	activeColumn  int
 	width         int
@@ -41,6 +41,14 @@ type Model struct {
 	// Delete confirmation modal state
 	showDeleteModal bool
 
+	// Blocked spawn confirmation modal state
+	showBlockedModal   bool
+	blockedTicket      *sdk.TicketSummary
+	blockedSpawnReason string
+
+	// Tickets whose blockers all finished since the board was opened
+	unblocked map[string]bool
+
 	// Variant selector state
 	showVariantSelector bool
 	variantSelector     variant.Model
@@ -51,11 +59,18 @@ type Model struct {
 	// Vim navigation state
 	pendingG bool // tracking 'g' key for 'gg' sequence
 
+	// Board filter and sort, and the filter prompt
+	filter          sdk.TicketFilter
+	filterText      string
+	filterInput     []rune
+	showFilterInput bool
+
 	// SSE subscription state
 	eventCh      <-chan sdk.Event
 	cancelEvents context.CancelFunc
 	sseBackoff   time.Duration
 	sseConnected bool
+	lastEventID  uint64 // resume point so reconnects replay missed events
 
 	// Log viewer state
 	logBuf        *tuilog.Buffer
@@ -80,12 +95,25 @@ type SessionSpawnedMsg struct {
 	Ticket  *sdk.TicketSummary
 }
 
+// SpawnQueuedMsg is sent when a concurrency limit queued the spawn.
+type SpawnQueuedMsg struct {
+	Queue  *sdk.QueuedSpawnResponse
+	Ticket *sdk.TicketSummary
+}
+
 type SessionErrorMsg struct {
 	Err error
 }
 
 type ClearStatusMsg struct{}
 
+// BlockedSpawnMsg is sent when spawn is refused because the ticket has open blockers.
+type BlockedSpawnMsg struct {
+	Ticket  *sdk.TicketSummary
+	Variant string
+	Reason  string
+}
+
 // OrphanedSessionMsg is sent when spawn encounters an orphaned session.
 type OrphanedSessionMsg struct {
 	Ticket *sdk.TicketSummary
@@ -124,7 +152,9 @@ type sseConnectedMsg struct {
 }
 
 // EventMsg is sent when an SSE event is received.
-type EventMsg struct{}
+type EventMsg struct {
+	Event sdk.Event
+}
 
 // sseDisconnectedMsg is sent when the SSE connection is lost.
 type sseDisconnectedMsg struct{}
@@ -144,18 +174,51 @@ type variantsErrMsg struct{ err error }
 // New creates a new kanban model with the given client and log buffer.
 func New(client *sdk.Client, logBuf *tuilog.Buffer) Model {
 	return Model{
-		columns: [3]Column{
+		columns: []Column{
 			NewColumn("Backlog", "backlog"),
 			NewColumn("Progress", "progress"),
 			NewColumn("Done", "done"),
 		},
 		client:    client,
 		loading:   true,
+		unblocked: make(map[string]bool),
 		logBuf:    logBuf,
 		logViewer: tuilog.NewViewer(logBuf),
 	}
 }
 
+// setColumns rebuilds the board from the configured statuses in resp,
+// keeping cursor positions for columns that still exist.
+func (m *Model) setColumns(resp *sdk.ListAllTicketsResponse) {
+	columns := resp.Columns
+	if len(columns) == 0 {
+		columns = []sdk.TicketColumn{
+			{Status: "backlog", Tickets: resp.Backlog},
+			{Status: "progress", Tickets: resp.Progress},
+			{Status: "done", Tickets: resp.Done},
+		}
+	}
+
+	existing := make(map[string]Column, len(m.columns))
+	for _, col := range m.columns {
+		existing[col.status] = col
+	}
+
+	next := make([]Column, len(columns))
+	for i, col := range columns {
+		c, ok := existing[col.Status]
+		if !ok {
+			c = NewColumn(columnTitle(col.Status), col.Status)
+		}
+		c.SetTickets(col.Tickets)
+		next[i] = c
+	}
+	m.columns = next
+	if m.activeColumn >= len(m.columns) {
+		m.activeColumn = len(m.columns) - 1
+	}
+}
+
 // Init initializes the model and starts loading tickets.
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(m.loadTickets(), m.subscribeEvents(), m.startPollTicker())
@@ -195,12 +258,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case TicketsLoadedMsg:
 		m.loading = false
 		m.err = nil
-		m.columns[0].SetTickets(msg.Response.Backlog)
-		m.columns[1].SetTickets(msg.Response.Progress)
-		m.columns[2].SetTickets(msg.Response.Done)
+		m.setColumns(msg.Response)
 		m.logBuf.Debug("api", "tickets loaded")
 		return m, nil
 
+	case filterAppliedMsg:
+		m.loading = false
+		m.err = nil
+		switch {
+		case msg.filter.Sort != m.filter.Sort:
+			m.statusMsg = "Sort: " + msg.filter.Sort
+		case filterActive(msg.filter):
+			m.statusMsg = "Filter: " + msg.text
+		default:
+			m.statusMsg = "Filter cleared"
+		}
+		m.filter = msg.filter
+		m.filterText = msg.text
+		m.setColumns(msg.response)
+		m.statusIsError = false
+		m.logBuf.Debugf("filter", "filter applied: %q sort %q", m.filterText, m.filter.Sort)
+		return m, m.clearStatusAfterDelay()
+
+	case filterErrMsg:
+		m.statusMsg = fmt.Sprintf("Filter error: %s", msg.err)
+		m.statusIsError = true
+		m.logBuf.Warnf("filter", "filter rejected: %s", msg.err)
+		return m, m.clearStatusAfterDelay()
+
 	case TicketsErrorMsg:
 		m.loading = false
 		m.err = msg.Err
@@ -208,17 +293,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case SessionSpawnedMsg:
+		delete(m.unblocked, msg.Ticket.ID)
 		m.statusMsg = fmt.Sprintf("Session spawned for: %s", msg.Ticket.Title)
 		m.statusIsError = false
 		m.logBuf.Infof("spawn", "session spawned for: %s", msg.Ticket.Title)
 		return m, tea.Batch(m.loadTickets(), m.clearStatusAfterDelay())
 
+	case SpawnQueuedMsg:
+		delete(m.unblocked, msg.Ticket.ID)
+		m.statusMsg = fmt.Sprintf("Queued #%d: %s (%s)", msg.Queue.Position, msg.Ticket.Title, msg.Queue.Reason)
+		m.statusIsError = false
+		m.logBuf.Infof("spawn", "spawn queued at position %d for: %s (%s)", msg.Queue.Position, msg.Ticket.Title, msg.Queue.Reason)
+		return m, m.clearStatusAfterDelay()
+
 	case SessionErrorMsg:
 		m.statusMsg = fmt.Sprintf("Error: %s", msg.Err)
 		m.statusIsError = true
 		m.logBuf.Errorf("spawn", "spawn failed: %s", msg.Err)
 		return m, m.clearStatusAfterDelay()
 
+	case BlockedSpawnMsg:
+		m.showBlockedModal = true
+		m.blockedTicket = msg.Ticket
+		m.blockedSpawnReason = msg.Reason
+		m.pendingSpawnVariant = msg.Variant
+		m.statusMsg = ""
+		m.logBuf.Warnf("spawn", "ticket is blocked: %s", msg.Reason)
+		return m, nil
+
 	case OrphanedSessionMsg:
 		m.showOrphanModal = true
 		m.orphanedTicket = msg.Ticket
@@ -282,6 +384,71 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case EventMsg:
 		m.logBuf.Debug("sse", "event received")
+		if msg.Event.ID > m.lastEventID {
+			m.lastEventID = msg.Event.ID
+		}
+		if msg.Event.Type == sdk.EventResyncRequired {
+			m.logBuf.Warn("sse", "missed events, reloading")
+		}
+		if msg.Event.Type == sdk.EventPipelineAction {
+			summary := sdk.PipelineActionSummary(msg.Event)
+			m.statusMsg = "Pipeline " + summary
+			m.statusIsError = false
+			if payload, _ := msg.Event.Payload.(map[string]any); payload["result"] == "failed" {
+				m.statusIsError = true
+				m.logBuf.Warnf("pipeline", "%s [%s]", summary, msg.Event.TicketID)
+			} else {
+				m.logBuf.Infof("pipeline", "%s [%s]", summary, msg.Event.TicketID)
+			}
+			return m, tea.Batch(m.loadTickets(), m.waitForEvent(), m.clearStatusAfterDelay())
+		}
+		if msg.Event.Type == sdk.EventVerificationFinished {
+			payload, _ := msg.Event.Payload.(map[string]any)
+			repo, _ := payload["repo"].(string)
+			message, _ := payload["message"].(string)
+			m.statusMsg = fmt.Sprintf("%s: %s", repo, message)
+			m.statusIsError = payload["status"] == "failed"
+			if m.statusIsError {
+				m.logBuf.Warnf("verify", "%s: %s [%s]", repo, message, msg.Event.TicketID)
+			} else {
+				m.logBuf.Infof("verify", "%s: %s [%s]", repo, message, msg.Event.TicketID)
+			}
+			return m, tea.Batch(m.loadTickets(), m.waitForEvent(), m.clearStatusAfterDelay())
+		}
+		if msg.Event.Type == sdk.EventSpawnDequeued {
+			payload, _ := msg.Event.Payload.(map[string]any)
+			result, _ := payload["result"].(string)
+			message, _ := payload["message"].(string)
+			if result == "failed" {
+				m.statusMsg = fmt.Sprintf("Queued spawn failed: %s", message)
+				m.statusIsError = true
+				m.logBuf.Warnf("spawn", "queued spawn failed: %s [%s]", message, msg.Event.TicketID)
+			} else {
+				m.logBuf.Infof("spawn", "queued spawn %s: %s [%s]", result, message, msg.Event.TicketID)
+			}
+			return m, tea.Batch(m.loadTickets(), m.waitForEvent(), m.clearStatusAfterDelay())
+		}
+		if msg.Event.Type == "ticket_unblocked" && msg.Event.TicketID != "" {
+			m.unblocked[msg.Event.TicketID] = true
+			m.statusMsg = fmt.Sprintf("Ticket ready: %s", msg.Event.TicketID)
+			m.statusIsError = false
+			m.logBuf.Infof("sse", "ticket unblocked: %s", msg.Event.TicketID)
+			return m, tea.Batch(m.loadTickets(), m.waitForEvent(), m.clearStatusAfterDelay())
+		}
+		if msg.Event.Type == sdk.EventTicketOverdue || msg.Event.Type == sdk.EventTicketDueSoon {
+			payload, _ := msg.Event.Payload.(map[string]any)
+			title, _ := payload["title"].(string)
+			if msg.Event.Type == sdk.EventTicketOverdue {
+				m.statusMsg = fmt.Sprintf("Overdue: %s", title)
+				m.statusIsError = true
+				m.logBuf.Warnf("due", "overdue: %s [%s]", title, msg.Event.TicketID)
+			} else {
+				m.statusMsg = fmt.Sprintf("Due soon: %s", title)
+				m.statusIsError = false
+				m.logBuf.Infof("due", "due soon: %s [%s]", title, msg.Event.TicketID)
+			}
+			return m, tea.Batch(m.loadTickets(), m.waitForEvent(), m.clearStatusAfterDelay())
+		}
 		return m, tea.Batch(m.loadTickets(), m.waitForEvent())
 
 	case sseDisconnectedMsg:
@@ -309,7 +476,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case variantsLoadedMsg:
 		if len(msg.variants) == 1 {
 			m.pendingSpawnVariant = msg.variants[0]
-			return m, m.spawnSessionWithVariant(m.pendingSpawnTicket, m.pendingSpawnMode, msg.variants[0])
+			return m, m.spawnSessionWithVariant(m.pendingSpawnTicket, m.pendingSpawnMode, msg.variants[0], false)
 		}
 		m.variantSelector = variant.New("Select agent variant", msg.variants)
 		m.showVariantSelector = true
@@ -329,7 +496,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.pendingSpawnVariant = msg.Name // preserve for potential orphan re-spawn
 		m.pendingSpawnTicket = nil
 		m.pendingSpawnMode = ""
-		return m, m.spawnSessionWithVariant(ticket, mode, msg.Name)
+		return m, m.spawnSessionWithVariant(ticket, mode, msg.Name, false)
 
 	case variant.CancelledMsg:
 		m.showVariantSelector = false
@@ -345,6 +512,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleKeyMsg handles keyboard input.
 func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The filter prompt takes every key, q and ! included.
+	if m.showFilterInput {
+		return m.updateFilterInput(msg)
+	}
+
 	// Quit.
 	if isKey(msg, KeyQuit, KeyCtrlC) {
 		if m.cancelEvents != nil {
@@ -370,6 +542,9 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.showDeleteModal {
 		return m.handleDeleteModalKey(msg)
 	}
+	if m.showBlockedModal {
+		return m.handleBlockedModalKey(msg)
+	}
 	if m.showOrphanModal {
 		return m.handleOrphanModalKey(msg)
 	}
@@ -442,7 +617,7 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	if isKey(msg, KeyRight, KeyL) {
-		if m.activeColumn < 2 {
+		if m.activeColumn < len(m.columns)-1 {
 			m.activeColumn++
 		}
 		return m, nil
@@ -477,6 +652,20 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Filter the board.
+	if isKey(msg, KeyFilter) {
+		m.showFilterInput = true
+		m.filterInput = []rune(m.filterText)
+		return m, nil
+	}
+
+	// Cycle the column sort.
+	if isKey(msg, KeySort) {
+		f := m.filter
+		f.Sort = nextSort(f.Sort)
+		return m, m.applyFilter(f, m.filterText)
+	}
+
 	// Refresh.
 	if isKey(msg, KeyRefresh) {
 		m.loading = true
@@ -508,7 +697,7 @@ func (m Model) handleOrphanModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.pendingSpawnVariant = ""
 		m.statusMsg = fmt.Sprintf("Resuming session for: %s...", ticket.Title)
 		m.statusIsError = false
-		return m, m.spawnSessionWithVariant(ticket, "resume", variantName)
+		return m, m.spawnSessionWithVariant(ticket, "resume", variantName, false)
 
 	case isKey(msg, KeyFresh): // 'f' for fresh
 		m.showOrphanModal = false
@@ -518,7 +707,7 @@ func (m Model) handleOrphanModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.pendingSpawnVariant = ""
 		m.statusMsg = fmt.Sprintf("Starting fresh session for: %s...", ticket.Title)
 		m.statusIsError = false
-		return m, m.spawnSessionWithVariant(ticket, "fresh", variantName)
+		return m, m.spawnSessionWithVariant(ticket, "fresh", variantName, false)
 
 	case isKey(msg, KeyDeleteOrphan): // 'D' for delete
 		m.showOrphanModal = false
@@ -551,6 +740,30 @@ func (m Model) handleDeleteModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleBlockedModalKey handles keyboard input when the blocked spawn modal is shown.
+func (m Model) handleBlockedModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case isKey(msg, KeyYes): // 'y' to spawn anyway
+		m.showBlockedModal = false
+		ticket := m.blockedTicket
+		variantName := m.pendingSpawnVariant
+		m.blockedTicket = nil
+		m.pendingSpawnVariant = ""
+		m.statusMsg = fmt.Sprintf("Spawning blocked ticket: %s...", ticket.Title)
+		m.statusIsError = false
+		return m, m.spawnSessionWithVariant(ticket, "normal", variantName, true)
+
+	case isKey(msg, KeyNo, KeyEscape): // 'n' or Esc to cancel
+		m.showBlockedModal = false
+		m.blockedTicket = nil
+		m.pendingSpawnVariant = ""
+		m.statusMsg = "Spawn cancelled"
+		m.statusIsError = false
+		return m, m.clearStatusAfterDelay()
+	}
+	return m, nil
+}
+
 // deleteOrphanedSession returns a command to delete an orphaned session.
 func (m Model) deleteOrphanedSession(ticket *sdk.TicketSummary) tea.Cmd {
 	return func() tea.Msg {
@@ -594,7 +807,7 @@ func (m Model) View() string {
 	}
 
 	// Calculate column width.
-	columnWidth := max((m.width-2)/3, 20) // -2 for minimal side margins
+	columnWidth := max((m.width-2)/len(m.columns), 20) // -2 for minimal side margins
 
 	// Calculate available height for columns.
 	// Status bar (1) + help bar (1) + margins (2) = ~4 lines overhead
@@ -618,9 +831,9 @@ func (m Model) View() string {
 	}
 
 	// Render columns side by side.
-	cols := make([]string, 3)
+	cols := make([]string, len(m.columns))
 	for i := range m.columns {
-		cols[i] = m.columns[i].View(columnWidth, i == m.activeColumn, columnHeight, repoColors)
+		cols[i] = m.columns[i].View(columnWidth, i == m.activeColumn, columnHeight, repoColors, m.unblocked)
 	}
 	columnsView := lipgloss.JoinHorizontal(lipgloss.Top, cols...)
 
@@ -634,11 +847,17 @@ func (m Model) View() string {
 	if m.showDeleteModal {
 		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.renderDeleteModal())
 	}
+	if m.showBlockedModal {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.renderBlockedModal())
+	}
 
 	b.WriteString(columnsView)
 	b.WriteString("\n")
 
-	if m.statusMsg != "" {
+	if m.showFilterInput {
+		b.WriteString(statusBarStyle.Render("filter: " + string(m.filterInput) + "█"))
+		b.WriteString("\n")
+	} else if m.statusMsg != "" {
 		style := statusBarStyle
 		if m.statusIsError {
 			style = errorStatusStyle
@@ -649,6 +868,9 @@ func (m Model) View() string {
 		b.WriteString("\n")
 	}
 	help := helpBarStyle.Render(helpText())
+	if status := m.filterStatus(); status != "" {
+		help = help + "  " + filterBadgeStyle.Render(status)
+	}
 	badge := m.logBadge()
 	if badge != "" {
 		help = help + "  " + badge
@@ -658,10 +880,11 @@ func (m Model) View() string {
 	return b.String()
 }
 
-// loadTickets returns a command to load all tickets.
+// loadTickets returns a command to load all tickets matching the board's
+// filter, in its sort order.
 func (m Model) loadTickets() tea.Cmd {
 	return func() tea.Msg {
-		resp, err := m.client.ListAllTickets("", nil)
+		resp, err := m.client.FilterTickets(m.filter)
 		if err != nil {
 			return TicketsErrorMsg{Err: err}
 		}
@@ -673,7 +896,7 @@ func (m Model) loadTickets() tea.Cmd {
 func (m Model) subscribeEvents() tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithCancel(context.Background())
-		ch, err := m.client.SubscribeEvents(ctx)
+		ch, err := m.client.SubscribeEventsFrom(ctx, m.lastEventID)
 		if err != nil {
 			cancel()
 			return sseDisconnectedMsg{}
@@ -689,11 +912,11 @@ func (m Model) waitForEvent() tea.Cmd {
 	}
 	ch := m.eventCh
 	return func() tea.Msg {
-		_, ok := <-ch
+		event, ok := <-ch
 		if !ok {
 			return sseDisconnectedMsg{}
 		}
-		return EventMsg{}
+		return EventMsg{Event: event}
 	}
 }
 
@@ -735,15 +958,22 @@ func (m Model) loadVariants() tea.Cmd {
 }
 
 // spawnSessionWithVariant spawns a session using a resolved variant name.
-func (m Model) spawnSessionWithVariant(ticket *sdk.TicketSummary, mode, variantName string) tea.Cmd {
+// force bypasses the daemon's blocked-ticket check.
+func (m Model) spawnSessionWithVariant(ticket *sdk.TicketSummary, mode, variantName string, force bool) tea.Cmd {
 	return func() tea.Msg {
-		result, err := m.client.SpawnSession(ticket.Status, ticket.ID, mode, variantName)
+		result, err := m.client.SpawnSession(ticket.Status, ticket.ID, mode, variantName, "", force)
 		if err != nil {
 			if apiErr, ok := err.(*sdk.APIError); ok && apiErr.IsOrphanedSession() {
 				return OrphanedSessionMsg{Ticket: ticket}
 			}
+			if apiErr, ok := err.(*sdk.APIError); ok && apiErr.IsTicketBlocked() {
+				return BlockedSpawnMsg{Ticket: ticket, Variant: variantName, Reason: apiErr.Message}
+			}
 			return SessionErrorMsg{Err: err}
 		}
+		if result.Queue != nil {
+			return SpawnQueuedMsg{Queue: result.Queue, Ticket: ticket}
+		}
 		return SessionSpawnedMsg{Session: result.Session, Ticket: ticket}
 	}
 }
@@ -835,3 +1065,20 @@ func (m Model) renderDeleteModal() string {
 		modalHelpStyle.Render("[y] yes   [n] back")
 	return modalBorderStyle.Render(content)
 }
+
+// renderBlockedModal renders the blocked spawn confirmation modal as a popup box.
+func (m Model) renderBlockedModal() string {
+	title := m.blockedTicket.Title
+	if len(title) > 40 {
+		title = title[:37] + "..."
+	}
+	reason := m.blockedSpawnReason
+	if len(reason) > 60 {
+		reason = reason[:57] + "..."
+	}
+	content := modalTitleStyle.Render("Ticket Blocked") + "\n" +
+		lipgloss.NewStyle().Foreground(lipgloss.Color("252")).Render("\""+title+"\"") + "\n" +
+		mutedStyle.Render(reason) + "\n" +
+		modalHelpStyle.Render("[y] spawn anyway   [n] cancel")
+	return modalBorderStyle.Render(content)
+}
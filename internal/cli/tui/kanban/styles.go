@@ -88,6 +88,18 @@ var (
 	warnBadgeStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("214"))
 
+	// Filter badge style for the active board filter and sort.
+	filterBadgeStyle = lipgloss.NewStyle().
+				Foreground(activeColor)
+
+	// Priority styles
+	urgentStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")). // red
+			Bold(true)
+
+	highPriorityStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("214")) // yellow/orange
+
 	// Due date styles
 	dueSoonStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("214")) // yellow/orange
@@ -97,6 +109,14 @@ var (
 
 	orphanedStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("214")) // yellow/orange
+
+	// Dependency styles
+	blockedStyle = lipgloss.NewStyle().
+			Foreground(mutedColor)
+
+	unblockedStyle = lipgloss.NewStyle().
+			Foreground(doneColor).
+			Bold(true)
 )
 
 // selectedFgColor is the default foreground color for selected card text.
@@ -0,0 +1,561 @@
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/kareemaly/cortex/internal/cli/tui/tuilog"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	sseInitialBackoff = 2 * time.Second
+	sseMaxBackoff     = 30 * time.Second
+	// pollInterval refreshes next-run times even without events.
+	pollInterval = 60 * time.Second
+)
+
+// Model is the Bubbletea model for the recurring ticket schedules view.
+type Model struct {
+	client    *sdk.Client
+	schedules []sdk.ScheduleResponse
+	invalid   []sdk.InvalidScheduleResponse
+	dir       string
+	cursor    int
+
+	width, height int
+	ready         bool
+	loading       bool
+	err           error
+	pendingG      bool
+
+	eventCh      <-chan sdk.Event
+	cancelEvents context.CancelFunc
+	sseBackoff   time.Duration
+	sseConnected bool
+
+	logBuf        *tuilog.Buffer
+	logViewer     tuilog.Viewer
+	showLogViewer bool
+
+	statusMsg     string
+	statusIsError bool
+
+	showRemoveModal bool
+}
+
+// Message types for async operations.
+
+// SchedulesLoadedMsg is sent when schedules are successfully fetched.
+type SchedulesLoadedMsg struct {
+	Data *sdk.ListSchedulesResponse
+}
+
+// SchedulesErrorMsg is sent when fetching schedules fails.
+type SchedulesErrorMsg struct {
+	Err error
+}
+
+// ScheduleRemovedMsg is sent when a schedule remove action completes.
+type ScheduleRemovedMsg struct {
+	Name string
+	Err  error
+}
+
+// ClearStatusMsg clears the status message after a delay.
+type ClearStatusMsg struct{}
+
+type sseConnectedMsg struct {
+	ch     <-chan sdk.Event
+	cancel context.CancelFunc
+}
+
+// EventMsg reports an event that may change the schedules.
+type EventMsg struct{}
+
+type sseDisconnectedMsg struct{}
+
+type sseReconnectTickMsg struct{}
+
+type pollTickMsg struct{}
+
+// New creates a new schedules model.
+func New(client *sdk.Client, logBuf *tuilog.Buffer) Model {
+	return Model{
+		client:    client,
+		loading:   true,
+		logBuf:    logBuf,
+		logViewer: tuilog.NewViewer(logBuf),
+	}
+}
+
+// Init starts loading schedules and subscribes to events.
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadSchedules(), m.subscribeEvents(), m.startPollTicker())
+}
+
+// InputActive reports whether the view captures keyboard input, so
+// view-switching keys should be suppressed.
+func (m Model) InputActive() bool {
+	return m.showRemoveModal
+}
+
+// Update handles messages.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tuilog.DismissLogViewerMsg); ok {
+		m.showLogViewer = false
+		return m, nil
+	}
+
+	if m.showLogViewer {
+		if sizeMsg, ok := msg.(tea.WindowSizeMsg); ok {
+			m.width = sizeMsg.Width
+			m.height = sizeMsg.Height
+			m.ready = true
+			m.logViewer.SetSize(m.width, m.height)
+		}
+		var cmd tea.Cmd
+		m.logViewer, cmd = m.logViewer.Update(msg)
+		return m, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.ready = true
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKeyMsg(msg)
+
+	case SchedulesLoadedMsg:
+		m.loading = false
+		m.err = nil
+		for _, bad := range msg.Data.Invalid {
+			if !slices.Contains(m.invalid, bad) {
+				m.logBuf.Warnf("schedule", "skipping invalid schedule %s: %s", bad.Name, bad.Error)
+			}
+		}
+		m.schedules = msg.Data.Schedules
+		m.invalid = msg.Data.Invalid
+		m.dir = msg.Data.Dir
+		m.cursor = min(m.cursor, max(len(m.schedules)-1, 0))
+		m.logBuf.Debug("api", "schedules loaded")
+		return m, nil
+
+	case SchedulesErrorMsg:
+		m.loading = false
+		m.err = msg.Err
+		m.logBuf.Errorf("api", "failed to load schedules: %s", msg.Err)
+		return m, nil
+
+	case ScheduleRemovedMsg:
+		if msg.Err != nil {
+			m.statusMsg = fmt.Sprintf("Error: %s", msg.Err)
+			m.statusIsError = true
+			m.logBuf.Errorf("api", "failed to remove schedule %s: %s", msg.Name, msg.Err)
+			return m, clearStatusAfter(5 * time.Second)
+		}
+		m.statusMsg = fmt.Sprintf("Removed %s", msg.Name)
+		m.statusIsError = false
+		return m, tea.Batch(m.loadSchedules(), clearStatusAfter(3*time.Second))
+
+	case ClearStatusMsg:
+		m.statusMsg = ""
+		m.statusIsError = false
+		return m, nil
+
+	case sseConnectedMsg:
+		if m.cancelEvents != nil {
+			m.cancelEvents()
+		}
+		m.eventCh = msg.ch
+		m.cancelEvents = msg.cancel
+		m.sseConnected = true
+		m.sseBackoff = 0
+		m.logBuf.Info("sse", "connected to event stream")
+		return m, tea.Batch(m.loadSchedules(), m.waitForEvent())
+
+	case EventMsg:
+		m.logBuf.Debug("sse", "schedule run received")
+		return m, tea.Batch(m.loadSchedules(), m.waitForEvent())
+
+	case sseDisconnectedMsg:
+		if m.sseConnected {
+			m.sseConnected = false
+			return m, nil
+		}
+		m.eventCh = nil
+		if m.cancelEvents != nil {
+			m.cancelEvents()
+			m.cancelEvents = nil
+		}
+		m.sseBackoff = nextBackoff(m.sseBackoff)
+		m.logBuf.Warnf("sse", "disconnected, reconnecting in %s", m.sseBackoff)
+		return m, m.scheduleSSEReconnect()
+
+	case sseReconnectTickMsg:
+		return m, m.subscribeEvents()
+
+	case pollTickMsg:
+		return m, tea.Batch(m.loadSchedules(), m.startPollTicker())
+	}
+
+	return m, nil
+}
+
+func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if isKey(msg, KeyCtrlC) {
+		return m.quit()
+	}
+
+	if m.showRemoveModal {
+		switch {
+		case isKey(msg, KeyY):
+			m.showRemoveModal = false
+			if s := m.current(); s != nil {
+				m.statusMsg = "Removing..."
+				m.statusIsError = false
+				return m, m.removeSchedule(s.Name)
+			}
+		case isKey(msg, KeyN, KeyEscape):
+			m.showRemoveModal = false
+		}
+		return m, nil
+	}
+
+	if isKey(msg, KeyBang) {
+		m.showLogViewer = !m.showLogViewer
+		if m.showLogViewer {
+			m.logViewer.SetSize(m.width, m.height)
+			m.logViewer.Reset()
+		}
+		return m, nil
+	}
+
+	if isKey(msg, KeyQuit) {
+		return m.quit()
+	}
+
+	if isKey(msg, KeyR) {
+		m.loading = true
+		m.err = nil
+		return m, m.loadSchedules()
+	}
+
+	if m.loading || m.err != nil {
+		return m, nil
+	}
+
+	if isKey(msg, KeyShiftG) {
+		m.pendingG = false
+		m.cursor = max(len(m.schedules)-1, 0)
+		return m, nil
+	}
+
+	if isKey(msg, KeyG) {
+		if m.pendingG {
+			m.pendingG = false
+			m.cursor = 0
+		} else {
+			m.pendingG = true
+		}
+		return m, nil
+	}
+
+	m.pendingG = false
+
+	switch {
+	case isKey(msg, KeyJ, KeyDown):
+		if m.cursor < len(m.schedules)-1 {
+			m.cursor++
+		}
+	case isKey(msg, KeyK, KeyUp):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case isKey(msg, KeyX):
+		if m.current() != nil {
+			m.showRemoveModal = true
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) quit() (tea.Model, tea.Cmd) {
+	if m.cancelEvents != nil {
+		m.cancelEvents()
+	}
+	return m, tea.Quit
+}
+
+// current returns the selected schedule, or nil.
+func (m Model) current() *sdk.ScheduleResponse {
+	if m.cursor < 0 || m.cursor >= len(m.schedules) {
+		return nil
+	}
+	return &m.schedules[m.cursor]
+}
+
+// View renders the schedule list, the selected schedule and the status bar.
+func (m Model) View() string {
+	if !m.ready {
+		return "Loading..."
+	}
+
+	if m.showLogViewer {
+		return m.logViewer.View()
+	}
+
+	var b strings.Builder
+
+	if m.err != nil {
+		b.WriteString(errorStatusStyle.Render(fmt.Sprintf("Error: %s", m.err)))
+		b.WriteString("\n\n")
+		b.WriteString("Press [r] to retry or [q] to quit\n")
+		return b.String()
+	}
+
+	if m.loading && m.schedules == nil {
+		b.WriteString(loadingStyle.Render("Loading schedules..."))
+		return b.String()
+	}
+
+	contentHeight := max(m.height-3, 3)
+	if len(m.schedules) == 0 {
+		empty := emptyStyle.Padding(1, 2).Render(fmt.Sprintf("No schedules. Add one with `cortex schedule add`, or a markdown file under %s.", m.dir))
+		b.WriteString(lipgloss.NewStyle().Width(m.width).Height(contentHeight).Render(empty))
+	} else {
+		listHeight := min(len(m.schedules), max(contentHeight/2, 3))
+		list := m.renderList(listHeight)
+		detail := m.renderDetail()
+		divider := dividerStyle.Render(strings.Repeat("─", m.width))
+		content := list + "\n" + divider + "\n" + detail
+		b.WriteString(lipgloss.NewStyle().Height(contentHeight).MaxHeight(contentHeight).Render(content))
+	}
+	b.WriteString("\n")
+
+	if m.showRemoveModal {
+		if s := m.current(); s != nil {
+			b.WriteString(statusBarStyle.Render(fmt.Sprintf("Remove schedule %q? Tickets it created are kept.", s.Name)))
+			b.WriteString("\n")
+			b.WriteString(helpBarStyle.Render("y yes  n no"))
+		}
+		return b.String()
+	}
+
+	if m.statusMsg != "" {
+		style := statusBarStyle
+		if m.statusIsError {
+			style = errorStatusStyle
+		}
+		b.WriteString(style.Render(m.statusMsg))
+	}
+	b.WriteString("\n")
+
+	count := fmt.Sprintf("%d schedules", len(m.schedules))
+	if len(m.invalid) > 0 {
+		count += errorStatusStyle.Render(fmt.Sprintf(", %d invalid", len(m.invalid)))
+	}
+	help := statusBarStyle.Render(count) + "  " + helpBarStyle.Render(helpText())
+	if badge := m.logBadge(); badge != "" {
+		help = help + "  " + badge
+	}
+	b.WriteString(help)
+
+	return b.String()
+}
+
+// renderList renders one row per schedule: name, cron, next run and title.
+func (m Model) renderList(height int) string {
+	titleWidth := max(m.width-colGutter-colName-colCron-colNext, 10)
+
+	var lines []string
+	for i, s := range m.schedules {
+		name := fmt.Sprintf("%-*s", colName, truncateToWidth(s.Name, colName-1))
+		cron := fmt.Sprintf("%-*s", colCron, truncateToWidth(s.Cron, colCron-1))
+		next := fmt.Sprintf("%-*s", colNext, nextRunLabel(s))
+		title := truncateToWidth(s.Title, titleWidth)
+
+		if i == m.cursor {
+			row := "▸ " + name + cron + next + fmt.Sprintf("%-*s", titleWidth, title)
+			lines = append(lines, selectedItemStyle.Width(m.width).Render(row))
+			continue
+		}
+		nextStyle := statusBarStyle
+		if s.Paused {
+			nextStyle = pausedStyle
+		}
+		lines = append(lines, "  "+name+cronStyle.Render(cron)+nextStyle.Render(next)+title)
+	}
+
+	start := 0
+	if m.cursor >= height {
+		start = m.cursor - height + 1
+	}
+	end := min(start+height, len(lines))
+	return strings.Join(lines[start:end], "\n")
+}
+
+// renderDetail renders the selected schedule's fields and body.
+func (m Model) renderDetail() string {
+	s := m.current()
+	if s == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(detailHeaderStyle.Render(s.Title))
+	b.WriteString("\n\n")
+	row := func(label, value string) {
+		if value == "" {
+			return
+		}
+		b.WriteString(detailLabelStyle.Render(label) + detailValueStyle.Render(value) + "\n")
+	}
+	row("Schedule", s.Cron)
+	row("Next run", nextRunLabel(*s))
+	row("Repo", s.Repo)
+	row("Type", s.Type)
+	row("Due", s.Due)
+	row("Spawns", s.Variant)
+	if s.LastRun != nil {
+		last := s.LastRun.Local().Format("Jan 2 15:04")
+		if s.LastTicketID != "" {
+			last += " → " + s.LastTicketID
+		}
+		row("Last run", last)
+	}
+	if s.LastError != "" {
+		b.WriteString(detailLabelStyle.Render("Last error") + errorStatusStyle.Render(s.LastError) + "\n")
+	}
+	if body := strings.TrimSpace(s.Body); body != "" {
+		b.WriteString("\n")
+		b.WriteString(body)
+	}
+	return b.String()
+}
+
+// nextRunLabel returns a schedule's next run, or why it has none.
+func nextRunLabel(s sdk.ScheduleResponse) string {
+	switch {
+	case s.Paused:
+		return "paused"
+	case s.NextRun == nil:
+		return "-"
+	}
+	return s.NextRun.Local().Format("Jan 2 15:04")
+}
+
+func (m Model) loadSchedules() tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.client.ListSchedules()
+		if err != nil {
+			return SchedulesErrorMsg{Err: err}
+		}
+		return SchedulesLoadedMsg{Data: resp}
+	}
+}
+
+func (m Model) removeSchedule(name string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		return ScheduleRemovedMsg{Name: name, Err: client.DeleteSchedule(name)}
+	}
+}
+
+func (m Model) subscribeEvents() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := m.client.SubscribeEvents(ctx)
+		if err != nil {
+			cancel()
+			return sseDisconnectedMsg{}
+		}
+		return sseConnectedMsg{ch: ch, cancel: cancel}
+	}
+}
+
+// waitForEvent waits for the next schedule run; other events do not
+// change the view.
+func (m Model) waitForEvent() tea.Cmd {
+	if m.eventCh == nil {
+		return nil
+	}
+	ch := m.eventCh
+	return func() tea.Msg {
+		for ev := range ch {
+			if ev.Type == "schedule_run" {
+				return EventMsg{}
+			}
+		}
+		return sseDisconnectedMsg{}
+	}
+}
+
+func nextBackoff(current time.Duration) time.Duration {
+	if current == 0 {
+		return sseInitialBackoff
+	}
+	return min(current*2, sseMaxBackoff)
+}
+
+func (m Model) scheduleSSEReconnect() tea.Cmd {
+	return tea.Tick(m.sseBackoff, func(time.Time) tea.Msg {
+		return sseReconnectTickMsg{}
+	})
+}
+
+func (m Model) startPollTicker() tea.Cmd {
+	return tea.Tick(pollInterval, func(time.Time) tea.Msg {
+		return pollTickMsg{}
+	})
+}
+
+func (m Model) logBadge() string {
+	ec := m.logBuf.ErrorCount()
+	wc := m.logBuf.WarnCount()
+	if ec == 0 && wc == 0 {
+		return ""
+	}
+	var parts []string
+	if ec > 0 {
+		parts = append(parts, errorStatusStyle.Render(fmt.Sprintf("E:%d", ec)))
+	}
+	if wc > 0 {
+		parts = append(parts, warnBadgeStyle.Render(fmt.Sprintf("W:%d", wc)))
+	}
+	return strings.Join(parts, " ")
+}
+
+func clearStatusAfter(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return ClearStatusMsg{}
+	})
+}
+
+func truncateToWidth(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if idx := strings.Index(s, "\n"); idx >= 0 {
+		s = s[:idx]
+	}
+	if runewidth.StringWidth(s) <= maxWidth {
+		return s
+	}
+	for i, r := range s {
+		if runewidth.StringWidth(s[:i])+runewidth.RuneWidth(r) > maxWidth-1 {
+			return s[:i] + "…"
+		}
+	}
+	return s
+}
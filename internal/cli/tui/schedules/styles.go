@@ -0,0 +1,64 @@
+package schedules
+
+import "github.com/charmbracelet/lipgloss"
+
+var (
+	errorColor  = lipgloss.Color("196")
+	mutedColor  = lipgloss.Color("240")
+	accentColor = lipgloss.Color("62")
+)
+
+// Column widths for aligned rendering.
+const (
+	colGutter = 2  // left gutter for cursor indicator
+	colName   = 22 // schedule name
+	colCron   = 16 // "*/15 9-17 * * 1-5"
+	colNext   = 14 // "Jan 2 15:04"
+)
+
+var (
+	selectedItemStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("255")).
+				Background(accentColor)
+
+	statusBarStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241"))
+
+	errorStatusStyle = lipgloss.NewStyle().
+				Foreground(errorColor)
+
+	helpBarStyle = lipgloss.NewStyle().
+			Foreground(mutedColor)
+
+	loadingStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241")).
+			Italic(true)
+
+	emptyStyle = lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Italic(true)
+
+	warnBadgeStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214"))
+
+	pausedStyle = lipgloss.NewStyle().
+			Foreground(mutedColor)
+
+	cronStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("141"))
+
+	dividerStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("238"))
+
+	detailHeaderStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("255"))
+
+	detailLabelStyle = lipgloss.NewStyle().
+				Foreground(mutedColor).
+				Width(12)
+
+	detailValueStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("255"))
+)
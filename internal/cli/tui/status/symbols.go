@@ -23,6 +23,7 @@ var symbols = map[string]string{
 	"awaiting_input": "⏸",
 	"error":          "✗",
 	"ended":          "○",
+	"queued":         "◷",
 }
 
 // endedStyle is applied on top of the caller's base style when rendering
@@ -8,6 +8,7 @@ import (
 	"github.com/kareemaly/cortex/internal/cli/sdk"
 	"github.com/kareemaly/cortex/internal/cli/tui/config"
 	"github.com/kareemaly/cortex/internal/cli/tui/kanban"
+	"github.com/kareemaly/cortex/internal/cli/tui/schedules"
 	"github.com/kareemaly/cortex/internal/cli/tui/sessions"
 	"github.com/kareemaly/cortex/internal/cli/tui/tuilog"
 )
@@ -17,14 +18,17 @@ type viewID int
 const (
 	viewKanban viewID = iota
 	viewSessions
+	viewSchedules
 	viewConfig
 	viewCount
 )
 
-// Model is the top-level wrapper that hosts kanban, sessions, and config views.
+// Model is the top-level wrapper that hosts kanban, sessions, schedules,
+// and config views.
 type Model struct {
 	kanban        kanban.Model
 	sessions      sessions.Model
+	schedules     schedules.Model
 	config        config.Model
 	active        viewID
 	width, height int
@@ -52,6 +56,7 @@ func New(client *sdk.Client, logBuf *tuilog.Buffer, projectName string) Model {
 	return Model{
 		kanban:      kanban.New(client, logBuf),
 		sessions:    sessions.New(client, logBuf),
+		schedules:   schedules.New(client, logBuf),
 		config:      config.New(client, logBuf),
 		active:      viewKanban,
 		projectName: projectName,
@@ -60,7 +65,7 @@ func New(client *sdk.Client, logBuf *tuilog.Buffer, projectName string) Model {
 
 // Init initializes all child models.
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(m.kanban.Init(), m.sessions.Init(), m.config.Init())
+	return tea.Batch(m.kanban.Init(), m.sessions.Init(), m.schedules.Init(), m.config.Init())
 }
 
 // Update routes messages to child models.
@@ -77,7 +82,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			Height: msg.Height - 2,
 		}
 
-		var cmd1, cmd2, cmd3 tea.Cmd
+		var cmd1, cmd2, cmd3, cmd4 tea.Cmd
 		var kanbanModel tea.Model
 		kanbanModel, cmd1 = m.kanban.Update(childSize)
 		m.kanban = kanbanModel.(kanban.Model)
@@ -86,11 +91,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		sessionsModel, cmd2 = m.sessions.Update(childSize)
 		m.sessions = sessionsModel.(sessions.Model)
 
+		var schedulesModel tea.Model
+		schedulesModel, cmd3 = m.schedules.Update(childSize)
+		m.schedules = schedulesModel.(schedules.Model)
+
 		var configModel tea.Model
-		configModel, cmd3 = m.config.Update(childSize)
+		configModel, cmd4 = m.config.Update(childSize)
 		m.config = configModel.(config.Model)
 
-		return m, tea.Batch(cmd1, cmd2, cmd3)
+		return m, tea.Batch(cmd1, cmd2, cmd3, cmd4)
 
 	case tea.KeyMsg:
 		// Check for view-switching keys first (suppressed when child captures input).
@@ -107,7 +116,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.updateActiveChild(msg)
 
 	default:
-		var cmd1, cmd2, cmd3 tea.Cmd
+		var cmd1, cmd2, cmd3, cmd4 tea.Cmd
 		var kanbanModel tea.Model
 		kanbanModel, cmd1 = m.kanban.Update(msg)
 		m.kanban = kanbanModel.(kanban.Model)
@@ -116,11 +125,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		sessionsModel, cmd2 = m.sessions.Update(msg)
 		m.sessions = sessionsModel.(sessions.Model)
 
+		var schedulesModel tea.Model
+		schedulesModel, cmd3 = m.schedules.Update(msg)
+		m.schedules = schedulesModel.(schedules.Model)
+
 		var configModel tea.Model
-		configModel, cmd3 = m.config.Update(msg)
+		configModel, cmd4 = m.config.Update(msg)
 		m.config = configModel.(config.Model)
 
-		return m, tea.Batch(cmd1, cmd2, cmd3)
+		return m, tea.Batch(cmd1, cmd2, cmd3, cmd4)
 	}
 }
 
@@ -139,6 +152,12 @@ func (m Model) updateActiveChild(msg tea.Msg) (tea.Model, tea.Cmd) {
 		model, cmd = m.sessions.Update(msg)
 		m.sessions = model.(sessions.Model)
 		return m, cmd
+	case viewSchedules:
+		var cmd tea.Cmd
+		var model tea.Model
+		model, cmd = m.schedules.Update(msg)
+		m.schedules = model.(schedules.Model)
+		return m, cmd
 	case viewConfig:
 		var cmd tea.Cmd
 		var model tea.Model
@@ -167,6 +186,8 @@ func (m Model) View() string {
 		b.WriteString(m.kanban.View())
 	case viewSessions:
 		b.WriteString(m.sessions.View())
+	case viewSchedules:
+		b.WriteString(m.schedules.View())
 	case viewConfig:
 		b.WriteString(m.config.View())
 	}
@@ -182,6 +203,7 @@ func (m Model) renderTabBar() string {
 	}{
 		{viewKanban, "Kanban"},
 		{viewSessions, "Sessions"},
+		{viewSchedules, "Schedules"},
 		{viewConfig, "Config"},
 	}
 
@@ -203,8 +225,11 @@ func (m Model) renderTabBar() string {
 // isChildCapturingInput returns true when the active child is capturing keyboard input
 // (e.g., text input or modal), so tab-switching keys should be suppressed.
 func (m Model) isChildCapturingInput() bool {
-	if m.active == viewSessions {
+	switch m.active {
+	case viewSessions:
 		return m.sessions.InputActive()
+	case viewSchedules:
+		return m.schedules.InputActive()
 	}
 	return false
 }
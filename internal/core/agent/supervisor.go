@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
 	"github.com/kareemaly/cortex/internal/session"
 )
 
@@ -51,6 +52,9 @@ type SupervisorConfig struct {
 	// DaemonURL when nil.
 	Publisher Publisher
 	DaemonURL string
+	// Token is sent as a bearer token on the default EndFunc and Publisher
+	// requests when the daemon requires auth.
+	Token string
 
 	// Logger is used for warnings. Defaults to slog.Default() when nil.
 	Logger *slog.Logger
@@ -86,7 +90,7 @@ func StartSupervisor(ctx context.Context, cfg SupervisorConfig) (context.CancelF
 		cfg.Logger = slog.Default()
 	}
 	if cfg.Publisher == nil {
-		cfg.Publisher = HTTPPublisherWithLogger(cfg.DaemonURL, cfg.ArchitectPath, cfg.Logger)
+		cfg.Publisher = HTTPPublisherWithLogger(cfg.DaemonURL, cfg.ArchitectPath, cfg.Token, cfg.Logger)
 	}
 
 	ctx, cancelCtx := context.WithCancel(ctx)
@@ -182,9 +186,9 @@ func (s *supervisor) endSession() {
 	if s.cfg.DaemonURL == "" || s.cfg.SessionID == "" {
 		return
 	}
-	client := &http.Client{Timeout: 5 * time.Second}
+	client, baseURL := daemonconfig.NewHTTPClient(s.cfg.DaemonURL, 5*time.Second)
 	req, err := http.NewRequest(http.MethodDelete,
-		s.cfg.DaemonURL+"/sessions/"+s.cfg.SessionID, nil)
+		baseURL+"/sessions/"+s.cfg.SessionID, nil)
 	if err != nil {
 		s.cfg.Logger.Warn("supervisor: end session request failed",
 			"error", err, "session_id", s.cfg.SessionID)
@@ -193,6 +197,9 @@ func (s *supervisor) endSession() {
 	if s.cfg.ArchitectPath != "" {
 		req.Header.Set("X-Cortex-Architect", s.cfg.ArchitectPath)
 	}
+	if s.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		s.cfg.Logger.Warn("supervisor: end session DELETE failed",
@@ -231,15 +238,16 @@ func sleep(ctx context.Context, d time.Duration) bool {
 // /agent/status endpoint. daemonURL and architectPath are captured by closure.
 // Failures are logged but not retried.
 func HTTPPublisher(daemonURL, architectPath string) Publisher {
-	return HTTPPublisherWithLogger(daemonURL, architectPath, nil)
+	return HTTPPublisherWithLogger(daemonURL, architectPath, "", nil)
 }
 
-// HTTPPublisherWithLogger is HTTPPublisher with an injectable logger.
-func HTTPPublisherWithLogger(daemonURL, architectPath string, logger *slog.Logger) Publisher {
+// HTTPPublisherWithLogger is HTTPPublisher with a bearer token and an
+// injectable logger.
+func HTTPPublisherWithLogger(daemonURL, architectPath, token string, logger *slog.Logger) Publisher {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	client := &http.Client{Timeout: 5 * time.Second}
+	client, baseURL := daemonconfig.NewHTTPClient(daemonURL, 5*time.Second)
 	return func(t Transition) {
 		payload := map[string]any{
 			"status": string(t.Status),
@@ -259,7 +267,7 @@ func HTTPPublisherWithLogger(daemonURL, architectPath string, logger *slog.Logge
 				"error", err, "session_id", t.SessionID, "status", string(t.Status))
 			return
 		}
-		req, err := http.NewRequest(http.MethodPost, daemonURL+"/agent/status", bytes.NewReader(body))
+		req, err := http.NewRequest(http.MethodPost, baseURL+"/agent/status", bytes.NewReader(body))
 		if err != nil {
 			logger.Warn("agent publisher: NewRequest failed",
 				"error", err, "session_id", t.SessionID)
@@ -267,6 +275,9 @@ func HTTPPublisherWithLogger(daemonURL, architectPath string, logger *slog.Logge
 		}
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("X-Cortex-Architect", architectPath)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
 		resp, err := client.Do(req)
 		if err != nil {
 			logger.Warn("agent publisher: POST failed",
@@ -13,9 +13,12 @@ type MCPConfigParams struct {
 	TicketsDir    string
 	ArchitectPath string
 	TmuxSession   string
-	DaemonURL     string // optional; defaults to daemonconfig.DefaultDaemonURL
+	DaemonURL     string // optional; defaults to daemonconfig.ClientDaemonURL()
 	StartedAt     string // RFC3339 timestamp of when the session started
 	CollabID      string
+	Token         string // API token for the daemon; empty when auth is off
+	// ReviewTicketID is the ticket a reviewer session audits.
+	ReviewTicketID string
 }
 
 // BuildMCPServerConfig converts Cortex MCP params to an agentruntime
@@ -42,9 +45,9 @@ func BuildMCPServerConfig(params MCPConfigParams) agentruntime.MCPServerConfig {
 
 	daemonURL := params.DaemonURL
 	if daemonURL == "" {
-		daemonURL = daemonconfig.DefaultDaemonURL
+		daemonURL = daemonconfig.ClientDaemonURL()
 	}
-	env["CORTEX_DAEMON_URL"] = daemonURL
+	env[daemonconfig.DaemonURLEnvVar] = daemonURL
 
 	if params.StartedAt != "" {
 		env["CORTEX_STARTED_AT"] = params.StartedAt
@@ -52,6 +55,12 @@ func BuildMCPServerConfig(params MCPConfigParams) agentruntime.MCPServerConfig {
 	if params.CollabID != "" {
 		env["CORTEX_COLLAB_ID"] = params.CollabID
 	}
+	if params.ReviewTicketID != "" {
+		env["CORTEX_REVIEW_TICKET_ID"] = params.ReviewTicketID
+	}
+	if params.Token != "" {
+		env[daemonconfig.TokenEnvVar] = params.Token
+	}
 
 	return agentruntime.MCPServerConfig{
 		Name:    "cortex",
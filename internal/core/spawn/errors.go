@@ -1,6 +1,9 @@
 package spawn
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // StateError indicates an invalid session state for the requested operation.
 type StateError struct {
@@ -13,6 +16,17 @@ func (e *StateError) Error() string {
 	return fmt.Sprintf("spawn: ticket %s in state %s: %s", e.TicketID, e.State, e.Message)
 }
 
+// BlockedError indicates the ticket still has open blockers and the spawn
+// was not forced.
+type BlockedError struct {
+	TicketID string
+	Blockers []string
+}
+
+func (e *BlockedError) Error() string {
+	return fmt.Sprintf("spawn: ticket %s is blocked by %s", e.TicketID, strings.Join(e.Blockers, ", "))
+}
+
 // ConfigError indicates missing or invalid configuration.
 type ConfigError struct {
 	Field   string
@@ -37,6 +51,20 @@ func (e *TmuxError) Unwrap() error {
 	return e.Cause
 }
 
+// WorktreeError indicates the git worktree for an isolated ticket could not be created.
+type WorktreeError struct {
+	Path  string
+	Cause error
+}
+
+func (e *WorktreeError) Error() string {
+	return fmt.Sprintf("spawn: worktree %s: %s", e.Path, e.Cause)
+}
+
+func (e *WorktreeError) Unwrap() error {
+	return e.Cause
+}
+
 // BinaryNotFoundError indicates cortexd binary was not found.
 type BinaryNotFoundError struct {
 	Binary string
@@ -74,12 +102,24 @@ func IsStateError(err error) bool {
 	return ok
 }
 
+// IsBlockedError returns true if err is a BlockedError.
+func IsBlockedError(err error) bool {
+	_, ok := err.(*BlockedError)
+	return ok
+}
+
 // IsConfigError returns true if err is a ConfigError.
 func IsConfigError(err error) bool {
 	_, ok := err.(*ConfigError)
 	return ok
 }
 
+// IsWorktreeError returns true if err is a WorktreeError.
+func IsWorktreeError(err error) bool {
+	_, ok := err.(*WorktreeError)
+	return ok
+}
+
 // IsBinaryNotFoundError returns true if err is a BinaryNotFoundError.
 func IsBinaryNotFoundError(err error) bool {
 	_, ok := err.(*BinaryNotFoundError)
@@ -0,0 +1,44 @@
+package spawn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hiveryn/agentruntime"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+)
+
+// hookTimeout matches the timeout of agentruntime's own hook commands.
+const hookTimeout = 10 * time.Second
+
+// hookCommand returns a hook command that posts agent hook events to the
+// daemon at endpoint, the hook URL recorded so setup can tell when it
+// changes. It builds the same envelope as agentruntime's commands, but
+// sends the session's hook token from $CORTEX_HOOK_TOKEN as a bearer
+// token: the agent config holding the command is shared by every session,
+// so the token cannot be baked into it. When socketPath is set the event
+// goes over that Unix socket via node's socketPath option, which
+// agentruntime's commands cannot do.
+func hookCommand(agent, endpoint, socketPath string) agentruntime.HookCommand {
+	// Claude's envelope names the agent; Codex's does not.
+	extra := ""
+	if agent == "claude" {
+		extra = `agent:"claude",`
+	}
+	request := fmt.Sprintf(`const u=new URL(%q);const r=(u.protocol==="https:"?require("https"):require("http")).request(u.href,{method:"POST",headers:hd,timeout:%d},res=>res.resume())`,
+		endpoint+"/"+agent, hookTimeout.Milliseconds())
+	if socketPath != "" {
+		request = fmt.Sprintf(`const r=require("http").request({socketPath:%q,path:%q,method:"POST",headers:hd,timeout:%d},res=>res.resume())`,
+			socketPath, "/hook/"+agent, hookTimeout.Milliseconds())
+	}
+	cmd := fmt.Sprintf(
+		`node -e 'let d="";process.stdin.on("data",c=>d+=c);process.stdin.on("end",()=>{try{const h=JSON.parse(d||"{}");const b=JSON.stringify({%sreceived_at:new Date().toISOString(),hook:h,env:{AGENTRUNTIME_SESSION_ID:process.env.AGENTRUNTIME_SESSION_ID||""},hook_cwd:process.cwd()});const hd={"Content-Type":"application/json"};const t=process.env.%s;if(t)hd.Authorization="Bearer "+t;%s;r.on("error",()=>{});r.write(b);r.end()}catch(e){}})'`,
+		extra, daemonconfig.HookTokenEnvVar, request,
+	)
+	return agentruntime.HookCommand{
+		Command:       cmd,
+		Endpoint:      endpoint,
+		Timeout:       hookTimeout,
+		StatusMessage: "agentruntime " + agent + " hook",
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/core/agent"
 	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/storage"
 	"github.com/kareemaly/cortex/internal/ticket"
 )
 
@@ -15,6 +17,9 @@ import (
 type OrchestrateStore interface {
 	Get(id string) (*ticket.Ticket, ticket.Status, error)
 	Move(id string, to ticket.Status) error
+	OpenBlockers(id string) ([]*ticket.Ticket, error)
+	ReadReview(id string) (*ticket.Review, error)
+	ReadConclusion(id string) (*ticket.TicketConclusionMeta, string, error)
 }
 
 // OrchestrateRequest contains parameters for orchestrating a spawn operation.
@@ -22,12 +27,16 @@ type OrchestrateRequest struct {
 	TicketID      string
 	Mode          string            // "normal", "resume", "fresh" (validated internally; defaults to "normal")
 	Agent         string            // pre-resolved by API handler; falls back to "claude"
+	Variant       string            // agents map entry Agent was resolved from, recorded on the session
+	Backend       string            // session backend for new sessions: "" or "tmux", or "headless"
 	AgentArgs     []string          // pre-resolved by API handler
 	EnvVars       map[string]string // per-variant env vars, pre-resolved by API handler
 	Companion     string            // pre-resolved by API handler
 	ArchitectPath string
 	TicketsDir    string // optional: derived from ProjectPath if empty
 	TmuxSession   string // optional: derived from project config name if empty
+	Force         bool   // spawn even if the ticket has open blockers
+	Repo          string // optional: limit a multi-repo ticket's spawn to this repo
 }
 
 // Outcome describes the result of an orchestration.
@@ -50,6 +59,20 @@ type OrchestrateResult struct {
 	SpawnResult  *SpawnResult // nil when Outcome is AlreadyActive
 	StateInfo    *StateInfo
 	TmuxSession  string // resolved tmux session name
+
+	// Repos holds one entry per repo a multi-repo ticket's spawn touched;
+	// the fields above then describe the first of them, with Outcome the
+	// strongest of their outcomes.
+	Repos []RepoResult
+}
+
+// RepoResult is the outcome of orchestrating one repo's session of a
+// multi-repo ticket.
+type RepoResult struct {
+	Repo        string
+	Outcome     Outcome
+	SpawnResult *SpawnResult // nil when Outcome is AlreadyActive
+	StateInfo   *StateInfo
 }
 
 // OrchestrateDeps contains the external dependencies for orchestration.
@@ -57,10 +80,11 @@ type OrchestrateDeps struct {
 	Store         OrchestrateStore
 	SessionStore  SessionStoreInterface
 	TmuxManager   TmuxManagerInterface
-	SupervisorCtx context.Context // daemon-root context for agent supervisors
-	CortexdPath   string          // optional: empty means auto-discover via binpath
-	Logger        *slog.Logger    // optional
-	DefaultsDir   string          // path to defaults for prompt fallback
+	Headless      TmuxManagerInterface // runs headless sessions; nil when unavailable
+	SupervisorCtx context.Context      // daemon-root context for agent supervisors
+	CortexdPath   string               // optional: empty means auto-discover via binpath
+	Logger        *slog.Logger         // optional
+	DefaultsDir   string               // path to defaults for prompt fallback
 
 	// HubEventSource, when non-nil, supplies per-session Hub event streams to
 	// the supervisor. The supervisor forwards these to /agent/status → SSE.
@@ -69,6 +93,11 @@ type OrchestrateDeps struct {
 	// DaemonEndpoint is the base daemon URL (e.g. "http://127.0.0.1:4200").
 	// Used to install agentruntime hook commands at spawn time.
 	DaemonEndpoint string
+
+	// IssueToken and IssueHookToken are passed to the Spawner; see
+	// Dependencies.
+	IssueToken     func(agentType AgentType, architectPath, id string) (string, error)
+	IssueHookToken func(sessionID string) (string, error)
 }
 
 // Orchestrate is the single source of truth for spawning ticket agent sessions.
@@ -81,6 +110,16 @@ type OrchestrateDeps struct {
 //	| normal  | Spawn new   | AlreadyActive  | StateError  |
 //	| resume  | StateError  | StateError     | Resume      |
 //	| fresh   | StateError  | StateError     | Fresh       |
+//
+// A multi-repo ticket has one session per repo, each placed by the matrix
+// above. Unless req.Repo picks one repo, resume and fresh instead act on
+// the orphaned repos, spawn repos without a session and leave active ones,
+// failing only when no repo is orphaned. Nothing is spawned if any repo
+// would fail, and when one repo's session fails to start the sessions
+// already started for the others are rolled back.
+//
+// Starting a new session (Normal state) on a ticket with open blockers
+// returns a BlockedError unless req.Force is set.
 func Orchestrate(ctx context.Context, req OrchestrateRequest, deps OrchestrateDeps) (*OrchestrateResult, error) {
 	// 1. Validate mode
 	if req.Mode == "" {
@@ -90,18 +129,13 @@ func Orchestrate(ctx context.Context, req OrchestrateRequest, deps OrchestrateDe
 		return nil, &ConfigError{Field: "Mode", Message: "must be 'normal', 'resume', or 'fresh'"}
 	}
 
-	// 2. Validate TmuxManager
-	if deps.TmuxManager == nil {
-		return nil, &ConfigError{Field: "TmuxManager", Message: "tmux manager is required"}
-	}
-
-	// 3. Load project config
+	// 2. Load project config
 	projectCfg, err := architectconfig.Load(req.ArchitectPath)
 	if err != nil {
 		return nil, &ConfigError{Field: "ProjectPath", Message: "failed to load project config: " + err.Error()}
 	}
 
-	// 4. Get ticket
+	// 3. Get ticket
 	t, ticketStatus, err := deps.Store.Get(req.TicketID)
 	if err != nil {
 		return nil, err
@@ -112,7 +146,7 @@ func Orchestrate(ctx context.Context, req OrchestrateRequest, deps OrchestrateDe
 		agent = "claude"
 	}
 
-	// 5. Resolve TmuxSession: request > project config name
+	// 4. Resolve TmuxSession: request > project config name
 	tmuxSession := req.TmuxSession
 	if tmuxSession == "" {
 		tmuxSession = projectCfg.GetTmuxSessionName()
@@ -121,153 +155,384 @@ func Orchestrate(ctx context.Context, req OrchestrateRequest, deps OrchestrateDe
 		return nil, &ConfigError{Field: "TmuxSession", Message: "tmux session name is required (set in project config or pass explicitly)"}
 	}
 
-	// 6. Resolve TicketsDir
+	// 5. Resolve TicketsDir
 	ticketsDir := req.TicketsDir
 	if ticketsDir == "" {
 		ticketsDir = projectCfg.TicketsPath(req.ArchitectPath)
 	}
 
-	// 7. Look up existing session
-	var existingSess *session.Session
-	if deps.SessionStore != nil {
-		existingSess, _ = deps.SessionStore.GetByTicketID(t.ID)
-	}
-
-	// 8. Detect state
-	stateInfo, err := DetectTicketState(existingSess, tmuxSession, deps.TmuxManager)
+	// 6. Plan each repo's session: pick its backend, detect its state and
+	// place it in the state/mode matrix.
+	repos, err := targetRepos(t, req.Repo)
 	if err != nil {
 		return nil, err
 	}
+	fanOut := t.IsMultiRepo() && req.Repo == ""
+	plans := make([]*repoPlan, 0, len(repos))
+	for _, repo := range repos {
+		plan, err := planRepo(t, repo, req, deps, tmuxSession)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	if err := placePlans(plans, req.Mode, req.TicketID, fanOut); err != nil {
+		return nil, err
+	}
+
+	// 7. Refuse to start work on a blocked ticket unless forced
+	if req.Mode == "normal" && !req.Force && anyPlanned(plans, OutcomeSpawned) {
+		blockers, err := deps.Store.OpenBlockers(t.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(blockers) > 0 {
+			ids := make([]string, len(blockers))
+			for i, b := range blockers {
+				ids[i] = b.ID
+			}
+			return nil, &BlockedError{TicketID: t.ID, Blockers: ids}
+		}
+	}
 
-	// 9. State/mode matrix
-	spawner := NewSpawner(Dependencies{
-		Store:          deps.Store,
-		SessionStore:   deps.SessionStore,
-		TmuxManager:    deps.TmuxManager,
-		SupervisorCtx:  deps.SupervisorCtx,
-		CortexdPath:    deps.CortexdPath,
-		Logger:         deps.Logger,
-		DefaultsDir:    deps.DefaultsDir,
-		HubEventSource: deps.HubEventSource,
-		DaemonEndpoint: deps.DaemonEndpoint,
-	})
-
-	buildSpawnReq := func() SpawnRequest {
-		return SpawnRequest{
+	if !anyPlanned(plans, OutcomeSpawned) && !anyPlanned(plans, OutcomeResumed) {
+		return &OrchestrateResult{
+			Outcome:      OutcomeAlreadyActive,
+			Ticket:       t,
+			TicketStatus: ticketStatus,
+			StateInfo:    plans[0].state,
+			TmuxSession:  tmuxSession,
+			Repos:        repoResults(t, plans),
+		}, nil
+	}
+
+	// 8. Spawn, resume or refresh each repo's session
+	for _, plan := range plans {
+		if plan.outcome == OutcomeAlreadyActive {
+			continue
+		}
+		spawner := NewSpawner(Dependencies{
+			Store:          deps.Store,
+			SessionStore:   deps.SessionStore,
+			TmuxManager:    plan.manager,
+			SupervisorCtx:  deps.SupervisorCtx,
+			CortexdPath:    deps.CortexdPath,
+			Logger:         deps.Logger,
+			DefaultsDir:    deps.DefaultsDir,
+			HubEventSource: deps.HubEventSource,
+			DaemonEndpoint: deps.DaemonEndpoint,
+			IssueToken:     deps.IssueToken,
+			IssueHookToken: deps.IssueHookToken,
+		})
+		spawnReq := SpawnRequest{
 			AgentType:     AgentTypeTicketAgent,
 			Agent:         agent,
+			Variant:       req.Variant,
+			Backend:       plan.backend,
 			TmuxSession:   tmuxSession,
 			ArchitectPath: req.ArchitectPath,
 			TicketsDir:    ticketsDir,
 			TicketID:      req.TicketID,
-			Ticket:        t,
+			Ticket:        plan.ticket,
 			Companion:     req.Companion,
 			AgentArgs:     req.AgentArgs,
 			EnvVars:       req.EnvVars,
 		}
+
+		var result *SpawnResult
+		switch {
+		case plan.outcome == OutcomeResumed:
+			result, err = spawner.Resume(ctx, ResumeRequest{
+				AgentType:     AgentTypeTicketAgent,
+				Agent:         agent,
+				TmuxSession:   tmuxSession,
+				ArchitectPath: req.ArchitectPath,
+				TicketsDir:    ticketsDir,
+				WindowName:    plan.state.Session.TmuxWindow,
+				TicketID:      req.TicketID,
+				TicketType:    spawnReq.ticketType(),
+				Companion:     req.Companion,
+				WorktreePath:  plan.state.Session.WorktreePath,
+				Repo:          spawnReq.sessionRepo(),
+				AgentArgs:     req.AgentArgs,
+				EnvVars:       req.EnvVars,
+			})
+		case plan.fresh:
+			result, err = spawner.Fresh(ctx, spawnReq)
+		default:
+			result, err = spawner.Spawn(ctx, spawnReq)
+		}
+		if err != nil {
+			rollbackPlans(plans, req.TicketID, tmuxSession, deps)
+			return nil, err
+		}
+
+		// Handle soft failures from spawner (e.g., prompt load failure, tmux spawn failure)
+		if result == nil || !result.Success {
+			msg := "spawn operation failed"
+			if result != nil && result.Message != "" {
+				msg = result.Message
+			}
+			if plan.repo != "" && fanOut {
+				msg = plan.repo + ": " + msg
+			}
+			rollbackPlans(plans, req.TicketID, tmuxSession, deps)
+			return nil, fmt.Errorf("spawn: %s", msg)
+		}
+		plan.result = result
 	}
 
-	var result *SpawnResult
-	var outcome Outcome
+	// 9. Post-spawn: move ticket to progress if in backlog
+	if ticketStatus == ticket.StatusBacklog {
+		if moveErr := deps.Store.Move(req.TicketID, ticket.StatusProgress); moveErr != nil {
+			if deps.Logger != nil {
+				deps.Logger.Warn("failed to move ticket to progress", "error", moveErr)
+			}
+		}
+	}
 
-	switch stateInfo.State {
+	// 10. Re-read ticket to get updated state
+	t, ticketStatus, err = deps.Store.Get(req.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	first := firstActed(plans)
+	return &OrchestrateResult{
+		Outcome:      aggregateOutcome(plans),
+		Ticket:       t,
+		TicketStatus: ticketStatus,
+		SpawnResult:  first.result,
+		StateInfo:    first.state,
+		TmuxSession:  tmuxSession,
+		Repos:        repoResults(t, plans),
+	}, nil
+}
+
+// repoPlan is what Orchestrate will do with one repo's session.
+type repoPlan struct {
+	repo    string         // the ticket's repo key; "" when it has none
+	ticket  *ticket.Ticket // the ticket as this repo's worker sees it
+	backend string
+	manager TmuxManagerInterface
+	state   *StateInfo
+
+	outcome Outcome
+	fresh   bool // clear the orphaned session before spawning
+	result  *SpawnResult
+}
+
+// targetRepos returns the repos to orchestrate sessions for. A ticket
+// without a repo has a single session, keyed "".
+func targetRepos(t *ticket.Ticket, only string) ([]string, error) {
+	repos := t.RepoKeys()
+	if only != "" {
+		if !slices.Contains(repos, only) {
+			return nil, &ConfigError{Field: "Repo", Message: fmt.Sprintf("ticket %s does not include repo %q", t.ID, only)}
+		}
+		return []string{only}, nil
+	}
+	if len(repos) == 0 {
+		return []string{""}, nil
+	}
+	if !t.IsMultiRepo() {
+		return repos[:1], nil
+	}
+	return repos, nil
+}
+
+// planRepo selects the session backend for repo and detects the state of
+// its session: an existing session keeps its own backend, new sessions
+// use the requested one.
+func planRepo(t *ticket.Ticket, repo string, req OrchestrateRequest, deps OrchestrateDeps, tmuxSession string) (*repoPlan, error) {
+	plan := &repoPlan{repo: repo, ticket: t}
+	sessionRepo := ""
+	if t.IsMultiRepo() {
+		// Each worker sees the ticket as if it were about its own repo, so
+		// the working directory, env and prompt follow the repo.
+		wt := *t
+		wt.Repo = repo
+		plan.ticket = &wt
+		sessionRepo = repo
+	}
+
+	var existingSess *session.Session
+	if deps.SessionStore != nil {
+		existingSess, _ = deps.SessionStore.GetByTicketRepo(req.TicketID, sessionRepo)
+	}
+	plan.backend = req.Backend
+	if existingSess != nil {
+		plan.backend = existingSess.Backend
+	}
+	plan.manager = deps.TmuxManager
+	switch plan.backend {
+	case "", session.BackendTmux:
+		plan.backend = ""
+		if plan.manager == nil {
+			return nil, &ConfigError{Field: "TmuxManager", Message: "tmux manager is required"}
+		}
+	case session.BackendHeadless:
+		plan.manager = deps.Headless
+		if plan.manager == nil {
+			return nil, &ConfigError{Field: "Headless", Message: "headless manager is required"}
+		}
+	default:
+		return nil, &ConfigError{Field: "Backend", Message: "must be 'tmux' or 'headless'"}
+	}
+
+	state, err := DetectTicketState(existingSess, tmuxSession, plan.manager)
+	if err != nil {
+		return nil, err
+	}
+	plan.state = state
+	return plan, nil
+}
+
+// placePlans applies the state/mode matrix to each plan. With fanOut, the
+// resume and fresh modes use the multi-repo rules described on Orchestrate.
+func placePlans(plans []*repoPlan, mode, ticketID string, fanOut bool) error {
+	orphaned := false
+	for _, plan := range plans {
+		state := plan.state.State
+		if fanOut && mode != "normal" {
+			switch state {
+			case StateNormal:
+				plan.outcome = OutcomeSpawned
+			case StateActive:
+				plan.outcome = OutcomeAlreadyActive
+			case StateOrphaned:
+				orphaned = true
+				if err := placePlan(plan, mode, ticketID); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := placePlan(plan, mode, ticketID); err != nil {
+			return err
+		}
+	}
+	if fanOut && mode != "normal" && !orphaned {
+		return &StateError{TicketID: ticketID, State: plans[0].state.State, Message: "cannot use " + mode + " mode - no repo has an orphaned session"}
+	}
+	return nil
+}
+
+// placePlan applies the state/mode matrix to a single session.
+func placePlan(plan *repoPlan, mode, ticketID string) error {
+	switch plan.state.State {
 	case StateNormal:
-		switch req.Mode {
+		switch mode {
 		case "normal":
-			result, err = spawner.Spawn(ctx, buildSpawnReq())
-			outcome = OutcomeSpawned
+			plan.outcome = OutcomeSpawned
 		case "resume":
-			return nil, &StateError{TicketID: req.TicketID, State: StateNormal, Message: "cannot resume - no existing session to resume"}
+			return &StateError{TicketID: ticketID, State: StateNormal, Message: "cannot resume - no existing session to resume"}
 		case "fresh":
-			return nil, &StateError{TicketID: req.TicketID, State: StateNormal, Message: "cannot use fresh mode - no existing session to clear"}
+			return &StateError{TicketID: ticketID, State: StateNormal, Message: "cannot use fresh mode - no existing session to clear"}
 		}
 
 	case StateActive:
-		if req.Mode == "normal" {
-			return &OrchestrateResult{
-				Outcome:      OutcomeAlreadyActive,
-				Ticket:       t,
-				TicketStatus: ticketStatus,
-				StateInfo:    stateInfo,
-				TmuxSession:  tmuxSession,
-			}, nil
+		if mode == "normal" {
+			plan.outcome = OutcomeAlreadyActive
+			return nil
 		}
-		return nil, &StateError{
-			TicketID: req.TicketID,
+		return &StateError{
+			TicketID: ticketID,
 			State:    StateActive,
 			Message:  "session is currently active - wait for it to finish or close the tmux window",
 		}
 
 	case StateOrphaned:
-		switch req.Mode {
+		switch mode {
 		case "normal":
-			return nil, &StateError{
-				TicketID: req.TicketID,
+			return &StateError{
+				TicketID: ticketID,
 				State:    StateOrphaned,
 				Message:  "session was orphaned (tmux window closed). Use mode='resume' to continue or mode='fresh' to start over",
 			}
 		case "resume":
-			if stateInfo.Session == nil {
-				return nil, &StateError{
-					TicketID: req.TicketID,
+			if plan.state.Session == nil {
+				return &StateError{
+					TicketID: ticketID,
 					State:    StateOrphaned,
 					Message:  "cannot resume - no session stored",
 				}
 			}
-			result, err = spawner.Resume(ctx, ResumeRequest{
-				AgentType:     AgentTypeTicketAgent,
-				Agent:         agent,
-				TmuxSession:   tmuxSession,
-				ArchitectPath: req.ArchitectPath,
-				TicketsDir:    ticketsDir,
-				WindowName:    stateInfo.Session.TmuxWindow,
-				TicketID:      req.TicketID,
-				TicketType:    ticket.DefaultTicketType,
-				Companion:     req.Companion,
-				AgentArgs:     req.AgentArgs,
-				EnvVars:       req.EnvVars,
-			})
-			outcome = OutcomeResumed
+			plan.outcome = OutcomeResumed
 		case "fresh":
-			result, err = spawner.Fresh(ctx, buildSpawnReq())
-			outcome = OutcomeSpawned
+			plan.outcome = OutcomeSpawned
+			plan.fresh = true
 		}
 	}
+	return nil
+}
 
-	if err != nil {
-		return nil, err
+func anyPlanned(plans []*repoPlan, outcome Outcome) bool {
+	for _, plan := range plans {
+		if plan.outcome == outcome {
+			return true
+		}
 	}
+	return false
+}
 
-	// Handle soft failures from spawner (e.g., prompt load failure, tmux spawn failure)
-	if result == nil || !result.Success {
-		msg := "spawn operation failed"
-		if result != nil && result.Message != "" {
-			msg = result.Message
-		}
-		return nil, fmt.Errorf("spawn: %s", msg)
+// aggregateOutcome is the strongest outcome across plans: spawned, then
+// resumed, then already active.
+func aggregateOutcome(plans []*repoPlan) Outcome {
+	switch {
+	case anyPlanned(plans, OutcomeSpawned):
+		return OutcomeSpawned
+	case anyPlanned(plans, OutcomeResumed):
+		return OutcomeResumed
 	}
+	return OutcomeAlreadyActive
+}
 
-	// 10. Post-spawn: move ticket to progress if in backlog
-	if ticketStatus == ticket.StatusBacklog {
-		if moveErr := deps.Store.Move(req.TicketID, ticket.StatusProgress); moveErr != nil {
-			if deps.Logger != nil {
-				deps.Logger.Warn("failed to move ticket to progress", "error", moveErr)
-			}
+// rollbackPlans stops the sessions a failed multi-repo orchestration
+// already started, so the ticket is not left half-running. New sessions
+// are ended; resumed ones are left orphaned, as they were before.
+func rollbackPlans(plans []*repoPlan, ticketID, tmuxSession string, deps OrchestrateDeps) {
+	for _, plan := range plans {
+		if plan.result == nil {
+			continue
+		}
+		if err := plan.manager.KillWindow(tmuxSession, plan.result.TmuxWindow); err != nil && deps.Logger != nil {
+			deps.Logger.Warn("rollback: failed to stop session", "ticket", ticketID, "repo", plan.repo, "error", err)
 		}
+		if plan.outcome == OutcomeResumed {
+			plan.result = nil
+			continue
+		}
+		if err := deps.SessionStore.EndByTicketRepo(ticketID, plan.repo); err != nil && !storage.IsNotFound(err) && deps.Logger != nil {
+			deps.Logger.Warn("rollback: failed to end session", "ticket", ticketID, "repo", plan.repo, "error", err)
+		}
+		plan.result = nil
 	}
+}
 
-	// 11. Re-read ticket to get updated state
-	t, ticketStatus, err = deps.Store.Get(req.TicketID)
-	if err != nil {
-		return nil, err
+// firstActed returns the first plan that spawned or resumed a session.
+func firstActed(plans []*repoPlan) *repoPlan {
+	for _, plan := range plans {
+		if plan.result != nil {
+			return plan
+		}
 	}
+	return plans[0]
+}
 
-	return &OrchestrateResult{
-		Outcome:      outcome,
-		Ticket:       t,
-		TicketStatus: ticketStatus,
-		SpawnResult:  result,
-		StateInfo:    stateInfo,
-		TmuxSession:  tmuxSession,
-	}, nil
+// repoResults reports per-repo outcomes for a multi-repo ticket.
+func repoResults(t *ticket.Ticket, plans []*repoPlan) []RepoResult {
+	if !t.IsMultiRepo() {
+		return nil
+	}
+	results := make([]RepoResult, len(plans))
+	for i, plan := range plans {
+		results[i] = RepoResult{
+			Repo:        plan.repo,
+			Outcome:     plan.outcome,
+			SpawnResult: plan.result,
+			StateInfo:   plan.state,
+		}
+	}
+	return results
 }
@@ -2,6 +2,7 @@ package spawn
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -9,6 +10,7 @@ import (
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/cli/sdk"
 	"github.com/kareemaly/cortex/internal/prompt"
+	"github.com/kareemaly/cortex/internal/storage"
 	"github.com/kareemaly/cortex/internal/ticket"
 )
 
@@ -30,6 +32,8 @@ func (s *Spawner) buildPrompt(req SpawnRequest, workingDir string) (*promptInfo,
 		return s.buildArchitectPrompt(req)
 	case AgentTypeCollabAgent:
 		return &promptInfo{PromptText: req.Prompt}, nil
+	case AgentTypeReviewerAgent:
+		return s.buildReviewerPrompt(req, workingDir)
 	default:
 		return nil, &ConfigError{Field: "AgentType", Message: "unknown agent type: " + string(req.AgentType)}
 	}
@@ -37,21 +41,27 @@ func (s *Spawner) buildPrompt(req SpawnRequest, workingDir string) (*promptInfo,
 
 // buildTicketAgentPrompt creates the dynamic ticket prompt.
 func (s *Spawner) buildTicketAgentPrompt(req SpawnRequest, workingDir string) (*promptInfo, error) {
-	ticketType := ticket.DefaultTicketType
+	ticketType := req.ticketType()
+	cfg, cfgErr := architectconfig.Load(req.ArchitectPath)
+	promptDir := ticketType
+	if cfgErr == nil {
+		promptDir = cfg.TicketPromptDir(ticketType)
+	}
 
 	resolver := prompt.NewPromptResolver(req.ArchitectPath, s.deps.DefaultsDir)
 
 	s.logWarn("buildTicketAgentPrompt: resolving prompts",
 		"ticketType", ticketType,
+		"promptDir", promptDir,
 		"architectPath", req.ArchitectPath,
 		"defaultsDir", s.deps.DefaultsDir)
 
-	systemPromptContent, systemErr := resolver.ResolveTicketPrompt(ticketType, prompt.StageSystem)
+	systemPromptContent, systemErr := resolver.ResolveTicketPrompt(promptDir, prompt.StageSystem)
 	s.logWarn("buildTicketAgentPrompt: system prompt resolved",
 		"systemPromptLen", len(systemPromptContent),
 		"systemErr", systemErr)
 
-	kickoffTemplate, err := resolver.ResolveTicketPrompt(ticketType, prompt.StageKickoff)
+	kickoffTemplate, err := resolver.ResolveTicketPrompt(promptDir, prompt.StageKickoff)
 	s.logWarn("buildTicketAgentPrompt: kickoff template resolved",
 		"kickoffTemplateLen", len(kickoffTemplate),
 		"err", err)
@@ -73,15 +83,69 @@ func (s *Spawner) buildTicketAgentPrompt(req SpawnRequest, workingDir string) (*
 		References:  formatTicketReferences(req.Ticket.References),
 		Repo:        req.Ticket.Repo,
 		RepoPath:    workingDir,
+		Criteria:    formatCriteria(req.Ticket.Criteria()),
 	}
 
-	if cfg, cfgErr := architectconfig.Load(req.ArchitectPath); cfgErr == nil {
+	if cfgErr == nil {
 		vars.ArchitectName = cfg.Name
-		vars.Repos = formatOtherRepos(cfg, req.Ticket.Repo)
+		vars.Repos = formatOtherRepos(cfg, req.Ticket.Repo, req.Ticket.Repos...)
+		if req.Ticket.IsMultiRepo() {
+			vars.SiblingRepos = formatRepos(cfg, req.Ticket.Repos, req.Ticket.Repo)
+		}
 	} else if req.Ticket.Repo != "" {
 		return nil, cfgErr
 	}
 
+	if s.deps.Store != nil {
+		if review, err := s.deps.Store.ReadReview(req.TicketID); err == nil && review.State == ticket.ReviewChangesRequested {
+			vars.ReviewSummary = review.Summary
+			vars.ReviewComments = formatReviewComments(review.RoundComments(), req.Ticket)
+		}
+		if meta, _, err := s.deps.Store.ReadConclusion(req.TicketID); err == nil {
+			if v := meta.Verification(req.Ticket.Repo); v != nil && v.Status == ticket.VerifyFailed {
+				vars.VerifyFailure = formatVerifyFailure(v)
+			}
+		}
+	}
+
+	promptText, err := prompt.RenderTemplate(kickoffTemplate, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &promptInfo{
+		PromptText:          promptText,
+		SystemPromptContent: systemPromptContent,
+	}, nil
+}
+
+// buildReviewerPrompt renders prompts/review/KICKOFF.md with the ticket, the
+// worker's conclusion and the concluded diffs. A review SYSTEM.md, when
+// present, is appended to the agent's system prompt.
+func (s *Spawner) buildReviewerPrompt(req SpawnRequest, workingDir string) (*promptInfo, error) {
+	resolver := prompt.NewPromptResolver(req.ArchitectPath, s.deps.DefaultsDir)
+
+	kickoffTemplate, err := resolver.ResolveReviewPrompt(prompt.StageKickoff)
+	if err != nil {
+		return nil, err
+	}
+	systemPromptContent, _ := resolver.ResolveReviewPrompt(prompt.StageSystem)
+
+	vars := prompt.ReviewerVars{
+		ProjectPath: req.ArchitectPath,
+		TicketID:    req.TicketID,
+		TicketTitle: req.Ticket.Title,
+		TicketBody:  req.Ticket.Body,
+		Repo:        req.Ticket.Repo,
+		RepoPath:    workingDir,
+		Round:       req.ReviewRound,
+		Conclusion:  strings.TrimSpace(req.Conclusion),
+		Diffs:       req.Diffs,
+	}
+	if cfg, err := architectconfig.Load(req.ArchitectPath); err == nil {
+		vars.ArchitectName = cfg.Name
+	}
+
 	promptText, err := prompt.RenderTemplate(kickoffTemplate, vars)
 	if err != nil {
 		return nil, err
@@ -130,16 +194,43 @@ func (s *Spawner) buildArchitectPrompt(req SpawnRequest) (*promptInfo, error) {
 		sb.WriteString("\n")
 	}
 
-	writeSection("Backlog", tickets.Backlog)
-	writeSection("In Progress", tickets.Progress)
-	doneTickets := tickets.Done
-	if len(doneTickets) > 10 {
-		doneTickets = doneTickets[:10]
+	columns := tickets.Columns
+	if len(columns) == 0 {
+		columns = []sdk.TicketColumn{
+			{Status: string(ticket.StatusBacklog), Tickets: tickets.Backlog},
+			{Status: string(ticket.StatusProgress), Tickets: tickets.Progress},
+			{Status: string(ticket.StatusDone), Tickets: tickets.Done},
+		}
+	}
+	for _, col := range columns {
+		switch ticket.Status(col.Status) {
+		case ticket.StatusBacklog:
+			writeSection("Backlog", col.Tickets)
+		case ticket.StatusProgress:
+			writeSection("In Progress", col.Tickets)
+		case ticket.StatusDone:
+			doneTickets := col.Tickets
+			if len(doneTickets) > 10 {
+				doneTickets = doneTickets[:10]
+			}
+			writeSection("Done", doneTickets)
+		default:
+			writeSection(strings.ToUpper(col.Status[:1])+col.Status[1:], col.Tickets)
+		}
 	}
-	writeSection("Done", doneTickets)
 
 	ticketList := sb.String()
 
+	var overdueSB strings.Builder
+	for _, col := range columns {
+		for _, t := range col.Tickets {
+			if t.DueState == string(ticket.DueStateOverdue) && t.Due != nil {
+				overdueSB.WriteString(fmt.Sprintf("- [%s] %s (due: %s, %s)\n", t.ID, t.Title, t.Due.Format(time.DateOnly), t.Status))
+			}
+		}
+	}
+	overdueList := overdueSB.String()
+
 	var sessionsList string
 	conclusionsResp, conclusionsErr := client.ListConclusions(sdk.ListConclusionsParams{Limit: 10})
 	if conclusionsErr == nil && len(conclusionsResp.Conclusions) > 0 {
@@ -189,6 +280,7 @@ func (s *Spawner) buildArchitectPrompt(req SpawnRequest) (*promptInfo, error) {
 			Repos:            reposList,
 			LastConclusionID: lastConclusionID,
 			Variants:         variantsList,
+			Overdue:          overdueList,
 		}
 		rendered, renderErr := prompt.RenderTemplate(kickoffTemplate, vars)
 		if renderErr == nil {
@@ -223,14 +315,84 @@ func formatTicketReferences(refs []string) string {
 	return sb.String()
 }
 
-// formatOtherRepos formats repos into a bulleted markdown list, excluding the current ticket's repo key.
-func formatOtherRepos(cfg *architectconfig.Config, currentRepo string) string {
+// formatVerifyFailure describes a failed verification: the commit, the
+// command that failed and the tail of its output.
+func formatVerifyFailure(v *ticket.Verification) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Commit: `%s`\n", v.Commit)
+	if v.Error != "" {
+		fmt.Fprintf(&sb, "\n%s\n", v.Error)
+	}
+	if c := v.Failed(); c != nil {
+		result := fmt.Sprintf("exited with %d", c.ExitCode)
+		if c.TimedOut {
+			result = "timed out"
+		}
+		fmt.Fprintf(&sb, "Command: `%s` (%s)\n", c.Command, result)
+		if out := strings.TrimRight(c.Output, "\n"); out != "" {
+			fmt.Fprintf(&sb, "\n```\n%s\n```\n", out)
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// formatReviewComments formats review comments into a bulleted markdown
+// list, keeping only those for t's repo when it is one worker of a
+// multi-repo ticket.
+func formatReviewComments(comments []ticket.ReviewComment, t *ticket.Ticket) string {
+	var sb strings.Builder
+	for _, c := range comments {
+		if t.IsMultiRepo() && c.Repo != t.Repo {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		anchor := c.File
+		if c.Line > 0 {
+			anchor = fmt.Sprintf("%s:%d", c.File, c.Line)
+		}
+		sb.WriteString(fmt.Sprintf("- `%s`", anchor))
+		if c.Commit != "" {
+			sb.WriteString(fmt.Sprintf(" (commit %s)", storage.ShortID(c.Commit)))
+		}
+		sb.WriteString(": ")
+		sb.WriteString(strings.ReplaceAll(strings.TrimSpace(c.Body), "\n", "\n  "))
+	}
+	return sb.String()
+}
+
+// formatCriteria lists acceptance criteria with the index checkCriterion
+// takes.
+func formatCriteria(criteria []ticket.Criterion) string {
+	var sb strings.Builder
+	for _, c := range criteria {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		mark := " "
+		if c.Checked {
+			mark = "x"
+		}
+		sb.WriteString(fmt.Sprintf("%d. [%s] %s", c.Index, mark, c.Text))
+	}
+	return sb.String()
+}
+
+// formatOtherRepos formats repos into a bulleted markdown list, excluding the current ticket's repo keys.
+func formatOtherRepos(cfg *architectconfig.Config, currentRepo string, ticketRepos ...string) string {
 	keys := cfg.RepoKeys()
 	sort.Strings(keys)
+	return formatRepos(cfg, keys, append([]string{currentRepo}, ticketRepos...)...)
+}
+
+// formatRepos formats keys with their resolved paths into a bulleted
+// markdown list, skipping excluded keys and keys that do not resolve.
+func formatRepos(cfg *architectconfig.Config, keys []string, exclude ...string) string {
 	var sb strings.Builder
 	first := true
 	for _, key := range keys {
-		if key == currentRepo {
+		if slices.Contains(exclude, key) {
 			continue
 		}
 		repoPath, err := cfg.ResolveRepoPath(key)
@@ -0,0 +1,71 @@
+package spawn
+
+import (
+	"context"
+
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// ReviewerSpawnRequest contains parameters for spawning a reviewer session.
+type ReviewerSpawnRequest struct {
+	Ticket        *ticket.Ticket
+	Repo          string // directory the reviewer starts in
+	Round         int
+	Conclusion    string
+	Diffs         string
+	ArchitectPath string
+	TmuxSession   string
+	Agent         string
+	Variant       string
+	Backend       string // session backend: empty for tmux, or "headless"
+	Companion     string
+	AgentArgs     []string
+	EnvVars       map[string]string
+	TicketsDir    string
+}
+
+// ReviewerSpawnResult contains the result of a reviewer spawn operation.
+type ReviewerSpawnResult struct {
+	TicketID    string
+	TmuxWindow  string
+	TmuxSession string
+}
+
+// SpawnReviewer spawns a reviewer agent for a concluded ticket. Reviewers
+// always spawn fresh, through the manager of req.Backend.
+func (s *Spawner) SpawnReviewer(ctx context.Context, req ReviewerSpawnRequest) (*ReviewerSpawnResult, error) {
+	if req.Ticket == nil {
+		return nil, &ConfigError{Field: "Ticket", Message: "cannot be nil for reviewer agent"}
+	}
+	result, err := s.Spawn(ctx, SpawnRequest{
+		AgentType:     AgentTypeReviewerAgent,
+		Agent:         req.Agent,
+		Variant:       req.Variant,
+		Backend:       req.Backend,
+		TmuxSession:   req.TmuxSession,
+		ArchitectPath: req.ArchitectPath,
+		TicketsDir:    req.TicketsDir,
+		TicketID:      req.Ticket.ID,
+		Ticket:        req.Ticket,
+		Repo:          req.Repo,
+		ReviewRound:   req.Round,
+		Conclusion:    req.Conclusion,
+		Diffs:         req.Diffs,
+		Companion:     req.Companion,
+		AgentArgs:     req.AgentArgs,
+		EnvVars:       req.EnvVars,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, &ConfigError{Field: "spawn", Message: result.Message}
+	}
+
+	return &ReviewerSpawnResult{
+		TicketID:    req.Ticket.ID,
+		TmuxWindow:  result.TmuxWindow,
+		TmuxSession: req.TmuxSession,
+	}, nil
+}
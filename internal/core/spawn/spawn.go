@@ -15,6 +15,7 @@ import (
 	"github.com/kareemaly/cortex/internal/architectsession"
 	"github.com/kareemaly/cortex/internal/binpath"
 	"github.com/kareemaly/cortex/internal/core/agent"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
 	"github.com/kareemaly/cortex/internal/session"
 	"github.com/kareemaly/cortex/internal/storage"
 	"github.com/kareemaly/cortex/internal/ticket"
@@ -34,23 +35,33 @@ const (
 	AgentTypeTicketAgent AgentType = "ticket_agent"
 	// AgentTypeCollabAgent is the collab agent type.
 	AgentTypeCollabAgent AgentType = "collab_agent"
+	// AgentTypeReviewerAgent is the agent type that audits a concluded
+	// ticket's commits.
+	AgentTypeReviewerAgent AgentType = "reviewer_agent"
 )
 
 // StoreInterface defines the ticket store operations needed for spawning.
 type StoreInterface interface {
 	Get(id string) (*ticket.Ticket, ticket.Status, error)
+	ReadReview(id string) (*ticket.Review, error)
+	ReadConclusion(id string) (*ticket.TicketConclusionMeta, string, error)
 }
 
 // SessionStoreInterface defines the session store operations needed for spawning.
 type SessionStoreInterface interface {
-	Create(ticketID, agent, tmuxWindow string) (*session.Session, error)
+	Create(ticketID, agent, variant, backend, tmuxWindow, worktreePath string) (*session.Session, error)
+	CreateForRepo(ticketID, repo, agent, variant, backend, tmuxWindow, worktreePath string) (*session.Session, error)
 	EndBySessionID(sessionID string) error
 	EndByTicketID(ticketID string) error
+	EndByTicketRepo(ticketID, repo string) error
 	GetByTicketID(ticketID string) (*session.Session, error)
+	GetByTicketRepo(ticketID, repo string) (*session.Session, error)
 	CreateArchitect(sessionID, agent, tmuxWindow string) (*session.Session, error)
 	GetArchitect() (*session.Session, error)
 	EndArchitect() error
 	CreateCollab(collabID, prompt, agent, tmuxWindow string) (*session.Session, error)
+	CreateReviewer(ticketID, agent, variant, backend, tmuxWindow string) (*session.Session, error)
+	EndReviewer(ticketID string) error
 }
 
 // TmuxManagerInterface defines the tmux operations needed for spawning.
@@ -58,6 +69,7 @@ type TmuxManagerInterface interface {
 	WindowExists(session, windowName string) (bool, error)
 	SpawnAgent(session, windowName, agentCommand, companionCommand, workingDir, companionWorkingDir string) (int, error)
 	SpawnArchitect(session, windowName, agentCommand, companionCommand, workingDir, companionWorkingDir string) error
+	KillWindow(session, windowName string) error
 }
 
 // Dependencies contains the external dependencies for the Spawner.
@@ -79,6 +91,14 @@ type Dependencies struct {
 	// Used to install agentruntime hook commands at spawn time so hooks always
 	// match the running daemon's bind address and port.
 	DaemonEndpoint string
+
+	// IssueToken, when non-nil, mints the API token a session's agent and
+	// MCP server send to the daemon. id is the ticket or collab ID.
+	IssueToken func(agentType AgentType, architectPath, id string) (string, error)
+
+	// IssueHookToken, when non-nil, mints the token a session's hook
+	// commands send with its hook events, scoped to that session.
+	IssueHookToken func(sessionID string) (string, error)
 }
 
 // Spawner handles spawning agent sessions.
@@ -105,11 +125,13 @@ func (s *Spawner) logWarn(msg string, args ...any) {
 type SpawnRequest struct {
 	AgentType     AgentType
 	Agent         string // agent identifier (e.g., "claude")
+	Variant       string // agents map entry the agent was resolved from, if any
+	Backend       string // session backend recorded on ticket and reviewer sessions; empty for tmux
 	TmuxSession   string
 	ArchitectPath string
 	TicketsDir    string
 
-	// For ticket agents
+	// For ticket and reviewer agents
 	TicketID string
 	Ticket   *ticket.Ticket
 
@@ -119,7 +141,12 @@ type SpawnRequest struct {
 	// For collab agents
 	CollabID string // unique UUID for this collab session
 	Prompt   string // kickoff prompt text
-	Repo     string // working directory (used for collab agents)
+	Repo     string // working directory (used for collab and reviewer agents)
+
+	// For reviewer agents
+	ReviewRound int    // review round being audited
+	Conclusion  string // the worker's conclusion body
+	Diffs       string // the concluded commits rendered for the prompt
 
 	// Companion pane command (from cortex.yaml)
 	Companion string
@@ -130,6 +157,23 @@ type SpawnRequest struct {
 	EnvVars map[string]string
 }
 
+// sessionRepo returns the repo the request's session is recorded under:
+// the worker's repo for a multi-repo ticket, else "".
+func (req SpawnRequest) sessionRepo() string {
+	if req.Ticket != nil && req.Ticket.IsMultiRepo() {
+		return req.Ticket.Repo
+	}
+	return ""
+}
+
+// ticketType returns the type of the request's ticket, defaulting to work.
+func (req SpawnRequest) ticketType() string {
+	if req.Ticket != nil && req.Ticket.Type != "" {
+		return req.Ticket.Type
+	}
+	return ticket.DefaultTicketType
+}
+
 // ResumeRequest contains parameters for resuming an orphaned session.
 type ResumeRequest struct {
 	AgentType     AgentType
@@ -141,9 +185,11 @@ type ResumeRequest struct {
 	WindowName    string
 
 	// For ticket agents
-	TicketID   string
-	TicketType string // ticket type
-	Companion  string // companion pane command (from cortex.yaml)
+	TicketID     string
+	TicketType   string // ticket type
+	Companion    string // companion pane command (from cortex.yaml)
+	WorktreePath string // isolated worktree recorded on the session, if any
+	Repo         string // repo of a multi-repo ticket's session; empty otherwise
 
 	// Extra CLI args appended to the agent command
 	AgentArgs []string
@@ -171,7 +217,7 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 	if req.AgentType == AgentTypeTicketAgent {
 		var existingSess *session.Session
 		if s.deps.SessionStore != nil {
-			existingSess, _ = s.deps.SessionStore.GetByTicketID(req.TicketID)
+			existingSess, _ = s.deps.SessionStore.GetByTicketRepo(req.TicketID, req.sessionRepo())
 		}
 		stateInfo, err := DetectTicketState(existingSess, req.TmuxSession, s.deps.TmuxManager)
 		if err != nil {
@@ -191,8 +237,17 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 		return nil, err
 	}
 
+	subject := req.TicketID
+	if req.AgentType == AgentTypeCollabAgent {
+		subject = req.CollabID
+	}
+	token, err := s.sessionToken(req.AgentType, req.ArchitectPath, subject)
+	if err != nil {
+		return nil, err
+	}
+
 	windowName := s.generateWindowName(req)
-	workingDir, err := getWorkingDirectory(req)
+	workingDir, worktreePath, err := getWorkingDirectory(req)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +256,7 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 	if s.deps.SessionStore != nil {
 		switch req.AgentType {
 		case AgentTypeTicketAgent:
-			sess, err := s.deps.SessionStore.Create(req.TicketID, req.Agent, windowName)
+			sess, err := s.deps.SessionStore.CreateForRepo(req.TicketID, req.sessionRepo(), req.Agent, req.Variant, req.Backend, windowName, worktreePath)
 			if err != nil {
 				return nil, err
 			}
@@ -228,23 +283,38 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 			if sess != nil {
 				sessionIDForStatus = sess.SessionID
 			}
+		case AgentTypeReviewerAgent:
+			sess, err := s.deps.SessionStore.CreateReviewer(req.TicketID, req.Agent, req.Variant, req.Backend, windowName)
+			if err != nil {
+				return nil, err
+			}
+			if sess != nil {
+				sessionIDForStatus = sess.SessionID
+			}
 		}
 	}
 
-	mcpServerConfig := BuildMCPServerConfig(MCPConfigParams{
+	mcpParams := MCPConfigParams{
 		CortexdPath:   cortexdPath,
 		TicketID:      req.TicketID,
-		TicketType:    ticket.DefaultTicketType,
+		TicketType:    req.ticketType(),
 		TicketsDir:    req.TicketsDir,
 		ArchitectPath: req.ArchitectPath,
 		TmuxSession:   req.TmuxSession,
 		StartedAt:     startedAt,
 		CollabID:      req.CollabID,
-	})
+		Token:         token,
+	}
+	if req.AgentType == AgentTypeReviewerAgent {
+		// Passing --ticket-id would start a worker's MCP server.
+		mcpParams.TicketID = ""
+		mcpParams.ReviewTicketID = req.TicketID
+	}
+	mcpServerConfig := BuildMCPServerConfig(mcpParams)
 
 	pInfo, err := s.buildPrompt(req, workingDir)
 	if err != nil {
-		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, nil)
+		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, req.sessionRepo(), nil)
 		return &SpawnResult{
 			Success: false,
 			Message: err.Error(),
@@ -252,7 +322,9 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 	}
 
 	identifier := req.TicketID
-	if identifier == "" && req.CollabID != "" {
+	if req.AgentType == AgentTypeReviewerAgent {
+		identifier = "review-" + req.TicketID
+	} else if identifier == "" && req.CollabID != "" {
 		identifier = "collab-" + storage.ShortID(req.CollabID)
 	} else if identifier == "" {
 		identifier = "architect-" + req.TmuxSession
@@ -260,7 +332,7 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 
 	adapter, err := s.adapterFor(req.Agent, req.AgentType)
 	if err != nil {
-		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, nil)
+		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, req.sessionRepo(), nil)
 		return nil, err
 	}
 
@@ -292,12 +364,16 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 		}
 	}
 
-	cortexEnv := s.buildCortexEnv(req, startedAt, workingDir)
+	cortexEnv := s.buildCortexEnv(req, startedAt, workingDir, token)
+	if err := s.addHookToken(cortexEnv, sessionIDForStatus); err != nil {
+		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, req.sessionRepo(), nil)
+		return nil, err
+	}
 	startReq.Env = mergeEnvMaps(req.EnvVars, cortexEnv)
 
 	spec, err := adapter.PrepareLaunch(ctx, startReq)
 	if err != nil {
-		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, nil)
+		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, req.sessionRepo(), nil)
 		return &SpawnResult{
 			Success: false,
 			Message: "failed to prepare agent launch: " + err.Error(),
@@ -306,7 +382,7 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 
 	launcherPath, err := WriteLauncherScript(spec, cortexEnv, identifier, s.deps.MCPConfigDir)
 	if err != nil {
-		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, nil)
+		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, req.sessionRepo(), nil)
 		return nil, err
 	}
 
@@ -316,7 +392,7 @@ func (s *Spawner) Spawn(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 
 	windowIndex, err := s.spawnInTmux(req, windowName, launchCmd, workingDir)
 	if err != nil {
-		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, allCleanupFiles)
+		s.cleanupOnFailure(ctx, req.AgentType, req.TicketID, req.sessionRepo(), allCleanupFiles)
 		return &SpawnResult{
 			Success: false,
 			Message: "failed to spawn agent in tmux: " + err.Error(),
@@ -368,6 +444,11 @@ func (s *Spawner) Resume(ctx context.Context, req ResumeRequest) (*SpawnResult,
 		identifier = "architect-" + req.TmuxSession
 	}
 
+	token, err := s.sessionToken(req.AgentType, req.ArchitectPath, req.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
 	mcpServerConfig := BuildMCPServerConfig(MCPConfigParams{
 		CortexdPath:   cortexdPath,
 		TicketID:      req.TicketID,
@@ -376,6 +457,7 @@ func (s *Spawner) Resume(ctx context.Context, req ResumeRequest) (*SpawnResult,
 		ArchitectPath: req.ArchitectPath,
 		TmuxSession:   req.TmuxSession,
 		StartedAt:     startedAt,
+		Token:         token,
 	})
 
 	adapter, err := s.adapterFor(req.Agent, req.AgentType)
@@ -388,10 +470,16 @@ func (s *Spawner) Resume(ctx context.Context, req ResumeRequest) (*SpawnResult,
 	cortexEnv := map[string]string{
 		"CORTEX_STARTED_AT": startedAt,
 	}
+	if token != "" {
+		cortexEnv[daemonconfig.TokenEnvVar] = token
+	}
 	switch req.AgentType {
 	case AgentTypeTicketAgent:
 		cortexEnv["CORTEX_TICKET_ID"] = req.TicketID
 		cortexEnv["CORTEX_TICKET_TYPE"] = req.TicketType
+		if req.Repo != "" {
+			cortexEnv["CORTEX_REPO"] = req.Repo
+		}
 	case AgentTypeArchitect:
 		cortexEnv["CORTEX_TICKET_ID"] = session.ArchitectSessionKey
 	}
@@ -414,7 +502,7 @@ func (s *Spawner) Resume(ctx context.Context, req ResumeRequest) (*SpawnResult,
 				resumeSessionID = sess.SessionID
 			}
 		case AgentTypeTicketAgent:
-			if existing, _ := s.deps.SessionStore.GetByTicketID(req.TicketID); existing != nil {
+			if existing, _ := s.deps.SessionStore.GetByTicketRepo(req.TicketID, req.Repo); existing != nil {
 				resumeSessionID = existing.SessionID
 			}
 		}
@@ -422,12 +510,20 @@ func (s *Spawner) Resume(ctx context.Context, req ResumeRequest) (*SpawnResult,
 	if resumeSessionID == "" {
 		resumeSessionID = newResumeSessionID()
 	}
+	if err := s.addHookToken(cortexEnv, resumeSessionID); err != nil {
+		return nil, err
+	}
+
+	workingDir := req.ArchitectPath
+	if req.AgentType == AgentTypeTicketAgent && req.WorktreePath != "" {
+		workingDir = req.WorktreePath
+	}
 
 	startReq := agentruntime.StartRequest{
 		ID:         resumeSessionID,
 		Agent:      s.agentKind(req.Agent),
 		Args:       req.AgentArgs,
-		Workdir:    req.ArchitectPath,
+		Workdir:    workingDir,
 		MCPServers: []agentruntime.MCPServerConfig{mcpServerConfig},
 		Resume:     req.SessionID == "",
 		ResumeID:   req.SessionID,
@@ -455,11 +551,6 @@ func (s *Spawner) Resume(ctx context.Context, req ResumeRequest) (*SpawnResult,
 	launchCmd := "bash " + launcherPath
 	var windowIndex int
 
-	workingDir := req.ArchitectPath
-	if req.AgentType == AgentTypeTicketAgent && req.TicketType == "work" {
-		workingDir = req.ArchitectPath
-	}
-
 	switch req.AgentType {
 	case AgentTypeArchitect:
 		companionCmd := req.Companion
@@ -517,7 +608,7 @@ func (s *Spawner) Fresh(ctx context.Context, req SpawnRequest) (*SpawnResult, er
 	if s.deps.SessionStore != nil {
 		switch req.AgentType {
 		case AgentTypeTicketAgent:
-			if err := s.deps.SessionStore.EndByTicketID(req.TicketID); err != nil && !storage.IsNotFound(err) {
+			if err := s.deps.SessionStore.EndByTicketRepo(req.TicketID, req.sessionRepo()); err != nil && !storage.IsNotFound(err) {
 				s.logWarn("fresh: failed to end existing session", "ticketID", req.TicketID, "error", err)
 			}
 		case AgentTypeArchitect:
@@ -555,8 +646,8 @@ func (s *Spawner) spawnInTmux(req SpawnRequest, windowName, launchCmd, workingDi
 		}
 		err := s.deps.TmuxManager.SpawnArchitect(req.TmuxSession, windowName, launchCmd, companionCmd, workingDir, req.ArchitectPath)
 		return 0, err
-	case AgentTypeCollabAgent:
-		// Collab agents spawn as simple agent windows (no companion)
+	case AgentTypeCollabAgent, AgentTypeReviewerAgent:
+		// Collab and reviewer agents spawn as simple agent windows (no companion)
 		return s.deps.TmuxManager.SpawnAgent(req.TmuxSession, windowName, launchCmd, req.Companion, workingDir, workingDir)
 	default:
 		return 0, &ConfigError{Field: "AgentType", Message: "unknown agent type: " + string(req.AgentType)}
@@ -564,12 +655,12 @@ func (s *Spawner) spawnInTmux(req SpawnRequest, windowName, launchCmd, workingDi
 }
 
 // cleanupOnFailure cleans up resources when spawn fails.
-func (s *Spawner) cleanupOnFailure(_ context.Context, agentType AgentType, ticketID string, tempFiles []string) {
+func (s *Spawner) cleanupOnFailure(_ context.Context, agentType AgentType, ticketID, repo string, tempFiles []string) {
 	if s.deps.SessionStore != nil {
 		switch agentType {
 		case AgentTypeTicketAgent:
 			if ticketID != "" {
-				if err := s.deps.SessionStore.EndByTicketID(ticketID); err != nil && !storage.IsNotFound(err) {
+				if err := s.deps.SessionStore.EndByTicketRepo(ticketID, repo); err != nil && !storage.IsNotFound(err) {
 					s.logWarn("cleanup: failed to end session", "ticketID", ticketID, "error", err)
 				}
 			}
@@ -577,6 +668,10 @@ func (s *Spawner) cleanupOnFailure(_ context.Context, agentType AgentType, ticke
 			if err := s.deps.SessionStore.EndArchitect(); err != nil && !storage.IsNotFound(err) {
 				s.logWarn("cleanup: failed to end architect session", "error", err)
 			}
+		case AgentTypeReviewerAgent:
+			if err := s.deps.SessionStore.EndReviewer(ticketID); err != nil && !storage.IsNotFound(err) {
+				s.logWarn("cleanup: failed to end reviewer session", "ticketID", ticketID, "error", err)
+			}
 			// AgentTypeCollabAgent cleanup handled in SpawnCollab via EndCollab
 		}
 	}
@@ -623,25 +718,59 @@ func (s *Spawner) agentKind(agent string) agentruntime.AgentKind {
 }
 
 // buildCortexEnv returns the per-session agent env vars set by Cortex.
-func (s *Spawner) buildCortexEnv(req SpawnRequest, startedAt, workingDir string) map[string]string {
+// token is the session's API token, or "" when the daemon has no auth.
+func (s *Spawner) buildCortexEnv(req SpawnRequest, startedAt, workingDir, token string) map[string]string {
 	env := map[string]string{
 		"CORTEX_STARTED_AT": startedAt,
 		"CORTEX_WORKDIR":    workingDir,
 	}
+	if token != "" {
+		env[daemonconfig.TokenEnvVar] = token
+	}
 	switch req.AgentType {
 	case AgentTypeArchitect:
 		env["CORTEX_TICKET_ID"] = session.ArchitectSessionKey
 	case AgentTypeTicketAgent:
 		env["CORTEX_TICKET_ID"] = req.TicketID
-		env["CORTEX_TICKET_TYPE"] = ticket.DefaultTicketType
+		env["CORTEX_TICKET_TYPE"] = req.ticketType()
 		env["CORTEX_REPO"] = req.Ticket.Repo
 		env["CORTEX_REPO_PATH"] = workingDir
 	case AgentTypeCollabAgent:
 		env["CORTEX_COLLAB_ID"] = req.CollabID
+	case AgentTypeReviewerAgent:
+		// Not CORTEX_TICKET_ID: hooks would report status as the worker's.
+		env["CORTEX_REVIEW_TICKET_ID"] = req.TicketID
 	}
 	return env
 }
 
+// sessionToken mints the session's API token, or returns "" when the
+// daemon does not require one.
+func (s *Spawner) sessionToken(agentType AgentType, architectPath, id string) (string, error) {
+	if s.deps.IssueToken == nil {
+		return "", nil
+	}
+	token, err := s.deps.IssueToken(agentType, architectPath, id)
+	if err != nil {
+		return "", fmt.Errorf("issue session token: %w", err)
+	}
+	return token, nil
+}
+
+// addHookToken mints the hook token of session sessionID into env, or
+// leaves env alone when the daemon does not require one.
+func (s *Spawner) addHookToken(env map[string]string, sessionID string) error {
+	if s.deps.IssueHookToken == nil || sessionID == "" {
+		return nil
+	}
+	token, err := s.deps.IssueHookToken(sessionID)
+	if err != nil {
+		return fmt.Errorf("issue hook token: %w", err)
+	}
+	env[daemonconfig.HookTokenEnvVar] = token
+	return nil
+}
+
 func hasAgentFlag(args []string) bool {
 	for _, a := range args {
 		if a == "--agent" {
@@ -673,22 +802,31 @@ func (s *Spawner) ensureAgentHooks(ctx context.Context, adapter agentruntime.Ada
 	}
 
 	hookEndpoint := s.deps.DaemonEndpoint + "/hook"
+	socket := daemonconfig.SocketFromURL(s.deps.DaemonEndpoint)
+	switch agent {
+	case "claude", "codex":
+		s.installHooks(ctx, adapter, agent, envVars, hookCommand(agent, hookEndpoint, socket))
+	case "opencode":
+		// opencode's hook plugin posts to a fixed URL and cannot send the
+		// session's hook token.
+		switch {
+		case socket != "":
+			s.logWarn("hook setup skipped: opencode hooks need a TCP daemon endpoint", "endpoint", s.deps.DaemonEndpoint)
+		case s.deps.IssueHookToken != nil:
+			s.logWarn("hook setup skipped: opencode hooks cannot authenticate to the daemon")
+		default:
+			s.installHooks(ctx, adapter, agent, envVars, agentruntime.HookCommand{Endpoint: hookEndpoint})
+		}
+	}
+}
 
-	var hook agentruntime.HookCommand
+// installHooks writes hook into the agent's config.
+func (s *Spawner) installHooks(ctx context.Context, adapter agentruntime.Adapter, agent string, envVars map[string]string, hook agentruntime.HookCommand) {
 	var configRoot string
-
-	switch agent {
-	case "claude":
-		hook = claude.HookCommand(hookEndpoint)
-	case "codex":
-		hook = codex.HookCommand(hookEndpoint)
+	if agent == "codex" {
 		if ch, ok := envVars["CODEX_HOME"]; ok && ch != "" {
 			configRoot = ch
 		}
-	case "opencode":
-		hook = agentruntime.HookCommand{Endpoint: hookEndpoint}
-	default:
-		return
 	}
 
 	_, err := adapter.EnsureSetup(ctx, agentruntime.SetupRequest{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/kareemaly/cortex/internal/session"
 	"github.com/kareemaly/cortex/internal/storage"
 	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/worktree"
 )
 
 // mockStore implements StoreInterface for testing (ticket store only).
@@ -40,6 +42,14 @@ func (m *mockStore) Get(id string) (*ticket.Ticket, ticket.Status, error) {
 	return t, ticket.StatusBacklog, nil
 }
 
+func (m *mockStore) ReadReview(id string) (*ticket.Review, error) {
+	return &ticket.Review{}, nil
+}
+
+func (m *mockStore) ReadConclusion(id string) (*ticket.TicketConclusionMeta, string, error) {
+	return nil, "", os.ErrNotExist
+}
+
 // mockSessionStore implements SessionStoreInterface for testing.
 type mockSessionStore struct {
 	sessions        map[string]*session.Session // keyed by SessionID UUID
@@ -69,24 +79,55 @@ func newMockSessionStore() *mockSessionStore {
 	}
 }
 
-func (m *mockSessionStore) Create(ticketID, agent, tmuxWindow string) (*session.Session, error) {
+func (m *mockSessionStore) Create(ticketID, agent, variant, backend, tmuxWindow, worktreePath string) (*session.Session, error) {
+	return m.CreateForRepo(ticketID, "", agent, variant, backend, tmuxWindow, worktreePath)
+}
+
+func (m *mockSessionStore) CreateForRepo(ticketID, repo, agent, variant, backend, tmuxWindow, worktreePath string) (*session.Session, error) {
 	if m.createErr != nil {
 		return nil, m.createErr
 	}
 	m.lastCreateAgent = agent
 	sess := &session.Session{
-		SessionID:  session.NewSessionID(),
-		Type:       session.SessionTypeTicket,
-		TicketID:   ticketID,
-		Agent:      agent,
-		TmuxWindow: tmuxWindow,
-		StartedAt:  time.Now(),
-		Status:     session.AgentStatusStarting,
+		SessionID:    session.NewSessionID(),
+		Type:         session.SessionTypeTicket,
+		TicketID:     ticketID,
+		Agent:        agent,
+		Variant:      variant,
+		Backend:      backend,
+		TmuxWindow:   tmuxWindow,
+		StartedAt:    time.Now(),
+		Status:       session.AgentStatusStarting,
+		WorktreePath: worktreePath,
+		Repo:         repo,
 	}
 	m.sessions[sess.SessionID] = sess
 	return sess, nil
 }
 
+func (m *mockSessionStore) EndByTicketRepo(ticketID, repo string) error {
+	if m.endErr != nil {
+		return m.endErr
+	}
+	for id, sess := range m.sessions {
+		if sess.Type == session.SessionTypeTicket && sess.TicketID == ticketID && sess.Repo == repo {
+			m.endCalls = append(m.endCalls, id)
+			delete(m.sessions, id)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *mockSessionStore) GetByTicketRepo(ticketID, repo string) (*session.Session, error) {
+	for _, sess := range m.sessions {
+		if sess.Type == session.SessionTypeTicket && sess.TicketID == ticketID && sess.Repo == repo {
+			return sess, nil
+		}
+	}
+	return nil, &storage.NotFoundError{Resource: "session", ID: ticketID}
+}
+
 func (m *mockSessionStore) EndByTicketID(ticketID string) error {
 	if m.endErr != nil {
 		return m.endErr
@@ -178,10 +219,39 @@ func (m *mockSessionStore) CreateCollab(collabID, prompt, agent, tmuxWindow stri
 	return sess, nil
 }
 
+func (m *mockSessionStore) CreateReviewer(ticketID, agent, variant, backend, tmuxWindow string) (*session.Session, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	sess := &session.Session{
+		SessionID:  session.NewSessionID(),
+		Type:       session.SessionTypeReviewer,
+		TicketID:   ticketID,
+		Agent:      agent,
+		Variant:    variant,
+		Backend:    backend,
+		TmuxWindow: tmuxWindow,
+	}
+	m.sessions[sess.SessionID] = sess
+	return sess, nil
+}
+
+func (m *mockSessionStore) EndReviewer(ticketID string) error {
+	for id, sess := range m.sessions {
+		if sess.Type == session.SessionTypeReviewer && sess.TicketID == ticketID {
+			delete(m.sessions, id)
+			return nil
+		}
+	}
+	return &storage.NotFoundError{Resource: "session", ID: ticketID}
+}
+
 // mockTmuxManager implements TmuxManagerInterface for testing.
 type mockTmuxManager struct {
 	windows          map[string]bool // window existence by name
 	spawnErr         error
+	spawnErrAt       int // fail only the nth spawn when set
+	killed           []string
 	windowExists     bool
 	spawnCalls       int
 	lastCommand      string
@@ -201,7 +271,7 @@ func (m *mockTmuxManager) WindowExists(session, windowName string) (bool, error)
 }
 
 func (m *mockTmuxManager) SpawnAgent(session, windowName, agentCommand, companionCommand, workingDir, companionWorkingDir string) (int, error) {
-	if m.spawnErr != nil {
+	if m.spawnErr != nil && (m.spawnErrAt == 0 || m.spawnErrAt == m.spawnCalls+1) {
 		return 0, m.spawnErr
 	}
 	m.spawnCalls++
@@ -224,6 +294,12 @@ func (m *mockTmuxManager) SpawnArchitect(session, windowName, agentCommand, comp
 	return nil
 }
 
+func (m *mockTmuxManager) KillWindow(session, windowName string) error {
+	m.killed = append(m.killed, windowName)
+	delete(m.windows, windowName)
+	return nil
+}
+
 // Test helpers
 
 func createTestTicket(id, title, body string) *ticket.Ticket {
@@ -320,6 +396,71 @@ func TestSpawn_TicketAgent_Success(t *testing.T) {
 	}
 }
 
+func TestSpawn_TicketAgent_WorktreeIsolation(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	config := "name: test\nrepos:\n  api:\n    path: " + repoDir + "\n    isolation: worktree\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := newMockStore()
+	sessStore := newMockSessionStore()
+	tmuxMgr := newMockTmuxManager()
+
+	testTicket := createTestTicket("ticket-1", "Test Ticket", "Test body")
+	testTicket.Repo = "api"
+	store.tickets["ticket-1"] = testTicket
+
+	createTestPromptFile(t, tmpDir, "work/SYSTEM.md", "## Test Instructions")
+
+	spawner := NewSpawner(Dependencies{
+		Store:        store,
+		SessionStore: sessStore,
+		TmuxManager:  tmuxMgr,
+		CortexdPath:  "/usr/bin/cortexd",
+		MCPConfigDir: tmpDir,
+	})
+
+	result, err := spawner.Spawn(context.Background(), SpawnRequest{
+		AgentType:     AgentTypeTicketAgent,
+		Agent:         "claude",
+		TmuxSession:   "test-session",
+		ArchitectPath: tmpDir,
+		TicketsDir:    filepath.Join(tmpDir, "tickets"),
+		TicketID:      "ticket-1",
+		Ticket:        testTicket,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected success, got: %s", result.Message)
+	}
+
+	want := filepath.Join(tmpDir, "worktrees", "api", "ticket-1")
+	if tmuxMgr.lastWorkingDir != want {
+		t.Errorf("working dir = %q, want %q", tmuxMgr.lastWorkingDir, want)
+	}
+	if !worktree.Exists(want) {
+		t.Error("expected worktree to be created")
+	}
+	sess, _ := sessStore.GetByTicketID("ticket-1")
+	if sess == nil || sess.WorktreePath != want {
+		t.Errorf("expected session to record worktree %q, got %+v", want, sess)
+	}
+}
+
 func TestSpawn_VariantEnv_InLauncherScript(t *testing.T) {
 	tmpDir := t.TempDir()
 	store := newMockStore()
@@ -370,6 +511,81 @@ func TestSpawn_VariantEnv_InLauncherScript(t *testing.T) {
 	}
 }
 
+func TestSpawn_HookTokenScopedToSession(t *testing.T) {
+	tmpDir := t.TempDir()
+	store := newMockStore()
+	sessStore := newMockSessionStore()
+	tmuxMgr := newMockTmuxManager()
+
+	testTicket := createTestTicket("ticket-1", "Test Ticket", "Test body")
+	store.tickets["ticket-1"] = testTicket
+	createTestPromptFile(t, tmpDir, "work/SYSTEM.md", "## Test Instructions")
+
+	var issuedFor string
+	spawner := NewSpawner(Dependencies{
+		Store:        store,
+		SessionStore: sessStore,
+		TmuxManager:  tmuxMgr,
+		CortexdPath:  "/usr/bin/cortexd",
+		MCPConfigDir: tmpDir,
+		IssueHookToken: func(sessionID string) (string, error) {
+			issuedFor = sessionID
+			return "hook-" + sessionID, nil
+		},
+	})
+
+	result, err := spawner.Spawn(context.Background(), SpawnRequest{
+		AgentType:     AgentTypeTicketAgent,
+		Agent:         "claude",
+		TmuxSession:   "test-session",
+		ArchitectPath: tmpDir,
+		TicketsDir:    filepath.Join(tmpDir, "tickets"),
+		TicketID:      "ticket-1",
+		Ticket:        testTicket,
+	})
+	if err != nil || !result.Success {
+		t.Fatalf("spawn failed: err=%v result=%v", err, result)
+	}
+
+	sess, _ := sessStore.GetByTicketRepo("ticket-1", "")
+	if sess == nil || issuedFor != sess.SessionID {
+		t.Fatalf("hook token issued for %q, want the spawned session", issuedFor)
+	}
+
+	launcherPath := strings.TrimPrefix(tmuxMgr.lastCommand, "bash ")
+	data, err := os.ReadFile(launcherPath)
+	if err != nil {
+		t.Fatalf("failed to read launcher script: %v", err)
+	}
+	if want := "export CORTEX_HOOK_TOKEN='hook-" + issuedFor + "'"; !containsSubstr(string(data), want) {
+		t.Errorf("expected %q in launcher; script:\n%s", want, data)
+	}
+}
+
+func TestHookCommand(t *testing.T) {
+	tcp := hookCommand("claude", "http://127.0.0.1:4200/hook", "")
+	if !strings.Contains(tcp.Command, `new URL("http://127.0.0.1:4200/hook/claude")`) {
+		t.Errorf("TCP command does not post to the hook URL: %s", tcp.Command)
+	}
+	if !strings.Contains(tcp.Command, `agent:"claude"`) {
+		t.Errorf("claude envelope should name the agent: %s", tcp.Command)
+	}
+
+	socket := hookCommand("codex", "unix:///tmp/cortexd.sock/hook", "/tmp/cortexd.sock")
+	if !strings.Contains(socket.Command, `socketPath:"/tmp/cortexd.sock",path:"/hook/codex"`) {
+		t.Errorf("socket command does not post over the socket: %s", socket.Command)
+	}
+	if strings.Contains(socket.Command, `agent:"claude"`) {
+		t.Errorf("codex envelope should not name an agent: %s", socket.Command)
+	}
+
+	for _, cmd := range []string{tcp.Command, socket.Command} {
+		if !strings.Contains(cmd, "process.env.CORTEX_HOOK_TOKEN") {
+			t.Errorf("command does not read the session's hook token: %s", cmd)
+		}
+	}
+}
+
 func TestSpawn_CodexVariantCODEXHomeSeedsTempConfigButDoesNotOverride(t *testing.T) {
 	tmpDir := t.TempDir()
 	store := newMockStore()
@@ -1332,10 +1548,11 @@ func TestSpawn_TmuxSessionValidation(t *testing.T) {
 
 // --- Orchestrate tests ---
 
-// mockOrchestrateStore implements OrchestrateStore (Get + Move) for testing.
+// mockOrchestrateStore implements OrchestrateStore (Get + Move + OpenBlockers) for testing.
 type mockOrchestrateStore struct {
 	tickets   map[string]*ticket.Ticket
 	statuses  map[string]ticket.Status
+	blockers  map[string][]*ticket.Ticket
 	getErr    error
 	moveErr   error
 	moveCalls []struct {
@@ -1348,6 +1565,7 @@ func newMockOrchestrateStore() *mockOrchestrateStore {
 	return &mockOrchestrateStore{
 		tickets:  make(map[string]*ticket.Ticket),
 		statuses: make(map[string]ticket.Status),
+		blockers: make(map[string][]*ticket.Ticket),
 	}
 }
 
@@ -1378,6 +1596,18 @@ func (m *mockOrchestrateStore) Move(id string, to ticket.Status) error {
 	return nil
 }
 
+func (m *mockOrchestrateStore) OpenBlockers(id string) ([]*ticket.Ticket, error) {
+	return m.blockers[id], nil
+}
+
+func (m *mockOrchestrateStore) ReadReview(id string) (*ticket.Review, error) {
+	return &ticket.Review{}, nil
+}
+
+func (m *mockOrchestrateStore) ReadConclusion(id string) (*ticket.TicketConclusionMeta, string, error) {
+	return nil, "", os.ErrNotExist
+}
+
 // orchestrateTestSetup creates common test fixtures for Orchestrate tests.
 func orchestrateTestSetup(t *testing.T) (string, *mockOrchestrateStore, *mockSessionStore, *mockTmuxManager) {
 	t.Helper()
@@ -1455,6 +1685,54 @@ func TestOrchestrate_Normal_Active(t *testing.T) {
 	}
 }
 
+func TestOrchestrate_Headless(t *testing.T) {
+	tmpDir, store, sessStore, headless := orchestrateTestSetup(t)
+
+	req := OrchestrateRequest{
+		TicketID:      "ticket-1",
+		ArchitectPath: tmpDir,
+		TmuxSession:   "test-session",
+		Backend:       session.BackendHeadless,
+	}
+	if _, err := Orchestrate(context.Background(), req, OrchestrateDeps{
+		Store:        store,
+		SessionStore: sessStore,
+		CortexdPath:  "/usr/bin/cortexd",
+	}); !IsConfigError(err) {
+		t.Fatalf("expected ConfigError without a headless manager, got: %v", err)
+	}
+
+	// No tmux manager is needed for headless sessions.
+	deps := OrchestrateDeps{
+		Store:        store,
+		SessionStore: sessStore,
+		Headless:     headless,
+		CortexdPath:  "/usr/bin/cortexd",
+	}
+	result, err := Orchestrate(context.Background(), req, deps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Outcome != OutcomeSpawned || headless.spawnCalls != 1 {
+		t.Fatalf("expected headless spawn, got %s with %d spawn calls", result.Outcome, headless.spawnCalls)
+	}
+	sess, _ := sessStore.GetByTicketID("ticket-1")
+	if sess == nil || !sess.IsHeadless() {
+		t.Fatalf("expected headless session record, got %+v", sess)
+	}
+
+	// The existing session's backend decides liveness, whatever the request.
+	headless.windowExists = true
+	req.Backend = ""
+	result, err = Orchestrate(context.Background(), req, deps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Outcome != OutcomeAlreadyActive {
+		t.Errorf("expected OutcomeAlreadyActive, got: %s", result.Outcome)
+	}
+}
+
 func TestOrchestrate_Normal_Orphaned(t *testing.T) {
 	tmpDir, store, sessStore, tmuxMgr := orchestrateTestSetup(t)
 	tmuxMgr.windowExists = false
@@ -1747,6 +2025,59 @@ func TestOrchestrate_InvalidMode(t *testing.T) {
 	}
 }
 
+func TestOrchestrate_BlockedTicketRefused(t *testing.T) {
+	tmpDir, store, sessStore, tmuxMgr := orchestrateTestSetup(t)
+	store.blockers["ticket-1"] = []*ticket.Ticket{createTestTicket("blocker-1", "Blocker", "")}
+
+	_, err := Orchestrate(context.Background(), OrchestrateRequest{
+		TicketID:      "ticket-1",
+		Mode:          "normal",
+		ArchitectPath: tmpDir,
+		TmuxSession:   "test-session",
+	}, OrchestrateDeps{
+		Store:        store,
+		SessionStore: sessStore,
+		TmuxManager:  tmuxMgr,
+		CortexdPath:  "/usr/bin/cortexd",
+	})
+
+	if !IsBlockedError(err) {
+		t.Fatalf("expected BlockedError, got: %v", err)
+	}
+	blockedErr := err.(*BlockedError)
+	if len(blockedErr.Blockers) != 1 || blockedErr.Blockers[0] != "blocker-1" {
+		t.Errorf("expected blockers [blocker-1], got: %v", blockedErr.Blockers)
+	}
+	if len(store.moveCalls) != 0 {
+		t.Errorf("expected no Move calls for blocked ticket, got: %d", len(store.moveCalls))
+	}
+}
+
+func TestOrchestrate_BlockedTicketForced(t *testing.T) {
+	tmpDir, store, sessStore, tmuxMgr := orchestrateTestSetup(t)
+	store.blockers["ticket-1"] = []*ticket.Ticket{createTestTicket("blocker-1", "Blocker", "")}
+
+	result, err := Orchestrate(context.Background(), OrchestrateRequest{
+		TicketID:      "ticket-1",
+		Mode:          "normal",
+		ArchitectPath: tmpDir,
+		TmuxSession:   "test-session",
+		Force:         true,
+	}, OrchestrateDeps{
+		Store:        store,
+		SessionStore: sessStore,
+		TmuxManager:  tmuxMgr,
+		CortexdPath:  "/usr/bin/cortexd",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Outcome != OutcomeSpawned {
+		t.Errorf("expected OutcomeSpawned, got: %s", result.Outcome)
+	}
+}
+
 // extractExportedEnvVar extracts the value of a shell-exported env var from a launcher script.
 // The launcher writes lines like: export VAR='value'
 // This reverses the shellQuote escaping (backslash-quote sequences).
@@ -1779,3 +2110,207 @@ func extractExportedEnvVar(t *testing.T, script, varName string) string {
 	}
 	return sb.String()
 }
+
+func TestOrchestrate_MultiRepoFansOut(t *testing.T) {
+	tmpDir, store, sessStore, tmuxMgr := orchestrateTestSetup(t)
+	config := "name: test\nrepos:\n"
+	for _, repo := range []string{"api", "web"} {
+		dir := filepath.Join(t.TempDir(), repo)
+		if err := os.MkdirAll(filepath.Join(dir, ".git"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		config += "  " + repo + ":\n    path: " + dir + "\n"
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tk := store.tickets["ticket-1"]
+	tk.Repo, tk.Repos = "api", []string{"api", "web"}
+
+	req := OrchestrateRequest{TicketID: "ticket-1", ArchitectPath: tmpDir, TmuxSession: "test-session"}
+	deps := OrchestrateDeps{Store: store, SessionStore: sessStore, TmuxManager: tmuxMgr, CortexdPath: "/usr/bin/cortexd"}
+
+	result, err := Orchestrate(context.Background(), req, deps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Outcome != OutcomeSpawned || tmuxMgr.spawnCalls != 2 || len(result.Repos) != 2 {
+		t.Fatalf("outcome = %s, spawn calls = %d, repos = %d", result.Outcome, tmuxMgr.spawnCalls, len(result.Repos))
+	}
+	for _, repo := range []string{"api", "web"} {
+		sess, _ := sessStore.GetByTicketRepo("ticket-1", repo)
+		if sess == nil || sess.TmuxWindow != "test-ticket-"+repo {
+			t.Errorf("session for %s = %+v", repo, sess)
+		}
+	}
+	if len(store.moveCalls) != 1 || store.moveCalls[0].Status != ticket.StatusProgress {
+		t.Errorf("expected one move to progress, got %+v", store.moveCalls)
+	}
+
+	// With both windows open the ticket is active; a lost window orphans
+	// only its own repo, which fresh mode restarts alone.
+	tmuxMgr.windowExists = true
+	if result, err = Orchestrate(context.Background(), req, deps); err != nil || result.Outcome != OutcomeAlreadyActive {
+		t.Fatalf("second spawn = %v, %v; want already active", result, err)
+	}
+
+	tmuxMgr.windowExists = false
+	if _, err := Orchestrate(context.Background(), req, deps); !IsStateError(err) {
+		t.Fatalf("normal mode on orphaned repos: expected StateError, got %v", err)
+	}
+	req.Mode, req.Repo = "fresh", "web"
+	result, err = Orchestrate(context.Background(), req, deps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tmuxMgr.spawnCalls != 3 || len(result.Repos) != 1 || result.Repos[0].Repo != "web" {
+		t.Errorf("fresh web: spawn calls = %d, repos = %+v", tmuxMgr.spawnCalls, result.Repos)
+	}
+
+	req.Repo = "cli"
+	if _, err := Orchestrate(context.Background(), req, deps); !IsConfigError(err) {
+		t.Errorf("unknown repo: expected ConfigError, got %v", err)
+	}
+}
+
+func TestOrchestrate_MultiRepoRollsBackOnFailure(t *testing.T) {
+	tmpDir, store, sessStore, tmuxMgr := orchestrateTestSetup(t)
+	config := "name: test\nrepos:\n"
+	for _, repo := range []string{"api", "web"} {
+		dir := filepath.Join(t.TempDir(), repo)
+		if err := os.MkdirAll(filepath.Join(dir, ".git"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		config += "  " + repo + ":\n    path: " + dir + "\n"
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tk := store.tickets["ticket-1"]
+	tk.Repo, tk.Repos = "api", []string{"api", "web"}
+	tmuxMgr.spawnErr, tmuxMgr.spawnErrAt = errors.New("tmux: no space for new pane"), 2
+
+	req := OrchestrateRequest{TicketID: "ticket-1", ArchitectPath: tmpDir, TmuxSession: "test-session"}
+	deps := OrchestrateDeps{Store: store, SessionStore: sessStore, TmuxManager: tmuxMgr, CortexdPath: "/usr/bin/cortexd"}
+
+	if _, err := Orchestrate(context.Background(), req, deps); err == nil {
+		t.Fatal("expected the second repo's spawn to fail")
+	}
+	if !slices.Equal(tmuxMgr.killed, []string{"test-ticket-api"}) {
+		t.Errorf("killed windows = %v, want the api window", tmuxMgr.killed)
+	}
+	for _, repo := range []string{"api", "web"} {
+		if sess, _ := sessStore.GetByTicketRepo("ticket-1", repo); sess != nil {
+			t.Errorf("session for %s left behind: %+v", repo, sess)
+		}
+	}
+	if len(store.moveCalls) != 0 {
+		t.Errorf("ticket should stay in backlog, got moves %+v", store.moveCalls)
+	}
+}
+
+func TestFormatReviewComments(t *testing.T) {
+	comments := []ticket.ReviewComment{
+		{Repo: "api", Commit: "0123456789abcdef", File: "main.go", Line: 12, Body: "handle the error"},
+		{Repo: "web", File: "app.ts", Body: "drop this file"},
+		{Repo: "api", File: "README.md", Body: "first line\nsecond line"},
+	}
+
+	got := formatReviewComments(comments, &ticket.Ticket{TicketMeta: ticket.TicketMeta{Repo: "api"}})
+	want := "- `main.go:12` (commit 01234567): handle the error\n" +
+		"- `app.ts`: drop this file\n" +
+		"- `README.md`: first line\n  second line"
+	if got != want {
+		t.Errorf("single-repo:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	got = formatReviewComments(comments, &ticket.Ticket{TicketMeta: ticket.TicketMeta{Repo: "web", Repos: []string{"api", "web"}}})
+	if got != "- `app.ts`: drop this file" {
+		t.Errorf("multi-repo session should only see its repo's comments, got %q", got)
+	}
+}
+
+func TestSpawnReviewer(t *testing.T) {
+	tmpDir := t.TempDir()
+	repoDir := t.TempDir()
+	sessStore := newMockSessionStore()
+	tmuxMgr := newMockTmuxManager()
+
+	// A worker kickoff must not be picked up for the reviewer.
+	createTestPromptFile(t, tmpDir, "work/KICKOFF.md", "WORK {{.TicketTitle}}")
+	createTestPromptFile(t, tmpDir, "review/KICKOFF.md", "REVIEW {{.TicketTitle}} round {{.Round}}\n{{.Conclusion}}\n{{.Diffs}}")
+
+	spawner := NewSpawner(Dependencies{
+		SessionStore: sessStore,
+		TmuxManager:  tmuxMgr,
+		CortexdPath:  "/usr/bin/cortexd",
+		MCPConfigDir: tmpDir,
+	})
+
+	testTicket := createTestTicket("ticket-1", "Fix Login", "body")
+	result, err := spawner.SpawnReviewer(context.Background(), ReviewerSpawnRequest{
+		Ticket:        testTicket,
+		Repo:          repoDir,
+		Round:         2,
+		Conclusion:    "fixed the redirect",
+		Diffs:         "+return nil",
+		ArchitectPath: tmpDir,
+		TmuxSession:   "test-session",
+		Agent:         "claude",
+		Variant:       "deep",
+	})
+	if err != nil {
+		t.Fatalf("SpawnReviewer: %v", err)
+	}
+	if result.TmuxWindow != "review-fix-login" || tmuxMgr.lastWorkingDir != repoDir {
+		t.Errorf("window %q in %q, want review-fix-login in %q", result.TmuxWindow, tmuxMgr.lastWorkingDir, repoDir)
+	}
+
+	var reviewer *session.Session
+	for _, sess := range sessStore.sessions {
+		reviewer = sess
+	}
+	if reviewer == nil || reviewer.Type != session.SessionTypeReviewer || reviewer.TicketID != "ticket-1" || reviewer.Variant != "deep" {
+		t.Fatalf("expected one reviewer session, got %+v", sessStore.sessions)
+	}
+
+	data, err := os.ReadFile(strings.TrimPrefix(tmuxMgr.lastCommand, "bash "))
+	if err != nil {
+		t.Fatalf("read launcher: %v", err)
+	}
+	script := string(data)
+	if containsSubstr(script, "export CORTEX_TICKET_ID=") || !containsSubstr(script, "export CORTEX_REVIEW_TICKET_ID=") {
+		t.Error("expected the launcher to export CORTEX_REVIEW_TICKET_ID and not CORTEX_TICKET_ID")
+	}
+
+	pInfo, err := spawner.buildPrompt(SpawnRequest{
+		AgentType:     AgentTypeReviewerAgent,
+		ArchitectPath: tmpDir,
+		TicketID:      "ticket-1",
+		Ticket:        testTicket,
+		ReviewRound:   2,
+		Conclusion:    "fixed the redirect",
+		Diffs:         "+return nil",
+	}, repoDir)
+	if err != nil {
+		t.Fatalf("buildPrompt: %v", err)
+	}
+	if want := "REVIEW Fix Login round 2\nfixed the redirect\n+return nil"; pInfo.PromptText != want {
+		t.Errorf("reviewer prompt = %q, want %q", pInfo.PromptText, want)
+	}
+}
+
+func TestBuildMCPServerConfig_Reviewer(t *testing.T) {
+	config := BuildMCPServerConfig(MCPConfigParams{
+		CortexdPath:    "/usr/bin/cortexd",
+		ArchitectPath:  "/path/to/project",
+		ReviewTicketID: "ticket-123",
+	})
+
+	if len(config.Args) != 1 || config.Args[0] != "mcp" {
+		t.Errorf("expected only the mcp arg for a reviewer, got: %v", config.Args)
+	}
+	if config.Env["CORTEX_REVIEW_TICKET_ID"] != "ticket-123" {
+		t.Errorf("expected CORTEX_REVIEW_TICKET_ID, got: %v", config.Env)
+	}
+}
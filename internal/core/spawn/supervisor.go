@@ -57,7 +57,8 @@ func startAgentSupervisor(ctx context.Context, p agentSupervisorParams) (context
 		ArchitectPath:  p.ArchitectPath,
 		LivenessPath:   p.LivenessPath,
 		HubEventSource: hubEventSource,
-		DaemonURL:      daemonconfig.DefaultDaemonURL,
+		DaemonURL:      daemonconfig.ClientDaemonURL(),
+		Token:          daemonconfig.ClientToken(),
 		Logger:         p.Logger,
 	})
 }
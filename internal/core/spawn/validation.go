@@ -9,6 +9,7 @@ import (
 
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/storage"
+	"github.com/kareemaly/cortex/internal/worktree"
 )
 
 var tmuxNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
@@ -48,7 +49,10 @@ func validateGitRepository(repoPath string) error {
 	return nil
 }
 
-func getWorkingDirectory(req SpawnRequest) (string, error) {
+// getWorkingDirectory resolves where the agent starts. For ticket agents on a
+// repo with isolation: worktree, the ticket's worktree is created if needed and
+// also returned as worktreePath.
+func getWorkingDirectory(req SpawnRequest) (workingDir, worktreePath string, err error) {
 	if req.AgentType == AgentTypeCollabAgent {
 		if req.Repo != "" {
 			path := req.Repo
@@ -58,40 +62,61 @@ func getWorkingDirectory(req SpawnRequest) (string, error) {
 				}
 			}
 			if _, err := os.Stat(path); os.IsNotExist(err) {
-				return "", &ConfigError{
+				return "", "", &ConfigError{
 					Field:   "Path",
 					Message: fmt.Sprintf("collab path directory does not exist: %s", path),
 				}
 			}
-			return path, nil
+			return path, "", nil
 		}
-		return req.ArchitectPath, nil
+		return req.ArchitectPath, "", nil
+	}
+
+	if req.AgentType == AgentTypeReviewerAgent {
+		if req.Repo != "" {
+			if _, err := os.Stat(req.Repo); err != nil {
+				return "", "", &ConfigError{
+					Field:   "Repo",
+					Message: fmt.Sprintf("review directory does not exist: %s", req.Repo),
+				}
+			}
+			return req.Repo, "", nil
+		}
+		return req.ArchitectPath, "", nil
 	}
 
 	if req.AgentType != AgentTypeTicketAgent {
-		return req.ArchitectPath, nil
+		return req.ArchitectPath, "", nil
 	}
 
 	if req.Ticket == nil {
-		return req.ArchitectPath, nil
+		return req.ArchitectPath, "", nil
 	}
 
 	if req.Ticket.Repo != "" {
 		cfg, err := architectconfig.Load(req.ArchitectPath)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		repoPath, err := cfg.ResolveRepoPath(req.Ticket.Repo)
 		if err != nil {
-			return "", &ConfigError{Field: "Repo", Message: err.Error()}
+			return "", "", &ConfigError{Field: "Repo", Message: err.Error()}
 		}
 		if err := validateGitRepository(repoPath); err != nil {
-			return "", err
+			return "", "", err
+		}
+		if cfg.RepoIsolation(req.Ticket.Repo) != architectconfig.IsolationWorktree {
+			return repoPath, "", nil
 		}
-		return repoPath, nil
+
+		path := worktree.Path(cfg.WorktreesPath(req.ArchitectPath), req.Ticket.Repo, req.Ticket.ID)
+		if err := worktree.Ensure(repoPath, path, worktree.Branch(req.Ticket.ID)); err != nil {
+			return "", "", &WorktreeError{Path: path, Cause: err}
+		}
+		return path, path, nil
 	}
 
-	return req.ArchitectPath, nil
+	return req.ArchitectPath, "", nil
 }
 
 func (s *Spawner) validateSpawnRequest(req SpawnRequest) error {
@@ -124,6 +149,12 @@ func (s *Spawner) validateSpawnRequest(req SpawnRequest) error {
 		}
 	}
 
+	if req.AgentType == AgentTypeReviewerAgent {
+		if req.TicketID == "" || req.Ticket == nil {
+			return &ConfigError{Field: "TicketID", Message: "cannot be empty for reviewer agent"}
+		}
+	}
+
 	if req.AgentType == AgentTypeCollabAgent {
 		if req.CollabID == "" {
 			return &ConfigError{Field: "CollabID", Message: "cannot be empty for collab agent"}
@@ -135,8 +166,14 @@ func (s *Spawner) validateSpawnRequest(req SpawnRequest) error {
 
 func (s *Spawner) generateWindowName(req SpawnRequest) string {
 	if req.AgentType == AgentTypeTicketAgent && req.Ticket != nil {
+		if repo := req.sessionRepo(); repo != "" {
+			return GenerateWindowName(req.Ticket.Title + " " + repo)
+		}
 		return GenerateWindowName(req.Ticket.Title)
 	}
+	if req.AgentType == AgentTypeReviewerAgent && req.Ticket != nil {
+		return GenerateWindowName("review " + req.Ticket.Title)
+	}
 	if req.AgentType == AgentTypeCollabAgent && req.CollabID != "" {
 		return "collab-" + storage.ShortID(req.CollabID)
 	}
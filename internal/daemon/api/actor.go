@@ -0,0 +1,50 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// ActorHeader identifies who is making a request, for the ticket history.
+// Values are "architect", "cli", "tui", "worker:<ticket-id>",
+// "collab:<collab-id>" or "reviewer:<ticket-id>".
+const ActorHeader = "X-Cortex-Actor"
+
+// requestActor resolves the request's actor header, attaching the session ID
+// of the architect, worker, collab or reviewer session when one is running.
+// Requests without the header are attributed to the daemon.
+func (d *Dependencies) requestActor(r *http.Request) ticket.Actor {
+	kind, ref, _ := strings.Cut(r.Header.Get(ActorHeader), ":")
+	switch kind {
+	case ticket.ActorArchitect, ticket.ActorWorker, ticket.ActorCollab, ticket.ActorReviewer, ticket.ActorCLI, ticket.ActorTUI:
+	default:
+		return ticket.DaemonActor
+	}
+
+	actor := ticket.Actor{Kind: kind}
+	if d.SessionManager == nil {
+		return actor
+	}
+	store := d.SessionManager.GetStore(GetArchitectPath(r.Context()))
+	switch kind {
+	case ticket.ActorArchitect:
+		if sess, err := store.GetArchitect(); err == nil && sess != nil {
+			actor.SessionID = sess.SessionID
+		}
+	case ticket.ActorWorker:
+		if sess, err := store.GetByTicketID(ref); ref != "" && err == nil && sess != nil {
+			actor.SessionID = sess.SessionID
+		}
+	case ticket.ActorCollab:
+		if sess, err := store.GetByCollabID(ref); ref != "" && err == nil && sess != nil {
+			actor.SessionID = sess.SessionID
+		}
+	case ticket.ActorReviewer:
+		if sess, err := store.GetReviewer(ref); ref != "" && err == nil && sess != nil {
+			actor.SessionID = sess.SessionID
+		}
+	}
+	return actor
+}
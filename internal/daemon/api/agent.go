@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/kareemaly/cortex/internal/daemon/auth"
 	"github.com/kareemaly/cortex/internal/events"
 	"github.com/kareemaly/cortex/internal/session"
 )
@@ -78,6 +79,10 @@ func (h *AgentHandlers) UpdateStatus(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusNotFound, "no_active_session", "no session matches the given session_id")
 		return
 	}
+	if p := GetPrincipal(r.Context()); p != nil && p.Role == auth.RoleWorker && (sess.Type != session.SessionTypeTicket || sess.TicketID != p.TicketID) {
+		writeError(w, http.StatusForbidden, "forbidden", "worker tokens may only report the status of their own ticket's sessions")
+		return
+	}
 
 	if err := sessStore.UpdateStatusBySessionID(sess.SessionID, agentStatus, req.Tool, req.Work); err != nil {
 		writeError(w, http.StatusInternalServerError, "update_error", err.Error())
@@ -208,6 +208,8 @@ func (h *ArchitectHandlers) spawnArchitectSession(w http.ResponseWriter, r *http
 		DefaultsDir:    h.deps.DefaultsDir,
 		HubEventSource: hubEventSource(h.deps.ReceiverManager),
 		DaemonEndpoint: h.deps.DaemonEndpoint,
+		IssueToken:     h.deps.tokenIssuer(),
+		IssueHookToken: h.deps.hookTokenIssuer(),
 	})
 
 	var result *spawn.SpawnResult
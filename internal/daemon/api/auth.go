@@ -0,0 +1,293 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/kareemaly/cortex/internal/core/spawn"
+	"github.com/kareemaly/cortex/internal/daemon/auth"
+)
+
+const principalKey contextKey = "principal"
+
+// Authenticate returns middleware that requires a valid bearer token on
+// every route except /health, and stores the caller's principal in the
+// request context. A nil authenticator disables authentication.
+//
+// Basic auth is accepted too, with the token as the password, for hook
+// endpoints that can only carry credentials in the URL.
+func Authenticate(a *auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if a == nil || r.URL.Path == "/health" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := bearerToken(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="cortex"`)
+				writeError(w, http.StatusUnauthorized, "unauthorized", "bearer token required")
+				return
+			}
+			p, err := a.Authenticate(token)
+			if err != nil {
+				msg := "invalid token"
+				if errors.Is(err, auth.ErrExpiredToken) {
+					msg = "token expired"
+				}
+				w.Header().Set("WWW-Authenticate", `Bearer realm="cortex", error="invalid_token"`)
+				writeError(w, http.StatusUnauthorized, "unauthorized", msg)
+				return
+			}
+
+			// Hook tokens sit in every agent's environment; keep them off
+			// everything else.
+			if p.Role == auth.RoleHook && !strings.HasPrefix(r.URL.Path, "/hook/") {
+				writeError(w, http.StatusForbidden, "forbidden", "hook tokens may only post hook events")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey, p)))
+		})
+	}
+}
+
+// GetPrincipal returns the authenticated caller, or nil when
+// authentication is disabled.
+func GetPrincipal(ctx context.Context) *auth.Principal {
+	if p, ok := ctx.Value(principalKey).(*auth.Principal); ok {
+		return p
+	}
+	return nil
+}
+
+// GlobalAccess returns middleware for routes outside any architect. Only
+// unscoped tokens may use them, and read-only tokens may only read.
+func GlobalAccess() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p := GetPrincipal(r.Context())
+			if p != nil {
+				if !p.Unscoped() || p.Role == auth.RoleWorker || p.Role == auth.RoleCollab || p.Role == auth.RoleReviewer {
+					writeError(w, http.StatusForbidden, "forbidden", "token is limited to one architect")
+					return
+				}
+				if p.Role == auth.RoleReadOnly && !isReadMethod(r.Method) {
+					writeError(w, http.StatusForbidden, "forbidden", "token is read-only")
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireRole returns middleware that admits only the given roles.
+func RequireRole(roles ...auth.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p := GetPrincipal(r.Context()); p != nil {
+				allowed := false
+				for _, role := range roles {
+					allowed = allowed || p.Role == role
+				}
+				if !allowed {
+					writeError(w, http.StatusForbidden, "forbidden", "token role "+string(p.Role)+" may not use this route")
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// authorizeArchitect checks the caller may make request r against the
+// architect at architectPath. Returns a message for a 403, or "".
+func authorizeArchitect(p *auth.Principal, r *http.Request, architectPath string) string {
+	if p == nil {
+		return ""
+	}
+	if !p.Unscoped() && filepath.Clean(p.Architect) != architectPath {
+		return "token is not valid for this architect"
+	}
+	if isReadMethod(r.Method) {
+		return ""
+	}
+
+	switch p.Role {
+	case auth.RoleArchitect:
+		return ""
+	case auth.RoleReadOnly:
+		return "token is read-only"
+	case auth.RoleWorker:
+		// Workers may file follow-up tickets and report their status, but
+		// their own ticket only moves through Conclude, so that review and
+		// criteria enforcement cannot be skipped.
+		if isTicketCreate(r) || matchesRoute(r, agentStatusRoute) {
+			return ""
+		}
+		if matchesRoute(r, workerTicketRoutes...) {
+			if id, _ := routeParam(r, "/tickets/", "id"); id == p.TicketID {
+				return ""
+			}
+		}
+		return "worker tokens may only create tickets, report agent status, and conclude, check criteria of or edit the body of their own ticket"
+	case auth.RoleCollab:
+		// Collabs shape the backlog with the architect, so they may create
+		// tickets and edit their fields, but not move, spawn or delete them.
+		if isTicketCreate(r) || isTicketFieldEdit(r) {
+			return ""
+		}
+		if id, ok := routeParam(r, "/collab/", "id"); ok && id == p.CollabID {
+			return ""
+		}
+		return "collab tokens may only create and edit tickets and conclude their own collab"
+	case auth.RoleReviewer:
+		if id, ok := routeParam(r, "/tickets/", "id"); ok && id == p.TicketID && r.URL.Path == "/tickets/"+id+"/review/submit" {
+			return ""
+		}
+		return "reviewer tokens may only submit the review of their own ticket"
+	}
+	return "token role " + string(p.Role) + " may not use this route"
+}
+
+// workerTicketRoutes are the routes a worker may use on its own ticket.
+var workerTicketRoutes = []string{
+	http.MethodPost + " /tickets/{id}/conclude",
+	http.MethodPost + " /tickets/{id}/criteria/{index}",
+	http.MethodPatch + " /tickets/{id}/body",
+}
+
+// agentStatusRoute reports a session's agent status. The handler checks a
+// worker only reports its own sessions.
+const agentStatusRoute = http.MethodPost + " /agent/status"
+
+// ticketFieldEditRoutes are the routes that change a ticket's fields.
+var ticketFieldEditRoutes = []string{
+	http.MethodPut + " /tickets/{status}/{id}",
+	http.MethodPatch + " /tickets/{id}/body",
+	http.MethodPatch + " /tickets/{id}/due-date",
+	http.MethodDelete + " /tickets/{id}/due-date",
+}
+
+// isTicketCreate reports whether r creates a ticket.
+func isTicketCreate(r *http.Request) bool {
+	tctx, ok := matchRoute(r)
+	return ok && r.Method == http.MethodPost && tctx.RoutePattern() == "/tickets"
+}
+
+// isTicketFieldEdit reports whether r changes a ticket's fields.
+func isTicketFieldEdit(r *http.Request) bool {
+	return matchesRoute(r, ticketFieldEditRoutes...)
+}
+
+// matchesRoute reports whether r's method and route pattern are one of
+// routes, each written as "METHOD /pattern".
+func matchesRoute(r *http.Request, routes ...string) bool {
+	tctx, ok := matchRoute(r)
+	return ok && slices.Contains(routes, r.Method+" "+tctx.RoutePattern())
+}
+
+// routeParam resolves r against the router and returns URL parameter name
+// when the matched route pattern starts with prefix.
+func routeParam(r *http.Request, prefix, name string) (string, bool) {
+	tctx, ok := matchRoute(r)
+	if !ok || !strings.HasPrefix(tctx.RoutePattern(), prefix) {
+		return "", false
+	}
+	v := tctx.URLParam(name)
+	return v, v != ""
+}
+
+// matchRoute resolves r against the router. Middleware runs before
+// sub-routers fill in their parameters, hence the separate match.
+func matchRoute(r *http.Request) (*chi.Context, bool) {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return nil, false
+	}
+	tctx := chi.NewRouteContext()
+	if !rctx.Routes.Match(tctx, r.Method, r.URL.Path) {
+		return nil, false
+	}
+	return tctx, true
+}
+
+// tokenIssuer returns the function the spawner uses to mint a session's
+// API token, or nil when authentication is disabled. Architect sessions get
+// an architect token for their architect, workers and reviewers one for
+// their ticket and collabs one for their collab.
+func (d *Dependencies) tokenIssuer() func(spawn.AgentType, string, string) (string, error) {
+	if d.Auth == nil {
+		return nil
+	}
+	return func(agentType spawn.AgentType, architectPath, id string) (string, error) {
+		p := auth.Principal{Architect: filepath.Clean(architectPath)}
+		switch agentType {
+		case spawn.AgentTypeArchitect:
+			p.Role = auth.RoleArchitect
+		case spawn.AgentTypeTicketAgent:
+			p.Role = auth.RoleWorker
+			p.TicketID = id
+		case spawn.AgentTypeCollabAgent:
+			p.Role = auth.RoleCollab
+			p.CollabID = id
+		case spawn.AgentTypeReviewerAgent:
+			p.Role = auth.RoleReviewer
+			p.TicketID = id
+		default:
+			return "", fmt.Errorf("no token role for agent type %q", agentType)
+		}
+		return d.Auth.IssueSessionToken(p)
+	}
+}
+
+// hookTokenIssuer returns the function the spawner uses to mint an agent
+// session's hook token, or nil when authentication is off. Hook events
+// arrive without an architect, so the token is scoped to the session alone.
+func (d *Dependencies) hookTokenIssuer() func(string) (string, error) {
+	if d.Auth == nil {
+		return nil
+	}
+	return func(sessionID string) (string, error) {
+		return d.Auth.IssueSessionToken(auth.Principal{Role: auth.RoleHook, SessionID: sessionID})
+	}
+}
+
+// sessionActor is the actor header a session token is pinned to,
+// so a session cannot write history as someone else.
+func sessionActor(p *auth.Principal) string {
+	switch {
+	case p == nil:
+		return ""
+	case p.Role == auth.RoleWorker && p.TicketID != "":
+		return "worker:" + p.TicketID
+	case p.Role == auth.RoleCollab && p.CollabID != "":
+		return "collab:" + p.CollabID
+	case p.Role == auth.RoleReviewer && p.TicketID != "":
+		return "reviewer:" + p.TicketID
+	}
+	return ""
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	if _, password, ok := r.BasicAuth(); ok && password != "" {
+		return password, true
+	}
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
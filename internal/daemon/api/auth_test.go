@@ -0,0 +1,268 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kareemaly/cortex/internal/daemon/auth"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// setupAuthServer starts a unit server that requires tokens.
+func setupAuthServer(t *testing.T) (*unitServer, *httptest.Server) {
+	t.Helper()
+	ts := setupUnitServer(t)
+	t.Cleanup(ts.Close)
+
+	a, err := auth.New(daemonconfig.AuthConfig{
+		Enabled: true,
+		Secret:  "test-secret",
+		Tokens: []daemonconfig.APIToken{
+			{Name: "local", Token: "tok-local", Role: "architect"},
+			{Name: "ci", Token: "tok-ci", Role: "read-only", Architect: ts.projectRoot},
+			{Name: "other", Token: "tok-other", Role: "architect", Architect: "/elsewhere"},
+			{Name: "hooks", Token: "tok-hooks", Role: "hook"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts.deps.Auth = a
+	srv := httptest.NewServer(NewRouter(ts.deps, ts.deps.Logger))
+	t.Cleanup(srv.Close)
+	return ts, srv
+}
+
+func authRequest(t *testing.T, srv *httptest.Server, method, path, architect, token, body string) int {
+	t.Helper()
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if architect != "" {
+		req.Header.Set(ArchitectHeader, architect)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestAuth_TokensAndScopes(t *testing.T) {
+	ts, srv := setupAuthServer(t)
+	root := ts.projectRoot
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
+
+	tests := []struct {
+		name                string
+		method, path, token string
+		architect           string
+		want                int
+	}{
+		{"health is open", http.MethodGet, "/health", "", "", http.StatusOK},
+		{"missing token", http.MethodGet, "/tickets", "", root, http.StatusUnauthorized},
+		{"unknown token", http.MethodGet, "/tickets", "bogus", root, http.StatusUnauthorized},
+		{"local token", http.MethodGet, "/tickets", "tok-local", root, http.StatusOK},
+		{"read-only reads", http.MethodGet, "/tickets", "tok-ci", root, http.StatusOK},
+		{"read-only cannot write", http.MethodPost, "/tickets", "tok-ci", root, http.StatusForbidden},
+		{"read-only cannot read settings", http.MethodGet, "/config/global", "tok-ci", "", http.StatusForbidden},
+		{"scoped token, other architect", http.MethodGet, "/tickets", "tok-other", root, http.StatusForbidden},
+		{"scoped token, global route", http.MethodGet, "/architects", "tok-other", "", http.StatusForbidden},
+		{"hook token off hook routes", http.MethodGet, "/tickets", "tok-hooks", root, http.StatusForbidden},
+		// The unit server has no receiver, so an admitted hook gets a 503.
+		{"hook token on hook route", http.MethodPost, "/hook/claude", "tok-hooks", "", http.StatusServiceUnavailable},
+		{"local token writes", http.MethodPost, "/tickets/" + created.ID + "/restore", "tok-local", root, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authRequest(t, srv, tt.method, tt.path, tt.architect, tt.token, "{}"); got != tt.want {
+				t.Errorf("%s %s = %d, want %d", tt.method, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuth_WorkerTokenLimitedToOwnTicket(t *testing.T) {
+	ts, srv := setupAuthServer(t)
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": ts.projectRoot})
+	own, _ := ts.store.Create("Own", "body\n\n## Acceptance\n\n- [ ] works\n", nil, nil, "", nil, nil, "")
+	other, _ := ts.store.Create("Other", "body", nil, nil, "", nil, nil, "")
+	sessStore := ts.deps.SessionManager.GetStore(ts.projectRoot)
+	ownSess, err := sessStore.Create(own.ID, "claude", "", "", "win-own", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSess, err := sessStore.Create(other.ID, "claude", "", "", "win-other", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := ts.deps.Auth.IssueSessionToken(auth.Principal{Role: auth.RoleWorker, Architect: ts.projectRoot, TicketID: own.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		method, path string
+		body         string
+		want         int
+	}{
+		{"read other ticket", http.MethodGet, "/tickets/by-id/" + other.ID, "", http.StatusOK},
+		{"create ticket", http.MethodPost, "/tickets/", `{"title":"Follow-up","repo":"api"}`, http.StatusCreated},
+		{"follow-up of other ticket", http.MethodPost, "/tickets", `{"title":"Stray","repo":"api","follow_up_of":"` + other.ID + `"}`, http.StatusForbidden},
+		{"edit own body", http.MethodPatch, "/tickets/" + own.ID + "/body", `{"oldString":"body","newString":"edited"}`, http.StatusOK},
+		{"check own criterion", http.MethodPost, "/tickets/" + own.ID + "/criteria/1", `{"checked":true,"evidence":"tested"}`, http.StatusOK},
+		{"own status", http.MethodPost, "/agent/status", `{"session_id":"` + ownSess.SessionID + `","status":"working"}`, http.StatusOK},
+		{"other session status", http.MethodPost, "/agent/status", `{"session_id":"` + otherSess.SessionID + `","status":"working"}`, http.StatusForbidden},
+		{"edit other body", http.MethodPatch, "/tickets/" + other.ID + "/body", `{"oldString":"body","newString":"edited"}`, http.StatusForbidden},
+		{"update own ticket", http.MethodPut, "/tickets/backlog/" + own.ID, `{"title":"x"}`, http.StatusForbidden},
+		{"set own due date", http.MethodPatch, "/tickets/" + own.ID + "/due-date", `{"due_date":"2026-06-01T00:00:00Z"}`, http.StatusForbidden},
+		{"move own ticket", http.MethodPost, "/tickets/backlog/" + own.ID + "/move", `{"to":"done"}`, http.StatusForbidden},
+		{"spawn own ticket", http.MethodPost, "/tickets/backlog/" + own.ID + "/spawn", "{}", http.StatusForbidden},
+		{"delete own ticket", http.MethodDelete, "/tickets/backlog/" + own.ID, "", http.StatusForbidden},
+		{"conclude other ticket", http.MethodPost, "/tickets/" + other.ID + "/conclude", `{"content":"done","rejected":true,"rejection_reason":"n/a"}`, http.StatusForbidden},
+		{"conclude own ticket", http.MethodPost, "/tickets/" + own.ID + "/conclude", `{"content":"done","rejected":true,"rejection_reason":"n/a"}`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authRequest(t, srv, tt.method, tt.path, ts.projectRoot, token, tt.body); got != tt.want {
+				t.Errorf("%s %s = %d, want %d", tt.method, tt.path, got, tt.want)
+			}
+		})
+	}
+	if _, status, _ := ts.store.Get(own.ID); status != ticket.StatusDone {
+		t.Errorf("own ticket status = %q, want done", status)
+	}
+}
+
+func TestAuth_WorkerFollowUpLinksOwnTicket(t *testing.T) {
+	ts, srv := setupAuthServer(t)
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": ts.projectRoot})
+	own, _ := ts.store.Create("Own", "body", nil, nil, "", nil, nil, "")
+
+	token, err := ts.deps.Auth.IssueSessionToken(auth.Principal{Role: auth.RoleWorker, Architect: ts.projectRoot, TicketID: own.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := `{"title":"Follow-up","repo":"api","follow_up_of":"` + own.ID + `"}`
+	if got := authRequest(t, srv, http.MethodPost, "/tickets", ts.projectRoot, token, body); got != http.StatusCreated {
+		t.Fatalf("create follow-up = %d, want 201", got)
+	}
+
+	origin, _, err := ts.store.Get(own.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(origin.References) != 1 {
+		t.Fatalf("origin references = %v, want the follow-up", origin.References)
+	}
+	followUp, _, err := ts.store.Get(origin.References[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(followUp.References) != 1 || followUp.References[0] != own.ID {
+		t.Errorf("follow-up references = %v, want [%s]", followUp.References, own.ID)
+	}
+}
+
+func TestAuth_CollabTokenCreatesAndEditsTickets(t *testing.T) {
+	ts, srv := setupAuthServer(t)
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": ts.projectRoot})
+	existing, _ := ts.store.Create("Existing", "body", nil, nil, "", nil, nil, "")
+
+	token, err := ts.deps.Auth.IssueSessionToken(auth.Principal{Role: auth.RoleCollab, Architect: ts.projectRoot, CollabID: "collab-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		method, path string
+		body         string
+		want         int
+	}{
+		{"create ticket", http.MethodPost, "/tickets", `{"title":"New","repo":"api"}`, http.StatusCreated},
+		{"update ticket", http.MethodPut, "/tickets/backlog/" + existing.ID, `{"body":"rewritten"}`, http.StatusOK},
+		{"edit body", http.MethodPatch, "/tickets/" + existing.ID + "/body", `{"oldString":"rewritten","newString":"edited"}`, http.StatusOK},
+		{"set due date", http.MethodPatch, "/tickets/" + existing.ID + "/due-date", `{"due_date":"2026-06-01T00:00:00Z"}`, http.StatusOK},
+		{"clear due date", http.MethodDelete, "/tickets/" + existing.ID + "/due-date", "", http.StatusOK},
+		{"move ticket", http.MethodPost, "/tickets/backlog/" + existing.ID + "/move", `{"to":"progress"}`, http.StatusForbidden},
+		{"spawn ticket", http.MethodPost, "/tickets/backlog/" + existing.ID + "/spawn", "{}", http.StatusForbidden},
+		{"delete ticket", http.MethodDelete, "/tickets/backlog/" + existing.ID, "", http.StatusForbidden},
+		{"conclude other collab", http.MethodPost, "/collab/collab-2/conclude", `{"content":"done"}`, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authRequest(t, srv, tt.method, tt.path, ts.projectRoot, token, tt.body); got != tt.want {
+				t.Errorf("%s %s = %d, want %d", tt.method, tt.path, got, tt.want)
+			}
+		})
+	}
+
+	// Admitted; the unit server has no collab session to conclude.
+	if got := authRequest(t, srv, http.MethodPost, "/collab/collab-1/conclude", ts.projectRoot, token, `{"content":"done"}`); got == http.StatusForbidden {
+		t.Errorf("conclude own collab = %d, want it admitted", got)
+	}
+}
+
+func TestAuth_ReviewerTokenLimitedToSubmit(t *testing.T) {
+	ts, srv := setupAuthServer(t)
+	own, _ := ts.store.Create("Own", "body", nil, nil, "", nil, nil, "")
+	other, _ := ts.store.Create("Other", "body", nil, nil, "", nil, nil, "")
+
+	token, err := ts.deps.Auth.IssueSessionToken(auth.Principal{Role: auth.RoleReviewer, Architect: ts.projectRoot, TicketID: own.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Admitted, but the ticket has no open review.
+	body := `{"verdict":"approve","summary":"ok"}`
+	if got := authRequest(t, srv, http.MethodPost, "/tickets/"+own.ID+"/review/submit", ts.projectRoot, token, body); got != http.StatusBadRequest {
+		t.Errorf("submit own review = %d, want 400", got)
+	}
+	if got := authRequest(t, srv, http.MethodPost, "/tickets/"+other.ID+"/review/submit", ts.projectRoot, token, body); got != http.StatusForbidden {
+		t.Errorf("submit other review = %d, want 403", got)
+	}
+	if got := authRequest(t, srv, http.MethodPatch, "/tickets/"+own.ID+"/due-date", ts.projectRoot, token, `{"due_date":"2026-06-01T00:00:00Z"}`); got != http.StatusForbidden {
+		t.Errorf("change own ticket = %d, want 403", got)
+	}
+	if got := authRequest(t, srv, http.MethodPost, "/tickets/"+own.ID+"/review/accept", ts.projectRoot, token, "{}"); got != http.StatusForbidden {
+		t.Errorf("accept own review = %d, want 403", got)
+	}
+	if got := authRequest(t, srv, http.MethodGet, "/tickets/"+own.ID+"/review", ts.projectRoot, token, ""); got != http.StatusOK {
+		t.Errorf("read own review = %d, want 200", got)
+	}
+}
+
+func TestAuth_HookTokenLimitedToOwnSession(t *testing.T) {
+	ts, srv := setupAuthServer(t)
+	ts.deps.ReceiverManager = NewReceiverManager(ts.deps.Logger)
+
+	token, err := ts.deps.hookTokenIssuer()("sess-own")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event := func(sessionID string) string {
+		return `{"agent":"claude","hook":{"hook_event_name":"Stop","session_id":"c1"},"env":{"AGENTRUNTIME_SESSION_ID":"` + sessionID + `"}}`
+	}
+	if got := authRequest(t, srv, http.MethodPost, "/hook/claude", "", token, event("sess-own")); got != http.StatusAccepted {
+		t.Errorf("own session event = %d, want 202", got)
+	}
+	if got := authRequest(t, srv, http.MethodPost, "/hook/claude", "", token, event("sess-other")); got != http.StatusForbidden {
+		t.Errorf("other session event = %d, want 403", got)
+	}
+	if got := authRequest(t, srv, http.MethodGet, "/tickets", ts.projectRoot, token, ""); got != http.StatusForbidden {
+		t.Errorf("hook token off hook routes = %d, want 403", got)
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"fmt"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/headless"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/tmux"
+	"github.com/kareemaly/cortex/internal/types"
+)
+
+// resolveBackend picks the backend for a new ticket session: the spawn
+// request's, else the architect's session_backend, else tmux (returned as
+// "").
+func resolveBackend(requested string, cfg *architectconfig.Config) (string, error) {
+	backend := requested
+	if backend == "" && cfg != nil {
+		backend = cfg.SessionBackend
+	}
+	switch backend {
+	case "", session.BackendTmux:
+		return "", nil
+	case session.BackendHeadless:
+		return backend, nil
+	}
+	return "", fmt.Errorf("unknown session backend %q: must be 'tmux' or 'headless'", backend)
+}
+
+// backendUnavailable returns why sessions on backend cannot start, or ""
+// when they can.
+func (d *Dependencies) backendUnavailable(backend string) string {
+	if backend == session.BackendHeadless {
+		if d.Headless == nil {
+			return "headless sessions are not enabled"
+		}
+		return ""
+	}
+	if d.TmuxManager == nil {
+		return "tmux is not installed"
+	}
+	return ""
+}
+
+// sessionChecker returns the liveness checker for the backend running
+// sess, or nil when that backend is unavailable.
+func (d *Dependencies) sessionChecker(sess *session.Session) types.TmuxChecker {
+	if sess != nil && sess.IsHeadless() {
+		if d.Headless == nil {
+			return nil
+		}
+		return d.Headless
+	}
+	if d.TmuxManager == nil {
+		return nil
+	}
+	return d.TmuxManager
+}
+
+// killSessionWindow stops the tmux window or headless process of a ticket
+// session. A session that is already gone is not an error.
+func (d *Dependencies) killSessionWindow(tmuxSession string, sess *session.Session) error {
+	if sess.TmuxWindow == "" {
+		return nil
+	}
+	if sess.IsHeadless() {
+		if d.Headless == nil {
+			return nil
+		}
+		if err := d.Headless.KillWindow(tmuxSession, sess.TmuxWindow); err != nil && !headless.IsNotRunning(err) {
+			return err
+		}
+		return nil
+	}
+	if d.TmuxManager == nil {
+		return nil
+	}
+	err := d.TmuxManager.KillWindow(tmuxSession, sess.TmuxWindow)
+	if err != nil && !tmux.IsWindowNotFound(err) && !tmux.IsSessionNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -130,6 +130,8 @@ func (h *CollabHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 		DefaultsDir:    h.deps.DefaultsDir,
 		HubEventSource: hubEventSource(h.deps.ReceiverManager),
 		DaemonEndpoint: h.deps.DaemonEndpoint,
+		IssueToken:     h.deps.tokenIssuer(),
+		IssueHookToken: h.deps.hookTokenIssuer(),
 	})
 
 	result, err := spawner.SpawnCollab(r.Context(), spawn.CollabSpawnRequest{
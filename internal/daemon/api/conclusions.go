@@ -236,8 +236,10 @@ func (h *ConclusionHandlers) Get(w http.ResponseWriter, r *http.Request) {
 						Commits:         meta.Commits,
 						Rejected:        meta.Rejected,
 						RejectionReason: meta.RejectionReason,
+						SessionID:       meta.SessionID,
 						StartedAt:       meta.StartedAt,
 						ConcludedAt:     meta.ConcludedAt,
+						Verifications:   types.ToVerificationResponses(meta.Verifications),
 					}
 					writeJSON(w, http.StatusOK, resp)
 					return
@@ -4,7 +4,9 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/kareemaly/cortex/internal/daemon/auth"
 	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/headless"
 	"github.com/kareemaly/cortex/internal/tmux"
 )
 
@@ -12,11 +14,16 @@ type Dependencies struct {
 	StoreManager    *StoreManager
 	SessionManager  *SessionManager
 	TmuxManager     *tmux.Manager
+	Headless        *headless.Manager
+	Auth            *auth.Authenticator
 	Bus             *events.Bus
 	Logger          *slog.Logger
 	SupervisorCtx   context.Context
 	CortexdPath     string
 	DefaultsDir     string
 	ReceiverManager *ReceiverManager
+	SearchManager   *SearchManager
+	SpawnQueue      *SpawnQueueManager
+	Scheduler       *ScheduleManager
 	DaemonEndpoint  string
 }
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/daemon/config"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/storage"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// dueWatchInterval is how often the due watcher checks due dates.
+const dueWatchInterval = time.Minute
+
+// dueRemindersFile records, per architect, the reminders already sent so
+// a restarted daemon does not repeat them.
+const dueRemindersFile = ".due-reminders.json"
+
+// dueReminder is the reminder last sent for a ticket's due date.
+type dueReminder struct {
+	Due   time.Time       `json:"due"`
+	State ticket.DueState `json:"state"`
+}
+
+// DueWatcher emits due-soon and overdue events for the open tickets of
+// each registered architect, and raises the configured tmux alert when a
+// ticket becomes overdue.
+type DueWatcher struct {
+	mu     sync.Mutex // serializes checks, which read and write the reminder files
+	deps   *Dependencies
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewDueWatcher creates a new DueWatcher.
+func NewDueWatcher(logger *slog.Logger, deps *Dependencies) *DueWatcher {
+	return &DueWatcher{
+		deps:   deps,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Start checks due dates once a minute. Runs until ctx is cancelled.
+func (w *DueWatcher) Start(ctx context.Context) {
+	if w == nil || w.deps.StoreManager == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(dueWatchInterval)
+		defer ticker.Stop()
+		w.Check()
+		for {
+			select {
+			case <-ticker.C:
+				w.Check()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Check checks the due dates of every registered architect.
+func (w *DueWatcher) Check() {
+	cfg, err := config.Load()
+	if err != nil {
+		w.logger.Warn("due watcher: failed to load config", "error", err)
+		return
+	}
+	for _, entry := range cfg.Architects {
+		w.checkArchitect(entry.Path)
+	}
+}
+
+// checkArchitect emits the reminders an architect's tickets have newly
+// become due for and records them.
+func (w *DueWatcher) checkArchitect(projectPath string) {
+	projectCfg, err := architectconfig.Load(projectPath)
+	if err != nil {
+		return
+	}
+	store, err := w.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		return
+	}
+	all, err := store.ListAll()
+	if err != nil {
+		w.logger.Warn("due watcher: failed to list tickets", "project", projectPath, "error", err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	path := filepath.Join(projectPath, dueRemindersFile)
+	sent, err := loadDueReminders(path)
+	if err != nil {
+		w.logger.Warn("due watcher: failed to load reminders", "project", projectPath, "error", err)
+		return
+	}
+
+	now := w.now()
+	window := projectCfg.Reminders.DueSoonDuration()
+	next := make(map[string]dueReminder)
+	var overdue []*ticket.Ticket
+	for _, tickets := range all {
+		for _, t := range tickets {
+			if t.Due == nil || t.Status == ticket.StatusDone {
+				continue
+			}
+			rem := sent[t.ID]
+			if !rem.Due.Equal(*t.Due) {
+				// A new or moved due date starts over.
+				rem = dueReminder{Due: *t.Due}
+			}
+			switch state := t.DueStateAt(now, window); {
+			case state == ticket.DueStateOverdue && rem.State != ticket.DueStateOverdue:
+				w.emit(events.TicketOverdue, projectPath, t)
+				overdue = append(overdue, t)
+				rem.State = state
+			case state == ticket.DueStateSoon && rem.State == ticket.DueStateNone:
+				w.emit(events.TicketDueSoon, projectPath, t)
+				rem.State = state
+			}
+			next[t.ID] = rem
+		}
+	}
+
+	if !sameDueReminders(sent, next) {
+		if err := saveDueReminders(path, next); err != nil {
+			w.logger.Warn("due watcher: failed to save reminders", "project", projectPath, "error", err)
+		}
+	}
+	if len(overdue) > 0 {
+		w.alert(projectPath, projectCfg, overdue)
+	}
+}
+
+func (w *DueWatcher) emit(typ events.EventType, projectPath string, t *ticket.Ticket) {
+	w.logger.Info("ticket due reminder", "project", projectPath, "ticket", t.ID, "event", typ, "due", t.Due)
+	w.deps.Bus.Emit(events.Event{
+		Type:          typ,
+		ArchitectPath: projectPath,
+		TicketID:      t.ID,
+		Payload: map[string]any{
+			"title": t.Title,
+			"due":   t.Due,
+		},
+	})
+}
+
+// alert raises the configured tmux alert for tickets that just became
+// overdue. A popup shows the first of them.
+func (w *DueWatcher) alert(projectPath string, projectCfg *architectconfig.Config, overdue []*ticket.Ticket) {
+	if w.deps.TmuxManager == nil {
+		return
+	}
+	var err error
+	switch projectCfg.Reminders.Alert {
+	case architectconfig.AlertMessage:
+		msg := fmt.Sprintf("cortex: %s is overdue", overdue[0].Title)
+		if len(overdue) > 1 {
+			msg = fmt.Sprintf("cortex: %d tickets are overdue", len(overdue))
+		}
+		err = w.deps.TmuxManager.DisplayMessage(projectCfg.GetTmuxSessionName(), msg)
+	case architectconfig.AlertPopup:
+		err = openCortexPopup(projectPath, w.deps.TmuxManager, "ticket", "show", overdue[0].ID)
+	}
+	if err != nil {
+		w.logger.Debug("due watcher: failed to raise alert", "project", projectPath, "error", err)
+	}
+}
+
+func loadDueReminders(path string) (map[string]dueReminder, error) {
+	sent := make(map[string]dueReminder)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return sent, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &sent); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", dueRemindersFile, err)
+	}
+	return sent, nil
+}
+
+func saveDueReminders(path string, sent map[string]dueReminder) error {
+	data, err := json.MarshalIndent(sent, "", "  ")
+	if err != nil {
+		return err
+	}
+	return storage.AtomicWriteFile(path, data)
+}
+
+func sameDueReminders(a, b map[string]dueReminder) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, ra := range a {
+		if rb, ok := b[id]; !ok || !ra.Due.Equal(rb.Due) || ra.State != rb.State {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// dueEvents drains the due reminder events already delivered on ch.
+func dueEvents(ch <-chan events.Event) []events.EventType {
+	var got []events.EventType
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type == events.TicketDueSoon || ev.Type == events.TicketOverdue {
+				got = append(got, ev.Type)
+			}
+		default:
+			return got
+		}
+	}
+}
+
+func TestDueWatcher_RemindsOncePerDueDate(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, nil)
+
+	now := time.Now()
+	due := now.Add(3 * time.Hour)
+	tk, err := ts.store.Create("Ship release", "", &due, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	later := now.Add(48 * time.Hour)
+	if _, err := ts.store.Create("Later", "", &later, nil, "", nil, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	ch, unsubscribe := ts.deps.Bus.Subscribe(ts.projectRoot)
+	defer unsubscribe()
+	w := NewDueWatcher(ts.deps.Logger, ts.deps)
+	w.now = func() time.Time { return now }
+
+	w.checkArchitect(ts.projectRoot)
+	if got := dueEvents(ch); len(got) != 1 || got[0] != events.TicketDueSoon {
+		t.Fatalf("expected one due-soon event, got %v", got)
+	}
+	w.checkArchitect(ts.projectRoot)
+	if got := dueEvents(ch); len(got) != 0 {
+		t.Fatalf("expected no repeated reminder, got %v", got)
+	}
+
+	now = now.Add(4 * time.Hour)
+	w.checkArchitect(ts.projectRoot)
+	if got := dueEvents(ch); len(got) != 1 || got[0] != events.TicketOverdue {
+		t.Fatalf("expected one overdue event, got %v", got)
+	}
+
+	// A restarted daemon remembers the reminders it sent.
+	restarted := NewDueWatcher(ts.deps.Logger, ts.deps)
+	restarted.now = w.now
+	restarted.checkArchitect(ts.projectRoot)
+	if got := dueEvents(ch); len(got) != 0 {
+		t.Fatalf("expected no reminders after restart, got %v", got)
+	}
+
+	// Listings report the due state at the real time, three hours out.
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets", nil)
+	assertStatus(t, resp, http.StatusOK)
+	var all ListAllTicketsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&all); err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	for _, s := range all.Backlog {
+		want := ""
+		if s.ID == tk.ID {
+			want = string(ticket.DueStateSoon)
+		}
+		if s.DueState != want {
+			t.Errorf("%s: expected due_state %q, got %q", s.Title, want, s.DueState)
+		}
+	}
+
+	// Moving the due date starts over; done tickets are never reminded.
+	moved := now.Add(time.Hour)
+	if _, err := ts.store.SetDueDate(tk.ID, &moved); err != nil {
+		t.Fatal(err)
+	}
+	w.checkArchitect(ts.projectRoot)
+	if got := dueEvents(ch); len(got) != 1 || got[0] != events.TicketDueSoon {
+		t.Fatalf("expected due-soon event for the moved date, got %v", got)
+	}
+	if err := ts.store.Move(tk.ID, ticket.StatusDone); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Hour)
+	w.checkArchitect(ts.projectRoot)
+	if got := dueEvents(ch); len(got) != 0 {
+		t.Fatalf("expected no reminders for a done ticket, got %v", got)
+	}
+}
@@ -2,8 +2,10 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/kareemaly/cortex/internal/ticket"
 )
@@ -30,18 +32,25 @@ func handleTicketError(w http.ResponseWriter, err error, logger *slog.Logger) {
 		writeError(w, http.StatusNotFound, "not_found", e.Error())
 	case *ticket.ValidationError:
 		writeError(w, http.StatusBadRequest, "validation_error", e.Error())
+	case *ticketCheckError:
+		writeError(w, http.StatusBadRequest, e.code, e.msg)
 	default:
 		logger.Error("internal ticket store error", "error", err)
 		writeError(w, http.StatusInternalServerError, "internal_error", "internal server error")
 	}
 }
 
-// validStatus returns true if the status is valid.
-func validStatus(status string) bool {
-	switch ticket.Status(status) {
-	case ticket.StatusBacklog, ticket.StatusProgress, ticket.StatusDone:
-		return true
-	default:
-		return false
+// validStatus returns true if the status is one of the store's configured statuses.
+func validStatus(store *ticket.Store, status string) bool {
+	return status != "" && store.HasStatus(ticket.Status(status))
+}
+
+// invalidStatusMessage builds an error message listing the store's configured statuses.
+func invalidStatusMessage(store *ticket.Store, prefix string) string {
+	statuses := store.Statuses()
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
 	}
+	return fmt.Sprintf("%s: must be one of: %s", prefix, strings.Join(names, ", "))
 }
@@ -5,8 +5,16 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/types"
 )
 
+// defaultEventHistoryLimit caps GET /events/history when no limit is given.
+const defaultEventHistoryLimit = 500
+
 // EventHandlers handles SSE event streaming.
 type EventHandlers struct {
 	deps *Dependencies
@@ -18,6 +26,10 @@ func NewEventHandlers(deps *Dependencies) *EventHandlers {
 }
 
 // Stream handles GET /events and streams SSE events for the project.
+// Journaled events carry an SSE id; a client reconnecting with
+// Last-Event-ID (or ?last_event_id=) first receives the events it missed.
+// When missed events can no longer be replayed, a resync_required event is
+// sent instead.
 func (h *EventHandlers) Stream(w http.ResponseWriter, r *http.Request) {
 	flusher, ok := w.(http.Flusher)
 	if !ok {
@@ -27,6 +39,14 @@ func (h *EventHandlers) Stream(w http.ResponseWriter, r *http.Request) {
 
 	projectPath := GetArchitectPath(r.Context())
 
+	lastID, err := parseLastEventID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	// Subscribe before replaying so nothing emitted in between is lost;
+	// duplicates are skipped by ID below.
 	ch, unsubscribe := h.deps.Bus.Subscribe(projectPath)
 	defer unsubscribe()
 
@@ -35,6 +55,19 @@ func (h *EventHandlers) Stream(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Connection", "keep-alive")
 	w.Header().Set("X-Accel-Buffering", "no")
 
+	journal := h.deps.Bus.Journal()
+	if journal != nil {
+		if lastID > 0 {
+			if err := replayEvents(w, journal, projectPath, &lastID); err != nil {
+				slog.Warn("failed to write SSE event", "error", err)
+				return
+			}
+			flusher.Flush()
+		} else if id, err := journal.LastID(projectPath); err == nil {
+			lastID = id
+		}
+	}
+
 	for {
 		select {
 		case <-r.Context().Done():
@@ -43,12 +76,21 @@ func (h *EventHandlers) Stream(w http.ResponseWriter, r *http.Request) {
 			if !ok {
 				return
 			}
-			data, err := json.Marshal(event)
-			if err != nil {
-				slog.Warn("failed to marshal SSE event", "error", err)
+
+			var err error
+			switch {
+			case event.Type == events.ResyncRequired && journal != nil:
+				// The bus dropped events for this stream; fill the gap from the journal.
+				err = replayEvents(w, journal, projectPath, &lastID)
+			case event.ID != 0 && event.ID <= lastID:
 				continue
+			default:
+				err = writeSSEEvent(w, event)
+				if event.ID > lastID {
+					lastID = event.ID
+				}
 			}
-			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+			if err != nil {
 				slog.Warn("failed to write SSE event", "error", err)
 				return
 			}
@@ -56,3 +98,122 @@ func (h *EventHandlers) Stream(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// History handles GET /events/history?since=ID&limit=N and returns
+// journaled events after ID, oldest first.
+func (h *EventHandlers) History(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+
+	journal := h.deps.Bus.Journal()
+	if journal == nil {
+		writeError(w, http.StatusServiceUnavailable, "journal_unavailable", "event journal is not enabled")
+		return
+	}
+
+	var since uint64
+	if v := r.URL.Query().Get("since"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_error", "since must be an event ID")
+			return
+		}
+		since = n
+	}
+	limit := defaultEventHistoryLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "validation_error", "limit must be a positive integer")
+			return
+		}
+		limit = n
+	}
+
+	evts, complete, err := journal.Since(projectPath, since, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "journal_error", err.Error())
+		return
+	}
+	lastID, err := journal.LastID(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "journal_error", err.Error())
+		return
+	}
+
+	resp := types.EventHistoryResponse{
+		Events:   make([]types.EventResponse, len(evts)),
+		LastID:   lastID,
+		Complete: complete,
+	}
+	for i, e := range evts {
+		resp.Events[i] = types.EventResponse{
+			ID:            e.ID,
+			Type:          string(e.Type),
+			ArchitectPath: e.ArchitectPath,
+			TicketID:      e.TicketID,
+			SessionID:     e.SessionID,
+			Payload:       e.Payload,
+			Time:          e.Time,
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// replayEvents writes journaled events after *lastID and advances it. If
+// the journal cannot cover the gap, a resync_required event is written
+// first so the client reloads its state.
+func replayEvents(w http.ResponseWriter, journal *events.Journal, projectPath string, lastID *uint64) error {
+	missed, complete, err := journal.Since(projectPath, *lastID, 0)
+	if err != nil || !complete {
+		if err != nil {
+			slog.Warn("failed to read event journal", "architect", projectPath, "error", err)
+		}
+		resync := events.Event{Type: events.ResyncRequired, ArchitectPath: projectPath, Time: time.Now().UTC()}
+		if werr := writeSSEEvent(w, resync); werr != nil {
+			return werr
+		}
+		if err != nil {
+			return nil
+		}
+	}
+	for _, e := range missed {
+		if err := writeSSEEvent(w, e); err != nil {
+			return err
+		}
+		*lastID = e.ID
+	}
+	return nil
+}
+
+// writeSSEEvent writes one SSE frame, with an id line for journaled events.
+func writeSSEEvent(w http.ResponseWriter, event events.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		slog.Warn("failed to marshal SSE event", "error", err)
+		return nil
+	}
+	if event.ID != 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", event.ID); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+// parseLastEventID reads the resume position from the Last-Event-ID header
+// or the last_event_id query parameter.
+func parseLastEventID(r *http.Request) (uint64, error) {
+	v := r.Header.Get("Last-Event-ID")
+	if v == "" {
+		v = r.URL.Query().Get("last_event_id")
+	}
+	if v == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Last-Event-ID %q", v)
+	}
+	return id, nil
+}
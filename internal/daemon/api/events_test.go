@@ -0,0 +1,106 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+)
+
+// setupJournalServer creates a test server whose bus journals events.
+func setupJournalServer(t *testing.T) (*httptest.Server, *events.Bus, string) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	writeUnitConfig(t, tmpDir, nil)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	bus := events.NewBusWithJournal(events.NewJournal())
+	deps := &Dependencies{
+		StoreManager:   NewStoreManager(logger, bus),
+		SessionManager: NewSessionManager(logger),
+		Bus:            bus,
+		Logger:         logger,
+	}
+
+	server := httptest.NewServer(NewRouter(deps, logger))
+	t.Cleanup(server.Close)
+	return server, bus, tmpDir
+}
+
+func TestEventHistory(t *testing.T) {
+	server, bus, projectRoot := setupJournalServer(t)
+
+	for _, id := range []string{"a", "b", "c"} {
+		bus.Emit(events.Event{Type: events.TicketCreated, ArchitectPath: projectRoot, TicketID: id})
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/events/history?since=1", nil)
+	req.Header.Set(ArchitectHeader, projectRoot)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[EventHistoryResponse](t, resp)
+	if len(result.Events) != 2 || result.Events[0].TicketID != "b" || result.Events[1].ID != 3 {
+		t.Fatalf("unexpected events: %+v", result.Events)
+	}
+	if result.LastID != 3 || !result.Complete {
+		t.Errorf("LastID = %d, Complete = %v", result.LastID, result.Complete)
+	}
+}
+
+func TestEventStream_ReplaysFromLastEventID(t *testing.T) {
+	server, bus, projectRoot := setupJournalServer(t)
+
+	for _, id := range []string{"a", "b", "c"} {
+		bus.Emit(events.Event{Type: events.TicketCreated, ArchitectPath: projectRoot, TicketID: id})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events", nil)
+	req.Header.Set(ArchitectHeader, projectRoot)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	var ids []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && len(ids) < 2 {
+		if id, ok := strings.CutPrefix(scanner.Text(), "id: "); ok {
+			ids = append(ids, id)
+		}
+	}
+	if strings.Join(ids, ",") != "2,3" {
+		t.Errorf("replayed ids = %v, want [2 3]", ids)
+	}
+}
+
+func TestEventStream_InvalidLastEventID(t *testing.T) {
+	server, _, projectRoot := setupJournalServer(t)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/events", nil)
+	req.Header.Set(ArchitectHeader, projectRoot)
+	req.Header.Set("Last-Event-ID", "abc")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusBadRequest)
+}
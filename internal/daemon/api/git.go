@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -12,6 +13,8 @@ import (
 	"unicode/utf8"
 
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/worktree"
 )
 
 // validateCommitSHAs returns the subset of shas that don't resolve in repoDir.
@@ -81,6 +84,70 @@ func resolveTicketRepoDir(projectPath, repoKey string) (string, error) {
 	return resolveGitRepoDir(repoPath)
 }
 
+// repoTickets returns t as each of its repos' workers see it: a copy per
+// repo of a multi-repo ticket, else t itself.
+func repoTickets(t *ticket.Ticket) []*ticket.Ticket {
+	if !t.IsMultiRepo() {
+		return []*ticket.Ticket{t}
+	}
+	out := make([]*ticket.Ticket, len(t.Repos))
+	for i, repo := range t.Repos {
+		out[i] = forRepo(t, repo)
+	}
+	return out
+}
+
+// forRepo returns a copy of t whose Repo is repo.
+func forRepo(t *ticket.Ticket, repo string) *ticket.Ticket {
+	rt := *t
+	rt.Repo = repo
+	return &rt
+}
+
+// sessionRepo returns the repo key t's session for repo is stored under:
+// repo for a multi-repo ticket, else "".
+func sessionRepo(t *ticket.Ticket, repo string) string {
+	if t.IsMultiRepo() {
+		return repo
+	}
+	return ""
+}
+
+// removeTicketWorktree removes the isolated git worktree of a ticket. recorded
+// is the path stored on the ticket's session, if any; otherwise the default
+// location for the ticket's repo is used. Returns the removed path, or "" when
+// the ticket has no worktree. The worktree branch is kept.
+func removeTicketWorktree(projectPath string, t *ticket.Ticket, recorded string) (string, error) {
+	if t.Repo == "" {
+		return "", nil
+	}
+
+	cfg, err := architectconfig.Load(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("load project config: %w", err)
+	}
+
+	path := recorded
+	if path == "" {
+		if cfg.RepoIsolation(t.Repo) != architectconfig.IsolationWorktree {
+			return "", nil
+		}
+		path = worktree.Path(cfg.WorktreesPath(projectPath), t.Repo, t.ID)
+	}
+	if !worktree.Exists(path) {
+		return "", nil
+	}
+
+	repoPath, err := cfg.ResolveRepoPath(t.Repo)
+	if err != nil {
+		return "", err
+	}
+	if err := worktree.Remove(repoPath, path, false); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
 type gitCommitMeta struct {
 	SHA         string
 	Subject     string
@@ -243,6 +310,70 @@ func gitFileContent(repoDir, spec string) (*string, bool, error) {
 	return &content, false, nil
 }
 
+// diffsError is a failure to build a ticket's diffs, with the status and
+// code the API reports it under.
+type diffsError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *diffsError) Error() string { return e.message }
+
+// ticketDiffs builds the diffs of every commit the ticket's conclusion
+// records, grouped by repo.
+func ticketDiffs(projectPath string, store *ticket.Store, t *ticket.Ticket) (*DiffsResponse, *diffsError) {
+	hasConclusion, _ := store.HasConclusion(t.ID)
+	if !hasConclusion {
+		return nil, &diffsError{http.StatusNotFound, "no_conclusion", "ticket has no conclusion"}
+	}
+
+	concMeta, _, err := store.ReadConclusion(t.ID)
+	if err != nil {
+		return nil, &diffsError{http.StatusNotFound, "no_conclusion", "failed to read ticket conclusion"}
+	}
+
+	// A multi-repo conclusion records each repo's commits separately.
+	groups := []ticket.RepoConclusion{{Repo: t.Repo, Commits: concMeta.Commits}}
+	if t.IsMultiRepo() {
+		groups = concMeta.Repos
+	}
+
+	resp := DiffsResponse{
+		TicketID: t.ID,
+		Repos:    make([]RepoDiffsResponse, 0, len(groups)),
+	}
+	for _, g := range groups {
+		repoDir, err := resolveTicketRepoDir(projectPath, g.Repo)
+		if err != nil {
+			return nil, &diffsError{http.StatusBadRequest, "invalid_repo", err.Error()}
+		}
+		if invalid := validateCommitSHAs(repoDir, g.Commits); len(invalid) > 0 {
+			return nil, &diffsError{http.StatusNotFound, "commit_not_found",
+				fmt.Sprintf("commit %s does not exist in %s", invalid[0], repoDir)}
+		}
+
+		group := RepoDiffsResponse{
+			Repo:    g.Repo,
+			Path:    repoDir,
+			Commits: make([]CommitDiffResponse, 0, len(g.Commits)),
+		}
+		for _, sha := range g.Commits {
+			diff, err := buildCommitDiff(repoDir, sha)
+			if err != nil {
+				return nil, &diffsError{http.StatusInternalServerError, "git_error", err.Error()}
+			}
+			group.Commits = append(group.Commits, *diff)
+		}
+		resp.Repos = append(resp.Repos, group)
+	}
+	if !t.IsMultiRepo() {
+		resp.Repo = resp.Repos[0].Path
+		resp.Commits = resp.Repos[0].Commits
+	}
+	return &resp, nil
+}
+
 func buildCommitDiff(repoDir, sha string) (*CommitDiffResponse, error) {
 	meta, err := gitCommitMetadata(repoDir, sha)
 	if err != nil {
@@ -1,11 +1,13 @@
 package api
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/hiveryn/agentruntime"
+	"github.com/kareemaly/cortex/internal/daemon/auth"
 )
 
 const maxHookBodyBytes = 1 << 20 // 1 MiB
@@ -42,6 +44,11 @@ func (h *HookHandlers) IngestHook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if p := GetPrincipal(r.Context()); p != nil && p.Role == auth.RoleHook && p.SessionID != "" && hookSessionID(body) != p.SessionID {
+		writeError(w, http.StatusForbidden, "forbidden", "hook token is not valid for this session")
+		return
+	}
+
 	agentKind := agentruntime.AgentKind(agentName)
 	_, err = h.deps.ReceiverManager.Ingest(r.Context(), agentKind, body)
 	if err != nil {
@@ -52,3 +59,22 @@ func (h *HookHandlers) IngestHook(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusAccepted)
 }
+
+// hookSessionID returns the agent session a hook event belongs to: the
+// AGENTRUNTIME_SESSION_ID the claude and codex commands copy from their
+// environment, or the agentruntime_session_id field of opencode's plugin.
+func hookSessionID(body []byte) string {
+	var envelope struct {
+		Env struct {
+			SessionID string `json:"AGENTRUNTIME_SESSION_ID"`
+		} `json:"env"`
+		SessionID string `json:"agentruntime_session_id"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return ""
+	}
+	if envelope.Env.SessionID != "" {
+		return envelope.Env.SessionID
+	}
+	return envelope.SessionID
+}
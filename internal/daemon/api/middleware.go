@@ -23,6 +23,7 @@ const architectPathKey contextKey = "architectPath"
 // 1. The header is present
 // 2. The path is absolute
 // 3. The path exists and has a cortex.yaml file
+// 4. The caller's token, if any, may make the request in that architect
 func ArchitectRequired() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -53,8 +54,18 @@ func ArchitectRequired() func(http.Handler) http.Handler {
 				return
 			}
 
+			architectPath = filepath.Clean(architectPath)
+			p := GetPrincipal(r.Context())
+			if msg := authorizeArchitect(p, r, architectPath); msg != "" {
+				writeError(w, http.StatusForbidden, "forbidden", msg)
+				return
+			}
+			if actor := sessionActor(p); actor != "" {
+				r.Header.Set(ActorHeader, actor)
+			}
+
 			// Add architect path to context
-			ctx := context.WithValue(r.Context(), architectPathKey, filepath.Clean(architectPath))
+			ctx := context.WithValue(r.Context(), architectPathKey, architectPath)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
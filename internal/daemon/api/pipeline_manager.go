@@ -0,0 +1,270 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/core/spawn"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// maxPipelineChain bounds how many follow-up tickets can be created from a
+// ticket that was itself created by a pipeline, so a rule matching its own
+// output cannot loop forever.
+const maxPipelineChain = 5
+
+// Results reported in pipeline_action event payloads.
+const (
+	PipelineResultDone     = "done"
+	PipelineResultDeferred = "deferred"
+	PipelineResultSkipped  = "skipped"
+	PipelineResultFailed   = "failed"
+)
+
+// PipelineManager runs the pipeline rules of each architect's cortex.yaml
+// against bus events. Rule spawns go through the spawn queue, which holds
+// them back while a concurrency cap is reached.
+type PipelineManager struct {
+	mu     sync.Mutex
+	chain  map[string]int // "<project>\x00<ticket>" → pipeline chain depth
+	deps   *Dependencies
+	logger *slog.Logger
+}
+
+// NewPipelineManager creates a new PipelineManager.
+func NewPipelineManager(logger *slog.Logger, deps *Dependencies) *PipelineManager {
+	return &PipelineManager{
+		chain:  make(map[string]int),
+		deps:   deps,
+		logger: logger,
+	}
+}
+
+// StartEventLoop evaluates rules as events arrive. Runs until ctx is
+// cancelled.
+func (m *PipelineManager) StartEventLoop(ctx context.Context) {
+	if m == nil || m.deps.Bus == nil {
+		return
+	}
+	ch, unsubscribe := m.deps.Bus.Subscribe("")
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case ev, ok := <-ch:
+				if !ok {
+					return
+				}
+				m.handle(ctx, ev)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// handle runs the rules triggered by ev.
+func (m *PipelineManager) handle(ctx context.Context, ev events.Event) {
+	if ev.ArchitectPath == "" || ev.TicketID == "" {
+		return
+	}
+	cfg, err := architectconfig.Load(ev.ArchitectPath)
+	if err != nil || len(cfg.Pipelines.Rules) == 0 {
+		return
+	}
+	store, err := m.deps.StoreManager.GetStore(ev.ArchitectPath)
+	if err != nil {
+		return
+	}
+	t, status, err := store.Get(ev.TicketID)
+	if err != nil {
+		return
+	}
+
+	var conclusion *ticket.TicketConclusionMeta
+	var conclusionBody string
+	if ev.Type == events.ConclusionCreated {
+		if conclusion, conclusionBody, err = store.ReadConclusion(ev.TicketID); err != nil {
+			m.logger.Warn("pipeline: failed to read conclusion", "ticket", ev.TicketID, "error", err)
+			return
+		}
+	}
+
+	for _, rule := range cfg.Pipelines.Rules {
+		if rule.On != string(ev.Type) || !pipelineMatches(rule.When, t, status, conclusion) {
+			continue
+		}
+		if rule.Spawn != nil {
+			m.requestSpawn(ctx, ev.ArchitectPath, rule.Name, t.ID, *rule.Spawn)
+			continue
+		}
+		m.createTicket(ctx, ev.ArchitectPath, store, rule, t, conclusionBody)
+	}
+}
+
+// pipelineMatches reports whether a ticket, and for conclusion_created its
+// conclusion, satisfies a rule's when clause.
+func pipelineMatches(when architectconfig.PipelineMatch, t *ticket.Ticket, status ticket.Status, conclusion *ticket.TicketConclusionMeta) bool {
+	if when.Status != "" && when.Status != string(status) {
+		return false
+	}
+	if when.Type != "" && when.Type != ticketTypeOrDefault(t.Type) {
+		return false
+	}
+	if when.Repo != "" && !slices.Contains(t.RepoKeys(), when.Repo) {
+		return false
+	}
+	if when.HasCommits != nil && (conclusion == nil || *when.HasCommits != (len(conclusion.Commits) > 0)) {
+		return false
+	}
+	if when.Rejected != nil && (conclusion == nil || *when.Rejected != conclusion.Rejected) {
+		return false
+	}
+	return true
+}
+
+func ticketTypeOrDefault(t string) string {
+	if t == "" {
+		return architectconfig.DefaultTicketType
+	}
+	return t
+}
+
+// createTicket creates a rule's follow-up ticket and, if configured,
+// spawns it.
+func (m *PipelineManager) createTicket(ctx context.Context, projectPath string, store *ticket.Store, rule architectconfig.PipelineRule, parent *ticket.Ticket, conclusion string) {
+	m.mu.Lock()
+	depth := m.chain[pipelineKey(projectPath, parent.ID)]
+	m.mu.Unlock()
+	if depth >= maxPipelineChain {
+		emitPipelineAction(m.deps, m.logger, projectPath, parent.ID, rule.Name, rule.Action(), PipelineResultFailed,
+			fmt.Sprintf("stopped after %d chained follow-ups", maxPipelineChain), nil)
+		return
+	}
+
+	spec := rule.CreateTicket
+	repo := spec.Repo
+	if repo == "" {
+		repo = parent.Repo
+	}
+	expand := strings.NewReplacer(
+		"{id}", parent.ID,
+		"{title}", parent.Title,
+		"{type}", ticketTypeOrDefault(parent.Type),
+		"{repo}", parent.Repo,
+		"{conclusion}", strings.TrimSpace(conclusion),
+	).Replace
+
+	created, err := store.CreateAs(ticket.Actor{Kind: ticket.ActorPipeline}, expand(spec.Title), expand(spec.Body), nil, nil, repo, nil, nil, spec.Type)
+	if err != nil {
+		emitPipelineAction(m.deps, m.logger, projectPath, parent.ID, rule.Name, rule.Action(), PipelineResultFailed, err.Error(), nil)
+		return
+	}
+
+	m.mu.Lock()
+	m.chain[pipelineKey(projectPath, created.ID)] = depth + 1
+	m.mu.Unlock()
+	emitPipelineAction(m.deps, m.logger, projectPath, parent.ID, rule.Name, rule.Action(), PipelineResultDone,
+		fmt.Sprintf("created %s", created.ID), map[string]any{"created_ticket_id": created.ID})
+
+	if spec.Spawn != nil {
+		m.requestSpawn(ctx, projectPath, rule.Name, created.ID, *spec.Spawn)
+	}
+}
+
+func pipelineKey(projectPath, ticketID string) string {
+	return filepath.Clean(projectPath) + "\x00" + ticketID
+}
+
+// requestSpawn spawns a ticket for a rule through the spawn queue, which
+// defers it while a cap is reached.
+func (m *PipelineManager) requestSpawn(ctx context.Context, projectPath, rule, ticketID string, ps architectconfig.PipelineSpawn) {
+	result, message, extra := queueSpawn(ctx, m.deps, projectPath,
+		session.QueuedSpawn{TicketID: ticketID, Variant: ps.Variant, Mode: ps.Mode, Rule: rule})
+	emitPipelineAction(m.deps, m.logger, projectPath, ticketID, rule, "spawn", result, message, extra)
+}
+
+// queueSpawn spawns an automatically requested ticket session through the
+// spawn queue and returns a pipeline result, a message and extra payload
+// fields describing the outcome.
+func queueSpawn(ctx context.Context, deps *Dependencies, projectPath string, qs session.QueuedSpawn) (string, string, map[string]any) {
+	store, err := deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		return PipelineResultFailed, err.Error(), nil
+	}
+	t, _, err := store.Get(qs.TicketID)
+	if err != nil {
+		return PipelineResultSkipped, "ticket no longer exists", nil
+	}
+	sessionStore := deps.SessionManager.GetStore(projectPath)
+	if sess, err := sessionStore.GetByTicketID(qs.TicketID); err == nil && sess != nil {
+		return PipelineResultSkipped, "session already active", nil
+	}
+	if deps.SpawnQueue == nil {
+		return PipelineResultFailed, "spawn queue is not configured", nil
+	}
+	projectCfg, err := mergeProjectConfig(projectPath)
+	if err != nil {
+		return PipelineResultFailed, err.Error(), nil
+	}
+	av, err := projectCfg.ResolveVariant(qs.Variant)
+	if err != nil {
+		return PipelineResultFailed, err.Error(), nil
+	}
+	if qs.Backend, err = resolveBackend("", projectCfg); err != nil {
+		return PipelineResultFailed, err.Error(), nil
+	}
+
+	spawned, err := deps.SpawnQueue.Spawn(ctx, projectPath, projectCfg, store, t, qs, av)
+	switch {
+	case err != nil:
+		return PipelineResultFailed, err.Error(), nil
+	case spawned.Queue != nil:
+		return PipelineResultDeferred, spawned.Queue.Reason, map[string]any{"queue_position": spawned.Queue.Position}
+	case spawned.Result.Outcome == spawn.OutcomeAlreadyActive:
+		return PipelineResultSkipped, "session already active", nil
+	}
+	var sessionID string
+	if sess, _ := sessionStore.GetByTicketID(qs.TicketID); sess != nil {
+		sessionID = sess.SessionID
+	}
+	deps.Bus.Emit(events.Event{
+		Type:          events.SessionStarted,
+		ArchitectPath: projectPath,
+		TicketID:      qs.TicketID,
+	})
+	return PipelineResultDone, fmt.Sprintf("spawned with variant %s", qs.Variant), map[string]any{"session_id": sessionID}
+}
+
+// emitPipelineAction logs a pipeline action and publishes it on the bus.
+func emitPipelineAction(deps *Dependencies, logger *slog.Logger, projectPath, ticketID, rule, action, result, message string, extra map[string]any) {
+	logFn := logger.Info
+	if result == PipelineResultFailed {
+		logFn = logger.Warn
+	}
+	logFn("pipeline action", "project", projectPath, "rule", rule, "action", action, "ticket", ticketID, "result", result, "message", message)
+
+	payload := map[string]any{
+		"rule":    rule,
+		"action":  action,
+		"result":  result,
+		"message": message,
+	}
+	for k, v := range extra {
+		payload[k] = v
+	}
+	deps.Bus.Emit(events.Event{
+		Type:          events.PipelineAction,
+		ArchitectPath: projectPath,
+		TicketID:      ticketID,
+		Payload:       payload,
+	})
+}
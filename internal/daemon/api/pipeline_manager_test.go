@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+func writePipelineConfig(t *testing.T, projectRoot, pipelines string) {
+	t.Helper()
+	data := "name: test\nrepos: {}\ntypes:\n  review: {}\n" + pipelines
+	if err := os.WriteFile(filepath.Join(projectRoot, "cortex.yaml"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// nextPipelineAction returns the next pipeline_action event on ch.
+func nextPipelineAction(t *testing.T, ch <-chan events.Event) map[string]any {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type == events.PipelineAction {
+				payload, _ := ev.Payload.(map[string]any)
+				return payload
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for pipeline_action event")
+			return nil
+		}
+	}
+}
+
+func TestPipeline_CreatesFollowUpTicket(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writePipelineConfig(t, ts.projectRoot, `pipelines:
+  rules:
+    - name: review-followup
+      on: conclusion_created
+      when: {has_commits: true}
+      create_ticket:
+        type: review
+        title: "Review: {title}"
+        body: "{conclusion}"
+`)
+
+	ch, unsubscribe := ts.deps.Bus.Subscribe(ts.projectRoot)
+	defer unsubscribe()
+	m := NewPipelineManager(ts.deps.Logger, ts.deps)
+	ctx := context.Background()
+
+	worked, _ := ts.store.Create("Add login", "body", nil, nil, "", nil, nil, "")
+	if err := ts.store.WriteConclusion(worked.ID, &ticket.TicketConclusionMeta{Commits: []string{"abc123"}}, "Added the login form."); err != nil {
+		t.Fatal(err)
+	}
+	m.handle(ctx, events.Event{Type: events.ConclusionCreated, ArchitectPath: ts.projectRoot, TicketID: worked.ID})
+
+	payload := nextPipelineAction(t, ch)
+	if payload["result"] != PipelineResultDone || payload["rule"] != "review-followup" {
+		t.Fatalf("unexpected payload: %v", payload)
+	}
+	createdID, _ := payload["created_ticket_id"].(string)
+	review, _, err := ts.store.Get(createdID)
+	if err != nil {
+		t.Fatalf("follow-up ticket not found: %v", err)
+	}
+	if review.Title != "Review: Add login" || review.Type != "review" || review.Body != "Added the login form." {
+		t.Errorf("unexpected follow-up ticket: %+v", review)
+	}
+	revisions, _ := ts.store.History(review.ID)
+	if len(revisions) == 0 || revisions[0].Actor.Kind != ticket.ActorPipeline {
+		t.Errorf("expected creation by pipeline actor, got %+v", revisions)
+	}
+
+	// Rejected conclusions carry no commits and do not match.
+	rejected, _ := ts.store.Create("Drop feature", "body", nil, nil, "", nil, nil, "")
+	if err := ts.store.WriteConclusion(rejected.ID, &ticket.TicketConclusionMeta{Rejected: true}, "Not needed."); err != nil {
+		t.Fatal(err)
+	}
+	m.handle(ctx, events.Event{Type: events.ConclusionCreated, ArchitectPath: ts.projectRoot, TicketID: rejected.ID})
+	tickets, _ := ts.store.List(ticket.StatusBacklog)
+	if len(tickets) != 3 {
+		t.Errorf("expected 3 backlog tickets, got %d", len(tickets))
+	}
+
+	// Follow-ups of follow-ups stop at the chain limit.
+	m.chain[pipelineKey(ts.projectRoot, worked.ID)] = maxPipelineChain
+	m.handle(ctx, events.Event{Type: events.ConclusionCreated, ArchitectPath: ts.projectRoot, TicketID: worked.ID})
+	if payload := nextPipelineAction(t, ch); payload["result"] != PipelineResultFailed {
+		t.Errorf("expected chain limit failure, got %v", payload)
+	}
+}
+
+func TestPipeline_SpawnDeferredAtCap(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writePipelineConfig(t, ts.projectRoot, `agents:
+  fast: {agent: claude}
+pipelines:
+  max_concurrent: 1
+  rules:
+    - name: auto-spawn
+      on: ticket_created
+      when: {type: review, status: backlog}
+      spawn: {variant: fast}
+`)
+
+	ch, unsubscribe := ts.deps.Bus.Subscribe(ts.projectRoot)
+	defer unsubscribe()
+	m := NewPipelineManager(ts.deps.Logger, ts.deps)
+	queue := ts.deps.SessionManager.GetQueue(ts.projectRoot)
+	ctx := context.Background()
+	queued := func() int {
+		t.Helper()
+		entries, err := queue.List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(entries)
+	}
+
+	running, _ := ts.store.Create("Running", "body", nil, nil, "", nil, nil, "")
+	sessStore := ts.deps.SessionManager.GetStore(ts.projectRoot)
+	if _, err := sessStore.Create(running.ID, "claude", "", "", "win", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tickets of other types do not match.
+	other, _ := ts.store.Create("Other", "body", nil, nil, "", nil, nil, "")
+	m.handle(ctx, events.Event{Type: events.TicketCreated, ArchitectPath: ts.projectRoot, TicketID: other.ID})
+	if n := queued(); n != 0 {
+		t.Fatalf("expected no queued spawns, got %d", n)
+	}
+
+	review, _ := ts.store.Create("Review", "body", nil, nil, "", nil, nil, "review")
+	m.handle(ctx, events.Event{Type: events.TicketCreated, ArchitectPath: ts.projectRoot, TicketID: review.ID})
+	if payload := nextPipelineAction(t, ch); payload["result"] != PipelineResultDeferred {
+		t.Fatalf("expected deferred spawn, got %v", payload)
+	}
+	entries, _ := queue.List()
+	if len(entries) != 1 || entries[0].TicketID != review.ID || entries[0].Rule != "auto-spawn" {
+		t.Fatalf("unexpected queue: %+v", entries)
+	}
+
+	// Still at the cap: the entry keeps its place.
+	ts.deps.SpawnQueue.Drain(ctx, ts.projectRoot)
+	if n := queued(); n != 1 {
+		t.Fatalf("expected spawn to stay queued, got %d", n)
+	}
+
+	// Once the running session ends the spawn is attempted; without tmux
+	// it fails and leaves the queue.
+	if err := sessStore.EndByTicketID(running.ID); err != nil {
+		t.Fatal(err)
+	}
+	ts.deps.SpawnQueue.Drain(ctx, ts.projectRoot)
+	if payload := nextPipelineAction(t, ch); payload["result"] != PipelineResultFailed {
+		t.Errorf("expected failed spawn without tmux, got %v", payload)
+	}
+	if n := queued(); n != 0 {
+		t.Errorf("expected empty queue, got %d", n)
+	}
+}
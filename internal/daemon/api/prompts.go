@@ -12,6 +12,7 @@ import (
 
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/prompt"
+	"github.com/kareemaly/cortex/internal/ticket"
 	"github.com/kareemaly/cortex/internal/types"
 )
 
@@ -162,6 +163,16 @@ func (h *PromptHandlers) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Ticket templates are plain files in the workspace, always editable.
+	if projectCfg, cfgErr := architectconfig.Load(projectPath); cfgErr == nil {
+		templates, _ := ticket.ListTemplates(projectCfg.TemplatesPath(projectPath))
+		for _, t := range templates {
+			relPath := templatesGroup + "/" + t.Name + ".md"
+			content, _ := os.ReadFile(filepath.Join(projectPath, relPath))
+			addPrompt(templatesGroup, "", t.Name, relPath, string(content), true)
+		}
+	}
+
 	// Sort groups by key
 	var groups []types.PromptGroupInfo
 	for _, g := range groupMap {
@@ -290,7 +301,7 @@ func (h *PromptHandlers) Edit(w http.ResponseWriter, r *http.Request) {
 	promptPath := strings.TrimPrefix(req.Path, "/")
 	promptPath = filepath.Clean(promptPath)
 
-	filePath := filepath.Join(prompt.PromptsDir(projectPath), promptPath)
+	filePath := promptFilePath(projectPath, promptPath)
 
 	// Validate file exists (must be ejected)
 	if _, err := os.Stat(filePath); err != nil {
@@ -348,6 +359,10 @@ func (h *PromptHandlers) Reset(w http.ResponseWriter, r *http.Request) {
 
 	promptPath := strings.TrimPrefix(req.Path, "/")
 	promptPath = filepath.Clean(promptPath)
+	if isTemplatePath(promptPath) {
+		writeError(w, http.StatusBadRequest, "validation_error", "ticket templates have no default to reset to")
+		return
+	}
 
 	projectPromptsDir := prompt.PromptsDir(projectPath)
 	ejectedPath := filepath.Join(projectPromptsDir, promptPath)
@@ -376,6 +391,22 @@ func (h *PromptHandlers) Reset(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// templatesGroup is the prompt list group holding the ticket templates.
+// Their paths are relative to the architect root rather than prompts/.
+const templatesGroup = "templates"
+
+func isTemplatePath(promptPath string) bool {
+	return strings.HasPrefix(filepath.ToSlash(promptPath), templatesGroup+"/")
+}
+
+// promptFilePath returns the file a prompt list path refers to.
+func promptFilePath(projectPath, promptPath string) string {
+	if isTemplatePath(promptPath) {
+		return filepath.Join(projectPath, promptPath)
+	}
+	return filepath.Join(prompt.PromptsDir(projectPath), promptPath)
+}
+
 // removeEmptyParents removes empty directories from dir up to (but not including) root.
 func removeEmptyParents(dir, root string) {
 	for dir != root && dir != "." && dir != "/" {
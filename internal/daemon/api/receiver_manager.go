@@ -125,6 +125,47 @@ func (m *ReceiverManager) EventsFor(ctx context.Context, cortexSessionID string)
 	return m.eventsFromAllReceivers(ctx, cortexSessionID)
 }
 
+// Events returns a channel of every normalized event from all receivers.
+// The channel is closed when ctx is cancelled.
+func (m *ReceiverManager) Events(ctx context.Context) <-chan agentruntime.Event {
+	if m == nil {
+		return nil
+	}
+	ch := make(chan agentruntime.Event, 64)
+	var wg sync.WaitGroup
+
+	for _, entry := range m.receivers {
+		sub := entry.receiver.Hub().Subscribe(ingest.Filter{})
+		wg.Add(1)
+		go func(sub *ingest.Subscription) {
+			defer wg.Done()
+			defer sub.Close()
+			for {
+				select {
+				case ev, ok := <-sub.Events:
+					if !ok {
+						return
+					}
+					select {
+					case ch <- ev:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(sub)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	return ch
+}
+
 // eventsFromAllReceivers subscribes to all receivers' hubs with a filter
 // on Event.ID and merges the streams. In practice only one receiver will
 // produce events for a given session UUID.
@@ -0,0 +1,542 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/core/spawn"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/storage"
+	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/types"
+)
+
+// ReviewHandlers provides HTTP handlers for the review stage a concluded
+// ticket waits in when the architect configures a review status.
+type ReviewHandlers struct {
+	deps *Dependencies
+}
+
+// NewReviewHandlers creates a new ReviewHandlers with the given dependencies.
+func NewReviewHandlers(deps *Dependencies) *ReviewHandlers {
+	return &ReviewHandlers{deps: deps}
+}
+
+// Get handles GET /tickets/{id}/review - returns the ticket's review.
+func (h *ReviewHandlers) Get(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	review, err := store.ReadReview(id)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, types.ToReviewResponse(id, review))
+}
+
+// AddComment handles POST /tickets/{id}/review/comments - leaves a comment
+// anchored to a file of the ticket's diffs.
+func (h *ReviewHandlers) AddComment(w http.ResponseWriter, r *http.Request) {
+	store, t, ok := h.ticketInReview(w, r)
+	if !ok {
+		return
+	}
+
+	var req AddReviewCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	repo := req.Repo
+	switch {
+	case t.IsMultiRepo() && !slices.Contains(t.Repos, repo):
+		writeError(w, http.StatusBadRequest, "validation_error",
+			"repo must be one of "+strings.Join(t.Repos, ", ")+" for this multi-repo ticket")
+		return
+	case !t.IsMultiRepo() && repo != "" && repo != t.Repo:
+		writeError(w, http.StatusBadRequest, "validation_error", fmt.Sprintf("ticket does not include repo %q", repo))
+		return
+	case !t.IsMultiRepo():
+		repo = t.Repo
+	}
+
+	c, err := store.AddReviewComment(h.deps.requestActor(r), t.ID, ticket.ReviewComment{
+		Repo:   repo,
+		Commit: req.Commit,
+		File:   req.File,
+		Line:   req.Line,
+		Body:   req.Body,
+	})
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+
+	h.emitReviewUpdated(r, t.ID, ticket.ReviewOpen)
+	writeJSON(w, http.StatusCreated, types.ToReviewCommentResponse(*c))
+}
+
+// Accept handles POST /tickets/{id}/review/accept - accepts the work and
+// moves the ticket to done.
+func (h *ReviewHandlers) Accept(w http.ResponseWriter, r *http.Request) {
+	store, t, ok := h.ticketInReview(w, r)
+	if !ok {
+		return
+	}
+
+	var req AcceptReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	review, err := store.FinishReview(t.ID, ticket.ReviewAccepted, req.Summary)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	if err := store.MoveAs(h.deps.requestActor(r), t.ID, ticket.StatusDone); err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	h.emitReviewUpdated(r, t.ID, review.State)
+
+	h.writeAction(w, store, t.ID, review, "Review accepted and ticket moved to done")
+}
+
+// RequestChanges handles POST /tickets/{id}/review/request-changes - sends
+// the ticket back to progress and spawns a worker whose kickoff prompt
+// carries the comments of the current round.
+func (h *ReviewHandlers) RequestChanges(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, t, ok := h.ticketInReview(w, r)
+	if !ok {
+		return
+	}
+
+	var req RequestChangesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	current, err := store.ReadReview(t.ID)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	if len(current.RoundComments()) == 0 && strings.TrimSpace(req.Summary) == "" {
+		writeError(w, http.StatusBadRequest, "validation_error", "requesting changes needs a summary or at least one comment")
+		return
+	}
+
+	variantName := req.Variant
+	if variantName == "" {
+		if meta, _, err := store.ReadConclusion(t.ID); err == nil {
+			variantName = meta.Variant
+		}
+	}
+	if variantName == "" {
+		writeError(w, http.StatusBadRequest, "variant_required", "variant is required: the concluding session recorded none")
+		return
+	}
+
+	projectCfg, _ := mergeProjectConfig(projectPath)
+	av, err := projectCfg.ResolveVariant(variantName)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_variant", err.Error())
+		return
+	}
+	backend, err := resolveBackend(req.Backend, projectCfg)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_backend", err.Error())
+		return
+	}
+	if reason := h.deps.backendUnavailable(backend); reason != "" {
+		writeError(w, http.StatusServiceUnavailable, "backend_unavailable", reason)
+		return
+	}
+
+	review, err := store.FinishReview(t.ID, ticket.ReviewChangesRequested, req.Summary)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	if err := store.MoveAs(h.deps.requestActor(r), t.ID, ticket.StatusProgress); err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	h.emitReviewUpdated(r, t.ID, review.State)
+
+	// The ticket already went through its blockers on the first spawn.
+	e := session.QueuedSpawn{TicketID: t.ID, Variant: variantName, Force: true, Backend: backend}
+	var spawnErr error
+	var queued *QueuedSpawnResponse
+	if h.deps.SpawnQueue != nil {
+		var spawned *queuedSpawn
+		if spawned, spawnErr = h.deps.SpawnQueue.Spawn(r.Context(), projectPath, projectCfg, store, t, e, av); spawnErr == nil {
+			queued = spawned.Queue
+		}
+	} else {
+		_, spawnErr = spawnTicketSession(r.Context(), h.deps, projectPath, projectCfg, store, e, av)
+	}
+
+	message := "Changes requested"
+	switch {
+	case spawnErr != nil:
+		h.deps.Logger.Warn("failed to spawn worker for requested changes", "ticket", t.ID, "error", spawnErr)
+		message += "; spawn failed: " + spawnErr.Error()
+	case queued != nil:
+		message += fmt.Sprintf("; worker queued at position %d (%s)", queued.Position, queued.Reason)
+	default:
+		message += "; worker spawned with variant " + variantName
+		h.deps.Bus.Emit(events.Event{
+			Type:          events.SessionStarted,
+			ArchitectPath: projectPath,
+			TicketID:      t.ID,
+		})
+	}
+
+	h.writeAction(w, store, t.ID, review, message)
+}
+
+// ticketInReview loads the ticket of the request and checks it waits in
+// review, writing the error response when it does not.
+func (h *ReviewHandlers) ticketInReview(w http.ResponseWriter, r *http.Request) (*ticket.Store, *ticket.Ticket, bool) {
+	store, err := h.deps.StoreManager.GetStore(GetArchitectPath(r.Context()))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
+		return nil, nil, false
+	}
+
+	t, status, err := store.Get(chi.URLParam(r, "id"))
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return nil, nil, false
+	}
+	if status != ticket.StatusReview {
+		writeError(w, http.StatusConflict, "not_in_review", fmt.Sprintf("ticket is in %s, not review", status))
+		return nil, nil, false
+	}
+	return store, t, true
+}
+
+func (h *ReviewHandlers) writeAction(w http.ResponseWriter, store *ticket.Store, id string, review *ticket.Review, message string) {
+	t, status, err := store.Get(id)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	ticketResp, err := ticketResponse(store, t, status)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, ReviewActionResponse{
+		Review:  types.ToReviewResponse(id, review),
+		Ticket:  ticketResp,
+		Message: message,
+	})
+}
+
+func (h *ReviewHandlers) emitReviewUpdated(r *http.Request, ticketID string, state ticket.ReviewState) {
+	h.deps.Bus.Emit(events.Event{
+		Type:          events.ReviewUpdated,
+		ArchitectPath: GetArchitectPath(r.Context()),
+		TicketID:      ticketID,
+		Payload:       map[string]any{"state": string(state)},
+	})
+}
+
+// errReviewerActive is returned by spawnReviewer when the ticket already has
+// a reviewer running.
+var errReviewerActive = errors.New("a reviewer is already running for this ticket")
+
+// SpawnReviewer handles POST /tickets/{id}/review/spawn - spawns a reviewer
+// agent that audits the ticket's concluded commits.
+func (h *ReviewHandlers) SpawnReviewer(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
+		return
+	}
+
+	t, _, err := store.Get(chi.URLParam(r, "id"))
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+
+	var req SpawnReviewerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	projectCfg, err := mergeProjectConfig(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", "failed to load project config")
+		return
+	}
+	variantName := req.Variant
+	if variantName == "" {
+		variantName = projectCfg.Reviewer.Variant
+	}
+	if variantName == "" {
+		writeError(w, http.StatusBadRequest, "variant_required", "variant is required: cortex.yaml sets no reviewer.variant")
+		return
+	}
+	if _, err := projectCfg.ResolveVariant(variantName); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_variant", err.Error())
+		return
+	}
+	backend, err := resolveBackend("", projectCfg)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_backend", err.Error())
+		return
+	}
+	if reason := h.deps.backendUnavailable(backend); reason != "" {
+		writeError(w, http.StatusServiceUnavailable, "backend_unavailable", reason)
+		return
+	}
+
+	resp, err := spawnReviewer(r.Context(), h.deps, projectPath, projectCfg, store, t, variantName)
+	if err != nil {
+		var diffErr *diffsError
+		switch {
+		case errors.Is(err, errReviewerActive):
+			writeError(w, http.StatusConflict, "reviewer_active", err.Error())
+		case errors.As(err, &diffErr):
+			writeError(w, diffErr.status, diffErr.code, diffErr.message)
+		default:
+			writeError(w, http.StatusInternalServerError, "spawn_error", err.Error())
+		}
+		return
+	}
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// Submit handles POST /tickets/{id}/review/submit - records a reviewer
+// agent's verdict and findings and ends its session. On tickets that do not
+// wait in review the verdict also closes the round.
+func (h *ReviewHandlers) Submit(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
+		return
+	}
+
+	t, status, err := store.Get(chi.URLParam(r, "id"))
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+
+	var req SubmitReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	findings := make([]ticket.ReviewComment, 0, len(req.Findings))
+	for i, f := range req.Findings {
+		repo := f.Repo
+		switch {
+		case t.IsMultiRepo() && !slices.Contains(t.Repos, repo):
+			writeError(w, http.StatusBadRequest, "validation_error",
+				fmt.Sprintf("findings[%d].repo must be one of %s for this multi-repo ticket", i, strings.Join(t.Repos, ", ")))
+			return
+		case !t.IsMultiRepo():
+			repo = t.Repo
+		}
+		findings = append(findings, ticket.ReviewComment{
+			Repo:   repo,
+			Commit: f.Commit,
+			File:   f.File,
+			Line:   f.Line,
+			Body:   f.Body,
+		})
+	}
+
+	verdict := ticket.ReviewVerdict(req.Verdict)
+	review, err := store.SubmitVerdict(h.deps.requestActor(r), t.ID, verdict, req.Summary, findings)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	if status != ticket.StatusReview {
+		state := ticket.ReviewAccepted
+		if verdict == ticket.VerdictRequestChanges {
+			state = ticket.ReviewChangesRequested
+		}
+		if review, err = store.FinishReview(t.ID, state, req.Summary); err != nil {
+			handleTicketError(w, err, h.deps.Logger)
+			return
+		}
+	}
+	h.emitReviewUpdated(r, t.ID, review.State)
+
+	if h.deps.SessionManager != nil {
+		sessStore := h.deps.SessionManager.GetStore(projectPath)
+		if sess, sessErr := sessStore.GetReviewer(t.ID); sessErr == nil && sess != nil {
+			if endErr := sessStore.EndReviewer(t.ID); endErr != nil && !storage.IsNotFound(endErr) {
+				h.deps.Logger.Warn("failed to end reviewer session", "ticket", t.ID, "error", endErr)
+			}
+			h.deps.Bus.Emit(events.Event{
+				Type:          events.SessionEnded,
+				ArchitectPath: projectPath,
+				TicketID:      t.ID,
+			})
+			projectCfg, _ := architectconfig.Load(projectPath)
+			if killErr := h.deps.killSessionWindow(projectCfg.GetTmuxSessionName(), sess); killErr != nil {
+				h.deps.Logger.Warn("failed to kill reviewer window", "window", sess.TmuxWindow, "error", killErr)
+			}
+		}
+	}
+
+	h.writeAction(w, store, t.ID, review, "Review submitted with verdict "+string(verdict))
+}
+
+// spawnReviewer spawns a reviewer agent with variantName for the concluded
+// ticket t, opening a review round first when none is open. The reviewer
+// runs on the architect's session backend.
+func spawnReviewer(ctx context.Context, deps *Dependencies, projectPath string, projectCfg *architectconfig.Config, store *ticket.Store, t *ticket.Ticket, variantName string) (*SpawnReviewerResponse, error) {
+	backend, err := resolveBackend("", projectCfg)
+	if err != nil {
+		return nil, err
+	}
+	if reason := deps.backendUnavailable(backend); reason != "" {
+		return nil, errors.New(reason)
+	}
+	var manager spawn.TmuxManagerInterface = deps.TmuxManager
+	if backend == session.BackendHeadless {
+		manager = deps.Headless
+	}
+	var sessStore spawn.SessionStoreInterface
+	if deps.SessionManager != nil {
+		ss := deps.SessionManager.GetStore(projectPath)
+		if sess, err := ss.GetReviewer(t.ID); err == nil && sess != nil {
+			return nil, errReviewerActive
+		}
+		sessStore = ss
+	}
+
+	av, err := projectCfg.ResolveVariant(variantName)
+	if err != nil {
+		return nil, err
+	}
+	diffs, diffErr := ticketDiffs(projectPath, store, t)
+	if diffErr != nil {
+		return nil, diffErr
+	}
+	_, conclusion, err := store.ReadConclusion(t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := store.ReadReview(t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if review.State != ticket.ReviewOpen {
+		if review, err = store.StartReview(t.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	agent := string(av.Agent)
+	if agent == "" {
+		agent = "claude"
+	}
+	spawner := spawn.NewSpawner(spawn.Dependencies{
+		TmuxManager:    manager,
+		SessionStore:   sessStore,
+		SupervisorCtx:  deps.SupervisorCtx,
+		CortexdPath:    deps.CortexdPath,
+		Logger:         deps.Logger,
+		DefaultsDir:    deps.DefaultsDir,
+		HubEventSource: hubEventSource(deps.ReceiverManager),
+		DaemonEndpoint: deps.DaemonEndpoint,
+		IssueToken:     deps.tokenIssuer(),
+		IssueHookToken: deps.hookTokenIssuer(),
+	})
+	result, err := spawner.SpawnReviewer(ctx, spawn.ReviewerSpawnRequest{
+		Ticket:        t,
+		Repo:          diffs.Repos[0].Path,
+		Round:         review.Round,
+		Conclusion:    conclusion,
+		Diffs:         formatReviewDiffs(diffs),
+		ArchitectPath: projectPath,
+		TmuxSession:   projectCfg.GetTmuxSessionName(),
+		Agent:         agent,
+		Variant:       variantName,
+		Backend:       backend,
+		Companion:     projectCfg.Companion,
+		AgentArgs:     av.Args,
+		EnvVars:       av.Env,
+		TicketsDir:    projectCfg.TicketsPath(projectPath),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deps.Bus.Emit(events.Event{
+		Type:          events.SessionStarted,
+		ArchitectPath: projectPath,
+		TicketID:      t.ID,
+	})
+	return &SpawnReviewerResponse{
+		TicketID:    t.ID,
+		Round:       review.Round,
+		TmuxWindow:  result.TmuxWindow,
+		TmuxSession: result.TmuxSession,
+		Variant:     variantName,
+	}, nil
+}
+
+// formatReviewDiffs renders a ticket's diffs as markdown for a reviewer's
+// kickoff prompt: one section per commit with each file's patch.
+func formatReviewDiffs(diffs *DiffsResponse) string {
+	var sb strings.Builder
+	for _, repo := range diffs.Repos {
+		for _, c := range repo.Commits {
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(fmt.Sprintf("### %s %s", storage.ShortID(c.SHA), c.Subject))
+			if len(diffs.Repos) > 1 {
+				sb.WriteString(fmt.Sprintf(" (repo %s)", repo.Repo))
+			}
+			sb.WriteString("\n")
+			for _, f := range c.Files {
+				sb.WriteString(fmt.Sprintf("\n`%s` (%s, +%d -%d)\n", f.Path, f.Status, f.Additions, f.Deletions))
+				switch {
+				case f.IsBinary:
+					sb.WriteString("\nBinary file, no patch.\n")
+				case strings.TrimSpace(f.Patch) != "":
+					sb.WriteString("\n```diff\n")
+					sb.WriteString(strings.TrimRight(f.Patch, "\n"))
+					sb.WriteString("\n```\n")
+				}
+			}
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/headless"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// setupReviewTicket configures a review status and concludes a ticket with
+// a real commit so it waits in review.
+func setupReviewTicket(t *testing.T, ts *unitServer) *ticket.Ticket {
+	t.Helper()
+
+	repoDir, sha := createGitRepoWithCommit(t)
+	content := "name: test\nrepos:\n  test-repo: " + repoDir + `
+statuses:
+  - name: backlog
+  - name: progress
+  - name: review
+  - name: done
+`
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "cortex.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := ts.store.Create("Review Ticket", "body", nil, nil, "test-repo", nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{Content: "done report", Commits: []string{sha}})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	return created
+}
+
+func TestReview_ConcludeEntersReviewAndAccept(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	created := setupReviewTicket(t, ts)
+
+	if _, status, _ := ts.store.Get(created.ID); status != ticket.StatusReview {
+		t.Fatalf("expected status 'review', got %q", status)
+	}
+
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets/"+created.ID+"/review", nil)
+	assertStatus(t, resp, http.StatusOK)
+	review := decode[ReviewResponse](t, resp)
+	_ = resp.Body.Close()
+	if review.State != "open" || review.Round != 1 {
+		t.Fatalf("expected open round 1, got %+v", review)
+	}
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/comments", AddReviewCommentRequest{File: "file.txt", Line: 2, Body: "rename"})
+	assertStatus(t, resp, http.StatusCreated)
+	comment := decode[ReviewCommentResponse](t, resp)
+	_ = resp.Body.Close()
+	if comment.ID != "c1" || comment.Repo != "test-repo" || comment.Round != 1 {
+		t.Errorf("unexpected comment: %+v", comment)
+	}
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/accept", AcceptReviewRequest{Summary: "ship it"})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+	result := decode[ReviewActionResponse](t, resp)
+	if result.Review.State != "accepted" || result.Ticket.Status != "done" {
+		t.Errorf("unexpected accept result: review %q, ticket %q", result.Review.State, result.Ticket.Status)
+	}
+}
+
+func TestReview_RequestChangesValidation(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	created := setupReviewTicket(t, ts)
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/request-changes", RequestChangesRequest{})
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "validation_error" {
+		t.Errorf("expected code 'validation_error', got %q", result.Code)
+	}
+	_ = resp.Body.Close()
+
+	// No session concluded the ticket, so there is no variant to reuse.
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/request-changes", RequestChangesRequest{Summary: "add tests"})
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "variant_required" {
+		t.Errorf("expected code 'variant_required', got %q", result.Code)
+	}
+	_ = resp.Body.Close()
+
+	if _, status, _ := ts.store.Get(created.ID); status != ticket.StatusReview {
+		t.Errorf("expected ticket to stay in review, got %q", status)
+	}
+}
+
+func TestReview_NotInReview(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, _ := ts.store.Create("Backlog Ticket", "body", nil, nil, "", nil, nil, "")
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/accept", AcceptReviewRequest{})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusConflict)
+	if result := decode[ErrorResponse](t, resp); result.Code != "not_in_review" {
+		t.Errorf("expected code 'not_in_review', got %q", result.Code)
+	}
+}
+
+func TestReview_SubmitVerdict(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	created := setupReviewTicket(t, ts)
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/submit", SubmitReviewRequest{Verdict: "maybe"})
+	assertStatus(t, resp, http.StatusBadRequest)
+	_ = resp.Body.Close()
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/submit", SubmitReviewRequest{
+		Verdict:  "request_changes",
+		Summary:  "missing tests",
+		Findings: []AddReviewCommentRequest{{File: "file.txt", Line: 2, Body: "untested"}},
+	})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+	result := decode[ReviewActionResponse](t, resp)
+
+	// The ticket waits in review, so the architect still decides.
+	if result.Review.State != "open" || result.Ticket.Status != "review" {
+		t.Errorf("unexpected state: review %q, ticket %q", result.Review.State, result.Ticket.Status)
+	}
+	if result.Review.Verdict != "request_changes" || result.Review.VerdictSummary != "missing tests" {
+		t.Errorf("unexpected verdict: %+v", result.Review)
+	}
+	if len(result.Review.Comments) != 1 || result.Review.Comments[0].Repo != "test-repo" {
+		t.Errorf("expected one finding on test-repo, got %+v", result.Review.Comments)
+	}
+}
+
+func TestReview_SpawnReviewerNeedsVariant(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	created := setupReviewTicket(t, ts)
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/spawn", SpawnReviewerRequest{})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "variant_required" {
+		t.Errorf("expected code 'variant_required', got %q", result.Code)
+	}
+}
+
+func TestFormatReviewDiffs(t *testing.T) {
+	diffs := &DiffsResponse{Repos: []RepoDiffsResponse{{
+		Repo: "api",
+		Commits: []CommitDiffResponse{{
+			SHA:     "0123456789abcdef",
+			Subject: "Fix redirect",
+			Files: []DiffFileResponse{
+				{Path: "main.go", Status: "modified", Additions: 1, Deletions: 1, Patch: "-old\n+new\n"},
+				{Path: "logo.png", Status: "added", IsBinary: true},
+			},
+		}},
+	}}}
+
+	got := formatReviewDiffs(diffs)
+	want := "### 01234567 Fix redirect\n\n`main.go` (modified, +1 -1)\n\n```diff\n-old\n+new\n```\n\n`logo.png` (added, +0 -0)\n\nBinary file, no patch."
+	if got != want {
+		t.Errorf("formatReviewDiffs() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestReview_SpawnReviewerHeadless(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	created := setupReviewTicket(t, ts)
+
+	configPath := filepath.Join(ts.projectRoot, "cortex.yaml")
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, "session_backend: headless\nagents:\n  fast: {agent: claude}\n"...)
+	if err := os.WriteFile(configPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without tmux or a headless manager there is no backend to run on.
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/spawn", SpawnReviewerRequest{Variant: "fast"})
+	assertStatus(t, resp, http.StatusServiceUnavailable)
+	if result := decode[ErrorResponse](t, resp); result.Code != "backend_unavailable" {
+		t.Errorf("expected code 'backend_unavailable', got %q", result.Code)
+	}
+	_ = resp.Body.Close()
+
+	ts.deps.Headless = headless.NewManager(t.TempDir())
+	ts.deps.CortexdPath = "/bin/true"
+	ts.deps.DefaultsDir = filepath.Join("..", "..", "install", "defaults", "main")
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/review/spawn", SpawnReviewerRequest{Variant: "fast"})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusCreated)
+
+	sess, err := ts.deps.SessionManager.GetStore(ts.projectRoot).GetReviewer(created.ID)
+	if err != nil || sess == nil || !sess.IsHeadless() {
+		t.Fatalf("expected a headless reviewer session, got %+v, %v", sess, err)
+	}
+	cfg, _ := architectconfig.Load(ts.projectRoot)
+	_ = ts.deps.Headless.KillWindow(cfg.GetTmuxSessionName(), sess.TmuxWindow)
+}
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/daemon/config"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/schedule"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// scheduleInterval is how often the scheduler checks for due schedules,
+// the resolution of a cron expression.
+const scheduleInterval = time.Minute
+
+// ScheduleManager creates backlog tickets from the recurring schedules of
+// each registered architect as they fall due, and spawns them when a
+// schedule names a variant.
+type ScheduleManager struct {
+	mu     sync.Mutex // serializes runs, which read and write the state files
+	deps   *Dependencies
+	logger *slog.Logger
+	now    func() time.Time
+
+	// invalid holds, per architect, the errors of the definitions last
+	// reported as invalid, so each is logged once rather than every run.
+	invalid map[string]map[string]string
+}
+
+// NewScheduleManager creates a new ScheduleManager.
+func NewScheduleManager(logger *slog.Logger, deps *Dependencies) *ScheduleManager {
+	return &ScheduleManager{
+		deps:    deps,
+		logger:  logger,
+		now:     time.Now,
+		invalid: make(map[string]map[string]string),
+	}
+}
+
+// Start checks schedules once a minute. Runs until ctx is cancelled.
+func (m *ScheduleManager) Start(ctx context.Context) {
+	if m == nil || m.deps.StoreManager == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(scheduleInterval)
+		defer ticker.Stop()
+		m.RunDue(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				m.RunDue(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// RunDue runs the due schedules of every registered architect.
+func (m *ScheduleManager) RunDue(ctx context.Context) {
+	cfg, err := config.Load()
+	if err != nil {
+		m.logger.Warn("scheduler: failed to load config", "error", err)
+		return
+	}
+	for _, entry := range cfg.Architects {
+		m.runArchitect(ctx, entry.Path)
+	}
+}
+
+// runArchitect runs an architect's due schedules and records their runs.
+func (m *ScheduleManager) runArchitect(ctx context.Context, projectPath string) {
+	projectCfg, err := mergeProjectConfig(projectPath)
+	if err != nil {
+		return
+	}
+	dir := projectCfg.SchedulesPath(projectPath)
+	now := m.now()
+	// Definitions whose tickets the create endpoint would reject are
+	// reported as invalid rather than run.
+	schedules, invalid, err := schedule.List(dir, func(s *schedule.Schedule) error {
+		return checkSchedule(projectCfg, s, now)
+	})
+	if err != nil {
+		m.logger.Warn("scheduler: failed to load schedules", "project", projectPath, "error", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reportInvalid(projectPath, invalid)
+	if len(schedules) == 0 {
+		return
+	}
+	state, err := schedule.LoadState(dir)
+	if err != nil {
+		m.logger.Warn("scheduler: failed to load state", "project", projectPath, "error", err)
+		return
+	}
+
+	ran := false
+	for _, s := range schedules {
+		var last *schedule.Run
+		if run, ok := state[s.Name]; ok {
+			last = &run
+		}
+		if !s.ShouldRun(last, now) {
+			continue
+		}
+		state[s.Name] = m.run(ctx, projectPath, s, now)
+		ran = true
+	}
+	if ran {
+		if err := schedule.SaveState(dir, state); err != nil {
+			m.logger.Warn("scheduler: failed to save state", "project", projectPath, "error", err)
+		}
+	}
+}
+
+// Remove deletes a schedule and its run state, serialized with runs so a
+// run in progress cannot save the state back with the schedule in it.
+func (m *ScheduleManager) Remove(dir, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return schedule.Remove(dir, name)
+}
+
+// reportInvalid logs the invalid schedule definitions of an architect
+// that are new or changed since the last run. Callers hold m.mu.
+func (m *ScheduleManager) reportInvalid(projectPath string, invalid []*schedule.InvalidSchedule) {
+	seen := make(map[string]string, len(invalid))
+	for _, bad := range invalid {
+		msg := bad.Err.Error()
+		seen[bad.Name] = msg
+		if m.invalid[projectPath][bad.Name] != msg {
+			m.logger.Warn("scheduler: skipping invalid schedule", "project", projectPath, "schedule", bad.Name, "error", msg)
+		}
+	}
+	m.invalid[projectPath] = seen
+}
+
+// run creates a schedule's ticket and, if the schedule names a variant,
+// spawns it.
+func (m *ScheduleManager) run(ctx context.Context, projectPath string, s *schedule.Schedule, now time.Time) schedule.Run {
+	run := schedule.Run{Time: now}
+	created, err := m.createTicket(projectPath, s, now)
+	if err != nil {
+		run.Error = err.Error()
+		emitScheduleRun(m.deps, m.logger, projectPath, "", s.Name, PipelineResultFailed, err.Error(), nil)
+		return run
+	}
+	run.TicketID = created.ID
+
+	var extra map[string]any
+	if s.Variant != "" {
+		result, message, spawnExtra := queueSpawn(ctx, m.deps, projectPath,
+			session.QueuedSpawn{TicketID: created.ID, Variant: s.Variant})
+		extra = map[string]any{"spawn_result": result, "spawn_message": message}
+		for k, v := range spawnExtra {
+			extra[k] = v
+		}
+	}
+	emitScheduleRun(m.deps, m.logger, projectPath, created.ID, s.Name, PipelineResultDone, fmt.Sprintf("created %s", created.ID), extra)
+	return run
+}
+
+func (m *ScheduleManager) createTicket(projectPath string, s *schedule.Schedule, now time.Time) (*ticket.Ticket, error) {
+	store, err := m.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := s.Instantiate(now)
+	if err != nil {
+		return nil, err
+	}
+	return store.CreateAs(ticket.Actor{Kind: ticket.ActorScheduler}, "", "", nil, nil, "", nil, nil, "", ticket.WithTemplate(inst))
+}
+
+// emitScheduleRun logs a schedule run and publishes it on the bus.
+func emitScheduleRun(deps *Dependencies, logger *slog.Logger, projectPath, ticketID, name, result, message string, extra map[string]any) {
+	logFn := logger.Info
+	if result == PipelineResultFailed {
+		logFn = logger.Warn
+	}
+	logFn("schedule run", "project", projectPath, "schedule", name, "ticket", ticketID, "result", result, "message", message)
+
+	payload := map[string]any{
+		"schedule": name,
+		"result":   result,
+		"message":  message,
+	}
+	for k, v := range extra {
+		payload[k] = v
+	}
+	deps.Bus.Emit(events.Event{
+		Type:          events.ScheduleRun,
+		ArchitectPath: projectPath,
+		TicketID:      ticketID,
+		Payload:       payload,
+	})
+}
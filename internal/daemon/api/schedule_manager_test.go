@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// nextScheduleRun returns the next schedule_run event on ch.
+func nextScheduleRun(t *testing.T, ch <-chan events.Event) events.Event {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type == events.ScheduleRun {
+				return ev
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for schedule_run event")
+			return events.Event{}
+		}
+	}
+}
+
+func TestScheduler_CreatesDueTicketOnce(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": ts.projectRoot})
+
+	resp := ts.makeRequest(t, http.MethodPost, "/schedules", map[string]any{
+		"name":  "dep-audit",
+		"cron":  "@daily",
+		"title": "Dependency audit {{.date}}",
+		"body":  "Run the audit.",
+		"repo":  "api",
+		"due":   "2d",
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	_ = resp.Body.Close()
+	// A broken definition next to it must not stop it from running.
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "schedules", "broken.md"), []byte("---\ncron: [unclosed\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ch, unsubscribe := ts.deps.Bus.Subscribe(ts.projectRoot)
+	defer unsubscribe()
+	m := NewScheduleManager(ts.deps.Logger, ts.deps)
+	ctx := context.Background()
+
+	// Nothing is due until the first midnight after the schedule was added.
+	m.runArchitect(ctx, ts.projectRoot)
+	if tickets, _ := ts.store.List(ticket.StatusBacklog); len(tickets) != 0 {
+		t.Fatalf("expected no tickets before the first run, got %d", len(tickets))
+	}
+
+	now := time.Now().AddDate(0, 0, 3)
+	m.now = func() time.Time { return now }
+	m.runArchitect(ctx, ts.projectRoot)
+
+	ev := nextScheduleRun(t, ch)
+	payload, _ := ev.Payload.(map[string]any)
+	if payload["result"] != PipelineResultDone || payload["schedule"] != "dep-audit" {
+		t.Fatalf("unexpected payload: %v", payload)
+	}
+	created, _, err := ts.store.Get(ev.TicketID)
+	if err != nil {
+		t.Fatalf("scheduled ticket not found: %v", err)
+	}
+	if created.Title != "Dependency audit "+now.Format(time.DateOnly) || created.Repo != "api" || created.Due == nil {
+		t.Errorf("unexpected ticket: %+v", created)
+	}
+	revisions, _ := ts.store.History(created.ID)
+	if len(revisions) == 0 || revisions[0].Actor.Kind != ticket.ActorScheduler {
+		t.Errorf("expected creation by scheduler actor, got %+v", revisions)
+	}
+
+	// Missed runs are made up once, then the schedule waits for the next.
+	m.runArchitect(ctx, ts.projectRoot)
+	if tickets, _ := ts.store.List(ticket.StatusBacklog); len(tickets) != 1 {
+		t.Errorf("expected 1 backlog ticket, got %d", len(tickets))
+	}
+
+	list := ts.makeRequest(t, http.MethodGet, "/schedules", nil)
+	defer func() { _ = list.Body.Close() }()
+	assertStatus(t, list, http.StatusOK)
+	result := decode[ListSchedulesResponse](t, list)
+	if len(result.Schedules) != 1 || result.Schedules[0].LastTicketID != created.ID || result.Schedules[0].NextRun == nil {
+		t.Errorf("unexpected schedules: %+v", result.Schedules)
+	}
+	if len(result.Invalid) != 1 || result.Invalid[0].Name != "broken" || result.Invalid[0].Error == "" {
+		t.Errorf("expected the broken schedule reported as invalid, got %+v", result.Invalid)
+	}
+}
+
+func TestScheduler_CreateValidation(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": ts.projectRoot})
+
+	tests := []struct {
+		name string
+		body map[string]any
+		code string
+	}{
+		{"bad cron", map[string]any{"name": "a", "cron": "weekly", "title": "x", "repo": "api"}, "validation_error"},
+		{"missing repo", map[string]any{"name": "a", "cron": "@weekly", "title": "x"}, "missing_repo"},
+		{"unknown repo", map[string]any{"name": "a", "cron": "@weekly", "title": "x", "repo": "web"}, "invalid_repo"},
+		{"unknown variant", map[string]any{"name": "a", "cron": "@weekly", "title": "x", "repo": "api", "variant": "nope"}, "validation_error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := ts.makeRequest(t, http.MethodPost, "/schedules", tt.body)
+			defer func() { _ = resp.Body.Close() }()
+			assertStatus(t, resp, http.StatusBadRequest)
+			if got := decode[ErrorResponse](t, resp); got.Code != tt.code {
+				t.Errorf("expected code %q, got %q (%s)", tt.code, got.Code, got.Error)
+			}
+		})
+	}
+
+	resp := ts.makeRequest(t, http.MethodDelete, "/schedules/missing", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusNotFound)
+	if got := decode[ErrorResponse](t, resp); !strings.Contains(got.Error, "missing") {
+		t.Errorf("unexpected error: %+v", got)
+	}
+}
+
+func TestScheduler_SkipsDefinitionsTheAPIWouldReject(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": ts.projectRoot})
+
+	// Hand-written definitions skip the checks of POST /schedules.
+	dir := filepath.Join(ts.projectRoot, "schedules")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"no-repo.md":      "---\ncron: \"@daily\"\ntitle: No repo\n---\n",
+		"unknown-repo.md": "---\ncron: \"@daily\"\ntitle: Unknown repo\nrepo: web\n---\n",
+		"unknown-type.md": "---\ncron: \"@daily\"\ntitle: Unknown type\nrepo: api\ntype: nope\n---\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := NewScheduleManager(ts.deps.Logger, ts.deps)
+	m.now = func() time.Time { return time.Now().AddDate(0, 0, 3) }
+	m.runArchitect(context.Background(), ts.projectRoot)
+	if tickets, _ := ts.store.List(ticket.StatusBacklog); len(tickets) != 0 {
+		t.Errorf("expected no tickets from invalid schedules, got %d", len(tickets))
+	}
+
+	list := ts.makeRequest(t, http.MethodGet, "/schedules", nil)
+	defer func() { _ = list.Body.Close() }()
+	assertStatus(t, list, http.StatusOK)
+	result := decode[ListSchedulesResponse](t, list)
+	if len(result.Schedules) != 0 || len(result.Invalid) != len(files) {
+		t.Errorf("expected all schedules reported as invalid, got %+v", result)
+	}
+}
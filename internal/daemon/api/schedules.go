@@ -0,0 +1,177 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/schedule"
+)
+
+// ScheduleHandlers serves the recurring ticket schedules of an architect.
+type ScheduleHandlers struct {
+	deps *Dependencies
+}
+
+// NewScheduleHandlers creates a new ScheduleHandlers with the given dependencies.
+func NewScheduleHandlers(deps *Dependencies) *ScheduleHandlers {
+	return &ScheduleHandlers{deps: deps}
+}
+
+// List handles GET /schedules - lists the schedules under schedules/ with
+// their last and next runs.
+func (h *ScheduleHandlers) List(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+
+	projectCfg, err := mergeProjectConfig(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
+		return
+	}
+
+	dir := projectCfg.SchedulesPath(projectPath)
+	now := time.Now()
+	schedules, invalid, err := schedule.List(dir, func(s *schedule.Schedule) error {
+		return checkSchedule(projectCfg, s, now)
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "schedule_error", err.Error())
+		return
+	}
+	state, err := schedule.LoadState(dir)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "schedule_error", err.Error())
+		return
+	}
+
+	resp := ListSchedulesResponse{Schedules: []ScheduleResponse{}, Dir: dir}
+	for _, s := range schedules {
+		var last *schedule.Run
+		if run, ok := state[s.Name]; ok {
+			last = &run
+		}
+		resp.Schedules = append(resp.Schedules, toScheduleResponse(s, last, now))
+	}
+	for _, bad := range invalid {
+		resp.Invalid = append(resp.Invalid, InvalidScheduleResponse{Name: bad.Name, Error: bad.Err.Error()})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Create handles POST /schedules - writes a new schedule definition.
+func (h *ScheduleHandlers) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	projectPath := GetArchitectPath(r.Context())
+	projectCfg, err := mergeProjectConfig(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
+		return
+	}
+
+	s := &schedule.Schedule{
+		Name: req.Name,
+		Meta: schedule.Meta{
+			Cron:       req.Cron,
+			Title:      req.Title,
+			Type:       req.Type,
+			Repo:       req.Repo,
+			References: req.References,
+			Due:        req.Due,
+			Variant:    req.Variant,
+			Paused:     req.Paused,
+		},
+		Body: req.Body,
+	}
+	if err := s.Validate(); err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	if err := checkSchedule(projectCfg, s, time.Now()); err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+
+	if err := schedule.Create(projectCfg.SchedulesPath(projectPath), s); err != nil {
+		if errors.Is(err, schedule.ErrExists) {
+			writeError(w, http.StatusConflict, "schedule_exists", err.Error())
+			return
+		}
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toScheduleResponse(s, nil, time.Now()))
+}
+
+// Delete handles DELETE /schedules/{name} - removes a schedule. Tickets it
+// already created are kept.
+func (h *ScheduleHandlers) Delete(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+
+	projectCfg, err := architectconfig.Load(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
+		return
+	}
+
+	if err := h.deps.Scheduler.Remove(projectCfg.SchedulesPath(projectPath), chi.URLParam(r, "name")); err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkSchedule checks the ticket a run of s would create as the create
+// endpoint would, along with the variant it spawns.
+func checkSchedule(projectCfg *architectconfig.Config, s *schedule.Schedule, now time.Time) error {
+	inst, err := s.Instantiate(now)
+	if err != nil {
+		return err
+	}
+	var repos []string
+	if inst.Repo != "" {
+		repos = []string{inst.Repo}
+	}
+	if err := checkNewTicket(projectCfg, inst, repos); err != nil {
+		return err
+	}
+	if s.Variant != "" {
+		if _, err := projectCfg.ResolveVariant(s.Variant); err != nil {
+			return &ticketCheckError{code: "validation_error", msg: err.Error()}
+		}
+	}
+	return nil
+}
+
+func toScheduleResponse(s *schedule.Schedule, last *schedule.Run, now time.Time) ScheduleResponse {
+	resp := ScheduleResponse{
+		Name:       s.Name,
+		Cron:       s.Cron,
+		Title:      s.Title,
+		Type:       s.Type,
+		Repo:       s.Repo,
+		References: s.References,
+		Due:        s.Due,
+		Variant:    s.Variant,
+		Paused:     s.Paused,
+		Body:       s.Body,
+	}
+	if last != nil {
+		resp.LastRun = &last.Time
+		resp.LastTicketID = last.TicketID
+		resp.LastError = last.Error
+	}
+	if !s.Paused {
+		if next := s.Next(now); !next.IsZero() {
+			resp.NextRun = &next
+		}
+	}
+	return resp
+}
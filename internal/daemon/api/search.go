@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kareemaly/cortex/internal/search"
+	"github.com/kareemaly/cortex/internal/types"
+)
+
+// defaultSearchLimit is used when the request does not set a limit.
+const defaultSearchLimit = 20
+
+// SearchHandlers serves full-text search over the architect workspace.
+type SearchHandlers struct {
+	deps *Dependencies
+}
+
+// NewSearchHandlers creates a new SearchHandlers with the given dependencies.
+func NewSearchHandlers(deps *Dependencies) *SearchHandlers {
+	return &SearchHandlers{deps: deps}
+}
+
+// Search handles GET /search?q=...&limit=N.
+// The query supports repo:, status:, type:, after:, before: and
+// updated:FROM..TO filters alongside free text.
+func (h *SearchHandlers) Search(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+
+	if h.deps.SearchManager == nil {
+		writeError(w, http.StatusServiceUnavailable, "search_unavailable", "search index is not available")
+		return
+	}
+
+	q, err := search.ParseQuery(r.URL.Query().Get("q"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_query", err.Error())
+		return
+	}
+
+	limit := defaultSearchLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "validation_error", "limit must be a positive integer")
+			return
+		}
+		limit = n
+	}
+
+	idx, err := h.deps.SearchManager.Index(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "search_error", err.Error())
+		return
+	}
+
+	results, total := idx.Search(q, limit)
+	resp := types.SearchResponse{
+		Results: make([]types.SearchResult, len(results)),
+		Total:   total,
+	}
+	for i, res := range results {
+		resp.Results[i] = types.SearchResult{
+			Kind:     string(res.Kind),
+			ID:       res.ID,
+			Title:    res.Title,
+			Snippet:  res.Snippet,
+			Score:    res.Score,
+			Repo:     res.Repo,
+			Status:   res.Status,
+			Type:     res.Type,
+			TicketID: res.TicketID,
+			Path:     res.Path,
+			Updated:  res.Updated,
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
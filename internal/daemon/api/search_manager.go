@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/prompt"
+	"github.com/kareemaly/cortex/internal/search"
+)
+
+// searchRefreshInterval bounds how stale the index can get for files edited
+// outside the daemon (notes, hand-edited tickets), which emit no events.
+const searchRefreshInterval = 2 * time.Second
+
+type searchEntry struct {
+	mu       sync.Mutex
+	index    *search.Index
+	syncer   *search.Syncer
+	dirty    bool
+	lastSync time.Time
+}
+
+// SearchManager maintains a full-text index per architect. Indexes are built
+// on first use, marked dirty by bus events, and re-synced incrementally
+// before a query when dirty or older than searchRefreshInterval.
+type SearchManager struct {
+	mu      sync.Mutex
+	entries map[string]*searchEntry
+	logger  *slog.Logger
+	bus     *events.Bus
+}
+
+// NewSearchManager creates a new SearchManager.
+func NewSearchManager(logger *slog.Logger, bus *events.Bus) *SearchManager {
+	return &SearchManager{
+		entries: make(map[string]*searchEntry),
+		logger:  logger,
+		bus:     bus,
+	}
+}
+
+// StartEventLoop marks indexes dirty as tickets, sessions and conclusions
+// change. Runs until ctx is cancelled.
+func (m *SearchManager) StartEventLoop(ctx context.Context) {
+	if m == nil || m.bus == nil {
+		return
+	}
+	ch, unsubscribe := m.bus.Subscribe("")
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case ev, ok := <-ch:
+				if !ok {
+					return
+				}
+				if ev.Type == events.ResyncRequired {
+					// Events were dropped; any architect may be stale.
+					m.markAllDirty()
+					continue
+				}
+				m.markDirty(ev.ArchitectPath)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (m *SearchManager) markDirty(architectPath string) {
+	m.mu.Lock()
+	entry, ok := m.entries[filepath.Clean(architectPath)]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+	entry.mu.Lock()
+	entry.dirty = true
+	entry.mu.Unlock()
+}
+
+func (m *SearchManager) markAllDirty() {
+	m.mu.Lock()
+	entries := make([]*searchEntry, 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, entry)
+	}
+	m.mu.Unlock()
+	for _, entry := range entries {
+		entry.mu.Lock()
+		entry.dirty = true
+		entry.mu.Unlock()
+	}
+}
+
+// Index returns the up-to-date search index for an architect.
+func (m *SearchManager) Index(architectPath string) (*search.Index, error) {
+	architectPath = filepath.Clean(architectPath)
+
+	m.mu.Lock()
+	entry, ok := m.entries[architectPath]
+	if !ok {
+		if _, err := os.Stat(architectPath); err != nil {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("project path not found: %w", err)
+		}
+		entry = newSearchEntry(architectPath)
+		m.entries[architectPath] = entry
+	}
+	m.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.dirty || entry.lastSync.IsZero() || time.Since(entry.lastSync) >= searchRefreshInterval {
+		start := time.Now()
+		if err := entry.syncer.Sync(); err != nil {
+			return nil, fmt.Errorf("sync search index: %w", err)
+		}
+		entry.dirty = false
+		entry.lastSync = time.Now()
+		m.logger.Debug("synced search index", "project", architectPath, "documents", entry.index.Len(), "took", time.Since(start))
+	}
+	return entry.index, nil
+}
+
+func newSearchEntry(architectPath string) *searchEntry {
+	cfg, err := architectconfig.Load(architectPath)
+	if err != nil {
+		cfg = architectconfig.DefaultConfig()
+	}
+
+	idx := search.NewIndex()
+	return &searchEntry{
+		index: idx,
+		syncer: search.NewSyncer(search.Workspace{
+			Root:       architectPath,
+			TicketsDir: cfg.TicketsPath(architectPath),
+			SkipDirs: []string{
+				prompt.PromptsDir(architectPath),
+				cfg.SessionsPath(architectPath),
+				cfg.WorktreesPath(architectPath),
+				cfg.TemplatesPath(architectPath),
+				cfg.SchedulesPath(architectPath),
+			},
+		}, idx),
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+func TestSearch_RanksAndFilters(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	login, _ := ts.store.Create("Fix login redirect", "Login loops back to /auth.", nil, nil, "", nil, nil, "")
+	other, _ := ts.store.Create("Cache headers", "Unrelated to login flow.", nil, nil, "", nil, nil, "")
+	meta := &ticket.TicketConclusionMeta{
+		StartedAt:   time.Now().UTC().Add(-time.Minute),
+		ConcludedAt: time.Now().UTC(),
+		Agent:       "claude",
+	}
+	if err := ts.store.WriteConclusion(login.ID, meta, "Dropped the stale login cookie."); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "notes.md"), []byte("# Auth notes\nlogin ideas\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := ts.makeRequest(t, http.MethodGet, "/search?q="+url.QueryEscape("login"), nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[SearchResponse](t, resp)
+	if result.Total != 4 {
+		t.Fatalf("total = %d, want 4", result.Total)
+	}
+	rank := map[string]int{}
+	for i, r := range result.Results {
+		rank[r.Kind+"/"+r.ID] = i
+	}
+	if rank["ticket/"+login.ID] > rank["ticket/"+other.ID] {
+		t.Errorf("title match should outrank body-only match: %+v", result.Results)
+	}
+
+	resp2 := ts.makeRequest(t, http.MethodGet, "/search?q="+url.QueryEscape("login type:conclusion"), nil)
+	defer func() { _ = resp2.Body.Close() }()
+	assertStatus(t, resp2, http.StatusOK)
+
+	filtered := decode[SearchResponse](t, resp2)
+	if filtered.Total != 1 || filtered.Results[0].TicketID != login.ID {
+		t.Fatalf("unexpected conclusion results: %+v", filtered.Results)
+	}
+	if filtered.Results[0].Snippet != "Dropped the stale **login** cookie." {
+		t.Errorf("snippet = %q", filtered.Results[0].Snippet)
+	}
+}
+
+func TestSearch_SkipsDefinitions(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	definitions := map[string]string{
+		"templates/bug.md":          "---\ntitle: Fix {{.component}} crash\n---\nThe crash reproduces every time.\n",
+		"schedules/crash-triage.md": "---\ncron: \"@daily\"\ntitle: Crash triage {{.date}}\n---\nSort the crash reports.\n",
+	}
+	for name, content := range definitions {
+		path := filepath.Join(ts.projectRoot, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp := ts.makeRequest(t, http.MethodGet, "/search?q="+url.QueryEscape("crash"), nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	if result := decode[SearchResponse](t, resp); result.Total != 0 {
+		t.Errorf("definitions should not be indexed as notes: %+v", result.Results)
+	}
+}
+
+func TestSearch_InvalidQuery(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	resp := ts.makeRequest(t, http.MethodGet, "/search?q="+url.QueryEscape("after:soon"), nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusBadRequest)
+}
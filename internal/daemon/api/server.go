@@ -2,18 +2,24 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/kareemaly/cortex/internal/daemon/auth"
 )
 
 // Server represents the HTTP server for the daemon.
 type Server struct {
 	httpServer *http.Server
+	socketPath string
 	logger     *slog.Logger
 }
 
@@ -26,30 +32,36 @@ func NewRouter(deps *Dependencies, logger *slog.Logger) chi.Router {
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Recoverer)
 	r.Use(RequestLogger(logger))
+	r.Use(Authenticate(deps.Auth))
 
 	// Global endpoints (no project required)
 	r.Get("/health", HealthHandler())
-	r.Get("/architects", ArchitectsHandler(deps.StoreManager))
-	r.Post("/architects", RegisterArchitectHandler())
-	r.Delete("/architects", UnlinkArchitectHandler())
-	// Global config routes (no project header required)
 	configHandlers := NewConfigHandlers(deps)
-	r.Get("/config/global", configHandlers.ReadGlobalConfig)
-	r.Put("/config/global", configHandlers.UpdateGlobalConfig)
+	r.Group(func(r chi.Router) {
+		r.Use(GlobalAccess())
+
+		r.Get("/architects", ArchitectsHandler(deps.StoreManager))
+		r.Post("/architects", RegisterArchitectHandler())
+		r.Delete("/architects", UnlinkArchitectHandler())
+		// Global config routes (no project header required). settings.yaml
+		// holds the API tokens, so read-only tokens cannot see it.
+		r.With(RequireRole(auth.RoleArchitect)).Get("/config/global", configHandlers.ReadGlobalConfig)
+		r.Put("/config/global", configHandlers.UpdateGlobalConfig)
 
-	// Daemon logs and status (global)
-	logsHandlers := NewLogsHandlers(deps)
-	r.Get("/daemon/logs", logsHandlers.ReadDaemonLogs)
-	r.Get("/daemon/status", logsHandlers.DaemonStatus)
+		// Daemon logs and status (global)
+		logsHandlers := NewLogsHandlers(deps)
+		r.Get("/daemon/logs", logsHandlers.ReadDaemonLogs)
+		r.Get("/daemon/status", logsHandlers.DaemonStatus)
 
-	// Agent status telemetry — global (no project scope) so one call
-	// covers every architect's pattern counters and observer metrics.
-	globalAgentHandlers := NewAgentHandlers(deps)
-	r.Get("/agent/status/debug", globalAgentHandlers.DebugStatus)
+		// Agent status telemetry — global (no project scope) so one call
+		// covers every architect's pattern counters and observer metrics.
+		globalAgentHandlers := NewAgentHandlers(deps)
+		r.Get("/agent/status/debug", globalAgentHandlers.DebugStatus)
 
-	// Hook ingestion endpoint (global)
-	hookHandlers := NewHookHandlers(deps)
-	r.Post("/hook/{agent}", hookHandlers.IngestHook)
+		// Hook ingestion endpoint (global)
+		hookHandlers := NewHookHandlers(deps)
+		r.With(RequireRole(auth.RoleHook, auth.RoleArchitect)).Post("/hook/{agent}", hookHandlers.IngestHook)
+	})
 
 	// Project-scoped routes
 	r.Group(func(r chi.Router) {
@@ -58,6 +70,7 @@ func NewRouter(deps *Dependencies, logger *slog.Logger) chi.Router {
 		// SSE event stream
 		eventHandlers := NewEventHandlers(deps)
 		r.Get("/events", eventHandlers.Stream)
+		r.Get("/events/history", eventHandlers.History)
 
 		// Conclusion routes (declared before ticket routes so it can be referenced in ticket route group)
 		conclusionHandlers := NewConclusionHandlers(deps)
@@ -67,18 +80,36 @@ func NewRouter(deps *Dependencies, logger *slog.Logger) chi.Router {
 			r.Post("/{id}/show", conclusionHandlers.Show)
 		})
 
+		// Full-text search
+		searchHandlers := NewSearchHandlers(deps)
+		r.Get("/search", searchHandlers.Search)
+
 		// Ticket routes
 		ticketHandlers := NewTicketHandlers(deps)
+		reviewHandlers := NewReviewHandlers(deps)
 		r.Route("/tickets", func(r chi.Router) {
 			r.Get("/", ticketHandlers.ListAll)
 			r.Post("/", ticketHandlers.Create)
 			r.Get("/by-id/{id}", ticketHandlers.GetByID)
 			r.Get("/{id}/diffs", ticketHandlers.GetDiffs)
+			r.Get("/{id}/graph", ticketHandlers.Graph)
+			r.Get("/{id}/history", ticketHandlers.History)
+			r.Get("/{id}/review", reviewHandlers.Get)
+			r.Group(func(r chi.Router) {
+				// Workers may change their own ticket, but not review it.
+				r.Use(RequireRole(auth.RoleArchitect))
+				r.Post("/{id}/review/comments", reviewHandlers.AddComment)
+				r.Post("/{id}/review/accept", reviewHandlers.Accept)
+				r.Post("/{id}/review/request-changes", reviewHandlers.RequestChanges)
+				r.Post("/{id}/review/spawn", reviewHandlers.SpawnReviewer)
+			})
+			r.With(RequireRole(auth.RoleArchitect, auth.RoleReviewer)).Post("/{id}/review/submit", reviewHandlers.Submit)
 			r.Get("/{status}", ticketHandlers.ListByStatus)
 			r.Get("/{status}/{id}", ticketHandlers.Get)
 			r.Put("/{status}/{id}", ticketHandlers.Update)
 			r.Delete("/{status}/{id}", ticketHandlers.Delete)
 			r.Patch("/{id}/body", ticketHandlers.EditBody)
+			r.Post("/{id}/criteria/{index}", ticketHandlers.CheckCriterion)
 			r.Post("/{status}/{id}/move", ticketHandlers.Move)
 			r.Post("/{status}/{id}/spawn", ticketHandlers.Spawn)
 			r.Post("/{id}/focus", ticketHandlers.Focus)
@@ -87,6 +118,19 @@ func NewRouter(deps *Dependencies, logger *slog.Logger) chi.Router {
 			r.Post("/{id}/edit", ticketHandlers.Edit)
 			r.Patch("/{id}/due-date", ticketHandlers.SetDueDate)
 			r.Delete("/{id}/due-date", ticketHandlers.ClearDueDate)
+			r.Post("/{id}/restore", ticketHandlers.RestoreBody)
+		})
+
+		// Ticket template routes
+		templateHandlers := NewTemplateHandlers(deps)
+		r.Get("/templates", templateHandlers.List)
+
+		// Recurring ticket schedule routes
+		scheduleHandlers := NewScheduleHandlers(deps)
+		r.Route("/schedules", func(r chi.Router) {
+			r.Get("/", scheduleHandlers.List)
+			r.With(RequireRole(auth.RoleArchitect)).Post("/", scheduleHandlers.Create)
+			r.With(RequireRole(auth.RoleArchitect)).Delete("/{name}", scheduleHandlers.Delete)
 		})
 
 		// Architect routes
@@ -102,8 +146,11 @@ func NewRouter(deps *Dependencies, logger *slog.Logger) chi.Router {
 		sessionHandlers := NewSessionHandlers(deps)
 		r.Route("/sessions", func(r chi.Router) {
 			r.Get("/", sessionHandlers.List)
+			r.Delete("/queue/{ticket_id}", sessionHandlers.CancelQueued)
 			r.Delete("/{id}", sessionHandlers.Kill)
 			r.Post("/{id}/approve", sessionHandlers.Approve)
+			r.Get("/{id}/timeline", sessionHandlers.Timeline)
+			r.Get("/{id}/log", sessionHandlers.Log)
 		})
 
 		// Agent routes
@@ -140,20 +187,25 @@ func NewRouter(deps *Dependencies, logger *slog.Logger) chi.Router {
 	return r
 }
 
-// NewServer creates a new Server with the given configuration.
-func NewServer(port int, bindAddress string, logger *slog.Logger, deps *Dependencies) *Server {
+// NewServer creates a Server listening on TCP at bindAddress:port and, when
+// socketPath is set, on that Unix socket. An empty bindAddress serves the
+// socket only.
+func NewServer(port int, bindAddress, socketPath string, logger *slog.Logger, deps *Dependencies) *Server {
 	r := NewRouter(deps, logger)
 
 	httpServer := &http.Server{
-		Addr:         fmt.Sprintf("%s:%d", bindAddress, port),
 		Handler:      r,
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 0, // No timeout for SSE support
 		IdleTimeout:  60 * time.Second,
 	}
+	if bindAddress != "" {
+		httpServer.Addr = fmt.Sprintf("%s:%d", bindAddress, port)
+	}
 
 	return &Server{
 		httpServer: httpServer,
+		socketPath: socketPath,
 		logger:     logger,
 	}
 }
@@ -161,18 +213,27 @@ func NewServer(port int, bindAddress string, logger *slog.Logger, deps *Dependen
 // Run starts the server and blocks until the context is cancelled.
 // It performs graceful shutdown when the context is done.
 func (s *Server) Run(ctx context.Context) error {
-	errCh := make(chan error, 1)
+	listeners, err := s.listen()
+	if err != nil {
+		return err
+	}
+	if s.socketPath != "" {
+		defer func() { _ = os.Remove(s.socketPath) }()
+	}
 
-	go func() {
-		s.logger.Info("starting server", "addr", s.httpServer.Addr)
-		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- err
-		}
-		close(errCh)
-	}()
+	errCh := make(chan error, len(listeners))
+	for _, ln := range listeners {
+		go func(ln net.Listener) {
+			s.logger.Info("starting server", "addr", ln.Addr().String())
+			if err := s.httpServer.Serve(ln); err != nil && err != http.ErrServerClosed {
+				errCh <- err
+			}
+		}(ln)
+	}
 
 	select {
 	case err := <-errCh:
+		_ = s.httpServer.Close()
 		return err
 	case <-ctx.Done():
 		s.logger.Info("shutting down...")
@@ -188,3 +249,74 @@ func (s *Server) Run(ctx context.Context) error {
 		return nil
 	}
 }
+
+// listen opens the TCP listener and the Unix socket, whichever are
+// configured.
+func (s *Server) listen() ([]net.Listener, error) {
+	var listeners []net.Listener
+	if s.httpServer.Addr != "" {
+		ln, err := net.Listen("tcp", s.httpServer.Addr)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, ln)
+	}
+	if s.socketPath != "" {
+		ln, err := listenUnix(s.socketPath)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, ln)
+	}
+	if len(listeners) == 0 {
+		return nil, errors.New("no listen address or socket configured")
+	}
+	return listeners, nil
+}
+
+// listenUnix listens on a Unix socket only the current user can connect
+// to. A socket file left behind by a daemon that is no longer running is
+// replaced; one that still accepts connections is an error.
+func listenUnix(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("create socket directory: %w", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		if conn, err := net.Dial("unix", path); err == nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("socket %s is in use by another daemon", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	}
+
+	// net.Listen creates the socket with the umask's permissions. Bind it
+	// in a private directory first, restrict it there and only then move
+	// it into place, so no other user can connect in between.
+	private, err := os.MkdirTemp(filepath.Dir(path), ".sock")
+	if err != nil {
+		return nil, fmt.Errorf("create socket directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(private) }()
+
+	tmp := filepath.Join(private, "s")
+	ln, err := net.Listen("unix", tmp)
+	if err != nil {
+		return nil, err
+	}
+	// The bound path is about to move; Run removes the socket on shutdown.
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+	if err := os.Chmod(tmp, 0600); err != nil {
+		_ = ln.Close()
+		return nil, fmt.Errorf("restrict socket permissions: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = ln.Close()
+		return nil, fmt.Errorf("move socket into place: %w", err)
+	}
+	return ln, nil
+}
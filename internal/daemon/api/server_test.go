@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+)
+
+func TestServer_UnixSocketOnly(t *testing.T) {
+	ts := setupUnitServer(t)
+	ts.Close()
+
+	socket := filepath.Join(t.TempDir(), "cortexd.sock")
+	// A stale socket file from a crashed daemon is replaced.
+	if err := os.WriteFile(socket, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- NewServer(0, "", socket, ts.deps.Logger, ts.deps).Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Run: %v", err)
+		}
+		if _, err := os.Stat(socket); !os.IsNotExist(err) {
+			t.Errorf("socket not removed on shutdown: %v", err)
+		}
+	})
+
+	client := sdk.NewClient(daemonconfig.UnixURLScheme+socket, ts.projectRoot)
+	deadline := time.Now().Add(2 * time.Second)
+	for client.Health() != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("daemon never became healthy over the socket")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	info, err := os.Stat(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("socket mode = %o, want 600", perm)
+	}
+	if entries, err := os.ReadDir(filepath.Dir(socket)); err != nil || len(entries) != 1 {
+		t.Errorf("socket directory = %v (%v), want only the socket", entries, err)
+	}
+	if _, err := client.ListAllTickets("", nil); err != nil {
+		t.Errorf("ListAllTickets over socket: %v", err)
+	}
+
+	// A second daemon must not steal a live socket.
+	if err := NewServer(0, "", socket, ts.deps.Logger, ts.deps).Run(context.Background()); err == nil {
+		t.Error("expected error for a socket already in use")
+	}
+}
@@ -12,6 +12,7 @@ import (
 type SessionManager struct {
 	mu     sync.RWMutex
 	stores map[string]*session.Store
+	queues map[string]*session.Queue
 	logger *slog.Logger
 }
 
@@ -19,6 +20,7 @@ type SessionManager struct {
 func NewSessionManager(logger *slog.Logger) *SessionManager {
 	return &SessionManager{
 		stores: make(map[string]*session.Store),
+		queues: make(map[string]*session.Queue),
 		logger: logger,
 	}
 }
@@ -43,6 +45,24 @@ func (m *SessionManager) TotalSessionCount() int {
 	return total
 }
 
+// FindBySessionID looks a session up by its UUID across every known
+// architect and returns it with the architect path it belongs to.
+func (m *SessionManager) FindBySessionID(sessionID string) (string, *session.Session, bool) {
+	m.mu.RLock()
+	stores := make(map[string]*session.Store, len(m.stores))
+	for path, s := range m.stores {
+		stores[path] = s
+	}
+	m.mu.RUnlock()
+
+	for path, s := range stores {
+		if sess, err := s.GetBySessionID(sessionID); err == nil && sess != nil {
+			return path, sess, true
+		}
+	}
+	return "", nil, false
+}
+
 // GetStore returns the session store for the given project path.
 // Creates a new store if one doesn't exist for the path.
 func (m *SessionManager) GetStore(projectPath string) *session.Store {
@@ -75,3 +95,45 @@ func (m *SessionManager) GetStore(projectPath string) *session.Store {
 
 	return store
 }
+
+// Stores returns the session stores of every architect seen so far, keyed
+// by architect path.
+func (m *SessionManager) Stores() map[string]*session.Store {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	stores := make(map[string]*session.Store, len(m.stores))
+	for path, s := range m.stores {
+		stores[path] = s
+	}
+	return stores
+}
+
+// GetQueue returns the spawn queue for the given project path.
+// Creates a new queue if one doesn't exist for the path.
+func (m *SessionManager) GetQueue(projectPath string) *session.Queue {
+	projectPath = filepath.Clean(projectPath)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if q, exists := m.queues[projectPath]; exists {
+		return q
+	}
+
+	// Spawn queue path: {projectPath}/.spawn-queue.json, next to the sessions
+	q := session.NewQueue(filepath.Join(projectPath, ".spawn-queue.json"))
+	m.queues[projectPath] = q
+	return q
+}
+
+// Queues returns the spawn queues of every architect seen so far, keyed by
+// architect path.
+func (m *SessionManager) Queues() map[string]*session.Queue {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	queues := make(map[string]*session.Queue, len(m.queues))
+	for path, q := range m.queues {
+		queues[path] = q
+	}
+	return queues
+}
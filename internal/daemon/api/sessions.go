@@ -2,11 +2,13 @@ package api
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/headless"
 	"github.com/kareemaly/cortex/internal/prompt"
 	"github.com/kareemaly/cortex/internal/session"
 	"github.com/kareemaly/cortex/internal/ticket"
@@ -23,7 +25,8 @@ func NewSessionHandlers(deps *Dependencies) *SessionHandlers {
 	return &SessionHandlers{deps: deps}
 }
 
-// List handles GET /sessions - lists all active sessions.
+// List handles GET /sessions - lists all active sessions, followed by the
+// spawns waiting in the architect's queue with status "queued".
 func (h *SessionHandlers) List(w http.ResponseWriter, r *http.Request) {
 	projectPath := GetArchitectPath(r.Context())
 
@@ -41,6 +44,8 @@ func (h *SessionHandlers) List(w http.ResponseWriter, r *http.Request) {
 
 	// Resolve ticket titles
 	ticketStore, _ := h.deps.StoreManager.GetStore(projectPath)
+	projectCfg, _ := architectconfig.Load(projectPath)
+	tmuxSession := projectCfg.GetTmuxSessionName()
 
 	type sessionListItem struct {
 		SessionID   string    `json:"session_id"`
@@ -48,10 +53,18 @@ func (h *SessionHandlers) List(w http.ResponseWriter, r *http.Request) {
 		TicketID    string    `json:"ticket_id"`
 		TicketTitle string    `json:"ticket_title"`
 		Agent       string    `json:"agent"`
+		Variant     string    `json:"variant,omitempty"`
 		TmuxWindow  string    `json:"tmux_window"`
 		StartedAt   time.Time `json:"started_at"`
 		Status      string    `json:"status"`
 		Tool        *string   `json:"tool,omitempty"`
+		Worktree    string    `json:"worktree_path,omitempty"`
+		Backend     string    `json:"backend,omitempty"`
+		LogPath     string    `json:"log_path,omitempty"`
+
+		QueuePosition int        `json:"queue_position,omitempty"`
+		QueueReason   string     `json:"queue_reason,omitempty"`
+		QueuedAt      *time.Time `json:"queued_at,omitempty"`
 	}
 
 	items := make([]sessionListItem, 0, len(sessions))
@@ -73,6 +86,14 @@ func (h *SessionHandlers) List(w http.ResponseWriter, r *http.Request) {
 			} else {
 				title = "Collab"
 			}
+		case session.SessionTypeReviewer:
+			sessionType = "reviewer"
+			title = "Review"
+			if ticketStore != nil {
+				if t, _, err := ticketStore.Get(sess.TicketID); err == nil {
+					title = "Review: " + t.Title
+				}
+			}
 		default:
 			if ticketStore != nil {
 				if t, _, err := ticketStore.Get(sess.TicketID); err == nil {
@@ -86,10 +107,16 @@ func (h *SessionHandlers) List(w http.ResponseWriter, r *http.Request) {
 			TicketID:    sess.TicketID,
 			TicketTitle: title,
 			Agent:       sess.Agent,
+			Variant:     sess.Variant,
 			TmuxWindow:  sess.TmuxWindow,
 			StartedAt:   sess.StartedAt,
 			Status:      string(sess.Status),
 			Tool:        sess.Tool,
+			Worktree:    sess.WorktreePath,
+			Backend:     sess.Backend,
+		}
+		if sess.IsHeadless() && h.deps.Headless != nil {
+			item.LogPath = h.deps.Headless.LogPath(tmuxSession, sess.TmuxWindow)
 		}
 
 		// Overlay Hub-sourced status/tool if available.
@@ -108,6 +135,30 @@ func (h *SessionHandlers) List(w http.ResponseWriter, r *http.Request) {
 		items = append(items, item)
 	}
 
+	queued, err := h.deps.SessionManager.GetQueue(projectPath).List()
+	if err != nil {
+		h.deps.Logger.Warn("failed to read spawn queue", "error", err)
+	}
+	for i, e := range queued {
+		var title string
+		if ticketStore != nil {
+			if t, _, err := ticketStore.Get(e.TicketID); err == nil {
+				title = t.Title
+			}
+		}
+		queuedAt := e.QueuedAt
+		items = append(items, sessionListItem{
+			SessionType:   "ticket",
+			TicketID:      e.TicketID,
+			TicketTitle:   title,
+			Variant:       e.Variant,
+			Status:        "queued",
+			QueuePosition: i + 1,
+			QueueReason:   e.Reason,
+			QueuedAt:      &queuedAt,
+		})
+	}
+
 	writeJSON(w, http.StatusOK, map[string]any{
 		"sessions": items,
 		"total":    len(items),
@@ -134,16 +185,10 @@ func (h *SessionHandlers) Kill(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// If session is active and tmux is available, kill the window
-	if h.deps.TmuxManager != nil && sess.TmuxWindow != "" {
-		projectCfg, _ := architectconfig.Load(projectPath)
-		sessionName := projectCfg.GetTmuxSessionName()
-
-		if err := h.deps.TmuxManager.KillWindow(sessionName, sess.TmuxWindow); err != nil {
-			if !tmux.IsWindowNotFound(err) && !tmux.IsSessionNotFound(err) {
-				h.deps.Logger.Warn("failed to kill tmux window", "error", err)
-			}
-		}
+	// Stop the session's tmux window or headless process
+	projectCfg, _ := architectconfig.Load(projectPath)
+	if err := h.deps.killSessionWindow(projectCfg.GetTmuxSessionName(), sess); err != nil {
+		h.deps.Logger.Warn("failed to kill session window", "error", err)
 	}
 
 	// End the session in the store
@@ -163,6 +208,123 @@ func (h *SessionHandlers) Kill(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// CancelQueued handles DELETE /sessions/queue/{ticket_id} - removes a
+// ticket's spawn from the architect's queue.
+func (h *SessionHandlers) CancelQueued(w http.ResponseWriter, r *http.Request) {
+	ticketID := chi.URLParam(r, "ticket_id")
+
+	if h.deps.SpawnQueue == nil {
+		writeError(w, http.StatusServiceUnavailable, "queue_unavailable",
+			"spawn queue is not configured")
+		return
+	}
+	projectPath := GetArchitectPath(r.Context())
+
+	removed, err := h.deps.SpawnQueue.Cancel(projectPath, ticketID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "queue_error", err.Error())
+		return
+	}
+	if !removed {
+		writeError(w, http.StatusNotFound, "not_found", "ticket is not queued")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Timeline handles GET /sessions/{id}/timeline - returns the recorded hook
+// events of an active or ended session, oldest first.
+func (h *SessionHandlers) Timeline(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "id")
+	if !session.ValidSessionID(sessionID) {
+		writeError(w, http.StatusBadRequest, "validation_error", "invalid session id")
+		return
+	}
+	projectPath := GetArchitectPath(r.Context())
+
+	path, ok := findTimeline(h.deps, projectPath, sessionID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "no timeline recorded for session")
+		return
+	}
+	entries, err := session.ReadTimeline(path)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "timeline_error", err.Error())
+		return
+	}
+
+	resp := SessionTimelineResponse{
+		SessionID: sessionID,
+		Entries:   make([]TimelineEntryResponse, len(entries)),
+	}
+	for i, e := range entries {
+		resp.Entries[i] = TimelineEntryResponse{
+			Time:    e.Time,
+			Agent:   e.Agent,
+			Status:  string(e.Status),
+			Tool:    e.Tool,
+			Message: e.Message,
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Log handles GET /sessions/{id}/log - returns the output of an active
+// headless session. The optional lines query parameter limits it to the
+// last N lines.
+func (h *SessionHandlers) Log(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "id")
+
+	if h.deps.SessionManager == nil {
+		writeError(w, http.StatusServiceUnavailable, "sessions_unavailable",
+			"session manager is not configured")
+		return
+	}
+	lines := 0
+	if v := r.URL.Query().Get("lines"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, "validation_error", "lines must be a non-negative integer")
+			return
+		}
+		lines = n
+	}
+	projectPath := GetArchitectPath(r.Context())
+
+	sess, err := h.deps.SessionManager.GetStore(projectPath).GetBySessionID(sessionID)
+	if err != nil || sess == nil {
+		writeError(w, http.StatusNotFound, "not_found", "session not found")
+		return
+	}
+	if !sess.IsHeadless() {
+		writeError(w, http.StatusConflict, "not_headless", "session runs in tmux and has no log; focus its window instead")
+		return
+	}
+	if h.deps.Headless == nil {
+		writeError(w, http.StatusServiceUnavailable, "backend_unavailable", "headless sessions are not enabled")
+		return
+	}
+
+	projectCfg, _ := architectconfig.Load(projectPath)
+	tmuxSession := projectCfg.GetTmuxSessionName()
+	content, err := h.deps.Headless.ReadLog(tmuxSession, sess.TmuxWindow, lines)
+	if err != nil {
+		if headless.IsNotRunning(err) {
+			writeError(w, http.StatusNotFound, "not_found", "no log recorded for session")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "log_error", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SessionLogResponse{
+		SessionID: sess.SessionID,
+		Path:      h.deps.Headless.LogPath(tmuxSession, sess.TmuxWindow),
+		Content:   content,
+	})
+}
+
 // Approve handles POST /sessions/{id}/approve - sends approve prompt to agent.
 func (h *SessionHandlers) Approve(w http.ResponseWriter, r *http.Request) {
 	sessionID := chi.URLParam(r, "id")
@@ -199,8 +361,13 @@ func (h *SessionHandlers) Approve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check tmux manager is available
-	if h.deps.TmuxManager == nil {
+	// Check the session's backend is available
+	if sess.IsHeadless() {
+		if h.deps.Headless == nil {
+			writeError(w, http.StatusServiceUnavailable, "backend_unavailable", "headless sessions are not enabled")
+			return
+		}
+	} else if h.deps.TmuxManager == nil {
 		writeError(w, http.StatusServiceUnavailable, "tmux_unavailable", "tmux is not installed")
 		return
 	}
@@ -230,6 +397,25 @@ func (h *SessionHandlers) Approve(w http.ResponseWriter, r *http.Request) {
 		approveContent = rendered
 	}
 
+	// Headless agents read the prompt from stdin
+	if sess.IsHeadless() {
+		if err := h.deps.Headless.SendInput(tmuxSession, sess.TmuxWindow, approveContent); err != nil {
+			if headless.IsNotRunning(err) {
+				writeError(w, http.StatusNotFound, "window_not_found", "headless session is not running")
+				return
+			}
+			h.deps.Logger.Error("failed to send approve prompt", "error", err)
+			writeError(w, http.StatusInternalServerError, "send_failed", err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"success":    true,
+			"session_id": sessionID,
+			"message":    "Approve prompt sent to agent",
+		})
+		return
+	}
+
 	// Get window info from session
 	window, err := h.deps.TmuxManager.GetWindowByName(tmuxSession, sess.TmuxWindow)
 	if err != nil {
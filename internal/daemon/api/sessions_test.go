@@ -0,0 +1,133 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hiveryn/agentruntime"
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/headless"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+func TestSessionTimeline(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, _ := ts.store.Create("Timeline Ticket", "body", nil, nil, "", nil, nil, "")
+	sessStore := ts.deps.SessionManager.GetStore(ts.projectRoot)
+	sess, err := sessStore.Create(created.ID, "claude", "", "", "win", "")
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+
+	tm := NewTimelineManager(ts.deps.Logger, ts.deps)
+	at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	for _, ev := range []agentruntime.Event{
+		{ID: sess.SessionID, Agent: "claude", Status: "working", Tool: "Bash", At: at},
+		{ID: sess.SessionID, Agent: "claude", Status: "working", Tool: "Bash", At: at.Add(time.Second)},
+		{ID: sess.SessionID, Agent: "claude", Status: "idle", At: at.Add(2 * time.Second)},
+		{ID: "unknown-session", Agent: "claude", Status: "working", At: at},
+	} {
+		tm.Record(ev)
+	}
+
+	resp := ts.makeRequest(t, http.MethodGet, "/sessions/"+sess.SessionID+"/timeline", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[SessionTimelineResponse](t, resp)
+	if len(result.Entries) != 2 {
+		t.Fatalf("expected 2 entries (repeat dropped), got %+v", result.Entries)
+	}
+	if result.Entries[0].Tool != "Bash" || result.Entries[1].Status != "idle" {
+		t.Errorf("unexpected entries: %+v", result.Entries)
+	}
+
+	// The timeline stays readable after the session ends and the ticket moves.
+	if err := sessStore.EndBySessionID(sess.SessionID); err != nil {
+		t.Fatal(err)
+	}
+	tm.closeEnded()
+	if err := ts.store.Move(created.ID, ticket.StatusDone); err != nil {
+		t.Fatal(err)
+	}
+
+	resp2 := ts.makeRequest(t, http.MethodGet, "/sessions/"+sess.SessionID+"/timeline", nil)
+	defer func() { _ = resp2.Body.Close() }()
+	assertStatus(t, resp2, http.StatusOK)
+
+	result = decode[SessionTimelineResponse](t, resp2)
+	if len(result.Entries) != 3 || result.Entries[2].Status != "ended" {
+		t.Errorf("expected ended entry after session end, got %+v", result.Entries)
+	}
+}
+
+func TestSessionTimeline_NotFound(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	resp := ts.makeRequest(t, http.MethodGet, "/sessions/nonexistent/timeline", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusNotFound)
+}
+
+func TestSessionLog_Headless(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	ts.deps.Headless = headless.NewManager(t.TempDir())
+
+	created, _ := ts.store.Create("Headless Ticket", "body", nil, nil, "", nil, nil, "")
+	sessStore := ts.deps.SessionManager.GetStore(ts.projectRoot)
+	sess, err := sessStore.Create(created.ID, "claude", "", session.BackendHeadless, "headless-win", "")
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+
+	cfg, _ := architectconfig.Load(ts.projectRoot)
+	tmuxSession := cfg.GetTmuxSessionName()
+	if _, err := ts.deps.Headless.SpawnAgent(tmuxSession, "headless-win", "echo first; echo second; sleep 30", "", t.TempDir(), ""); err != nil {
+		t.Fatalf("SpawnAgent: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	var result SessionLogResponse
+	for {
+		resp := ts.makeRequest(t, http.MethodGet, "/sessions/"+sess.SessionID+"/log?lines=1", nil)
+		assertStatus(t, resp, http.StatusOK)
+		result = decode[SessionLogResponse](t, resp)
+		_ = resp.Body.Close()
+		if result.Content == "second\n" || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if result.Content != "second\n" {
+		t.Errorf("expected last log line, got %q", result.Content)
+	}
+
+	// Killing the session stops the process.
+	resp := ts.makeRequest(t, http.MethodDelete, "/sessions/"+sess.SessionID, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusNoContent)
+	if exists, _ := ts.deps.Headless.WindowExists(tmuxSession, "headless-win"); exists {
+		t.Error("expected headless process to be killed")
+	}
+}
+
+func TestSessionLog_TmuxSession(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, _ := ts.store.Create("Tmux Ticket", "body", nil, nil, "", nil, nil, "")
+	sess, err := ts.deps.SessionManager.GetStore(ts.projectRoot).Create(created.ID, "claude", "", "", "win", "")
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+
+	resp := ts.makeRequest(t, http.MethodGet, "/sessions/"+sess.SessionID+"/log", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusConflict)
+}
@@ -0,0 +1,377 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/core/spawn"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/types"
+)
+
+// spawnQueueRetryInterval re-checks the queues in case a session went away
+// without a SessionEnded event reaching the manager.
+const spawnQueueRetryInterval = 30 * time.Second
+
+// Results reported in spawn_dequeued event payloads.
+const (
+	spawnResultStarted = "started"
+	spawnResultSkipped = "skipped"
+	spawnResultFailed  = "failed"
+)
+
+// queuedSpawn is what SpawnQueueManager.Spawn did with a request: started
+// a session (Result) or queued it (Queue).
+type queuedSpawn struct {
+	Result *spawn.OrchestrateResult
+	Queue  *QueuedSpawnResponse
+}
+
+// SpawnQueueManager enforces the max_concurrent limits of repos and agent
+// variants. Spawns over a limit are kept in the architect's persisted spawn
+// queue and started, oldest first, as sessions end.
+type SpawnQueueManager struct {
+	// mu serializes limit checks with the spawns they admit.
+	mu     sync.Mutex
+	deps   *Dependencies
+	logger *slog.Logger
+}
+
+// NewSpawnQueueManager creates a new SpawnQueueManager.
+func NewSpawnQueueManager(logger *slog.Logger, deps *Dependencies) *SpawnQueueManager {
+	return &SpawnQueueManager{deps: deps, logger: logger}
+}
+
+// StartEventLoop drains the queues left from before a restart, then again
+// whenever a session ends. Runs until ctx is cancelled.
+func (m *SpawnQueueManager) StartEventLoop(ctx context.Context) {
+	if m == nil || m.deps.Bus == nil || m.deps.SessionManager == nil {
+		return
+	}
+
+	// Load the queues and sessions of registered architects so limits that
+	// span architects see every running session.
+	if cfg, err := daemonconfig.Load(); err == nil {
+		for _, entry := range cfg.Architects {
+			m.deps.SessionManager.GetStore(entry.Path)
+			m.deps.SessionManager.GetQueue(entry.Path)
+		}
+	}
+
+	ch, unsubscribe := m.deps.Bus.Subscribe("")
+	ticker := time.NewTicker(spawnQueueRetryInterval)
+	go func() {
+		defer unsubscribe()
+		defer ticker.Stop()
+		m.DrainAll(ctx)
+		for {
+			select {
+			case ev, ok := <-ch:
+				if !ok {
+					return
+				}
+				// Variant limits span architects, so any ended session
+				// may free capacity for any queue.
+				if ev.Type == events.SessionEnded || ev.Type == events.ResyncRequired {
+					m.DrainAll(ctx)
+				}
+			case <-ticker.C:
+				m.DrainAll(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Spawn starts a worker session for t, or queues the spawn when a limit is
+// reached. A spawn whose every repo already has a session record is never
+// queued: Orchestrate reports those sessions as active or resumes them.
+func (m *SpawnQueueManager) Spawn(ctx context.Context, projectPath string, cfg *architectconfig.Config, store *ticket.Store, t *ticket.Ticket, e session.QueuedSpawn, av architectconfig.AgentVariant) (*queuedSpawn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.deps.SessionManager.GetQueue(projectPath)
+	if repos, opens := m.newSessionRepos(projectPath, t, e); opens {
+		if reason := m.limitReason(projectPath, cfg, store, repos, e); reason != "" {
+			e.Reason = reason
+			pos, err := queue.Enqueue(e)
+			if err != nil {
+				return nil, err
+			}
+			entries, _ := queue.List()
+			if pos <= len(entries) {
+				e = entries[pos-1]
+			}
+			m.logger.Info("spawn queued", "project", projectPath, "ticket", t.ID, "repo", e.Repo, "variant", e.Variant, "position", pos, "reason", reason)
+			m.deps.Bus.Emit(events.Event{
+				Type:          events.SpawnQueued,
+				ArchitectPath: projectPath,
+				TicketID:      t.ID,
+				Payload:       map[string]any{"variant": e.Variant, "position": pos, "reason": reason},
+			})
+			return &queuedSpawn{Queue: types.ToQueuedSpawnResponse(e, pos)}, nil
+		}
+	}
+
+	// A direct spawn supersedes a queued one for the same repo; one that
+	// fans out to every repo supersedes all of the ticket's.
+	removeQueued := func() (bool, error) { return queue.Remove(t.ID, e.Repo) }
+	if e.Repo == "" {
+		removeQueued = func() (bool, error) { return queue.RemoveTicket(t.ID) }
+	}
+	if _, err := removeQueued(); err != nil {
+		m.logger.Warn("failed to update spawn queue", "ticket", t.ID, "error", err)
+	}
+	if reason := m.deps.backendUnavailable(e.Backend); reason != "" {
+		return nil, errors.New(reason)
+	}
+	result, err := spawnTicketSession(ctx, m.deps, projectPath, cfg, store, e, av)
+	if err != nil {
+		return nil, err
+	}
+	return &queuedSpawn{Result: result}, nil
+}
+
+// Cancel removes a ticket's spawns, for every repo, from an architect's
+// spawn queue and reports whether any was queued.
+func (m *SpawnQueueManager) Cancel(projectPath, ticketID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	removed, err := m.deps.SessionManager.GetQueue(projectPath).RemoveTicket(ticketID)
+	if err != nil || !removed {
+		return removed, err
+	}
+	m.deps.Bus.Emit(events.Event{
+		Type:          events.SpawnDequeued,
+		ArchitectPath: projectPath,
+		TicketID:      ticketID,
+		Payload:       map[string]any{"result": spawnResultSkipped, "message": "cancelled"},
+	})
+	return true, nil
+}
+
+// DrainAll starts every queued spawn that fits within its limits.
+func (m *SpawnQueueManager) DrainAll(ctx context.Context) {
+	for projectPath := range m.deps.SessionManager.Queues() {
+		m.Drain(ctx, projectPath)
+	}
+}
+
+// Drain starts the queued spawns of one architect that fit within their
+// limits, oldest first. Entries still over a limit keep their place.
+func (m *SpawnQueueManager) Drain(ctx context.Context, projectPath string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.deps.SessionManager.GetQueue(projectPath)
+	entries, err := queue.List()
+	if err != nil {
+		m.logger.Warn("failed to read spawn queue", "project", projectPath, "error", err)
+		return
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	cfg, err := mergeProjectConfig(projectPath)
+	if err != nil {
+		m.logger.Warn("failed to load config for spawn queue", "project", projectPath, "error", err)
+		return
+	}
+	store, err := m.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		return
+	}
+
+	for _, e := range entries {
+		t, _, err := store.Get(e.TicketID)
+		if err != nil {
+			m.dequeue(projectPath, e, spawnResultSkipped, "ticket no longer exists")
+			continue
+		}
+		repos, opens := m.newSessionRepos(projectPath, t, e)
+		if !opens {
+			m.dequeue(projectPath, e, spawnResultSkipped, "session already active")
+			continue
+		}
+		if reason := m.limitReason(projectPath, cfg, store, repos, e); reason != "" {
+			if reason != e.Reason {
+				e.Reason = reason
+				if _, err := queue.Enqueue(e); err != nil {
+					m.logger.Warn("failed to update spawn queue", "ticket", e.TicketID, "error", err)
+				}
+			}
+			continue
+		}
+
+		m.start(ctx, projectPath, cfg, store, e)
+	}
+}
+
+// start removes an entry from the queue and spawns it. Callers must hold m.mu.
+func (m *SpawnQueueManager) start(ctx context.Context, projectPath string, cfg *architectconfig.Config, store *ticket.Store, e session.QueuedSpawn) {
+	if reason := m.deps.backendUnavailable(e.Backend); reason != "" {
+		m.dequeue(projectPath, e, spawnResultFailed, reason)
+		return
+	}
+	av, err := cfg.ResolveVariant(e.Variant)
+	if err != nil {
+		m.dequeue(projectPath, e, spawnResultFailed, err.Error())
+		return
+	}
+
+	result, err := spawnTicketSession(ctx, m.deps, projectPath, cfg, store, e, av)
+	switch {
+	case err != nil:
+		m.dequeue(projectPath, e, spawnResultFailed, err.Error())
+	case result.Outcome == spawn.OutcomeAlreadyActive:
+		m.dequeue(projectPath, e, spawnResultSkipped, "session already active")
+	default:
+		m.dequeue(projectPath, e, spawnResultStarted, fmt.Sprintf("spawned with variant %s", e.Variant))
+		m.deps.Bus.Emit(events.Event{
+			Type:          events.SessionStarted,
+			ArchitectPath: projectPath,
+			TicketID:      e.TicketID,
+		})
+	}
+}
+
+// dequeue removes an entry and reports the outcome. Entries queued by a
+// pipeline rule also report it as a pipeline action.
+func (m *SpawnQueueManager) dequeue(projectPath string, e session.QueuedSpawn, result, message string) {
+	if _, err := m.deps.SessionManager.GetQueue(projectPath).Remove(e.TicketID, e.Repo); err != nil {
+		m.logger.Warn("failed to update spawn queue", "ticket", e.TicketID, "error", err)
+	}
+
+	logFn := m.logger.Info
+	if result == spawnResultFailed {
+		logFn = m.logger.Warn
+	}
+	logFn("spawn dequeued", "project", projectPath, "ticket", e.TicketID, "repo", e.Repo, "variant", e.Variant, "result", result, "message", message)
+	m.deps.Bus.Emit(events.Event{
+		Type:          events.SpawnDequeued,
+		ArchitectPath: projectPath,
+		TicketID:      e.TicketID,
+		Payload:       map[string]any{"variant": e.Variant, "result": result, "message": message},
+	})
+
+	if e.Rule != "" {
+		pipelineResult := PipelineResultDone
+		switch result {
+		case spawnResultSkipped:
+			pipelineResult = PipelineResultSkipped
+		case spawnResultFailed:
+			pipelineResult = PipelineResultFailed
+		}
+		emitPipelineAction(m.deps, m.logger, projectPath, e.TicketID, e.Rule, "spawn", pipelineResult, message, nil)
+	}
+}
+
+// newSessionRepos returns the repos spawn e would open a new session in,
+// and whether it opens any. Repos that already have a session record are
+// left out: Orchestrate reports those as active or resumes them.
+func (m *SpawnQueueManager) newSessionRepos(projectPath string, t *ticket.Ticket, e session.QueuedSpawn) ([]string, bool) {
+	sessStore := m.deps.SessionManager.GetStore(projectPath)
+	if !t.IsMultiRepo() {
+		if sess, err := sessStore.GetByTicketRepo(t.ID, ""); err == nil && sess != nil {
+			return nil, false
+		}
+		return t.RepoKeys(), true
+	}
+	candidates := t.Repos
+	if e.Repo != "" {
+		candidates = []string{e.Repo}
+	}
+	var repos []string
+	for _, repo := range candidates {
+		if sess, err := sessStore.GetByTicketRepo(t.ID, repo); err != nil || sess == nil {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, len(repos) > 0
+}
+
+// limitReason returns the limit spawn e must wait for before opening
+// sessions in repos, or "" when it may start now. Repo limits count the
+// architect's ticket sessions in that repo, and apply to each repo the
+// spawn opens a session in; variant limits count sessions of the variant
+// across every architect. Pipeline spawns are also held to the pipelines
+// caps.
+func (m *SpawnQueueManager) limitReason(projectPath string, cfg *architectconfig.Config, store *ticket.Store, repos []string, e session.QueuedSpawn) string {
+	var repoMax int
+	for _, repo := range repos {
+		repoMax = max(repoMax, cfg.Repos[repo].MaxConcurrent)
+	}
+	variantMax := cfg.Agents[e.Variant].MaxConcurrent
+	var pipelineMax, pipelineRepoMax int
+	if e.Rule != "" {
+		pipelineMax, pipelineRepoMax = cfg.Pipelines.MaxConcurrent, cfg.Pipelines.MaxConcurrentPerRepo
+	}
+	if repoMax == 0 && variantMax == 0 && pipelineMax == 0 && pipelineRepoMax == 0 {
+		return ""
+	}
+
+	total, withVariant := 0, 0
+	inRepo := make(map[string]int)
+	for path, sessStore := range m.deps.SessionManager.Stores() {
+		sessions, err := sessStore.List()
+		if err != nil {
+			continue
+		}
+		local := path == filepath.Clean(projectPath)
+		for _, sess := range sessions {
+			if sess.Type != session.SessionTypeTicket {
+				continue
+			}
+			if sess.Variant != "" && sess.Variant == e.Variant {
+				withVariant++
+			}
+			if !local {
+				continue
+			}
+			total++
+			if len(repos) == 0 {
+				continue
+			}
+			// Sessions of a multi-repo ticket record their repo.
+			repo := sess.Repo
+			if repo == "" {
+				if st, _, err := store.Get(sess.TicketID); err == nil {
+					repo = st.Repo
+				}
+			}
+			inRepo[repo]++
+		}
+	}
+
+	if variantMax > 0 && withVariant >= variantMax {
+		return fmt.Sprintf("variant %s at max_concurrent (%d/%d)", e.Variant, withVariant, variantMax)
+	}
+	for _, repo := range repos {
+		if limit := cfg.Repos[repo].MaxConcurrent; limit > 0 && inRepo[repo] >= limit {
+			return fmt.Sprintf("repo %s at max_concurrent (%d/%d)", repo, inRepo[repo], limit)
+		}
+	}
+	if pipelineMax > 0 && total >= pipelineMax {
+		return fmt.Sprintf("pipelines.max_concurrent reached (%d/%d)", total, pipelineMax)
+	}
+	if pipelineRepoMax > 0 {
+		for _, repo := range repos {
+			if inRepo[repo] >= pipelineRepoMax {
+				return fmt.Sprintf("pipelines.max_concurrent_per_repo reached for %s (%d/%d)", repo, inRepo[repo], pipelineRepoMax)
+			}
+		}
+	}
+	return ""
+}
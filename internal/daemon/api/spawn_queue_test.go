@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/session"
+)
+
+func TestSpawnQueue_QueuesAtVariantLimit(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	cfg := "name: test\nrepos: {}\nagents:\n  fast: {agent: claude, max_concurrent: 1}\n"
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "cortex.yaml"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	running, _ := ts.store.Create("Running", "body", nil, nil, "", nil, nil, "")
+	sessStore := ts.deps.SessionManager.GetStore(ts.projectRoot)
+	if _, err := sessStore.Create(running.ID, "claude", "fast", "", "win", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	waiting, _ := ts.store.Create("Waiting", "body", nil, nil, "", nil, nil, "")
+	projectCfg, err := mergeProjectConfig(ts.projectRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	av, err := projectCfg.ResolveVariant("fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spawned, err := ts.deps.SpawnQueue.Spawn(context.Background(), ts.projectRoot, projectCfg, ts.store, waiting,
+		session.QueuedSpawn{TicketID: waiting.ID, Variant: "fast"}, av)
+	if err != nil {
+		t.Fatalf("Spawn: %v", err)
+	}
+	if spawned.Queue == nil || spawned.Queue.Position != 1 || spawned.Queue.Reason == "" {
+		t.Fatalf("expected spawn to be queued, got %+v", spawned)
+	}
+
+	// Queued spawns are listed after the running sessions.
+	resp := ts.makeRequest(t, http.MethodGet, "/sessions", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+	type listItem struct {
+		TicketID      string `json:"ticket_id"`
+		TicketTitle   string `json:"ticket_title"`
+		Variant       string `json:"variant"`
+		Status        string `json:"status"`
+		QueuePosition int    `json:"queue_position"`
+	}
+	list := decode[struct {
+		Sessions []listItem `json:"sessions"`
+	}](t, resp)
+	if len(list.Sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %+v", list.Sessions)
+	}
+	if got := list.Sessions[1]; got.Status != "queued" || got.TicketID != waiting.ID || got.TicketTitle != "Waiting" || got.QueuePosition != 1 {
+		t.Errorf("unexpected queued item: %+v", got)
+	}
+	if list.Sessions[0].Variant != "fast" {
+		t.Errorf("expected running session variant, got %+v", list.Sessions[0])
+	}
+
+	// The queue is persisted, so a new manager sees the same entry.
+	entries, err := session.NewQueue(filepath.Join(ts.projectRoot, ".spawn-queue.json")).List()
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected persisted queue entry, got %+v, %v", entries, err)
+	}
+
+	// Cancelling removes it; a second cancel finds nothing.
+	del := ts.makeRequest(t, http.MethodDelete, "/sessions/queue/"+waiting.ID, nil)
+	defer func() { _ = del.Body.Close() }()
+	assertStatus(t, del, http.StatusNoContent)
+	del2 := ts.makeRequest(t, http.MethodDelete, "/sessions/queue/"+waiting.ID, nil)
+	defer func() { _ = del2.Body.Close() }()
+	assertStatus(t, del2, http.StatusNotFound)
+}
+
+func TestSpawnQueue_MultiRepoLimitsPerRepo(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	apiDir, _ := createGitRepoWithCommit(t)
+	webDir, _ := createGitRepoWithCommit(t)
+	writeUnitConfig(t, ts.projectRoot, map[string]string{
+		"api": "{path: " + apiDir + ", max_concurrent: 1}",
+		"web": "{path: " + webDir + ", max_concurrent: 1}",
+	})
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets", map[string]any{"title": "Rename field", "body": "body", "repos": []string{"api", "web"}})
+	assertStatus(t, resp, http.StatusCreated)
+	multi := decode[TicketResponse](t, resp)
+	_ = resp.Body.Close()
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets", map[string]any{"title": "Web fix", "body": "body", "repo": "web"})
+	assertStatus(t, resp, http.StatusCreated)
+	other := decode[TicketResponse](t, resp)
+	_ = resp.Body.Close()
+
+	// The ticket runs in api; another ticket fills web.
+	sessStore := ts.deps.SessionManager.GetStore(ts.projectRoot)
+	if _, err := sessStore.CreateForRepo(multi.ID, "api", "claude", "fast", "", "win-api", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sessStore.Create(other.ID, "claude", "fast", "", "win-web", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	projectCfg, err := mergeProjectConfig(ts.projectRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, _, err := ts.store.Get(multi.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spawned, err := ts.deps.SpawnQueue.Spawn(context.Background(), ts.projectRoot, projectCfg, ts.store, tk,
+		session.QueuedSpawn{TicketID: multi.ID, Variant: "fast", Repo: "web"}, architectconfig.AgentVariant{Agent: "claude"})
+	if err != nil {
+		t.Fatalf("Spawn: %v", err)
+	}
+	if spawned.Queue == nil || !strings.Contains(spawned.Queue.Reason, "repo web") {
+		t.Fatalf("expected the web spawn to wait for the repo limit, got %+v", spawned)
+	}
+
+	// The live api session does not make the queued web spawn redundant.
+	ts.deps.SpawnQueue.Drain(context.Background(), ts.projectRoot)
+	entries, err := ts.deps.SessionManager.GetQueue(ts.projectRoot).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].TicketID != multi.ID || entries[0].Repo != "web" {
+		t.Fatalf("expected the web spawn to stay queued, got %+v", entries)
+	}
+}
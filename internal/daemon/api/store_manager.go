@@ -5,11 +5,14 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/events"
 	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/worktree"
 )
 
 // StoreManager manages per-project ticket stores.
@@ -40,6 +43,7 @@ func (m *StoreManager) GetStore(architectPath string) (*ticket.Store, error) {
 	m.mu.RUnlock()
 
 	if exists {
+		m.refreshStatuses(store, architectPath)
 		return store, nil
 	}
 
@@ -64,13 +68,97 @@ func (m *StoreManager) GetStore(architectPath string) (*ticket.Store, error) {
 	}
 
 	ticketsDir := cfg.TicketsPath(architectPath)
-	store, err = ticket.NewStore(ticketsDir, m.bus, architectPath)
+	store, err = ticket.NewStoreWithStatuses(ticketsDir, m.bus, architectPath, configStatuses(cfg))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create ticket store: %w", err)
 	}
+	store.SetMoveCheck(moveCheck(architectPath))
+	store.SetRenameCheck(renameCheck(architectPath))
 
 	m.stores[architectPath] = store
 	m.logger.Debug("created ticket store", "project", architectPath)
 
 	return store, nil
 }
+
+// refreshStatuses picks up status changes made to cortex.yaml after the store was created.
+func (m *StoreManager) refreshStatuses(store *ticket.Store, architectPath string) {
+	cfg, err := architectconfig.Load(architectPath)
+	if err != nil {
+		return
+	}
+	statuses := configStatuses(cfg)
+	if slices.Equal(store.Statuses(), statuses) {
+		return
+	}
+	if err := store.SetStatuses(statuses); err != nil {
+		m.logger.Warn("failed to update ticket statuses", "project", architectPath, "error", err)
+	}
+}
+
+// moveCheck returns the check a store runs on every status change, so
+// moves made by the daemon itself follow cortex.yaml like API moves do.
+func moveCheck(architectPath string) ticket.MoveCheck {
+	return func(t *ticket.Ticket, from, to ticket.Status) error {
+		cfg, err := architectconfig.Load(architectPath)
+		if err != nil {
+			return err
+		}
+		return checkMove(cfg, t, from, to)
+	}
+}
+
+// renameCheck returns the check a store runs before a title change gives a
+// ticket a new ID. Worktrees and their branches are named after the ID,
+// so a ticket that still has a worktree keeps its ID.
+func renameCheck(architectPath string) ticket.RenameCheck {
+	return func(t *ticket.Ticket) error {
+		cfg, err := architectconfig.Load(architectPath)
+		if err != nil {
+			return err
+		}
+		for _, repo := range t.RepoKeys() {
+			if cfg.RepoIsolation(repo) != architectconfig.IsolationWorktree {
+				continue
+			}
+			path := worktree.Path(cfg.WorktreesPath(architectPath), repo, t.ID)
+			if worktree.Exists(path) {
+				return &ticketCheckError{
+					code: "worktree_exists",
+					msg:  fmt.Sprintf("cannot rename ticket %s while its worktree %s exists", t.ID, path),
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// checkMove checks a move against the configured transitions and the
+// allowed statuses of the ticket's type. Tickets of an unknown type may
+// move to any status the transitions allow.
+func checkMove(cfg *architectconfig.Config, t *ticket.Ticket, from, to ticket.Status) error {
+	if !cfg.CanTransition(string(from), string(to)) {
+		return &ticketCheckError{code: "invalid_transition", msg: fmt.Sprintf("cannot move ticket from %s to %s", from, to)}
+	}
+	typeDef, err := cfg.ResolveTicketType(t.Type)
+	if err != nil {
+		return nil
+	}
+	if !typeDef.AllowsStatus(string(to)) {
+		return &ticketCheckError{
+			code: "status_not_allowed",
+			msg:  fmt.Sprintf("%s tickets cannot move to %s (allowed: %s)", ticketTypeName(t.Type), to, strings.Join(typeDef.AllowedStatuses, ", ")),
+		}
+	}
+	return nil
+}
+
+// configStatuses converts the configured status names to ticket statuses.
+func configStatuses(cfg *architectconfig.Config) []ticket.Status {
+	names := cfg.StatusNames()
+	statuses := make([]ticket.Status, len(names))
+	for i, name := range names {
+		statuses[i] = ticket.Status(name)
+	}
+	return statuses
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"net/http"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// TemplateHandlers serves the ticket templates of an architect.
+type TemplateHandlers struct {
+	deps *Dependencies
+}
+
+// NewTemplateHandlers creates a new TemplateHandlers with the given dependencies.
+func NewTemplateHandlers(deps *Dependencies) *TemplateHandlers {
+	return &TemplateHandlers{deps: deps}
+}
+
+// List handles GET /templates - lists the ticket templates under templates/.
+func (h *TemplateHandlers) List(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+
+	projectCfg, err := architectconfig.Load(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
+		return
+	}
+
+	dir := projectCfg.TemplatesPath(projectPath)
+	templates, err := ticket.ListTemplates(dir)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "template_error", err.Error())
+		return
+	}
+
+	resp := ListTemplatesResponse{Templates: []TemplateResponse{}, Dir: dir}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toTemplateResponse(t))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func toTemplateResponse(t *ticket.Template) TemplateResponse {
+	return TemplateResponse{
+		Name:        t.Name,
+		Description: t.Description,
+		Title:       t.Title,
+		Type:        t.Type,
+		Repo:        t.Repo,
+		References:  t.References,
+		Due:         t.Due,
+		Variables:   t.Variables(),
+		Body:        t.Body,
+	}
+}
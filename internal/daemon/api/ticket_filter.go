@@ -0,0 +1,138 @@
+package api
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// Ticket list orders accepted by ?sort=. Dates sort newest first, except
+// due dates, which sort soonest first with undated tickets last.
+const (
+	sortCreated  = "created"
+	sortUpdated  = "updated"
+	sortPriority = "priority"
+	sortDue      = "due"
+)
+
+// ticketFilter narrows and orders a ticket listing.
+type ticketFilter struct {
+	query      string // lower-cased title or body substring
+	dueBefore  *time.Time
+	labels     []string          // every one must be present
+	priorities []ticket.Priority // any one must match
+	assignee   string
+	sort       string
+}
+
+// parseTicketFilter reads the filter of GET /tickets and
+// GET /tickets/{status}: query, due_before, label, priority, assignee and
+// sort. label and priority take comma-separated lists. On a bad value it
+// writes the error response and returns false.
+func parseTicketFilter(w http.ResponseWriter, r *http.Request) (ticketFilter, bool) {
+	q := r.URL.Query()
+	f := ticketFilter{
+		query:    strings.ToLower(q.Get("query")),
+		labels:   splitQueryList(q["label"]),
+		assignee: strings.TrimSpace(q.Get("assignee")),
+		sort:     q.Get("sort"),
+	}
+
+	if dueBeforeStr := q.Get("due_before"); dueBeforeStr != "" {
+		parsed, err := time.Parse(time.RFC3339, dueBeforeStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_due_before", "due_before must be in RFC3339 format")
+			return ticketFilter{}, false
+		}
+		f.dueBefore = &parsed
+	}
+	for _, name := range splitQueryList(q["priority"]) {
+		p, err := ticket.ParsePriority(name)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_priority", err.Error())
+			return ticketFilter{}, false
+		}
+		f.priorities = append(f.priorities, p)
+	}
+	switch f.sort {
+	case "", sortCreated, sortUpdated, sortPriority, sortDue:
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_sort", "sort must be created, updated, priority or due")
+		return ticketFilter{}, false
+	}
+	return f, true
+}
+
+// splitQueryList flattens repeated and comma-separated query values.
+func splitQueryList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				out = append(out, item)
+			}
+		}
+	}
+	return out
+}
+
+// matches reports whether t passes the filter.
+func (f ticketFilter) matches(t *ticket.Ticket) bool {
+	if f.query != "" &&
+		!strings.Contains(strings.ToLower(t.Title), f.query) &&
+		!strings.Contains(strings.ToLower(t.Body), f.query) {
+		return false
+	}
+	if f.dueBefore != nil && (t.Due == nil || !t.Due.Before(*f.dueBefore)) {
+		return false
+	}
+	for _, label := range f.labels {
+		if !t.HasLabel(label) {
+			return false
+		}
+	}
+	if len(f.priorities) > 0 && !slices.Contains(f.priorities, t.Priority) {
+		return false
+	}
+	if f.assignee != "" && t.Assignee != f.assignee {
+		return false
+	}
+	return true
+}
+
+// sortSummaries orders summaries by the filter's sort, newest created
+// first by default and to break ties.
+func (f ticketFilter) sortSummaries(summaries []TicketSummary) {
+	slices.SortStableFunc(summaries, func(a, b TicketSummary) int {
+		var c int
+		switch f.sort {
+		case sortUpdated:
+			c = b.Updated.Compare(a.Updated)
+		case sortPriority:
+			c = cmp.Compare(ticket.Priority(a.Priority).Rank(), ticket.Priority(b.Priority).Rank())
+		case sortDue:
+			c = compareDue(a.Due, b.Due)
+		}
+		if c != 0 {
+			return c
+		}
+		return b.Created.Compare(a.Created)
+	})
+}
+
+// compareDue orders due dates soonest first, with undated last.
+func compareDue(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return a.Compare(*b)
+}
@@ -1,18 +1,22 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/core/spawn"
+	"github.com/kareemaly/cortex/internal/daemon/auth"
 	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/session"
 	"github.com/kareemaly/cortex/internal/storage"
 	"github.com/kareemaly/cortex/internal/ticket"
 	"github.com/kareemaly/cortex/internal/types"
@@ -55,44 +59,39 @@ func (h *TicketHandlers) ListAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	query := strings.ToLower(r.URL.Query().Get("query"))
-
-	var dueBefore *time.Time
-	if dueBeforeStr := r.URL.Query().Get("due_before"); dueBeforeStr != "" {
-		parsed, err := time.Parse(time.RFC3339, dueBeforeStr)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "invalid_due_before", "due_before must be in RFC3339 format")
-			return
-		}
-		dueBefore = &parsed
+	filter, ok := parseTicketFilter(w, r)
+	if !ok {
+		return
 	}
 
 	projectCfg, _ := architectconfig.Load(projectPath)
 	tmuxSession := projectCfg.GetTmuxSessionName()
 
-	resp := ListAllTicketsResponse{
-		Backlog:  filterSummaryList(all[ticket.StatusBacklog], ticket.StatusBacklog, query, dueBefore, tmuxSession, h.deps.TmuxManager, h.deps.SessionManager, projectPath, h.deps.ReceiverManager, store),
-		Progress: filterSummaryList(all[ticket.StatusProgress], ticket.StatusProgress, query, dueBefore, tmuxSession, h.deps.TmuxManager, h.deps.SessionManager, projectPath, h.deps.ReceiverManager, store),
-		Done:     filterSummaryList(all[ticket.StatusDone], ticket.StatusDone, query, dueBefore, tmuxSession, h.deps.TmuxManager, h.deps.SessionManager, projectPath, h.deps.ReceiverManager, store),
-	}
+	blocked, blockedErr := store.BlockedIDs()
 
-	sortByCreated := func(a, b TicketSummary) int {
-		return b.Created.Compare(a.Created)
+	var resp ListAllTicketsResponse
+	for _, status := range store.Statuses() {
+		summaries := filterSummaryList(all[status], status, filter, tmuxSession, h.deps.sessionChecker, h.deps.SessionManager, projectPath, h.deps.ReceiverManager, store)
+		filter.sortSummaries(summaries)
+		if blockedErr == nil && status != ticket.StatusDone {
+			markBlocked(summaries, blocked)
+		}
+
+		switch status {
+		case ticket.StatusBacklog:
+			resp.Backlog = summaries
+		case ticket.StatusProgress:
+			resp.Progress = summaries
+		case ticket.StatusDone:
+			resp.Done = summaries
+		}
+		resp.Columns = append(resp.Columns, TicketColumn{Status: string(status), Tickets: summaries})
 	}
-	slices.SortFunc(resp.Backlog, sortByCreated)
-	slices.SortFunc(resp.Progress, sortByCreated)
-	slices.SortFunc(resp.Done, sortByCreated)
 
 	writeJSON(w, http.StatusOK, resp)
 }
 
 func (h *TicketHandlers) ListByStatus(w http.ResponseWriter, r *http.Request) {
-	status := chi.URLParam(r, "status")
-	if !validStatus(status) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid status: must be backlog, progress, or done")
-		return
-	}
-
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
@@ -100,34 +99,34 @@ func (h *TicketHandlers) ListByStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status := chi.URLParam(r, "status")
+	if !validStatus(store, status) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid status"))
+		return
+	}
+
 	tickets, err := store.List(ticket.Status(status))
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
-	query := strings.ToLower(r.URL.Query().Get("query"))
-
-	var dueBefore *time.Time
-	if dueBeforeStr := r.URL.Query().Get("due_before"); dueBeforeStr != "" {
-		parsed, err := time.Parse(time.RFC3339, dueBeforeStr)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "invalid_due_before", "due_before must be in RFC3339 format")
-			return
-		}
-		dueBefore = &parsed
+	filter, ok := parseTicketFilter(w, r)
+	if !ok {
+		return
 	}
 
 	projectCfg, _ := architectconfig.Load(projectPath)
 	tmuxSession := projectCfg.GetTmuxSessionName()
 
 	resp := ListTicketsResponse{
-		Tickets: filterSummaryList(tickets, ticket.Status(status), query, dueBefore, tmuxSession, h.deps.TmuxManager, h.deps.SessionManager, projectPath, h.deps.ReceiverManager, store),
+		Tickets: filterSummaryList(tickets, ticket.Status(status), filter, tmuxSession, h.deps.sessionChecker, h.deps.SessionManager, projectPath, h.deps.ReceiverManager, store),
 	}
+	filter.sortSummaries(resp.Tickets)
 
-	slices.SortFunc(resp.Tickets, func(a, b TicketSummary) int {
-		return b.Created.Compare(a.Created)
-	})
+	if blocked, err := store.BlockedIDs(); err == nil {
+		markBlocked(resp.Tickets, blocked)
+	}
 
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -141,15 +140,6 @@ func (h *TicketHandlers) Create(w http.ResponseWriter, r *http.Request) {
 
 	projectPath := GetArchitectPath(r.Context())
 
-	if req.Title == "" {
-		writeError(w, http.StatusBadRequest, "missing_title", "title is required")
-		return
-	}
-	if req.Repo == "" {
-		writeError(w, http.StatusBadRequest, "missing_repo", "repo is required")
-		return
-	}
-
 	var dueDate *time.Time
 	if req.DueDate != nil && *req.DueDate != "" {
 		parsed, err := time.Parse(time.RFC3339, *req.DueDate)
@@ -165,8 +155,47 @@ func (h *TicketHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
 		return
 	}
-	if err := projectCfg.ValidateRepo(req.Repo); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid_repo", err.Error())
+
+	candidate := &ticket.Ticket{
+		TicketMeta: ticket.TicketMeta{
+			Title:      req.Title,
+			Type:       req.Type,
+			References: req.References,
+			BlockedBy:  req.BlockedBy,
+			Blocks:     req.Blocks,
+			Due:        dueDate,
+		},
+		Body: req.Body,
+	}
+	// A template fills in whatever the request left empty.
+	if req.Template != "" {
+		tmpl, err := ticket.LoadTemplate(projectCfg.TemplatesPath(projectPath), req.Template)
+		if err != nil {
+			handleTicketError(w, err, h.deps.Logger)
+			return
+		}
+		inst, err := tmpl.Instantiate(req.Vars, time.Now().UTC())
+		if err != nil {
+			handleTicketError(w, err, h.deps.Logger)
+			return
+		}
+		ticket.WithTemplate(inst)(candidate)
+	}
+
+	if candidate.Title == "" {
+		writeError(w, http.StatusBadRequest, "missing_title", "title is required")
+		return
+	}
+	repos := req.Repos
+	if req.Repo != "" {
+		repos = append([]string{req.Repo}, repos...)
+	}
+	repos = slices.DeleteFunc(repos, func(r string) bool { return r == "" })
+	if len(repos) == 0 && candidate.Repo != "" {
+		repos = []string{candidate.Repo}
+	}
+	if err := checkNewTicket(projectCfg, candidate, repos); err != nil {
+		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
@@ -176,11 +205,35 @@ func (h *TicketHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	t, err := store.Create(req.Title, req.Body, dueDate, req.References, req.Repo)
+	if req.FollowUpOf != "" {
+		// Workers link follow-ups to their own ticket only.
+		if p := GetPrincipal(r.Context()); p != nil && p.Role == auth.RoleWorker && req.FollowUpOf != p.TicketID {
+			writeError(w, http.StatusForbidden, "forbidden", "worker tokens may only file follow-ups of their own ticket")
+			return
+		}
+		if _, _, err := store.Get(req.FollowUpOf); err != nil {
+			handleTicketError(w, err, h.deps.Logger)
+			return
+		}
+		ticket.AddReference(req.FollowUpOf)(candidate)
+	}
+
+	t, err := store.CreateAs(h.deps.requestActor(r), candidate.Title, candidate.Body, candidate.Due, candidate.References, repos[0], req.BlockedBy, req.Blocks, candidate.Type,
+		ticket.WithRepos(repos...),
+		ticket.WithPriority(ticket.Priority(req.Priority)),
+		ticket.WithLabels(req.Labels...),
+		ticket.WithAssignee(req.Assignee))
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
+	if req.FollowUpOf != "" {
+		if _, err := store.UpdateAs(h.deps.requestActor(r), req.FollowUpOf, nil, nil, nil, nil, nil, ticket.AddReference(t.ID)); err != nil {
+			writeError(w, http.StatusInternalServerError, "link_error",
+				fmt.Sprintf("follow-up ticket %s was created but failed to update %s references: %s", t.ID, req.FollowUpOf, err))
+			return
+		}
+	}
 
 	resp, err := ticketResponse(store, t, ticket.StatusBacklog)
 	if err != nil {
@@ -191,12 +244,6 @@ func (h *TicketHandlers) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TicketHandlers) Get(w http.ResponseWriter, r *http.Request) {
-	status := chi.URLParam(r, "status")
-	if !validStatus(status) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid status: must be backlog, progress, or done")
-		return
-	}
-
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
@@ -204,6 +251,12 @@ func (h *TicketHandlers) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status := chi.URLParam(r, "status")
+	if !validStatus(store, status) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid status"))
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 	t, actualStatus, err := store.Get(id)
 	if err != nil {
@@ -225,12 +278,6 @@ func (h *TicketHandlers) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TicketHandlers) Update(w http.ResponseWriter, r *http.Request) {
-	status := chi.URLParam(r, "status")
-	if !validStatus(status) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid status: must be backlog, progress, or done")
-		return
-	}
-
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
@@ -238,9 +285,15 @@ func (h *TicketHandlers) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status := chi.URLParam(r, "status")
+	if !validStatus(store, status) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid status"))
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 
-	_, actualStatus, err := store.Get(id)
+	existing, actualStatus, err := store.Get(id)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -256,7 +309,41 @@ func (h *TicketHandlers) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	t, err := store.Update(id, req.Title, req.Body, req.References)
+	typeDef, err := h.ticketTypeDef(projectPath, existing)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
+		return
+	}
+	candidate := *existing
+	if req.Body != nil {
+		candidate.Body = *req.Body
+	}
+	if req.References != nil {
+		candidate.References = *req.References
+	}
+	if req.BlockedBy != nil {
+		candidate.BlockedBy = *req.BlockedBy
+	}
+	if req.Blocks != nil {
+		candidate.Blocks = *req.Blocks
+	}
+	if field := missingRequiredField(typeDef, &candidate); field != "" {
+		writeError(w, http.StatusBadRequest, "missing_field", fmt.Sprintf("%s is required for %s tickets", field, ticketTypeName(existing.Type)))
+		return
+	}
+
+	var opts []ticket.UpdateOption
+	if req.Priority != nil {
+		opts = append(opts, ticket.SetPriority(ticket.Priority(*req.Priority)))
+	}
+	if req.Labels != nil {
+		opts = append(opts, ticket.SetLabels(*req.Labels...))
+	}
+	if req.Assignee != nil {
+		opts = append(opts, ticket.SetAssignee(*req.Assignee))
+	}
+
+	t, err := store.UpdateAs(h.deps.requestActor(r), id, req.Title, req.Body, req.References, req.BlockedBy, req.Blocks, opts...)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -291,7 +378,7 @@ func (h *TicketHandlers) EditBody(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updated, err := store.EditBody(id, req.OldString, req.NewString, req.ReplaceAll)
+	updated, err := store.EditBodyAs(h.deps.requestActor(r), id, req.OldString, req.NewString, req.ReplaceAll)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -305,13 +392,42 @@ func (h *TicketHandlers) EditBody(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, resp)
 }
 
-func (h *TicketHandlers) Delete(w http.ResponseWriter, r *http.Request) {
-	status := chi.URLParam(r, "status")
-	if !validStatus(status) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid status: must be backlog, progress, or done")
+// CheckCriterion handles POST /tickets/{id}/criteria/{index}.
+func (h *TicketHandlers) CheckCriterion(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_error", "criterion index must be a number")
+		return
+	}
+	var req CheckCriterionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+
+	updated, err := store.CheckCriterionAs(h.deps.requestActor(r), id, index, req.Checked, req.Evidence)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
+	resp, err := ticketResponse(store, updated, updated.Status)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TicketHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
@@ -319,9 +435,15 @@ func (h *TicketHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status := chi.URLParam(r, "status")
+	if !validStatus(store, status) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid status"))
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 
-	_, actualStatus, err := store.Get(id)
+	existing, actualStatus, err := store.Get(id)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -331,7 +453,22 @@ func (h *TicketHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := store.Delete(id); err != nil {
+	if r.URL.Query().Get("cleanup_worktree") == "true" {
+		for _, rt := range repoTickets(existing) {
+			var recorded string
+			if h.deps.SessionManager != nil {
+				if sess, sessErr := h.deps.SessionManager.GetStore(projectPath).GetByTicketRepo(id, sessionRepo(existing, rt.Repo)); sessErr == nil && sess != nil {
+					recorded = sess.WorktreePath
+				}
+			}
+			if _, err := removeTicketWorktree(projectPath, rt, recorded); err != nil {
+				writeError(w, http.StatusConflict, "worktree_error", "ticket not deleted: "+err.Error())
+				return
+			}
+		}
+	}
+
+	if err := store.DeleteAs(h.deps.requestActor(r), id); err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
@@ -340,12 +477,6 @@ func (h *TicketHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TicketHandlers) Move(w http.ResponseWriter, r *http.Request) {
-	status := chi.URLParam(r, "status")
-	if !validStatus(status) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid status: must be backlog, progress, or done")
-		return
-	}
-
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
@@ -353,6 +484,12 @@ func (h *TicketHandlers) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status := chi.URLParam(r, "status")
+	if !validStatus(store, status) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid status"))
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 
 	_, actualStatus, err := store.Get(id)
@@ -371,12 +508,13 @@ func (h *TicketHandlers) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !validStatus(req.To) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid target status: must be backlog, progress, or done")
+	if !validStatus(store, req.To) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid target status"))
 		return
 	}
 
-	if err := store.Move(id, ticket.Status(req.To)); err != nil {
+	// The store checks the transitions and the ticket type's allowed statuses.
+	if err := store.MoveAs(h.deps.requestActor(r), id, ticket.Status(req.To)); err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
@@ -422,7 +560,7 @@ func (h *TicketHandlers) SetDueDate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	t, err := store.SetDueDate(id, &dueDate)
+	t, err := store.SetDueDateAs(h.deps.requestActor(r), id, &dueDate)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -452,7 +590,22 @@ func (h *TicketHandlers) ClearDueDate(w http.ResponseWriter, r *http.Request) {
 
 	id := chi.URLParam(r, "id")
 
-	t, err := store.ClearDueDate(id)
+	existing, _, err := store.Get(id)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
+	}
+	typeDef, err := h.ticketTypeDef(projectPath, existing)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "config_error", err.Error())
+		return
+	}
+	if slices.Contains(typeDef.RequiredFields, "due") {
+		writeError(w, http.StatusBadRequest, "missing_field", fmt.Sprintf("due is required for %s tickets", ticketTypeName(existing.Type)))
+		return
+	}
+
+	t, err := store.SetDueDateAs(h.deps.requestActor(r), id, nil)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -473,15 +626,11 @@ func (h *TicketHandlers) ClearDueDate(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
-	status := chi.URLParam(r, "status")
-	if !validStatus(status) {
-		writeError(w, http.StatusBadRequest, "invalid_status", "invalid status: must be backlog, progress, or done")
-		return
-	}
-
 	id := chi.URLParam(r, "id")
 	mode := r.URL.Query().Get("mode")
 	variantName := r.URL.Query().Get("variant")
+	force := r.URL.Query().Get("force") == "true"
+	repo := r.URL.Query().Get("repo")
 	projectPath := GetArchitectPath(r.Context())
 
 	store, err := h.deps.StoreManager.GetStore(projectPath)
@@ -490,7 +639,13 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, actualStatus, err := store.Get(id)
+	status := chi.URLParam(r, "status")
+	if !validStatus(store, status) {
+		writeError(w, http.StatusBadRequest, "invalid_status", invalidStatusMessage(store, "invalid status"))
+		return
+	}
+
+	t, actualStatus, err := store.Get(id)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
@@ -500,12 +655,17 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if h.deps.TmuxManager == nil {
-		writeError(w, http.StatusServiceUnavailable, "tmux_unavailable", "tmux is not installed")
+	projectCfg, _ := mergeProjectConfig(projectPath)
+
+	backend, err := resolveBackend(r.URL.Query().Get("backend"), projectCfg)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_backend", err.Error())
+		return
+	}
+	if reason := h.deps.backendUnavailable(backend); reason != "" {
+		writeError(w, http.StatusServiceUnavailable, "backend_unavailable", reason)
 		return
 	}
-
-	projectCfg, _ := mergeProjectConfig(projectPath)
 
 	if variantName == "" {
 		names := projectCfg.VariantNames()
@@ -523,31 +683,29 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, "invalid_variant", avErr.Error())
 		return
 	}
-	resolvedAgent := string(av.Agent)
-	if resolvedAgent == "" {
-		resolvedAgent = "claude"
-	}
 
 	sessionStore := h.deps.SessionManager.GetStore(projectPath)
-	result, err := spawn.Orchestrate(r.Context(), spawn.OrchestrateRequest{
-		TicketID:      id,
-		Mode:          mode,
-		Agent:         resolvedAgent,
-		AgentArgs:     av.Args,
-		EnvVars:       av.Env,
-		Companion:     projectCfg.Companion,
-		ArchitectPath: projectPath,
-	}, spawn.OrchestrateDeps{
-		Store:          store,
-		SessionStore:   sessionStore,
-		TmuxManager:    h.deps.TmuxManager,
-		SupervisorCtx:  h.deps.SupervisorCtx,
-		Logger:         h.deps.Logger,
-		CortexdPath:    h.deps.CortexdPath,
-		DefaultsDir:    h.deps.DefaultsDir,
-		HubEventSource: hubEventSource(h.deps.ReceiverManager),
-		DaemonEndpoint: h.deps.DaemonEndpoint,
-	})
+	var result *spawn.OrchestrateResult
+	if h.deps.SpawnQueue != nil {
+		var spawned *queuedSpawn
+		spawned, err = h.deps.SpawnQueue.Spawn(r.Context(), projectPath, projectCfg, store, t,
+			session.QueuedSpawn{TicketID: id, Variant: variantName, Mode: mode, Force: force, Backend: backend, Repo: repo}, av)
+		if err == nil && spawned.Queue != nil {
+			ticketResp, err := ticketResponse(store, t, actualStatus)
+			if err != nil {
+				handleTicketError(w, err, h.deps.Logger)
+				return
+			}
+			writeJSON(w, http.StatusAccepted, SpawnResponse{Ticket: ticketResp, Queue: spawned.Queue})
+			return
+		}
+		if err == nil {
+			result = spawned.Result
+		}
+	} else {
+		result, err = spawnTicketSession(r.Context(), h.deps, projectPath, projectCfg, store,
+			session.QueuedSpawn{TicketID: id, Variant: variantName, Mode: mode, Force: force, Backend: backend, Repo: repo}, av)
+	}
 	if err != nil {
 		switch {
 		case spawn.IsStateError(err):
@@ -557,8 +715,12 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 			} else {
 				writeError(w, http.StatusConflict, "state_conflict", err.Error())
 			}
+		case spawn.IsBlockedError(err):
+			writeError(w, http.StatusConflict, "ticket_blocked", err.Error())
 		case spawn.IsConfigError(err):
 			writeError(w, http.StatusBadRequest, "config_error", err.Error())
+		case spawn.IsWorktreeError(err):
+			writeError(w, http.StatusInternalServerError, "worktree_error", err.Error())
 		case spawn.IsBinaryNotFoundError(err):
 			h.deps.Logger.Error("binary not found", "error", err)
 			writeError(w, http.StatusInternalServerError, "spawn_error", err.Error())
@@ -574,7 +736,7 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 			handleTicketError(w, err, h.deps.Logger)
 			return
 		}
-		if result.StateInfo.Session != nil {
+		if result.StateInfo.Session != nil && !result.StateInfo.Session.IsHeadless() && h.deps.TmuxManager != nil {
 			if err := h.deps.TmuxManager.FocusWindow(result.TmuxSession, result.StateInfo.Session.TmuxWindow); err != nil {
 				h.deps.Logger.Warn("failed to focus window", "error", err)
 			}
@@ -583,21 +745,23 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 		resp := SpawnResponse{
-			Session: types.ToSessionResponse(result.StateInfo.Session),
-			Ticket:  ticketResp,
+			Session:  types.ToSessionResponse(result.StateInfo.Session),
+			Sessions: repoSessionResponses(sessionStore, id, result),
+			Ticket:   ticketResp,
 		}
 		writeJSON(w, http.StatusOK, resp)
 		return
 	}
 
-	sess, _ := sessionStore.GetByTicketID(id)
+	sess, _ := sessionStore.GetByTicketRepo(id, firstSpawnedRepo(result))
 	ticketResp, err := ticketResponse(store, result.Ticket, result.TicketStatus)
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 	resp := SpawnResponse{
-		Ticket: ticketResp,
+		Ticket:   ticketResp,
+		Sessions: repoSessionResponses(sessionStore, id, result),
 	}
 	if sess != nil {
 		resp.Session = types.ToSessionResponse(sess)
@@ -610,7 +774,72 @@ func (h *TicketHandlers) Spawn(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusCreated, resp)
 }
 
-func (h *TicketHandlers) GetDiffs(w http.ResponseWriter, r *http.Request) {
+// spawnTicketSession starts a worker session for the ticket, variant, mode
+// and backend in e, or reports the session already running.
+func spawnTicketSession(ctx context.Context, deps *Dependencies, projectPath string, projectCfg *architectconfig.Config, store *ticket.Store, e session.QueuedSpawn, av architectconfig.AgentVariant) (*spawn.OrchestrateResult, error) {
+	resolvedAgent := string(av.Agent)
+	if resolvedAgent == "" {
+		resolvedAgent = "claude"
+	}
+
+	var headlessManager spawn.TmuxManagerInterface
+	if deps.Headless != nil {
+		headlessManager = deps.Headless
+	}
+
+	return spawn.Orchestrate(ctx, spawn.OrchestrateRequest{
+		TicketID:      e.TicketID,
+		Mode:          e.Mode,
+		Agent:         resolvedAgent,
+		Variant:       e.Variant,
+		AgentArgs:     av.Args,
+		EnvVars:       av.Env,
+		Companion:     projectCfg.Companion,
+		ArchitectPath: projectPath,
+		Force:         e.Force,
+		Backend:       e.Backend,
+		Repo:          e.Repo,
+	}, spawn.OrchestrateDeps{
+		Store:          store,
+		SessionStore:   deps.SessionManager.GetStore(projectPath),
+		TmuxManager:    deps.TmuxManager,
+		Headless:       headlessManager,
+		SupervisorCtx:  deps.SupervisorCtx,
+		Logger:         deps.Logger,
+		CortexdPath:    deps.CortexdPath,
+		DefaultsDir:    deps.DefaultsDir,
+		HubEventSource: hubEventSource(deps.ReceiverManager),
+		DaemonEndpoint: deps.DaemonEndpoint,
+		IssueToken:     deps.tokenIssuer(),
+		IssueHookToken: deps.hookTokenIssuer(),
+	})
+}
+
+// firstSpawnedRepo returns the session repo of the first repo a multi-repo
+// spawn started or resumed, or "" for other tickets.
+func firstSpawnedRepo(result *spawn.OrchestrateResult) string {
+	for _, rr := range result.Repos {
+		if rr.Outcome != spawn.OutcomeAlreadyActive {
+			return rr.Repo
+		}
+	}
+	return ""
+}
+
+// repoSessionResponses returns the sessions of the repos a multi-repo
+// spawn touched, or nil for other tickets.
+func repoSessionResponses(sessStore *session.Store, ticketID string, result *spawn.OrchestrateResult) []SessionResponse {
+	var resp []SessionResponse
+	for _, rr := range result.Repos {
+		if sess, err := sessStore.GetByTicketRepo(ticketID, rr.Repo); err == nil {
+			resp = append(resp, types.ToSessionResponse(sess))
+		}
+	}
+	return resp
+}
+
+// Graph returns the dependency graph connected to a ticket.
+func (h *TicketHandlers) Graph(w http.ResponseWriter, r *http.Request) {
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
@@ -618,55 +847,89 @@ func (h *TicketHandlers) GetDiffs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id := chi.URLParam(r, "id")
-	t, _, err := store.Get(id)
+	g, err := store.Graph(chi.URLParam(r, "id"))
 	if err != nil {
 		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
-	hasConclusion, _ := store.HasConclusion(id)
-	if !hasConclusion {
-		writeError(w, http.StatusNotFound, "no_conclusion", "ticket has no conclusion")
+	writeJSON(w, http.StatusOK, types.ToTicketGraphResponse(g))
+}
+
+// History handles GET /tickets/{id}/history and returns the ticket's
+// revisions, oldest first.
+func (h *TicketHandlers) History(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	revisions, err := store.History(id)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
-	concMeta, _, err := store.ReadConclusion(id)
+	writeJSON(w, http.StatusOK, types.ToTicketHistoryResponse(id, revisions))
+}
+
+// RestoreBody handles POST /tickets/{id}/restore and sets the ticket body
+// back to a prior revision.
+func (h *TicketHandlers) RestoreBody(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
 	if err != nil {
-		writeError(w, http.StatusNotFound, "no_conclusion", "failed to read ticket conclusion")
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
 		return
 	}
 
-	repoDir, err := resolveTicketRepoDir(projectPath, t.Repo)
+	var req RestoreTicketBodyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", "invalid JSON in request body")
+		return
+	}
+	if req.Revision < 1 {
+		writeError(w, http.StatusBadRequest, "validation_error", "revision must be a positive integer")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	t, err := store.RestoreBody(h.deps.requestActor(r), id, req.Revision)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "invalid_repo", err.Error())
+		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
-	resp := DiffsResponse{
-		TicketID: t.ID,
-		Repo:     repoDir,
-		Commits:  []CommitDiffResponse{},
+	resp, err := ticketResponse(store, t, t.Status)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
+		return
 	}
-	if len(concMeta.Commits) == 0 {
-		writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TicketHandlers) GetDiffs(w http.ResponseWriter, r *http.Request) {
+	projectPath := GetArchitectPath(r.Context())
+	store, err := h.deps.StoreManager.GetStore(projectPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_error", err.Error())
 		return
 	}
 
-	if invalid := validateCommitSHAs(repoDir, concMeta.Commits); len(invalid) > 0 {
-		writeError(w, http.StatusNotFound, "commit_not_found",
-			fmt.Sprintf("commit %s does not exist in %s", invalid[0], repoDir))
+	id := chi.URLParam(r, "id")
+	t, _, err := store.Get(id)
+	if err != nil {
+		handleTicketError(w, err, h.deps.Logger)
 		return
 	}
 
-	resp.Commits = make([]CommitDiffResponse, 0, len(concMeta.Commits))
-	for _, sha := range concMeta.Commits {
-		diff, err := buildCommitDiff(repoDir, sha)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, "git_error", err.Error())
-			return
-		}
-		resp.Commits = append(resp.Commits, *diff)
+	resp, diffErr := ticketDiffs(projectPath, store, t)
+	if diffErr != nil {
+		writeError(w, diffErr.status, diffErr.code, diffErr.message)
+		return
 	}
 
 	writeJSON(w, http.StatusOK, resp)
@@ -711,14 +974,23 @@ func (h *TicketHandlers) Focus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	projectCfg, _ := architectconfig.Load(projectPath)
+	tmuxSession := projectCfg.GetTmuxSessionName()
+
+	if sess.IsHeadless() {
+		msg := "session runs headless and has no tmux window"
+		if h.deps.Headless != nil {
+			msg += "; its output is logged to " + h.deps.Headless.LogPath(tmuxSession, sess.TmuxWindow)
+		}
+		writeError(w, http.StatusConflict, "headless_session", msg)
+		return
+	}
+
 	if h.deps.TmuxManager == nil {
 		writeError(w, http.StatusServiceUnavailable, "tmux_unavailable", "tmux is not installed")
 		return
 	}
 
-	projectCfg, _ := architectconfig.Load(projectPath)
-	tmuxSession := projectCfg.GetTmuxSessionName()
-
 	if err := h.deps.TmuxManager.FocusWindow(tmuxSession, sess.TmuxWindow); err != nil {
 		writeError(w, http.StatusInternalServerError, "focus_error", err.Error())
 		return
@@ -761,9 +1033,22 @@ func (h *TicketHandlers) Conclude(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A multi-repo ticket concludes one repo at a time; rt is the ticket as
+	// that repo's worker sees it.
+	rt := t
+	if t.IsMultiRepo() {
+		if !slices.Contains(t.Repos, req.Repo) {
+			writeError(w, http.StatusBadRequest, "validation_error",
+				"Cannot conclude: repo must be one of "+strings.Join(t.Repos, ", ")+" for this multi-repo ticket.")
+			return
+		}
+		rt = forRepo(t, req.Repo)
+	}
+	sessRepo := sessionRepo(t, rt.Repo)
+
 	repoDir := ""
 	if len(req.Commits) > 0 {
-		repoDir, err = resolveTicketRepoDir(projectPath, t.Repo)
+		repoDir, err = resolveTicketRepoDir(projectPath, rt.Repo)
 		if err != nil {
 			writeError(w, http.StatusBadRequest, "invalid_repo", err.Error())
 			return
@@ -794,19 +1079,46 @@ func (h *TicketHandlers) Conclude(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var tmuxWindow string
+	var verification *verifyRun
+	if !req.Rejected {
+		if verification, err = pendingVerification(projectPath, id, rt.Repo, repoDir, req.Commits); err != nil {
+			h.deps.Logger.Warn("verification skipped", "ticket", id, "error", err)
+		}
+	}
+
+	// Acceptance criteria cover the whole ticket, so only the conclusion
+	// that completes it is checked.
+	var unchecked []ticket.Criterion
+	if !req.Rejected && lastRepoToConclude(store, t, req.Repo) {
+		unchecked = ticket.UncheckedCriteria(t.Criteria())
+	}
+	if len(unchecked) > 0 {
+		if projectCfg, cfgErr := architectconfig.Load(projectPath); cfgErr == nil && projectCfg.AcceptanceCriteria == "enforce" {
+			writeError(w, http.StatusConflict, "criteria_unchecked",
+				"Cannot conclude: acceptance criteria "+formatCriteria(unchecked)+" are unchecked. Check them with evidence, or conclude with rejected: true.")
+			return
+		}
+	}
+
+	var ended *session.Session
 	var agent string
+	var variant string
+	var worktreePath string
+	var sessionID string
 	if h.deps.SessionManager != nil {
 		sessStore := h.deps.SessionManager.GetStore(projectPath)
-		if sess, sessErr := sessStore.GetByTicketID(id); sessErr == nil && sess != nil {
-			tmuxWindow = sess.TmuxWindow
+		if sess, sessErr := sessStore.GetByTicketRepo(id, sessRepo); sessErr == nil && sess != nil {
+			ended = sess
+			sessionID = sess.SessionID
 			agent = sess.Agent
+			variant = sess.Variant
+			worktreePath = sess.WorktreePath
 		}
 	}
 
 	if h.deps.SessionManager != nil {
 		sessStore := h.deps.SessionManager.GetStore(projectPath)
-		if endErr := sessStore.EndByTicketID(id); endErr != nil && !storage.IsNotFound(endErr) {
+		if endErr := sessStore.EndByTicketRepo(id, sessRepo); endErr != nil && !storage.IsNotFound(endErr) {
 			h.deps.Logger.Warn("failed to end session", "error", endErr)
 		}
 	}
@@ -837,33 +1149,155 @@ func (h *TicketHandlers) Conclude(w http.ResponseWriter, r *http.Request) {
 		Rejected:        req.Rejected,
 		RejectionReason: req.RejectionReason,
 		Commits:         req.Commits,
+		SessionID:       sessionID,
 	}
-
-	if writeErr := store.WriteConclusion(id, conclusionMeta, req.Content); writeErr != nil {
+	finish := func(m *ticket.TicketConclusionMeta) {
+		m.Variant = variant
+		if verification != nil {
+			m.PutVerification(verification.pending())
+		}
+	}
+	var writeErr error
+	pending := []string(nil)
+	if t.IsMultiRepo() {
+		conclusionMeta, writeErr = store.ConcludeRepo(id, t.Repos, ticket.RepoConclusion{
+			Repo:            req.Repo,
+			SessionID:       sessionID,
+			Agent:           agent,
+			StartedAt:       startedAt,
+			ConcludedAt:     concludedAt,
+			Rejected:        req.Rejected,
+			RejectionReason: req.RejectionReason,
+			Commits:         req.Commits,
+		}, req.Content, finish)
+		for _, repo := range t.Repos {
+			if conclusionMeta.RepoConclusion(repo) == nil {
+				pending = append(pending, repo)
+			}
+		}
+	} else {
+		finish(conclusionMeta)
+		writeErr = store.WriteConclusion(id, conclusionMeta, req.Content)
+	}
+	if writeErr != nil {
 		h.deps.Logger.Warn("failed to write conclusion", "error", writeErr)
 	}
+	if writeErr == nil && verification != nil {
+		ctx := h.deps.SupervisorCtx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		go runVerification(ctx, h.deps, store, verification)
+	}
 
-	if err := store.Move(id, ticket.StatusDone); err != nil {
-		handleTicketError(w, err, h.deps.Logger)
-		return
+	// The ticket is done once its last repo concludes, or waits in review
+	// when the architect has a review status and there is work to review.
+	target := ticket.StatusDone
+	if !conclusionMeta.Rejected && store.HasStatus(ticket.StatusReview) {
+		target = ticket.StatusReview
+	}
+	if len(pending) == 0 {
+		if target == ticket.StatusReview {
+			if _, err := store.StartReview(id); err != nil {
+				handleTicketError(w, err, h.deps.Logger)
+				return
+			}
+		}
+		if err := store.MoveAs(h.deps.requestActor(r), id, target); err != nil {
+			handleTicketError(w, err, h.deps.Logger)
+			return
+		}
+	}
+
+	if writeErr == nil && len(pending) == 0 {
+		h.deps.Bus.Emit(events.Event{
+			Type:          events.ConclusionCreated,
+			ArchitectPath: projectPath,
+			TicketID:      id,
+			SessionID:     sessionID,
+		})
 	}
 
-	if tmuxWindow != "" && h.deps.TmuxManager != nil {
+	if ended != nil {
 		projectCfg, _ := architectconfig.Load(projectPath)
 		tmuxSession := projectCfg.GetTmuxSessionName()
-		if killErr := h.deps.TmuxManager.KillWindow(tmuxSession, tmuxWindow); killErr != nil {
-			h.deps.Logger.Warn("failed to kill tmux window", "window", tmuxWindow, "error", killErr)
+		if killErr := h.deps.killSessionWindow(tmuxSession, ended); killErr != nil {
+			h.deps.Logger.Warn("failed to kill session window", "window", ended.TmuxWindow, "error", killErr)
+		}
+	}
+
+	message := "Session concluded and ticket moved to " + string(target)
+	if len(pending) > 0 {
+		message = "Session concluded for repo " + req.Repo + "; ticket stays open until " + strings.Join(pending, ", ") + " conclude"
+	}
+	if len(unchecked) > 0 {
+		message += "; warning: acceptance criteria " + formatCriteria(unchecked) + " are unchecked"
+	}
+	if verification != nil {
+		message += "; verifying commit " + verification.commit
+	}
+	if req.CleanupWorktree {
+		removed, rmErr := removeTicketWorktree(projectPath, rt, worktreePath)
+		switch {
+		case rmErr != nil:
+			h.deps.Logger.Warn("failed to remove worktree", "ticket", id, "error", rmErr)
+			message += "; worktree kept: " + rmErr.Error()
+		case removed != "":
+			message += "; removed worktree " + removed
+		}
+	}
+
+	if writeErr == nil && len(pending) == 0 && !conclusionMeta.Rejected {
+		if projectCfg, cfgErr := mergeProjectConfig(projectPath); cfgErr == nil && projectCfg.Reviewer.Auto {
+			rv, err := spawnReviewer(r.Context(), h.deps, projectPath, projectCfg, store, t, projectCfg.Reviewer.Variant)
+			switch {
+			case err != nil:
+				h.deps.Logger.Warn("failed to spawn reviewer", "ticket", id, "error", err)
+				message += "; reviewer spawn failed: " + err.Error()
+			case rv.Variant != "":
+				message += "; reviewer spawned with variant " + rv.Variant
+			default:
+				message += "; reviewer spawned"
+			}
 		}
 	}
 
 	resp := ConcludeSessionResponse{
 		Success:  true,
 		TicketID: id,
-		Message:  "Session concluded and ticket moved to done",
+		Message:  message,
 	}
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// lastRepoToConclude reports whether concluding repo completes ticket t:
+// always for a single-repo ticket, else when every other repo of the
+// current round has concluded.
+func lastRepoToConclude(store *ticket.Store, t *ticket.Ticket, repo string) bool {
+	if !t.IsMultiRepo() {
+		return true
+	}
+	existing, _, err := store.ReadConclusion(t.ID)
+	if err != nil || len(existing.Repos) == 0 || existing.ConcludedAll(t.Repos) {
+		return false
+	}
+	for _, r := range t.Repos {
+		if r != repo && existing.RepoConclusion(r) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// formatCriteria lists criteria as "#2 (text), #4 (text)".
+func formatCriteria(criteria []ticket.Criterion) string {
+	parts := make([]string, len(criteria))
+	for i, c := range criteria {
+		parts[i] = fmt.Sprintf("#%d (%s)", c.Index, c.Text)
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (h *TicketHandlers) Show(w http.ResponseWriter, r *http.Request) {
 	projectPath := GetArchitectPath(r.Context())
 	store, err := h.deps.StoreManager.GetStore(projectPath)
@@ -934,3 +1368,83 @@ func (h *TicketHandlers) Edit(w http.ResponseWriter, r *http.Request) {
 		Message: "Editor opened",
 	})
 }
+
+// ticketTypeDef resolves the cortex.yaml definition for t's type. Tickets whose
+// type has since been removed from cortex.yaml are treated as unconstrained.
+func (h *TicketHandlers) ticketTypeDef(projectPath string, t *ticket.Ticket) (architectconfig.TicketTypeDef, error) {
+	projectCfg, err := architectconfig.Load(projectPath)
+	if err != nil {
+		return architectconfig.TicketTypeDef{}, err
+	}
+	def, err := projectCfg.ResolveTicketType(t.Type)
+	if err != nil {
+		return architectconfig.TicketTypeDef{}, nil
+	}
+	return def, nil
+}
+
+// ticketCheckError is a check a new ticket failed, reported with its API
+// error code.
+type ticketCheckError struct {
+	code string
+	msg  string
+}
+
+func (e *ticketCheckError) Error() string {
+	return e.msg
+}
+
+// checkNewTicket checks a ticket about to be created in repos against the
+// project's repos and ticket types. Ticket creates and schedule runs share
+// it so a schedule cannot create a ticket the API would reject.
+func checkNewTicket(projectCfg *architectconfig.Config, t *ticket.Ticket, repos []string) error {
+	if len(repos) == 0 {
+		return &ticketCheckError{code: "missing_repo", msg: "repo is required"}
+	}
+	for _, repo := range repos {
+		if err := projectCfg.ValidateRepo(repo); err != nil {
+			return &ticketCheckError{code: "invalid_repo", msg: err.Error()}
+		}
+	}
+	typeDef, err := projectCfg.ResolveTicketType(t.Type)
+	if err != nil {
+		return &ticketCheckError{code: "invalid_type", msg: err.Error()}
+	}
+	if field := missingRequiredField(typeDef, t); field != "" {
+		return &ticketCheckError{code: "missing_field", msg: fmt.Sprintf("%s is required for %s tickets", field, ticketTypeName(t.Type))}
+	}
+	return nil
+}
+
+// missingRequiredField returns the first field required by def that t leaves empty.
+func missingRequiredField(def architectconfig.TicketTypeDef, t *ticket.Ticket) string {
+	for _, field := range def.RequiredFields {
+		var present bool
+		switch field {
+		case "body":
+			present = strings.TrimSpace(t.Body) != ""
+		case "due":
+			present = t.Due != nil
+		case "references":
+			present = len(t.References) > 0
+		case "blocked_by":
+			present = len(t.BlockedBy) > 0
+		case "blocks":
+			present = len(t.Blocks) > 0
+		default:
+			present = true
+		}
+		if !present {
+			return field
+		}
+	}
+	return ""
+}
+
+// ticketTypeName returns the ticket type name, defaulting to the built-in work type.
+func ticketTypeName(name string) string {
+	if name == "" {
+		return ticket.DefaultTicketType
+	}
+	return name
+}
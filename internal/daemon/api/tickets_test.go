@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -10,13 +11,17 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/events"
 	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/worktree"
 )
 
 // --- Test helpers (redefined here since integration_test.go uses a build tag) ---
@@ -26,6 +31,7 @@ type unitServer struct {
 	*httptest.Server
 	store       *ticket.Store
 	projectRoot string
+	deps        *Dependencies
 }
 
 func writeUnitConfig(t *testing.T, projectRoot string, repos map[string]string) {
@@ -185,6 +191,8 @@ func setupUnitServer(t *testing.T) *unitServer {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	bus := events.NewBus()
 	storeManager := NewStoreManager(logger, nil)
+	store.SetMoveCheck(moveCheck(tmpDir))
+	store.SetRenameCheck(renameCheck(tmpDir))
 	storeManager.stores[tmpDir] = store
 
 	sessionManager := NewSessionManager(logger)
@@ -195,12 +203,16 @@ func setupUnitServer(t *testing.T) *unitServer {
 		TmuxManager:    nil,
 		Bus:            bus,
 		Logger:         logger,
+		SearchManager:  NewSearchManager(logger, bus),
 	}
+	deps.SpawnQueue = NewSpawnQueueManager(logger, deps)
+	deps.Scheduler = NewScheduleManager(logger, deps)
 
 	return &unitServer{
 		Server:      httptest.NewServer(NewRouter(deps, deps.Logger)),
 		store:       store,
 		projectRoot: tmpDir,
+		deps:        deps,
 	}
 }
 
@@ -210,7 +222,7 @@ func TestSetDueDate_Success(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Due Date Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Due Date Ticket", "body", nil, nil, "", nil, nil, "")
 
 	body := SetDueDateRequest{DueDate: "2025-06-01T00:00:00Z"}
 	resp := ts.makeRequest(t, http.MethodPatch, "/tickets/"+created.ID+"/due-date", body)
@@ -228,7 +240,7 @@ func TestSetDueDate_InvalidJSON(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
 
 	req, _ := http.NewRequest(http.MethodPatch, ts.URL+"/tickets/"+created.ID+"/due-date", bytes.NewReader([]byte("bad json")))
 	req.Header.Set("Content-Type", "application/json")
@@ -252,7 +264,7 @@ func TestSetDueDate_EmptyDueDate(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
 
 	body := SetDueDateRequest{DueDate: ""}
 	resp := ts.makeRequest(t, http.MethodPatch, "/tickets/"+created.ID+"/due-date", body)
@@ -270,7 +282,7 @@ func TestSetDueDate_InvalidFormat(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
 
 	body := SetDueDateRequest{DueDate: "not-a-date"}
 	resp := ts.makeRequest(t, http.MethodPatch, "/tickets/"+created.ID+"/due-date", body)
@@ -301,7 +313,7 @@ func TestClearDueDate_Success(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
 
 	resp := ts.makeRequest(t, http.MethodDelete, "/tickets/"+created.ID+"/due-date", nil)
 	defer func() { _ = resp.Body.Close() }()
@@ -330,7 +342,7 @@ func TestGetByID_Success(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	resp := ts.makeRequest(t, http.MethodGet, "/tickets/by-id/"+created.ID, nil)
 	defer func() { _ = resp.Body.Close() }()
@@ -362,7 +374,7 @@ func TestGetDiffs_Success(t *testing.T) {
 
 	repoDir, sha := createGitRepoWithStructuredCommit(t)
 	writeUnitConfig(t, ts.projectRoot, map[string]string{"repo": repoDir})
-	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo")
+	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo", nil, nil, "")
 	meta := &ticket.TicketConclusionMeta{
 		StartedAt:   time.Now().UTC().Add(-time.Minute),
 		ConcludedAt: time.Now().UTC(),
@@ -451,7 +463,7 @@ func TestGetDiffs_NoConclusion(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "", nil, nil, "")
 	resp := ts.makeRequest(t, http.MethodGet, "/tickets/"+created.ID+"/diffs", nil)
 	defer func() { _ = resp.Body.Close() }()
 
@@ -469,7 +481,7 @@ func TestGetDiffs_EmptyCommits(t *testing.T) {
 
 	repoDir, _ := createGitRepoWithCommit(t)
 	writeUnitConfig(t, ts.projectRoot, map[string]string{"repo": repoDir})
-	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo")
+	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo", nil, nil, "")
 	meta := &ticket.TicketConclusionMeta{
 		StartedAt:   time.Now().UTC().Add(-time.Minute),
 		ConcludedAt: time.Now().UTC(),
@@ -496,7 +508,7 @@ func TestGetDiffs_InvalidRepo(t *testing.T) {
 
 	repoDir := filepath.Join(t.TempDir(), "missing")
 	writeUnitConfig(t, ts.projectRoot, map[string]string{"repo": repoDir})
-	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo")
+	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo", nil, nil, "")
 	meta := &ticket.TicketConclusionMeta{
 		StartedAt:   time.Now().UTC().Add(-time.Minute),
 		ConcludedAt: time.Now().UTC(),
@@ -524,7 +536,7 @@ func TestGetDiffs_MissingCommit(t *testing.T) {
 
 	repoDir, _ := createGitRepoWithCommit(t)
 	writeUnitConfig(t, ts.projectRoot, map[string]string{"repo": repoDir})
-	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo")
+	created, _ := ts.store.Create("Diff Ticket", "body", nil, nil, "repo", nil, nil, "")
 	meta := &ticket.TicketConclusionMeta{
 		StartedAt:   time.Now().UTC().Add(-time.Minute),
 		ConcludedAt: time.Now().UTC(),
@@ -552,7 +564,9 @@ func TestConclude_Success(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Conclude Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Conclude Ticket", "body", nil, nil, "", nil, nil, "")
+	ch, unsubscribe := ts.deps.Bus.Subscribe(ts.projectRoot)
+	defer unsubscribe()
 
 	// Use rejected=true to avoid needing a real git repo in the unit test.
 	body := ConcludeSessionRequest{
@@ -578,13 +592,245 @@ func TestConclude_Success(t *testing.T) {
 	if status != ticket.StatusDone {
 		t.Errorf("expected status 'done', got %q", status)
 	}
+
+	var concluded bool
+	for len(ch) > 0 {
+		if ev := <-ch; ev.Type == events.ConclusionCreated && ev.TicketID == created.ID {
+			concluded = true
+		}
+	}
+	if !concluded {
+		t.Error("expected conclusion_created event")
+	}
+}
+
+func TestConclude_MultiRepoWaitsForEveryRepo(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	apiDir, apiSHA := createGitRepoWithStructuredCommit(t)
+	webDir, _ := createGitRepoWithCommit(t)
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"api": apiDir, "web": webDir})
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets", map[string]any{
+		"title": "Rename field", "body": "body", "repos": []string{"api", "web"},
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	created := decode[TicketResponse](t, resp)
+	_ = resp.Body.Close()
+	if created.Repo != "api" || len(created.Repos) != 2 {
+		t.Fatalf("created repo = %q, repos = %v", created.Repo, created.Repos)
+	}
+
+	ch, unsubscribe := ts.deps.Bus.Subscribe(ts.projectRoot)
+	defer unsubscribe()
+
+	// The repo is required for a multi-repo ticket.
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{Content: "api done", Commits: []string{apiSHA}})
+	assertStatus(t, resp, http.StatusBadRequest)
+	_ = resp.Body.Close()
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{Content: "api done", Commits: []string{apiSHA}, Repo: "api"})
+	assertStatus(t, resp, http.StatusOK)
+	_ = resp.Body.Close()
+	if _, status, _ := ts.store.Get(created.ID); status == ticket.StatusDone {
+		t.Fatal("ticket moved to done before web concluded")
+	}
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{Content: "nothing to change", Rejected: true, RejectionReason: "client already compatible", Repo: "web"})
+	assertStatus(t, resp, http.StatusOK)
+	_ = resp.Body.Close()
+	if _, status, _ := ts.store.Get(created.ID); status != ticket.StatusDone {
+		t.Fatalf("expected done once every repo concluded, got %q", status)
+	}
+
+	var conclusions int
+	for len(ch) > 0 {
+		if ev := <-ch; ev.Type == events.ConclusionCreated {
+			conclusions++
+		}
+	}
+	if conclusions != 1 {
+		t.Errorf("expected one conclusion_created event, got %d", conclusions)
+	}
+
+	meta, body, err := ts.store.ReadConclusion(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(meta.Repos) != 2 || meta.Rejected || len(meta.Commits) != 1 {
+		t.Errorf("conclusion: repos = %d, rejected = %v, commits = %v", len(meta.Repos), meta.Rejected, meta.Commits)
+	}
+	if !strings.Contains(body, "## api\n\napi done") || !strings.Contains(body, "## web\n\nnothing to change") {
+		t.Errorf("conclusion body = %q", body)
+	}
+
+	resp = ts.makeRequest(t, http.MethodGet, "/tickets/"+created.ID+"/diffs", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+	diffs := decode[DiffsResponse](t, resp)
+	if len(diffs.Repos) != 2 || diffs.Repos[0].Repo != "api" || len(diffs.Repos[0].Commits) != 1 || len(diffs.Repos[1].Commits) != 0 {
+		t.Fatalf("diffs grouped as %+v", diffs.Repos)
+	}
+	if diffs.Repos[0].Commits[0].SHA != apiSHA || diffs.Commits != nil {
+		t.Errorf("unexpected diffs: %+v", diffs)
+	}
+}
+
+func TestConclude_MultiRepoConcurrently(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	repos := []string{"api", "web", "cli", "docs"}
+	paths := make(map[string]string, len(repos))
+	for _, repo := range repos {
+		paths[repo], _ = createGitRepoWithCommit(t)
+	}
+	writeUnitConfig(t, ts.projectRoot, paths)
+
+	for i := range 10 {
+		resp := ts.makeRequest(t, http.MethodPost, "/tickets", map[string]any{
+			"title": fmt.Sprintf("Rename field %d", i), "body": "body", "repos": repos,
+		})
+		assertStatus(t, resp, http.StatusCreated)
+		created := decode[TicketResponse](t, resp)
+		_ = resp.Body.Close()
+
+		// Every worker concludes at once; no result may be lost.
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for _, repo := range repos {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{
+					Content: repo + " done", Rejected: true, RejectionReason: "no change needed", Repo: repo,
+				})
+				defer func() { _ = resp.Body.Close() }()
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("conclude %s: status %d", repo, resp.StatusCode)
+				}
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		meta, _, err := ts.store.ReadConclusion(created.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(meta.Repos) != len(repos) {
+			t.Fatalf("round %d: conclusion holds %d repos, want %d", i, len(meta.Repos), len(repos))
+		}
+		if _, status, _ := ts.store.Get(created.ID); status != ticket.StatusDone {
+			t.Fatalf("round %d: expected done once every repo concluded, got %q", i, status)
+		}
+	}
+}
+
+func TestConclude_UncheckedCriteria(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	repoDir, sha := createGitRepoWithCommit(t)
+	config := "name: test\nrepos:\n  test-repo: " + repoDir + "\nacceptance_criteria: enforce\n"
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	body := "## Acceptance criteria\n\n- [x] parses input\n- [ ] reports errors\n"
+	created, _ := ts.store.Create("Criteria Ticket", body, nil, nil, "test-repo", nil, nil, "")
+	conclude := ConcludeSessionRequest{Content: "done report", Commits: []string{sha}}
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", conclude)
+	assertStatus(t, resp, http.StatusConflict)
+	if result := decode[ErrorResponse](t, resp); result.Code != "criteria_unchecked" || !strings.Contains(result.Error, "#2 (reports errors)") {
+		t.Errorf("unexpected error: %+v", result)
+	}
+	_ = resp.Body.Close()
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/criteria/2", CheckCriterionRequest{Checked: true, Evidence: "TestErrors"})
+	assertStatus(t, resp, http.StatusOK)
+	checked := decode[TicketResponse](t, resp)
+	_ = resp.Body.Close()
+	if len(checked.Criteria) != 2 || !checked.Criteria[1].Checked || checked.Criteria[1].Evidence != "TestErrors" {
+		t.Fatalf("unexpected criteria: %+v", checked.Criteria)
+	}
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", conclude)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+}
+
+func TestConclude_UncheckedCriteriaWarns(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	repoDir, sha := createGitRepoWithCommit(t)
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"test-repo": repoDir})
+
+	body := "## Acceptance\n\n- [ ] reports errors\n"
+	created, _ := ts.store.Create("Criteria Ticket", body, nil, nil, "test-repo", nil, nil, "")
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{Content: "done report", Commits: []string{sha}})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+	if result := decode[ConcludeSessionResponse](t, resp); !strings.Contains(result.Message, "acceptance criteria #1 (reports errors) are unchecked") {
+		t.Errorf("expected an unchecked criteria warning, got %q", result.Message)
+	}
+}
+
+func TestConclude_RunsVerification(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	repoDir, sha := createGitRepoWithCommit(t)
+	config := "name: test\nrepos:\n  test-repo:\n    path: " + repoDir + "\n" +
+		"    verify:\n      commands: [grep -q world file.txt, grep -q missing file.txt]\n"
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	created, _ := ts.store.Create("Verified Ticket", "body", nil, nil, "test-repo", nil, nil, "")
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", ConcludeSessionRequest{Content: "done report", Commits: []string{sha}})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+	if result := decode[ConcludeSessionResponse](t, resp); !strings.Contains(result.Message, "verifying commit "+sha) {
+		t.Errorf("expected the message to mention the verification, got %q", result.Message)
+	}
+
+	var v *ticket.Verification
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		meta, _, err := ts.store.ReadConclusion(created.ID)
+		if err != nil {
+			t.Fatalf("read conclusion: %v", err)
+		}
+		if v = meta.Verification("test-repo"); v != nil && v.Status != ticket.VerifyPending {
+			break
+		}
+	}
+	if v == nil || v.Status != ticket.VerifyFailed {
+		t.Fatalf("expected a failed verification, got %+v", v)
+	}
+	if len(v.Commands) != 2 || v.Commands[0].ExitCode != 0 || v.Commands[1].ExitCode != 1 || v.Commit != sha {
+		t.Errorf("unexpected verification: %+v", v)
+	}
+
+	listResp := ts.makeRequest(t, http.MethodGet, "/tickets/done", nil)
+	defer func() { _ = listResp.Body.Close() }()
+	assertStatus(t, listResp, http.StatusOK)
+	list := decode[ListTicketsResponse](t, listResp)
+	if len(list.Tickets) != 1 || list.Tickets[0].Verification != "failed" {
+		t.Errorf("expected the summary to carry the verdict, got %+v", list.Tickets)
+	}
 }
 
 func TestConclude_MissingCommits(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Conclude Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Conclude Ticket", "body", nil, nil, "", nil, nil, "")
 
 	body := ConcludeSessionRequest{Content: "done report"}
 	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", body)
@@ -602,7 +848,7 @@ func TestConclude_Rejected_NoReason(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Conclude Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Conclude Ticket", "body", nil, nil, "", nil, nil, "")
 
 	body := ConcludeSessionRequest{Content: "done report", Rejected: true}
 	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", body)
@@ -620,7 +866,7 @@ func TestConclude_InvalidJSON(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
 
 	req, _ := http.NewRequest(http.MethodPost, ts.URL+"/tickets/"+created.ID+"/conclude", bytes.NewReader([]byte("bad")))
 	req.Header.Set("Content-Type", "application/json")
@@ -639,7 +885,7 @@ func TestConclude_EmptyContent(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "")
 
 	body := ConcludeSessionRequest{Content: ""}
 	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", body)
@@ -679,6 +925,8 @@ func TestFocus_NoSessionManager(t *testing.T) {
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	storeManager := NewStoreManager(logger, nil)
+	store.SetMoveCheck(moveCheck(tmpDir))
+	store.SetRenameCheck(renameCheck(tmpDir))
 	storeManager.stores[tmpDir] = store
 
 	deps := &Dependencies{
@@ -691,7 +939,7 @@ func TestFocus_NoSessionManager(t *testing.T) {
 	srv := httptest.NewServer(NewRouter(deps, deps.Logger))
 	defer srv.Close()
 
-	created, _ := store.Create("Focus Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Focus Ticket", "body", nil, nil, "", nil, nil, "")
 
 	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/tickets/"+created.ID+"/focus", nil)
 	req.Header.Set(ArchitectHeader, tmpDir)
@@ -710,7 +958,7 @@ func TestFocus_NoActiveSession(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	created, _ := ts.store.Create("Focus Ticket", "body", nil, nil, "")
+	created, _ := ts.store.Create("Focus Ticket", "body", nil, nil, "", nil, nil, "")
 
 	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/focus", nil)
 	defer func() { _ = resp.Body.Close() }()
@@ -750,8 +998,8 @@ func TestListAll_WithQueryFilter(t *testing.T) {
 	ts := setupUnitServer(t)
 	defer ts.Close()
 
-	_, _ = ts.store.Create("Alpha Ticket", "body1", nil, nil, "")
-	_, _ = ts.store.Create("Beta Ticket", "body2", nil, nil, "")
+	_, _ = ts.store.Create("Alpha Ticket", "body1", nil, nil, "", nil, nil, "")
+	_, _ = ts.store.Create("Beta Ticket", "body2", nil, nil, "", nil, nil, "")
 
 	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/tickets?query=alpha", nil)
 	req.Header.Set(ArchitectHeader, ts.projectRoot)
@@ -842,6 +1090,51 @@ func TestCreate_MissingRepo(t *testing.T) {
 	}
 }
 
+func TestCreate_FromTemplate(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"test-repo": ts.projectRoot})
+
+	dir := filepath.Join(ts.projectRoot, "templates")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	tmpl := "---\ntitle: \"Fix {{.component}}\"\nrepo: test-repo\ndue: 2d\n---\n## Context\n\n{{.component}} is broken.\n"
+	if err := os.WriteFile(filepath.Join(dir, "bug.md"), []byte(tmpl), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := ts.makeRequest(t, http.MethodGet, "/templates", nil)
+	assertStatus(t, resp, http.StatusOK)
+	list := decode[ListTemplatesResponse](t, resp)
+	_ = resp.Body.Close()
+	if len(list.Templates) != 1 || list.Templates[0].Name != "bug" || len(list.Templates[0].Variables) != 1 {
+		t.Fatalf("unexpected templates: %+v", list.Templates)
+	}
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets", CreateTicketRequest{Template: "bug"})
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "validation_error" {
+		t.Errorf("expected code 'validation_error' for a missing variable, got %q", result.Code)
+	}
+	_ = resp.Body.Close()
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets", CreateTicketRequest{Template: "missing"})
+	assertStatus(t, resp, http.StatusNotFound)
+	_ = resp.Body.Close()
+
+	resp = ts.makeRequest(t, http.MethodPost, "/tickets", CreateTicketRequest{Template: "bug", Vars: map[string]string{"component": "parser"}})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusCreated)
+	result := decode[TicketResponse](t, resp)
+	if result.Title != "Fix parser" || result.Repo != "test-repo" || result.Body != "## Context\n\nparser is broken.\n" {
+		t.Errorf("template not applied: %+v", result)
+	}
+	if result.Due == nil {
+		t.Error("expected the template's due date")
+	}
+}
+
 // --- ListByStatus with due_before filter ---
 
 func TestListByStatus_DueBeforeInvalidFormat(t *testing.T) {
@@ -865,6 +1158,365 @@ func TestListByStatus_DueBeforeInvalidFormat(t *testing.T) {
 	}
 }
 
+// --- Dependency graph ---
+
+func TestGetTicketGraph(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	blocker, _ := ts.store.Create("Blocker", "body", nil, nil, "", nil, nil, "")
+	blocked, _ := ts.store.Create("Blocked", "body", nil, nil, "", []string{blocker.ID}, nil, "")
+
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets/"+blocked.ID+"/graph", nil)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[TicketGraphResponse](t, resp)
+	if result.TicketID != blocked.ID {
+		t.Errorf("expected ticket_id %q, got %q", blocked.ID, result.TicketID)
+	}
+	if len(result.Nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %d", len(result.Nodes))
+	}
+	if len(result.Edges) != 1 || result.Edges[0].From != blocker.ID || result.Edges[0].To != blocked.ID {
+		t.Errorf("unexpected edges: %+v", result.Edges)
+	}
+}
+
+func TestGetTicketGraph_NotFound(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets/missing/graph", nil)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusNotFound)
+}
+
+func TestUpdateTicket_BlockedByCycle(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	a, _ := ts.store.Create("A", "body", nil, nil, "", nil, nil, "")
+	b, _ := ts.store.Create("B", "body", nil, nil, "", []string{a.ID}, nil, "")
+
+	blockedBy := []string{b.ID}
+	resp := ts.makeRequest(t, http.MethodPut, "/tickets/backlog/"+a.ID, UpdateTicketRequest{BlockedBy: &blockedBy})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+
+	result := decode[ErrorResponse](t, resp)
+	if result.Code != "validation_error" {
+		t.Errorf("expected code 'validation_error', got %q", result.Code)
+	}
+}
+
+func TestListAllTickets_MarksBlocked(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	blocker, _ := ts.store.Create("Blocker", "body", nil, nil, "", nil, nil, "")
+	blocked, _ := ts.store.Create("Blocked", "body", nil, nil, "", []string{blocker.ID}, nil, "")
+
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets", nil)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[ListAllTicketsResponse](t, resp)
+	for _, s := range result.Backlog {
+		if want := s.ID == blocked.ID; s.IsBlocked != want {
+			t.Errorf("ticket %s is_blocked = %v, want %v", s.ID, s.IsBlocked, want)
+		}
+	}
+}
+
+// --- Ticket types ---
+
+// writeTypesConfig writes a cortex.yaml with a test-repo and the given types section.
+func writeTypesConfig(t *testing.T, projectRoot, types string) {
+	t.Helper()
+	content := "name: test\nrepos:\n  test-repo: " + projectRoot + "\n" + types
+	if err := os.WriteFile(filepath.Join(projectRoot, "cortex.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreate_CustomType(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeTypesConfig(t, ts.projectRoot, "types:\n  bug:\n    required_fields: [references]\n")
+
+	body := map[string]any{"title": "Crash", "body": "body", "repo": "test-repo", "type": "bug"}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "missing_field" {
+		t.Errorf("expected code 'missing_field', got %q", result.Code)
+	}
+
+	body["references"] = []string{"ref"}
+	resp2 := ts.makeRequest(t, http.MethodPost, "/tickets", body)
+	defer func() { _ = resp2.Body.Close() }()
+
+	assertStatus(t, resp2, http.StatusCreated)
+	if result := decode[TicketResponse](t, resp2); result.Type != "bug" {
+		t.Errorf("expected type 'bug', got %q", result.Type)
+	}
+}
+
+func TestCreate_UnknownType(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeTypesConfig(t, ts.projectRoot, "")
+
+	body := map[string]any{"title": "Spike", "body": "body", "repo": "test-repo", "type": "spike"}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "invalid_type" {
+		t.Errorf("expected code 'invalid_type', got %q", result.Code)
+	}
+}
+
+func TestMove_StatusNotAllowedForType(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeTypesConfig(t, ts.projectRoot, "types:\n  spike:\n    allowed_statuses: [backlog, progress]\n")
+
+	created, _ := ts.store.Create("Spike", "body", nil, nil, "test-repo", nil, nil, "spike")
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/backlog/"+created.ID+"/move", MoveTicketRequest{To: "done"})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "status_not_allowed" {
+		t.Errorf("expected code 'status_not_allowed', got %q", result.Code)
+	}
+
+	resp2 := ts.makeRequest(t, http.MethodPost, "/tickets/backlog/"+created.ID+"/move", MoveTicketRequest{To: "progress"})
+	defer func() { _ = resp2.Body.Close() }()
+
+	assertStatus(t, resp2, http.StatusOK)
+}
+
+func TestConclude_StatusNotAllowedForType(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeTypesConfig(t, ts.projectRoot, "types:\n  spike:\n    allowed_statuses: [backlog, progress]\n")
+
+	created, _ := ts.store.Create("Spike", "body", nil, nil, "test-repo", nil, nil, "spike")
+	if err := ts.store.Move(created.ID, ticket.StatusProgress); err != nil {
+		t.Fatal(err)
+	}
+
+	body := ConcludeSessionRequest{Content: "report", Rejected: true, RejectionReason: "no git repo"}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "status_not_allowed" {
+		t.Errorf("expected code 'status_not_allowed', got %q", result.Code)
+	}
+	if _, status, _ := ts.store.Get(created.ID); status != ticket.StatusProgress {
+		t.Errorf("expected ticket to stay in progress, got %q", status)
+	}
+}
+
+func TestMove_InvalidTransition(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeTypesConfig(t, ts.projectRoot, `statuses:
+  - name: backlog
+    transitions: [progress]
+  - name: progress
+    transitions: [review]
+  - name: review
+    transitions: [progress, done]
+  - name: done
+`)
+
+	created, _ := ts.store.Create("Feature", "body", nil, nil, "test-repo", nil, nil, "")
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/backlog/"+created.ID+"/move", MoveTicketRequest{To: "done"})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "invalid_transition" {
+		t.Errorf("expected code 'invalid_transition', got %q", result.Code)
+	}
+
+	for _, step := range []struct{ from, to string }{{"backlog", "progress"}, {"progress", "review"}} {
+		r := ts.makeRequest(t, http.MethodPost, "/tickets/"+step.from+"/"+created.ID+"/move", MoveTicketRequest{To: step.to})
+		assertStatus(t, r, http.StatusOK)
+		_ = r.Body.Close()
+	}
+
+	listResp := ts.makeRequest(t, http.MethodGet, "/tickets/review", nil)
+	defer func() { _ = listResp.Body.Close() }()
+
+	assertStatus(t, listResp, http.StatusOK)
+	if result := decode[ListTicketsResponse](t, listResp); len(result.Tickets) != 1 {
+		t.Errorf("expected 1 ticket in review, got %d", len(result.Tickets))
+	}
+
+	allResp := ts.makeRequest(t, http.MethodGet, "/tickets", nil)
+	defer func() { _ = allResp.Body.Close() }()
+
+	all := decode[ListAllTicketsResponse](t, allResp)
+	if len(all.Columns) != 4 || all.Columns[2].Status != "review" || len(all.Columns[2].Tickets) != 1 {
+		t.Errorf("expected review column with 1 ticket, got %+v", all.Columns)
+	}
+}
+
+func TestConclude_InvalidTransition(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeTypesConfig(t, ts.projectRoot, `statuses:
+  - name: backlog
+    transitions: [progress]
+  - name: progress
+    transitions: [review]
+  - name: review
+    transitions: [progress, done]
+  - name: done
+`)
+
+	created, _ := ts.store.Create("Feature", "body", nil, nil, "test-repo", nil, nil, "")
+	if err := ts.store.Move(created.ID, ticket.StatusProgress); err != nil {
+		t.Fatal(err)
+	}
+
+	// A rejected conclusion skips review, which progress must not do here.
+	body := ConcludeSessionRequest{Content: "report", Rejected: true, RejectionReason: "no git repo"}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "invalid_transition" {
+		t.Errorf("expected code 'invalid_transition', got %q", result.Code)
+	}
+	if _, status, _ := ts.store.Get(created.ID); status != ticket.StatusProgress {
+		t.Errorf("expected ticket to stay in progress, got %q", status)
+	}
+}
+
+// setupWorktreeTicket configures test-repo with isolation: worktree and
+// creates the worktree of a new ticket at its default location.
+func setupWorktreeTicket(t *testing.T, ts *unitServer) (*ticket.Ticket, string) {
+	t.Helper()
+
+	repoDir, _ := createGitRepoWithCommit(t)
+	content := "name: test\nrepos:\n  test-repo:\n    path: " + repoDir + "\n    isolation: worktree\n"
+	if err := os.WriteFile(filepath.Join(ts.projectRoot, "cortex.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := ts.store.Create("Isolated", "body", nil, nil, "test-repo", nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := worktree.Path(filepath.Join(ts.projectRoot, "worktrees"), "test-repo", created.ID)
+	if err := worktree.Ensure(repoDir, path, worktree.Branch(created.ID)); err != nil {
+		t.Fatalf("create worktree: %v", err)
+	}
+	return created, path
+}
+
+func TestConclude_CleanupWorktree(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, path := setupWorktreeTicket(t, ts)
+
+	body := ConcludeSessionRequest{
+		Content:         "done report",
+		Rejected:        true,
+		RejectionReason: "nothing to do",
+		CleanupWorktree: true,
+	}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/conclude", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusOK)
+	if result := decode[ConcludeSessionResponse](t, resp); !strings.Contains(result.Message, "removed worktree") {
+		t.Errorf("expected removal in message, got %q", result.Message)
+	}
+	if worktree.Exists(path) {
+		t.Error("expected worktree to be removed")
+	}
+}
+
+func TestDelete_CleanupWorktree(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, path := setupWorktreeTicket(t, ts)
+	if err := os.WriteFile(filepath.Join(path, "wip.txt"), []byte("wip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Uncommitted changes keep both the worktree and the ticket.
+	resp := ts.makeRequest(t, http.MethodDelete, "/tickets/backlog/"+created.ID+"?cleanup_worktree=true", nil)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusConflict)
+	if _, _, err := ts.store.Get(created.ID); err != nil {
+		t.Fatalf("expected ticket to survive failed cleanup: %v", err)
+	}
+
+	if err := os.Remove(filepath.Join(path, "wip.txt")); err != nil {
+		t.Fatal(err)
+	}
+	resp2 := ts.makeRequest(t, http.MethodDelete, "/tickets/backlog/"+created.ID+"?cleanup_worktree=true", nil)
+	defer func() { _ = resp2.Body.Close() }()
+
+	assertStatus(t, resp2, http.StatusNoContent)
+	if worktree.Exists(path) {
+		t.Error("expected worktree to be removed")
+	}
+}
+
+func TestUpdateTicket_RenameRefusedWithWorktree(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, path := setupWorktreeTicket(t, ts)
+
+	title := "Renamed"
+	resp := ts.makeRequest(t, http.MethodPut, "/tickets/backlog/"+created.ID, UpdateTicketRequest{Title: &title})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+	if result := decode[ErrorResponse](t, resp); result.Code != "worktree_exists" {
+		t.Errorf("expected code 'worktree_exists', got %q", result.Code)
+	}
+	if got, _, err := ts.store.Get(created.ID); err != nil || got.Title != created.Title {
+		t.Errorf("expected ticket to keep its ID and title, got %v, %v", got, err)
+	}
+
+	// Once the worktree is gone the rename goes through.
+	cfg, err := architectconfig.Load(ts.projectRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDir, err := cfg.ResolveRepoPath("test-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := worktree.Remove(repoDir, path, false); err != nil {
+		t.Fatal(err)
+	}
+	resp2 := ts.makeRequest(t, http.MethodPut, "/tickets/backlog/"+created.ID, UpdateTicketRequest{Title: &title})
+	defer func() { _ = resp2.Body.Close() }()
+
+	assertStatus(t, resp2, http.StatusOK)
+}
+
 // --- Helper function tests ---
 
 func TestValidStatus(t *testing.T) {
@@ -881,11 +1533,200 @@ func TestValidStatus(t *testing.T) {
 		{"BACKLOG", false},
 	}
 
+	store, err := ticket.NewStore(t.TempDir(), nil, "")
+	if err != nil {
+		t.Fatalf("failed to create ticket store: %v", err)
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.status, func(t *testing.T) {
-			if got := validStatus(tt.status); got != tt.valid {
+			if got := validStatus(store, tt.status); got != tt.valid {
 				t.Errorf("validStatus(%q) = %v, want %v", tt.status, got, tt.valid)
 			}
 		})
 	}
+
+	custom, err := ticket.NewStoreWithStatuses(t.TempDir(), nil, "", []ticket.Status{"backlog", "progress", "review", "done"})
+	if err != nil {
+		t.Fatalf("failed to create ticket store: %v", err)
+	}
+	if !validStatus(custom, "review") {
+		t.Error("validStatus(review) = false for store configured with review")
+	}
+}
+
+// --- History ---
+
+func TestTicketHistory_RecordsActor(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, _ := ts.store.Create("History Ticket", "old body", nil, nil, "", nil, nil, "")
+
+	data, _ := json.Marshal(EditTicketBodyRequest{OldString: "old", NewString: "new"})
+	req, _ := http.NewRequest(http.MethodPatch, ts.URL+"/tickets/"+created.ID+"/body", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(ArchitectHeader, ts.projectRoot)
+	req.Header.Set(ActorHeader, "worker:"+created.ID)
+	editResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = editResp.Body.Close()
+	assertStatus(t, editResp, http.StatusOK)
+
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets/"+created.ID+"/history", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[TicketHistoryResponse](t, resp)
+	if len(result.Revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %+v", result.Revisions)
+	}
+	rev := result.Revisions[1]
+	if rev.Action != ticket.ActionBodyEdited || rev.Actor != ticket.ActorWorker {
+		t.Errorf("revision 2 = %s by %s, want body_edited by worker", rev.Action, rev.Actor)
+	}
+	if !strings.Contains(rev.BodyDiff, "-old body\n+new body\n") {
+		t.Errorf("unexpected body diff %q", rev.BodyDiff)
+	}
+}
+
+func TestTicketHistory_NotFound(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	resp := ts.makeRequest(t, http.MethodGet, "/tickets/nonexistent/history", nil)
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusNotFound)
+}
+
+func TestRestoreTicketBody(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, _ := ts.store.Create("Restore Ticket", "first", nil, nil, "", nil, nil, "")
+	second := "second"
+	_, _ = ts.store.Update(created.ID, nil, &second, nil, nil, nil)
+
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/restore", RestoreTicketBodyRequest{Revision: 1})
+	defer func() { _ = resp.Body.Close() }()
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[TicketResponse](t, resp)
+	if result.Body != "first" {
+		t.Errorf("expected restored body %q, got %q", "first", result.Body)
+	}
+
+	resp2 := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/restore", RestoreTicketBodyRequest{Revision: 42})
+	defer func() { _ = resp2.Body.Close() }()
+	assertStatus(t, resp2, http.StatusNotFound)
+
+	resp3 := ts.makeRequest(t, http.MethodPost, "/tickets/"+created.ID+"/restore", RestoreTicketBodyRequest{})
+	defer func() { _ = resp3.Body.Close() }()
+	assertStatus(t, resp3, http.StatusBadRequest)
+}
+
+// --- Triage: priority, labels and assignee ---
+
+func TestCreate_WithTriage(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"test-repo": ts.projectRoot})
+
+	body := map[string]any{
+		"title":    "Triaged",
+		"body":     "body",
+		"repo":     "test-repo",
+		"priority": "High",
+		"labels":   []string{"bug", "ui", "bug"},
+		"assignee": "alice",
+	}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusCreated)
+
+	result := decode[TicketResponse](t, resp)
+	if result.Priority != "high" || result.Assignee != "alice" || !slices.Equal(result.Labels, []string{"bug", "ui"}) {
+		t.Errorf("unexpected triage: priority %q, labels %v, assignee %q", result.Priority, result.Labels, result.Assignee)
+	}
+}
+
+func TestCreate_InvalidPriority(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+	writeUnitConfig(t, ts.projectRoot, map[string]string{"test-repo": ts.projectRoot})
+
+	body := map[string]any{"title": "Bad", "body": "body", "repo": "test-repo", "priority": "someday"}
+	resp := ts.makeRequest(t, http.MethodPost, "/tickets", body)
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusBadRequest)
+}
+
+func TestUpdateTicket_Triage(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	created, _ := ts.store.Create("Ticket", "body", nil, nil, "", nil, nil, "",
+		ticket.WithPriority(ticket.PriorityLow), ticket.WithAssignee("bob"))
+
+	priority, labels, assignee := "urgent", []string{"infra"}, ""
+	resp := ts.makeRequest(t, http.MethodPut, "/tickets/backlog/"+created.ID,
+		UpdateTicketRequest{Priority: &priority, Labels: &labels, Assignee: &assignee})
+	defer func() { _ = resp.Body.Close() }()
+
+	assertStatus(t, resp, http.StatusOK)
+
+	result := decode[TicketResponse](t, resp)
+	if result.Priority != "urgent" || result.Assignee != "" || !slices.Equal(result.Labels, []string{"infra"}) {
+		t.Errorf("unexpected triage: priority %q, labels %v, assignee %q", result.Priority, result.Labels, result.Assignee)
+	}
+}
+
+func TestListAll_TriageFiltersAndSort(t *testing.T) {
+	ts := setupUnitServer(t)
+	defer ts.Close()
+
+	_, _ = ts.store.Create("Low bug", "body", nil, nil, "", nil, nil, "",
+		ticket.WithPriority(ticket.PriorityLow), ticket.WithLabels("bug"), ticket.WithAssignee("alice"))
+	_, _ = ts.store.Create("Urgent bug", "body", nil, nil, "", nil, nil, "",
+		ticket.WithPriority(ticket.PriorityUrgent), ticket.WithLabels("bug", "ui"), ticket.WithAssignee("alice"))
+	_, _ = ts.store.Create("Untriaged bug", "body", nil, nil, "", nil, nil, "", ticket.WithLabels("bug"))
+	_, _ = ts.store.Create("Feature", "body", nil, nil, "", nil, nil, "", ticket.WithAssignee("bob"))
+
+	list := func(query string) []string {
+		t.Helper()
+		resp := ts.makeRequest(t, http.MethodGet, "/tickets?"+query, nil)
+		defer func() { _ = resp.Body.Close() }()
+		assertStatus(t, resp, http.StatusOK)
+		var titles []string
+		for _, s := range decode[ListAllTicketsResponse](t, resp).Backlog {
+			titles = append(titles, s.Title)
+		}
+		return titles
+	}
+
+	if got := list("label=bug&sort=priority"); !slices.Equal(got, []string{"Urgent bug", "Low bug", "Untriaged bug"}) {
+		t.Errorf("label=bug sorted by priority: got %v", got)
+	}
+	if got := list("label=bug,ui"); !slices.Equal(got, []string{"Urgent bug"}) {
+		t.Errorf("label=bug,ui: got %v", got)
+	}
+	if got := list("priority=urgent,low&sort=priority"); !slices.Equal(got, []string{"Urgent bug", "Low bug"}) {
+		t.Errorf("priority=urgent,low: got %v", got)
+	}
+	if got := list("assignee=bob"); !slices.Equal(got, []string{"Feature"}) {
+		t.Errorf("assignee=bob: got %v", got)
+	}
+
+	for query, code := range map[string]string{"priority=someday": "invalid_priority", "sort=random": "invalid_sort"} {
+		resp := ts.makeRequest(t, http.MethodGet, "/tickets?"+query, nil)
+		assertStatus(t, resp, http.StatusBadRequest)
+		if result := decode[ErrorResponse](t, resp); result.Code != code {
+			t.Errorf("%s: expected code %q, got %q", query, code, result.Code)
+		}
+		_ = resp.Body.Close()
+	}
 }
@@ -0,0 +1,224 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hiveryn/agentruntime"
+	"github.com/kareemaly/cortex/internal/architectsession"
+	"github.com/kareemaly/cortex/internal/collab"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/session"
+)
+
+type timelineSession struct {
+	projectPath string
+	session     session.Session
+	last        session.TimelineEntry
+}
+
+// TimelineManager persists the normalized hook event stream of each
+// session in the directory its conclusion is written to: the ticket's
+// entity dir, the collab dir, or the architect session dir.
+type TimelineManager struct {
+	mu       sync.Mutex
+	sessions map[string]*timelineSession // by session UUID
+	deps     *Dependencies
+	logger   *slog.Logger
+}
+
+// NewTimelineManager creates a new TimelineManager.
+func NewTimelineManager(logger *slog.Logger, deps *Dependencies) *TimelineManager {
+	return &TimelineManager{
+		sessions: make(map[string]*timelineSession),
+		deps:     deps,
+		logger:   logger,
+	}
+}
+
+// StartEventLoop records hook events from the receivers and closes the
+// timelines of sessions as they end. Runs until ctx is cancelled.
+func (m *TimelineManager) StartEventLoop(ctx context.Context) {
+	if m == nil || m.deps.ReceiverManager == nil {
+		return
+	}
+	hookCh := m.deps.ReceiverManager.Events(ctx)
+
+	var busCh <-chan events.Event
+	unsubscribe := func() {}
+	if m.deps.Bus != nil {
+		busCh, unsubscribe = m.deps.Bus.Subscribe("")
+	}
+
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case ev, ok := <-hookCh:
+				if !ok {
+					return
+				}
+				m.Record(ev)
+			case ev, ok := <-busCh:
+				if !ok {
+					busCh = nil
+					continue
+				}
+				if ev.Type == events.SessionEnded || ev.Type == events.ResyncRequired {
+					m.closeEnded()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Record appends a hook event to its session's timeline. Events for
+// unknown sessions and repeats of the previous entry are dropped.
+func (m *TimelineManager) Record(ev agentruntime.Event) {
+	if ev.ID == "" || !session.ValidSessionID(ev.ID) {
+		return
+	}
+
+	entry := session.TimelineEntry{
+		Time:    ev.At.UTC(),
+		Agent:   string(ev.Agent),
+		Status:  session.AgentStatus(ev.Status),
+		Tool:    ev.Tool,
+		Message: ev.Message,
+	}
+	if ev.At.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ts, ok := m.sessions[ev.ID]
+	if !ok {
+		if m.deps.SessionManager == nil {
+			return
+		}
+		projectPath, sess, found := m.deps.SessionManager.FindBySessionID(ev.ID)
+		if !found {
+			return
+		}
+		ts = &timelineSession{projectPath: projectPath, session: *sess}
+		m.sessions[ev.ID] = ts
+	}
+
+	if sameTimelineEntry(ts.last, entry) {
+		return
+	}
+	if err := m.append(ts, entry); err != nil {
+		m.logger.Warn("failed to record session timeline", "session", ev.ID, "error", err)
+		return
+	}
+	ts.last = entry
+}
+
+// closeEnded records an ended entry for tracked sessions that are no longer
+// in their session store and stops tracking them.
+func (m *TimelineManager) closeEnded() {
+	if m.deps.SessionManager == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, ts := range m.sessions {
+		store := m.deps.SessionManager.GetStore(ts.projectPath)
+		if sess, err := store.GetBySessionID(id); err == nil && sess != nil {
+			continue
+		}
+		ended := session.TimelineEntry{Time: time.Now().UTC(), Agent: ts.session.Agent, Status: session.AgentStatusEnded}
+		if err := m.append(ts, ended); err != nil {
+			m.logger.Warn("failed to record session timeline", "session", id, "error", err)
+		}
+		delete(m.sessions, id)
+	}
+}
+
+// append writes an entry to the session's timeline. Callers must hold m.mu.
+func (m *TimelineManager) append(ts *timelineSession, entry session.TimelineEntry) error {
+	dir, err := sessionDir(m.deps, ts.projectPath, &ts.session)
+	if err != nil {
+		return err
+	}
+	return session.AppendTimeline(session.TimelinePath(dir, ts.session.SessionID), entry)
+}
+
+// sessionDir returns the directory a session's conclusion is written to.
+// A ticket's directory is resolved on every call since it moves between
+// status columns.
+func sessionDir(deps *Dependencies, projectPath string, sess *session.Session) (string, error) {
+	switch sess.Type {
+	case session.SessionTypeArchitect:
+		return filepath.Join(architectsession.Dir(projectPath), sess.SessionID), nil
+	case session.SessionTypeCollab:
+		return filepath.Join(collab.Dir(projectPath), sess.CollabID), nil
+	default:
+		store, err := deps.StoreManager.GetStore(projectPath)
+		if err != nil {
+			return "", err
+		}
+		return store.Dir(sess.TicketID)
+	}
+}
+
+// findTimeline locates a session's timeline file in an architect, for
+// active and ended sessions alike.
+func findTimeline(deps *Dependencies, projectPath, sessionID string) (string, bool) {
+	var candidates []string
+	if deps.SessionManager != nil {
+		if sess, err := deps.SessionManager.GetStore(projectPath).GetBySessionID(sessionID); err == nil && sess != nil {
+			if dir, err := sessionDir(deps, projectPath, sess); err == nil {
+				candidates = append(candidates, session.TimelinePath(dir, sessionID))
+			}
+		}
+	}
+
+	candidates = append(candidates, session.TimelinePath(filepath.Join(architectsession.Dir(projectPath), sessionID), sessionID))
+	dirs := subdirs(collab.Dir(projectPath), 1)
+	if store, err := deps.StoreManager.GetStore(projectPath); err == nil {
+		dirs = append(dirs, subdirs(store.RootDir(), 2)...)
+	}
+	for _, dir := range dirs {
+		candidates = append(candidates, session.TimelinePath(dir, sessionID))
+	}
+
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// subdirs returns the directories depth levels below root. The session ID
+// is then joined to each by name rather than matched as a glob pattern.
+func subdirs(root string, depth int) []string {
+	dirs := []string{root}
+	for range depth {
+		var next []string
+		for _, dir := range dirs {
+			entries, _ := os.ReadDir(dir)
+			for _, e := range entries {
+				if e.IsDir() {
+					next = append(next, filepath.Join(dir, e.Name()))
+				}
+			}
+		}
+		dirs = next
+	}
+	return dirs
+}
+
+func sameTimelineEntry(a, b session.TimelineEntry) bool {
+	return a.Status == b.Status && a.Tool == b.Tool && a.Message == b.Message && a.Agent == b.Agent
+}
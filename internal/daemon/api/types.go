@@ -1,9 +1,9 @@
 package api
 
 import (
-	"strings"
 	"time"
 
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
 	"github.com/kareemaly/cortex/internal/session"
 	"github.com/kareemaly/cortex/internal/ticket"
 	"github.com/kareemaly/cortex/internal/types"
@@ -14,16 +14,33 @@ type (
 	SessionResponse          = types.SessionResponse
 	TicketResponse           = types.TicketResponse
 	TicketSummary            = types.TicketSummary
+	CriterionResponse        = types.CriterionResponse
 	ListTicketsResponse      = types.ListTicketsResponse
 	ListAllTicketsResponse   = types.ListAllTicketsResponse
+	TicketColumn             = types.TicketColumn
+	TicketGraphResponse      = types.TicketGraphResponse
 	DiffFileResponse         = types.DiffFileResponse
 	CommitDiffResponse       = types.CommitDiffResponse
 	DiffsResponse            = types.DiffsResponse
+	RepoDiffsResponse        = types.RepoDiffsResponse
+	FieldChangeResponse      = types.FieldChangeResponse
+	TicketRevisionResponse   = types.TicketRevisionResponse
+	TicketHistoryResponse    = types.TicketHistoryResponse
+	TimelineEntryResponse    = types.TimelineEntryResponse
+	QueuedSpawnResponse      = types.QueuedSpawnResponse
+	SessionTimelineResponse  = types.SessionTimelineResponse
+	SessionLogResponse       = types.SessionLogResponse
 	ArchitectSessionResponse = types.ArchitectSessionResponse
 	ArchitectStateResponse   = types.ArchitectStateResponse
 	ArchitectSpawnResponse   = types.ArchitectSpawnResponse
 	ConclusionResponse       = types.ConclusionResponse
+	VerificationResponse     = types.VerificationResponse
+	VerifyCommandResponse    = types.VerifyCommandResponse
 	ListConclusionsResponse  = types.ListConclusionsResponse
+	SearchResult             = types.SearchResult
+	SearchResponse           = types.SearchResponse
+	EventResponse            = types.EventResponse
+	EventHistoryResponse     = types.EventHistoryResponse
 	HealthResponse           = types.HealthResponse
 	ArchitectTicketCounts    = types.ArchitectTicketCounts
 	ArchitectResponse        = types.ArchitectResponse
@@ -33,20 +50,65 @@ type (
 	PromptGroupInfo          = types.PromptGroupInfo
 	ListPromptsResponse      = types.ListPromptsResponse
 	SpawnCollabResponse      = types.SpawnCollabResponse
+	ReviewResponse           = types.ReviewResponse
+	ReviewCommentResponse    = types.ReviewCommentResponse
+	ReviewActionResponse     = types.ReviewActionResponse
+	SpawnReviewerResponse    = types.SpawnReviewerResponse
+	TemplateResponse         = types.TemplateResponse
+	ListTemplatesResponse    = types.ListTemplatesResponse
+	ScheduleResponse         = types.ScheduleResponse
+	ListSchedulesResponse    = types.ListSchedulesResponse
+	InvalidScheduleResponse  = types.InvalidScheduleResponse
 )
 
 type CreateTicketRequest struct {
+	Title string `json:"title"`
+	Type  string `json:"type,omitempty"`
+	Body  string `json:"body"`
+	Repo  string `json:"repo,omitempty"`
+	// Repos makes a ticket span several repos, one worker each. Repo, when
+	// also set, is added first.
+	Repos      []string `json:"repos,omitempty"`
+	DueDate    *string  `json:"due_date,omitempty"`
+	References []string `json:"references,omitempty"`
+	BlockedBy  []string `json:"blocked_by,omitempty"`
+	Blocks     []string `json:"blocks,omitempty"`
+	Priority   string   `json:"priority,omitempty"`
+	Labels     []string `json:"labels,omitempty"`
+	Assignee   string   `json:"assignee,omitempty"`
+	// FollowUpOf links the new ticket with an existing one both ways:
+	// each lists the other in its references.
+	FollowUpOf string `json:"follow_up_of,omitempty"`
+	// Template names a file under templates/ whose defaults fill the
+	// fields left empty, rendered with Vars.
+	Template string            `json:"template,omitempty"`
+	Vars     map[string]string `json:"vars,omitempty"`
+}
+
+// CreateScheduleRequest adds a recurring ticket schedule.
+type CreateScheduleRequest struct {
+	Name       string   `json:"name"`
+	Cron       string   `json:"cron"`
 	Title      string   `json:"title"`
 	Body       string   `json:"body"`
-	Repo       string   `json:"repo,omitempty"`
-	DueDate    *string  `json:"due_date,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	Repo       string   `json:"repo"`
 	References []string `json:"references,omitempty"`
+	Due        string   `json:"due,omitempty"`
+	Variant    string   `json:"variant,omitempty"`
+	Paused     bool     `json:"paused,omitempty"`
 }
 
 type UpdateTicketRequest struct {
 	Title      *string   `json:"title,omitempty"`
 	Body       *string   `json:"body,omitempty"`
 	References *[]string `json:"references,omitempty"`
+	BlockedBy  *[]string `json:"blocked_by,omitempty"`
+	Blocks     *[]string `json:"blocks,omitempty"`
+	// Priority and Assignee clear when set to "", Labels when set to [].
+	Priority *string   `json:"priority,omitempty"`
+	Labels   *[]string `json:"labels,omitempty"`
+	Assignee *string   `json:"assignee,omitempty"`
 }
 
 type EditTicketBodyRequest struct {
@@ -55,6 +117,13 @@ type EditTicketBodyRequest struct {
 	ReplaceAll bool   `json:"replaceAll,omitempty"`
 }
 
+// CheckCriterionRequest checks or unchecks an acceptance criterion.
+// Evidence, when set, is recorded under the criterion.
+type CheckCriterionRequest struct {
+	Checked  bool   `json:"checked"`
+	Evidence string `json:"evidence,omitempty"`
+}
+
 type MoveTicketRequest struct {
 	To string `json:"to"`
 }
@@ -63,9 +132,17 @@ type SetDueDateRequest struct {
 	DueDate string `json:"due_date"`
 }
 
+type RestoreTicketBodyRequest struct {
+	Revision int `json:"revision"`
+}
+
 type SpawnResponse struct {
 	Session SessionResponse `json:"session,omitempty"`
-	Ticket  TicketResponse  `json:"ticket"`
+	// Sessions lists every repo's session of a multi-repo ticket.
+	Sessions []SessionResponse `json:"sessions,omitempty"`
+	Ticket   TicketResponse    `json:"ticket"`
+	// Queue is set instead of Session when a concurrency limit is reached.
+	Queue *QueuedSpawnResponse `json:"queue,omitempty"`
 }
 
 type ConcludeSessionRequest struct {
@@ -74,6 +151,44 @@ type ConcludeSessionRequest struct {
 	Commits         []string `json:"commits,omitempty"`
 	Rejected        bool     `json:"rejected,omitempty"`
 	RejectionReason string   `json:"rejection_reason,omitempty"`
+	CleanupWorktree bool     `json:"cleanup_worktree,omitempty"`
+	// Repo is the repo a multi-repo ticket's worker concludes; required
+	// for those tickets and ignored otherwise.
+	Repo string `json:"repo,omitempty"`
+}
+
+type AddReviewCommentRequest struct {
+	// Repo picks the repo of a multi-repo ticket the file belongs to.
+	Repo   string `json:"repo,omitempty"`
+	Commit string `json:"commit,omitempty"`
+	File   string `json:"file"`
+	// Line is the line in the file after the change; 0 comments on the
+	// whole file.
+	Line int    `json:"line,omitempty"`
+	Body string `json:"body"`
+}
+
+type AcceptReviewRequest struct {
+	Summary string `json:"summary,omitempty"`
+}
+
+type RequestChangesRequest struct {
+	Summary string `json:"summary,omitempty"`
+	// Variant and Backend pick the worker session that picks up the
+	// comments. Variant defaults to the one that concluded.
+	Variant string `json:"variant,omitempty"`
+	Backend string `json:"backend,omitempty"`
+}
+
+type SpawnReviewerRequest struct {
+	// Variant defaults to reviewer.variant in cortex.yaml.
+	Variant string `json:"variant,omitempty"`
+}
+
+type SubmitReviewRequest struct {
+	Verdict  string                    `json:"verdict"`
+	Summary  string                    `json:"summary,omitempty"`
+	Findings []AddReviewCommentRequest `json:"findings,omitempty"`
 }
 
 type FocusResponse struct {
@@ -106,40 +221,44 @@ type SpawnCollabRequest struct {
 	Variant string `json:"variant,omitempty"`
 }
 
-func filterSummaryList(tickets []*ticket.Ticket, status ticket.Status, query string, dueBefore *time.Time, tmuxSession string, checker types.TmuxChecker, sessionMgr *SessionManager, projectPath string, receiverMgr *ReceiverManager, ticketStore *ticket.Store) []TicketSummary {
+func filterSummaryList(tickets []*ticket.Ticket, status ticket.Status, filter ticketFilter, tmuxSession string, checkerFor func(*session.Session) types.TmuxChecker, sessionMgr *SessionManager, projectPath string, receiverMgr *ReceiverManager, ticketStore *ticket.Store) []TicketSummary {
 	var summaries []TicketSummary
 
 	var sessStore *session.Store
 	if sessionMgr != nil {
 		sessStore = sessionMgr.GetStore(projectPath)
 	}
+	now := time.Now()
+	dueWindow := architectconfig.DefaultDueSoon
+	if projectCfg, err := architectconfig.Load(projectPath); err == nil {
+		dueWindow = projectCfg.Reminders.DueSoonDuration()
+	}
 
 	for _, t := range tickets {
-		if query != "" &&
-			!strings.Contains(strings.ToLower(t.Title), query) &&
-			!strings.Contains(strings.ToLower(t.Body), query) {
+		if !filter.matches(t) {
 			continue
 		}
-		if dueBefore != nil {
-			if t.Due == nil || !t.Due.Before(*dueBefore) {
-				continue
-			}
-		}
 
 		var sess *session.Session
 		if sessStore != nil {
 			sess, _ = sessStore.GetByTicketID(t.ID)
 		}
 
-		summary := types.ToTicketSummary(t, status, sess, tmuxSession, checker)
+		summary := types.ToTicketSummary(t, status, sess, tmuxSession, checkerFor(sess))
+		summary.DueState = string(t.DueStateAt(now, dueWindow))
 
 		hasConclusion := false
-		if ticketStore != nil && status == ticket.StatusDone {
+		if ticketStore != nil && (status == ticket.StatusDone || status == ticket.StatusReview) {
 			if ok, err := ticketStore.HasConclusion(t.ID); err == nil && ok {
 				hasConclusion = true
 			}
 		}
 		summary.HasConclusion = hasConclusion
+		if ticketStore != nil && status != ticket.StatusBacklog {
+			if meta, _, err := ticketStore.ReadConclusion(t.ID); err == nil {
+				summary.Verification = string(meta.VerifyStatus())
+			}
+		}
 
 		if receiverMgr != nil && sess != nil && sess.SessionID != "" {
 			if ev, ok := receiverMgr.GetEvent(sess.SessionID); ok {
@@ -160,3 +279,10 @@ func filterSummaryList(tickets []*ticket.Ticket, status ticket.Status, query str
 	}
 	return summaries
 }
+
+// markBlocked flags summaries whose tickets still have open blockers.
+func markBlocked(summaries []TicketSummary, blocked map[string]bool) {
+	for i := range summaries {
+		summaries[i].IsBlocked = blocked[summaries[i].ID]
+	}
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	architectconfig "github.com/kareemaly/cortex/internal/architect/config"
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/session"
+	"github.com/kareemaly/cortex/internal/ticket"
+	"github.com/kareemaly/cortex/internal/verify"
+	"github.com/kareemaly/cortex/internal/worktree"
+)
+
+// verifyRun is a verification to start once its pending entry is written
+// to the conclusion.
+type verifyRun struct {
+	projectPath string
+	ticketID    string
+	repo        string
+	repoDir     string
+	commit      string
+	startedAt   time.Time
+	cfg         *architectconfig.VerifyConfig
+}
+
+// pendingVerification returns the verification to run for commits
+// concluded in repo, or nil when the repo configures no verify commands.
+func pendingVerification(projectPath, ticketID, repo, repoDir string, commits []string) (*verifyRun, error) {
+	if len(commits) == 0 {
+		return nil, nil
+	}
+	cfg, err := architectconfig.Load(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	vc := cfg.RepoVerify(repo)
+	if vc == nil {
+		return nil, nil
+	}
+	tip, err := verify.Tip(repoDir, commits)
+	if err != nil {
+		return nil, err
+	}
+	return &verifyRun{projectPath: projectPath, ticketID: ticketID, repo: repo, repoDir: repoDir, commit: tip, startedAt: time.Now().UTC(), cfg: vc}, nil
+}
+
+// pending is the conclusion entry recorded while the run is in progress.
+func (v *verifyRun) pending() ticket.Verification {
+	return ticket.Verification{Repo: v.repo, Commit: v.commit, Status: ticket.VerifyPending, StartedAt: v.startedAt}
+}
+
+// runVerification runs v in a scratch worktree under the architect's
+// worktrees directory, stores the verdict on the conclusion and, when the
+// repo bounces failures, sends the ticket back to its worker.
+func runVerification(ctx context.Context, deps *Dependencies, store *ticket.Store, v *verifyRun) {
+	projectCfg, err := architectconfig.Load(v.projectPath)
+	if err != nil {
+		deps.Logger.Warn("verification skipped", "ticket", v.ticketID, "error", err)
+		return
+	}
+	// Each run checks out its own scratch worktree, so a run started by a
+	// later conclusion cannot collide with one still going.
+	runDir := fmt.Sprintf("%s-%d", v.ticketID, v.startedAt.UnixNano())
+	scratch := worktree.Path(filepath.Join(projectCfg.WorktreesPath(v.projectPath), ".verify"), v.repo, runDir)
+
+	result := ticket.Verification{Repo: v.repo, Commit: v.commit, Status: ticket.VerifyPassed, StartedAt: v.startedAt}
+	results, runErr := verify.Run(ctx, v.repoDir, scratch, v.commit, v.cfg.Commands, v.cfg.TimeoutDuration())
+	if runErr != nil {
+		result.Status = ticket.VerifyFailed
+		result.Error = runErr.Error()
+	}
+	for _, r := range results {
+		result.Commands = append(result.Commands, ticket.VerifyCommand{
+			Command:    r.Command,
+			ExitCode:   r.ExitCode,
+			TimedOut:   r.TimedOut,
+			DurationMS: r.Duration.Milliseconds(),
+			Output:     r.Output,
+		})
+		if !r.Passed() {
+			result.Status = ticket.VerifyFailed
+		}
+	}
+	finished := time.Now().UTC()
+	result.FinishedAt = &finished
+
+	recorded, err := store.SetVerification(v.ticketID, result)
+	if err != nil {
+		deps.Logger.Warn("failed to record verification", "ticket", v.ticketID, "error", err)
+		return
+	}
+	if !recorded {
+		// The worker concluded again while this run was going.
+		return
+	}
+	deps.Logger.Info("verification finished", "ticket", v.ticketID, "repo", v.repo, "status", result.Status)
+
+	message := "verification " + string(result.Status)
+	if result.Status == ticket.VerifyFailed && v.cfg.Bounce() {
+		message = bounceFailedVerification(ctx, deps, v.projectPath, store, v.ticketID, v.repo)
+	}
+	deps.Bus.Emit(events.Event{
+		Type:          events.VerificationFinished,
+		ArchitectPath: v.projectPath,
+		TicketID:      v.ticketID,
+		Payload: map[string]any{
+			"repo":    v.repo,
+			"commit":  v.commit,
+			"status":  string(result.Status),
+			"message": message,
+		},
+	})
+}
+
+// bounceFailedVerification moves a ticket whose verification failed back
+// to progress and respawns the worker of repo with the variant that
+// concluded. The kickoff prompt carries the failure. Returns a message
+// describing what was done.
+func bounceFailedVerification(ctx context.Context, deps *Dependencies, projectPath string, store *ticket.Store, id, repo string) string {
+	t, status, err := store.Get(id)
+	if err != nil {
+		return "verification failed; bounce failed: " + err.Error()
+	}
+	meta, _, err := store.ReadConclusion(id)
+	if err != nil || meta.Variant == "" {
+		return "verification failed; not bounced: the concluding session recorded no variant"
+	}
+
+	if status == ticket.StatusReview {
+		if review, err := store.ReadReview(id); err == nil && review.State == ticket.ReviewOpen {
+			if _, err := store.FinishReview(id, ticket.ReviewChangesRequested, "Verification failed for "+repo+"."); err != nil {
+				deps.Logger.Warn("failed to close review for bounced ticket", "ticket", id, "error", err)
+			}
+		}
+	}
+	if status != ticket.StatusProgress {
+		if err := store.MoveAs(ticket.DaemonActor, id, ticket.StatusProgress); err != nil {
+			return "verification failed; bounce failed: " + err.Error()
+		}
+	}
+
+	projectCfg, _ := mergeProjectConfig(projectPath)
+	av, err := projectCfg.ResolveVariant(meta.Variant)
+	if err != nil {
+		return "verification failed; ticket moved to progress; spawn failed: " + err.Error()
+	}
+	backend, err := resolveBackend("", projectCfg)
+	if err != nil {
+		return "verification failed; ticket moved to progress; spawn failed: " + err.Error()
+	}
+	if reason := deps.backendUnavailable(backend); reason != "" {
+		return "verification failed; ticket moved to progress; spawn failed: " + reason
+	}
+
+	e := session.QueuedSpawn{TicketID: id, Variant: meta.Variant, Force: true, Backend: backend}
+	if t.IsMultiRepo() {
+		e.Repo = repo
+	}
+	var spawnErr error
+	var queued *QueuedSpawnResponse
+	if deps.SpawnQueue != nil {
+		var spawned *queuedSpawn
+		if spawned, spawnErr = deps.SpawnQueue.Spawn(ctx, projectPath, projectCfg, store, t, e, av); spawnErr == nil {
+			queued = spawned.Queue
+		}
+	} else {
+		_, spawnErr = spawnTicketSession(ctx, deps, projectPath, projectCfg, store, e, av)
+	}
+
+	switch {
+	case spawnErr != nil:
+		deps.Logger.Warn("failed to respawn worker after failed verification", "ticket", id, "error", spawnErr)
+		return "verification failed; ticket moved to progress; spawn failed: " + spawnErr.Error()
+	case queued != nil:
+		return fmt.Sprintf("verification failed; ticket moved to progress; worker queued at position %d (%s)", queued.Position, queued.Reason)
+	}
+	deps.Bus.Emit(events.Event{
+		Type:          events.SessionStarted,
+		ArchitectPath: projectPath,
+		TicketID:      id,
+	})
+	return "verification failed; worker respawned with variant " + meta.Variant
+}
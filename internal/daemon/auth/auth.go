@@ -0,0 +1,184 @@
+// Package auth authenticates daemon API callers by bearer token.
+//
+// Two kinds of token are accepted: long-lived tokens listed under auth.tokens
+// in ~/.cortex/settings.yaml, and short-lived session tokens the daemon
+// signs for the agents it spawns. Either resolves to a Principal whose role
+// and scope the API then enforces.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+)
+
+// Role is what a token may do.
+type Role string
+
+const (
+	// RoleArchitect has full access to its architect, or to every architect
+	// and the global routes when unscoped.
+	RoleArchitect Role = "architect"
+	// RoleWorker reads its architect, creates tickets, and concludes,
+	// checks criteria of and edits the body of only its own ticket.
+	RoleWorker Role = "worker"
+	// RoleCollab reads its architect, creates and edits tickets and
+	// concludes only its own collab.
+	RoleCollab Role = "collab"
+	// RoleReviewer reads its architect and reviews only its own ticket.
+	RoleReviewer Role = "reviewer"
+	// RoleReadOnly may only read.
+	RoleReadOnly Role = "read-only"
+	// RoleHook may only post agent hook events, and only for its own
+	// session when scoped to one.
+	RoleHook Role = "hook"
+)
+
+// DefaultSessionTokenTTL is how long session tokens stay valid unless
+// auth.session_token_ttl says otherwise.
+const DefaultSessionTokenTTL = 24 * time.Hour
+
+// sessionTokenPrefix marks a signed session token.
+const sessionTokenPrefix = "cxs."
+
+// ParseRole validates a role name.
+func ParseRole(s string) (Role, error) {
+	switch r := Role(s); r {
+	case RoleArchitect, RoleWorker, RoleCollab, RoleReviewer, RoleReadOnly, RoleHook:
+		return r, nil
+	}
+	return "", fmt.Errorf("unknown role %q: must be architect, worker, collab, reviewer, read-only or hook", s)
+}
+
+// ErrInvalidToken is returned for unknown, malformed or tampered tokens.
+var ErrInvalidToken = errors.New("invalid token")
+
+// ErrExpiredToken is returned for session tokens past their expiry.
+var ErrExpiredToken = errors.New("token expired")
+
+// Principal is the identity a token resolves to.
+type Principal struct {
+	// Name is the token's name, or "session" for session tokens.
+	Name string `json:"-"`
+	Role Role   `json:"role"`
+	// Architect is the architect path the token is limited to; empty
+	// means every architect.
+	Architect string `json:"architect,omitempty"`
+	// TicketID is the ticket a worker token may change, or the one a
+	// reviewer token may review.
+	TicketID string `json:"ticket_id,omitempty"`
+	// CollabID is the collab a collab token may conclude.
+	CollabID string `json:"collab_id,omitempty"`
+	// SessionID is the agent session a hook token may post events for.
+	SessionID string    `json:"session_id,omitempty"`
+	ExpiresAt time.Time `json:"exp,omitempty"`
+}
+
+// Unscoped reports whether the principal is not limited to one architect.
+func (p *Principal) Unscoped() bool {
+	return p.Architect == ""
+}
+
+// Authenticator verifies tokens and mints session tokens.
+type Authenticator struct {
+	secret []byte
+	ttl    time.Duration
+	tokens map[string]Principal
+	now    func() time.Time
+}
+
+// New builds an Authenticator from the auth section of settings.yaml.
+func New(cfg daemonconfig.AuthConfig) (*Authenticator, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("auth.secret is required")
+	}
+	ttl := DefaultSessionTokenTTL
+	if cfg.SessionTokenTTL != "" {
+		d, err := time.ParseDuration(cfg.SessionTokenTTL)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid auth.session_token_ttl %q", cfg.SessionTokenTTL)
+		}
+		ttl = d
+	}
+
+	a := &Authenticator{
+		secret: []byte(cfg.Secret),
+		ttl:    ttl,
+		tokens: make(map[string]Principal, len(cfg.Tokens)),
+		now:    time.Now,
+	}
+	for _, t := range cfg.Tokens {
+		role, err := ParseRole(t.Role)
+		if err != nil {
+			return nil, fmt.Errorf("token %q: %w", t.Name, err)
+		}
+		if t.Token == "" {
+			return nil, fmt.Errorf("token %q has no value", t.Name)
+		}
+		a.tokens[t.Token] = Principal{Name: t.Name, Role: role, Architect: t.Architect}
+	}
+	return a, nil
+}
+
+// Authenticate resolves a bearer token to its principal.
+func (a *Authenticator) Authenticate(token string) (*Principal, error) {
+	if strings.HasPrefix(token, sessionTokenPrefix) {
+		return a.verifySession(token)
+	}
+	for value, p := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1 {
+			p := p
+			return &p, nil
+		}
+	}
+	return nil, ErrInvalidToken
+}
+
+// IssueSessionToken signs a token for p that expires after the configured
+// session TTL.
+func (a *Authenticator) IssueSessionToken(p Principal) (string, error) {
+	p.ExpiresAt = a.now().Add(a.ttl).UTC().Truncate(time.Second)
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal token: %w", err)
+	}
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return sessionTokenPrefix + body + "." + a.sign(body), nil
+}
+
+func (a *Authenticator) verifySession(token string) (*Principal, error) {
+	body, sig, ok := strings.Cut(strings.TrimPrefix(token, sessionTokenPrefix), ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(a.sign(body))) {
+		return nil, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var p Principal
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if _, err := ParseRole(string(p.Role)); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if !a.now().Before(p.ExpiresAt) {
+		return nil, ErrExpiredToken
+	}
+	p.Name = "session"
+	return &p, nil
+}
+
+func (a *Authenticator) sign(body string) string {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
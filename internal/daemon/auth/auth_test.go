@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
+)
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+	a, err := New(daemonconfig.AuthConfig{
+		Enabled: true,
+		Secret:  "s3cret",
+		Tokens: []daemonconfig.APIToken{
+			{Name: "local", Token: "tok-local", Role: "architect"},
+			{Name: "ci", Token: "tok-ci", Role: "read-only", Architect: "/work/proj"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return a
+}
+
+func TestAuthenticate_StaticTokens(t *testing.T) {
+	a := newTestAuthenticator(t)
+
+	p, err := a.Authenticate("tok-ci")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.Name != "ci" || p.Role != RoleReadOnly || p.Architect != "/work/proj" || p.Unscoped() {
+		t.Errorf("unexpected principal: %+v", p)
+	}
+	if _, err := a.Authenticate("nope"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("unknown token err = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestSessionToken_RoundTripAndExpiry(t *testing.T) {
+	a := newTestAuthenticator(t)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	a.now = func() time.Time { return now }
+
+	token, err := a.IssueSessionToken(Principal{Role: RoleWorker, Architect: "/work/proj", TicketID: "t1"})
+	if err != nil {
+		t.Fatalf("IssueSessionToken: %v", err)
+	}
+	p, err := a.Authenticate(token)
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.Role != RoleWorker || p.TicketID != "t1" || p.Architect != "/work/proj" {
+		t.Errorf("unexpected principal: %+v", p)
+	}
+
+	// Any change to the payload breaks the signature.
+	body, sig, _ := strings.Cut(strings.TrimPrefix(token, sessionTokenPrefix), ".")
+	if _, err := a.Authenticate(sessionTokenPrefix + body + "x." + sig); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("tampered token err = %v, want ErrInvalidToken", err)
+	}
+
+	// Tokens signed with another secret are rejected.
+	other, _ := New(daemonconfig.AuthConfig{Secret: "other"})
+	if _, err := other.Authenticate(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("foreign token err = %v, want ErrInvalidToken", err)
+	}
+
+	now = now.Add(DefaultSessionTokenTTL)
+	if _, err := a.Authenticate(token); !errors.Is(err, ErrExpiredToken) {
+		t.Errorf("expired token err = %v, want ErrExpiredToken", err)
+	}
+}
+
+func TestNew_RejectsUnknownRole(t *testing.T) {
+	_, err := New(daemonconfig.AuthConfig{
+		Secret: "s",
+		Tokens: []daemonconfig.APIToken{{Name: "x", Token: "t", Role: "admin"}},
+	})
+	if err == nil {
+		t.Fatal("expected error for unknown role")
+	}
+}
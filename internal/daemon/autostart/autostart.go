@@ -22,16 +22,17 @@ var ErrDaemonStartFailed = errors.New("daemon failed to start after retries")
 // EnsureDaemonRunning ensures the daemon is running, starting it if necessary.
 // This is the main entry point for auto-starting the daemon.
 func EnsureDaemonRunning() error {
-	// Load config to get port
+	// Load config to get port and socket
 	cfg, err := config.Load()
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
 	port := cfg.Port
+	daemonURL := cfg.ClientURL()
 
 	// Check if daemon is already healthy
-	if checkHealth(port) {
+	if checkHealth(daemonURL) {
 		return nil
 	}
 
@@ -47,17 +48,18 @@ func EnsureDaemonRunning() error {
 	}
 
 	// Wait for daemon to become healthy with retries
-	if err := checkHealthWithRetry(port); err != nil {
+	if err := checkHealthWithRetry(daemonURL); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// checkHealth performs a single health check against the daemon.
-func checkHealth(port int) bool {
-	client := &http.Client{Timeout: 2 * time.Second}
-	resp, err := client.Get(fmt.Sprintf("http://localhost:%d/health", port))
+// checkHealth performs a single health check against the daemon at
+// daemonURL, which may be a unix:// socket URL.
+func checkHealth(daemonURL string) bool {
+	client, baseURL := config.NewHTTPClient(daemonURL, 2*time.Second)
+	resp, err := client.Get(baseURL + "/health")
 	if err != nil {
 		return false
 	}
@@ -66,7 +68,7 @@ func checkHealth(port int) bool {
 }
 
 // checkHealthWithRetry waits for the daemon to become healthy with backoff.
-func checkHealthWithRetry(port int) error {
+func checkHealthWithRetry(daemonURL string) error {
 	for i, interval := range retryIntervals {
 		// Wait before checking (except on first iteration for immediate check)
 		if i > 0 {
@@ -76,14 +78,14 @@ func checkHealthWithRetry(port int) error {
 			time.Sleep(100 * time.Millisecond)
 		}
 
-		if checkHealth(port) {
+		if checkHealth(daemonURL) {
 			return nil
 		}
 	}
 
 	// Final attempt after last interval
 	time.Sleep(retryIntervals[len(retryIntervals)-1])
-	if checkHealth(port) {
+	if checkHealth(daemonURL) {
 		return nil
 	}
 
@@ -98,7 +100,8 @@ func GetStatus() (*Status, error) {
 	}
 
 	status := &Status{
-		Port: cfg.Port,
+		Port:   cfg.Port,
+		Socket: cfg.SocketPath(),
 	}
 
 	// Try to read PID file
@@ -120,7 +123,7 @@ func GetStatus() (*Status, error) {
 	}
 
 	// Verify via health check
-	status.Running = checkHealth(cfg.Port)
+	status.Running = checkHealth(cfg.ClientURL())
 	if status.Running {
 		status.PID = info.PID
 		status.Version = info.Version
@@ -139,6 +142,7 @@ type Status struct {
 	Running bool
 	PID     int
 	Port    int
+	Socket  string
 	Version string
 	Uptime  time.Duration
 }
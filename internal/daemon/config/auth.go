@@ -0,0 +1,130 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// LocalTokenName is the token the cortex CLI and TUI on this machine use.
+const LocalTokenName = "local"
+
+// HookTokenEnvVar carries a session's hook token to the agent hook
+// commands.
+const HookTokenEnvVar = "CORTEX_HOOK_TOKEN"
+
+// TokenEnvVar overrides the token clients send to the daemon.
+const TokenEnvVar = "CORTEX_TOKEN"
+
+// AuthConfig enables bearer-token authentication on the daemon API.
+type AuthConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Secret signs the short-lived tokens minted for agent sessions.
+	Secret string `yaml:"secret,omitempty"`
+	// SessionTokenTTL is how long a session token stays valid, as a Go
+	// duration. Defaults to 24h.
+	SessionTokenTTL string     `yaml:"session_token_ttl,omitempty"`
+	Tokens          []APIToken `yaml:"tokens,omitempty"`
+}
+
+// APIToken is a long-lived token with a role, optionally scoped to one
+// architect.
+type APIToken struct {
+	Name  string `yaml:"name"`
+	Token string `yaml:"token"`
+	// Role is architect, worker, collab, read-only or hook.
+	Role string `yaml:"role"`
+	// Architect limits the token to one architect path. Empty means every
+	// architect.
+	Architect string `yaml:"architect,omitempty"`
+}
+
+// FindToken returns the named token, or nil.
+func (a *AuthConfig) FindToken(name string) *APIToken {
+	for i := range a.Tokens {
+		if a.Tokens[i].Name == name {
+			return &a.Tokens[i]
+		}
+	}
+	return nil
+}
+
+// AddToken generates a token and adds it under name. Returns an error if
+// the name is taken.
+func (a *AuthConfig) AddToken(name, role, architect string) (*APIToken, error) {
+	if name == "" {
+		return nil, fmt.Errorf("token name is required")
+	}
+	if a.FindToken(name) != nil {
+		return nil, fmt.Errorf("token %q already exists", name)
+	}
+	value, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	a.Tokens = append(a.Tokens, APIToken{Name: name, Token: value, Role: role, Architect: architect})
+	return &a.Tokens[len(a.Tokens)-1], nil
+}
+
+// RemoveToken deletes the named token and reports whether it existed.
+func (a *AuthConfig) RemoveToken(name string) bool {
+	for i := range a.Tokens {
+		if a.Tokens[i].Name == name {
+			a.Tokens = append(a.Tokens[:i], a.Tokens[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureDefaults generates the signing secret and the local token when
+// auth is enabled and they are missing. Reports whether the config changed
+// and needs saving.
+func (a *AuthConfig) EnsureDefaults() (bool, error) {
+	if !a.Enabled {
+		return false, nil
+	}
+	changed := false
+	if a.Secret == "" {
+		secret, err := newToken()
+		if err != nil {
+			return false, err
+		}
+		a.Secret = secret
+		changed = true
+	}
+	if a.FindToken(LocalTokenName) == nil {
+		if _, err := a.AddToken(LocalTokenName, "architect", ""); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// ClientToken returns the token clients on this machine send to the
+// daemon: $CORTEX_TOKEN, else the local token from settings.yaml when auth
+// is enabled, else "".
+func ClientToken() string {
+	if token := os.Getenv(TokenEnvVar); token != "" {
+		return token
+	}
+	cfg, err := Load()
+	if err != nil || !cfg.Auth.Enabled {
+		return ""
+	}
+	if t := cfg.Auth.FindToken(LocalTokenName); t != nil {
+		return t.Token
+	}
+	return ""
+}
+
+// newToken returns 32 random bytes, hex encoded.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -28,15 +28,24 @@ type AgentVariant struct {
 	Agent string            `yaml:"agent"`
 	Args  []string          `yaml:"args,omitempty"`
 	Env   map[string]string `yaml:"env,omitempty"`
+	// MaxConcurrent caps the ticket sessions running this variant across
+	// all architects; further spawns are queued. Zero means unlimited.
+	MaxConcurrent int `yaml:"max_concurrent,omitempty"`
 }
 
 // Config holds the daemon configuration.
 type Config struct {
-	Port        int                     `yaml:"port"`
-	BindAddress string                  `yaml:"bind_address"`
-	LogLevel    string                  `yaml:"log_level"`
-	Architects  []ArchitectEntry        `yaml:"architects,omitempty"`
-	Agents      map[string]AgentVariant `yaml:"agents,omitempty"`
+	Port        int    `yaml:"port"`
+	BindAddress string `yaml:"bind_address"`
+	// Socket is a Unix socket path the daemon listens on alongside TCP,
+	// e.g. ~/.cortex/cortexd.sock. Clients on this machine prefer it.
+	Socket string `yaml:"socket,omitempty"`
+	// DisableTCP serves the API on Socket only.
+	DisableTCP bool                    `yaml:"disable_tcp,omitempty"`
+	LogLevel   string                  `yaml:"log_level"`
+	Architects []ArchitectEntry        `yaml:"architects,omitempty"`
+	Agents     map[string]AgentVariant `yaml:"agents,omitempty"`
+	Auth       AuthConfig              `yaml:"auth,omitempty"`
 }
 
 // DefaultConfig returns a Config with default values.
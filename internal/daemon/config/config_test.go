@@ -123,3 +123,35 @@ func TestSaveToFileCreatesFile(t *testing.T) {
 		t.Fatalf("expected file to exist: %v", err)
 	}
 }
+
+func TestDaemonURLs(t *testing.T) {
+	cfg := DefaultConfig()
+	if got := cfg.ClientURL(); got != DefaultDaemonURL {
+		t.Errorf("ClientURL() = %q, want %q", got, DefaultDaemonURL)
+	}
+	if got := cfg.ListenURL(); got != "http://127.0.0.1:4200" {
+		t.Errorf("ListenURL() = %q", got)
+	}
+
+	cfg.Socket = "/run/cortexd.sock"
+	if got := cfg.ClientURL(); got != "unix:///run/cortexd.sock" {
+		t.Errorf("ClientURL() with socket = %q", got)
+	}
+	if got := cfg.ListenURL(); got != "http://127.0.0.1:4200" {
+		t.Errorf("ListenURL() with TCP enabled = %q", got)
+	}
+	cfg.DisableTCP = true
+	if got := cfg.ListenURL(); got != "unix:///run/cortexd.sock" {
+		t.Errorf("ListenURL() socket only = %q", got)
+	}
+
+	if got := SocketFromURL("unix:///run/cortexd.sock"); got != "/run/cortexd.sock" {
+		t.Errorf("SocketFromURL(unix) = %q", got)
+	}
+	if got := SocketFromURL(DefaultDaemonURL); got != "" {
+		t.Errorf("SocketFromURL(http) = %q, want empty", got)
+	}
+	if _, base := NewHTTPClient("http://localhost:4200/", 0); base != "http://localhost:4200" {
+		t.Errorf("NewHTTPClient base = %q", base)
+	}
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+// DaemonURLEnvVar overrides the URL clients use to reach the daemon.
+const DaemonURLEnvVar = "CORTEX_DAEMON_URL"
+
+// UnixURLScheme prefixes daemon URLs that point at a Unix socket, as in
+// unix:///home/me/.cortex/cortexd.sock.
+const UnixURLScheme = "unix://"
+
+// unixBaseURL is the base URL of requests sent over a Unix socket. The host
+// is never resolved.
+const unixBaseURL = "http://cortexd"
+
+// SocketPath returns the expanded path of the daemon's Unix socket, or ""
+// when none is configured.
+func (c *Config) SocketPath() string {
+	return storage.ExpandHome(c.Socket)
+}
+
+// ListenURL returns the URL the daemon serves its API on: the TCP address,
+// or the socket when TCP is disabled.
+func (c *Config) ListenURL() string {
+	if c.DisableTCP && c.Socket != "" {
+		return UnixURLScheme + c.SocketPath()
+	}
+	return fmt.Sprintf("http://%s:%d", c.BindAddress, c.Port)
+}
+
+// ClientURL returns the URL clients on this machine use: the socket when
+// one is configured, else localhost on the configured port.
+func (c *Config) ClientURL() string {
+	if c.Socket != "" {
+		return UnixURLScheme + c.SocketPath()
+	}
+	return fmt.Sprintf("http://localhost:%d", c.Port)
+}
+
+// ClientDaemonURL returns $CORTEX_DAEMON_URL, else the client URL from
+// settings.yaml, else DefaultDaemonURL.
+func ClientDaemonURL() string {
+	if u := os.Getenv(DaemonURLEnvVar); u != "" {
+		return u
+	}
+	cfg, err := Load()
+	if err != nil {
+		return DefaultDaemonURL
+	}
+	return cfg.ClientURL()
+}
+
+// SocketFromURL returns the socket path of a unix:// daemon URL, or "".
+func SocketFromURL(daemonURL string) string {
+	if !strings.HasPrefix(daemonURL, UnixURLScheme) {
+		return ""
+	}
+	return storage.ExpandHome(strings.TrimPrefix(daemonURL, UnixURLScheme))
+}
+
+// NewHTTPClient returns an HTTP client for daemonURL and the base URL to
+// build request URLs from. For unix:// URLs the client dials the socket;
+// other URLs are returned unchanged with a plain client.
+func NewHTTPClient(daemonURL string, timeout time.Duration) (*http.Client, string) {
+	socket := SocketFromURL(daemonURL)
+	if socket == "" {
+		return &http.Client{Timeout: timeout}, strings.TrimSuffix(daemonURL, "/")
+	}
+	var d net.Dialer
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}, unixBaseURL
+}
@@ -26,6 +26,10 @@ type Config struct {
 	// When set, the session is a collab session with concludeSession access only.
 	CollabID string
 
+	// ReviewTicketID identifies a reviewer session and the ticket it
+	// reviews. Set from CORTEX_REVIEW_TICKET_ID.
+	ReviewTicketID string
+
 	// ArchitectPath is the architect root for hook execution.
 	// If set, architect config is loaded from this path.
 	// Required for architect sessions.
@@ -54,6 +58,10 @@ type Config struct {
 	// instead of creating its own ticket store.
 	DaemonURL string
 
+	// Token is the session's API token, sent as a bearer token when the
+	// daemon requires one. Set from CORTEX_TOKEN.
+	Token string
+
 	// Logger is an optional logger for warnings and errors.
 	// If nil, warnings are silently ignored.
 	Logger *slog.Logger
@@ -82,6 +90,11 @@ func NewServer(cfg *Config) (*Server, error) {
 			CollabID: cfg.CollabID,
 			Repo:     cfg.Repo,
 		}
+	} else if cfg.ReviewTicketID != "" {
+		session = &Session{
+			Type:     SessionTypeReviewer,
+			TicketID: cfg.ReviewTicketID,
+		}
 	} else if cfg.TicketID != "" {
 		session = &Session{
 			Type:       SessionTypeTicket,
@@ -98,12 +111,20 @@ func NewServer(cfg *Config) (*Server, error) {
 	var sdkClient *sdk.Client
 
 	switch session.Type {
-	case SessionTypeTicket, SessionTypeCollab:
-		// Ticket and collab sessions always route through the daemon HTTP API
+	case SessionTypeTicket, SessionTypeCollab, SessionTypeReviewer:
+		// Ticket, collab and reviewer sessions always route through the daemon HTTP API
 		if cfg.DaemonURL == "" {
 			return nil, fmt.Errorf("ticket/collab sessions require CORTEX_DAEMON_URL to be set")
 		}
-		sdkClient = sdk.NewClient(cfg.DaemonURL, cfg.ArchitectPath)
+		sdkClient = sdk.NewClient(cfg.DaemonURL, cfg.ArchitectPath).WithToken(cfg.Token)
+		switch session.Type {
+		case SessionTypeCollab:
+			sdkClient.WithActor("collab:" + session.CollabID)
+		case SessionTypeReviewer:
+			sdkClient.WithActor("reviewer:" + session.TicketID)
+		default:
+			sdkClient.WithActor("worker:" + session.TicketID)
+		}
 
 	default:
 		// Architect sessions route all operations through the daemon HTTP API
@@ -112,10 +133,10 @@ func NewServer(cfg *Config) (*Server, error) {
 		}
 
 		if cfg.DaemonURL == "" {
-			cfg.DaemonURL = daemonconfig.DefaultDaemonURL
+			cfg.DaemonURL = daemonconfig.ClientDaemonURL()
 		}
 
-		sdkClient = sdk.NewClient(cfg.DaemonURL, cfg.ArchitectPath)
+		sdkClient = sdk.NewClient(cfg.DaemonURL, cfg.ArchitectPath).WithActor("architect").WithToken(cfg.Token)
 	}
 
 	// Create MCP server
@@ -138,6 +159,8 @@ func NewServer(cfg *Config) (*Server, error) {
 		s.registerArchitectTools()
 	case SessionTypeCollab:
 		s.registerCollabTools()
+	case SessionTypeReviewer:
+		s.registerReviewerTools()
 	default:
 		s.registerTicketTools()
 	}
@@ -169,3 +192,8 @@ func (s *Server) IsTicketSession() bool {
 func (s *Server) IsCollabSession() bool {
 	return s.session.Type == SessionTypeCollab
 }
+
+// IsReviewerSession returns true if this is a reviewer session.
+func (s *Server) IsReviewerSession() bool {
+	return s.session.Type == SessionTypeReviewer
+}
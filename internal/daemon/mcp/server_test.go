@@ -69,6 +69,24 @@ func TestNewServerTicket(t *testing.T) {
 	}
 }
 
+func TestNewServerReviewer(t *testing.T) {
+	server, err := NewServer(&Config{
+		ReviewTicketID: "test-ticket-123",
+		DaemonURL:      daemonconfig.DefaultDaemonURL,
+		ArchitectPath:  t.TempDir(),
+	})
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+
+	if !server.IsReviewerSession() || server.IsTicketSession() {
+		t.Errorf("session type = %q, want %q", server.Session().Type, SessionTypeReviewer)
+	}
+	if server.Session().TicketID != "test-ticket-123" {
+		t.Errorf("ticket ID = %q, want %q", server.Session().TicketID, "test-ticket-123")
+	}
+}
+
 func TestNewServerTicketRequiresDaemonURL(t *testing.T) {
 	_, err := NewServer(&Config{
 		TicketID: "test-ticket-123",
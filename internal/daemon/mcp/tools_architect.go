@@ -6,12 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"sort"
 	"strings"
 	"time"
 
 	"github.com/kareemaly/cortex/internal/cli/sdk"
 	"github.com/kareemaly/cortex/internal/daemon/api"
+	daemonconfig "github.com/kareemaly/cortex/internal/daemon/config"
 	"github.com/kareemaly/cortex/internal/ticket"
 	"github.com/kareemaly/cortex/internal/types"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -22,7 +22,7 @@ func (s *Server) registerArchitectTools() {
 	// List tickets
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "listTickets",
-		Description: "List tickets by status. Status parameter is required and must be one of: backlog, progress, done.",
+		Description: "List tickets by status. Status parameter is required and must be one of the statuses configured in cortex.yaml (backlog, progress, done by default). Optional labels, priorities and assignee filter the list, and sort orders it by created, updated, priority or due.",
 	}, s.handleListTickets)
 
 	// Read ticket
@@ -31,16 +31,16 @@ func (s *Server) registerArchitectTools() {
 		Description: "Read full ticket details by ID",
 	}, s.handleReadTicket)
 
-	// Create work ticket
+	// Create ticket
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
-		Name:        "createWorkTicket",
-		Description: "Create a new work ticket in backlog. Requires a repo field — provide a stable repo key from cortex.yaml.",
-	}, s.handleCreateWorkTicket)
+		Name:        "createTicket",
+		Description: "Create a new ticket in backlog. Requires a repo field — provide a stable repo key from cortex.yaml. Optional type selects a ticket type from cortex.yaml (defaults to work); the type may require extra fields. Optional blocked_by and blocks link it to existing tickets. Optional priority (urgent, high, medium, low), labels and assignee (a person or agent variant) help triage. Optional template names a ticket template (see listTemplates) whose defaults fill the fields left empty, rendered with vars.",
+	}, s.handleCreateTicket)
 
 	// Update ticket
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "updateTicket",
-		Description: "Update mutable ticket fields. Accepts: id (required), title, body, dueDate, references. dueDate must be RFC3339 when set, and an explicit empty string clears it. Use editTicketBody for targeted body edits; keep updateTicket for full-body rewrites. Does NOT support updating type, repo, status, or any other fields.",
+		Description: "Update mutable ticket fields. Accepts: id (required), title, body, dueDate, references, blocked_by, blocks, priority, labels, assignee. dueDate must be RFC3339 when set, and an explicit empty string clears it, as it does priority and assignee. Use editTicketBody for targeted body edits; keep updateTicket for full-body rewrites. Does NOT support updating type, repo, status, or any other fields.",
 	}, s.handleUpdateTicket)
 
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
@@ -57,9 +57,15 @@ func (s *Server) registerArchitectTools() {
 	// Move ticket
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "moveTicket",
-		Description: "Move a ticket to a different status",
+		Description: "Move a ticket to a different status. The status must be configured in cortex.yaml (backlog, progress, done by default) and reachable from the ticket's current status under the configured transitions.",
 	}, s.handleMoveTicket)
 
+	// List templates
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "listTemplates",
+		Description: "List the ticket templates in the architect's templates/ directory, with their defaults and the variables each one needs. Pass a name as the template parameter of createTicket.",
+	}, s.handleListTemplates)
+
 	// List variants
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "listVariants",
@@ -69,7 +75,7 @@ func (s *Server) registerArchitectTools() {
 	// Spawn session
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "spawnSession",
-		Description: "Spawn a new agent session for a ticket. Use listVariants to see available agent variant names, then pass the chosen name as the variant parameter.",
+		Description: "Spawn a new agent session for a ticket. Use listVariants to see available agent variant names, then pass the chosen name as the variant parameter. Tickets blocked by unfinished tickets are refused unless force=true. When a max_concurrent limit is reached the spawn is queued (state \"queued\") and starts once a session ends. Set backend to 'headless' to run the agent without tmux.",
 	}, s.handleSpawnSession)
 
 	// List conclusions (persistent conclusion records)
@@ -84,10 +90,42 @@ func (s *Server) registerArchitectTools() {
 		Description: "Read a conclusion record by ID, including the full body.",
 	}, s.handleReadConclusion)
 
-	// Search across tickets and conclusions
+	// Read session timeline
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "readSessionTimeline",
+		Description: "Read the recorded timeline of a session: status transitions and tool calls with timestamps. Use it to review how a worker got to its conclusion; readConclusion returns the session_id.",
+	}, s.handleReadSessionTimeline)
+
+	// Review a concluded ticket
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "readReview",
+		Description: "Read the review of a ticket: its state (open, changes_requested, accepted), round, verdict summary, a reviewer agent's verdict and the comments of every round. Concluded tickets wait in the review status when cortex.yaml configures one.",
+	}, s.handleReadReview)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "addReviewComment",
+		Description: "Comment on a changed file of a ticket in review, optionally anchored to a line and commit. Comments are sent to the worker when you call requestChanges.",
+	}, s.handleAddReviewComment)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "acceptReview",
+		Description: "Accept the work of a ticket in review and move it to done.",
+	}, s.handleAcceptReview)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "requestChanges",
+		Description: "Send a ticket in review back to progress and spawn a worker whose prompt carries this round's review comments and the summary. Leave comments with addReviewComment first, or pass a summary.",
+	}, s.handleRequestChanges)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "spawnReviewer",
+		Description: "Spawn a reviewer agent that audits a concluded ticket's commits and records a verdict and findings on its review. The reviewer is read-only; read its verdict with readReview.",
+	}, s.handleSpawnReviewer)
+
+	// Full-text search across the workspace
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "search",
-		Description: "Search across all tickets (all statuses) and conclusions by a case-insensitive substring query. Returns tickets in readTicket shape (with conclusion nested if done) and bare ticketless conclusions. Results sorted newest-updated first.",
+		Description: "Full-text search across tickets (all statuses), conclusions, collabs and markdown notes in the architect workspace. Results are ranked by relevance with highlighted snippets. Supports filters in the query: repo:, status:, type:, after:, before:, updated:FROM..TO. Use readTicket or readConclusion to load a hit in full.",
 	}, s.handleSearch)
 
 	// Conclude architect session
@@ -113,11 +151,14 @@ func (s *Server) handleListTickets(
 	if input.Status == "" {
 		return nil, ListTicketsOutput{}, NewValidationError("status", "is required")
 	}
-	if input.Status != "backlog" && input.Status != "progress" && input.Status != "done" {
-		return nil, ListTicketsOutput{}, NewValidationError("status", "must be one of: backlog, progress, done")
-	}
 
-	resp, err := s.sdkClient.ListTicketsByStatus(input.Status, input.Query, nil)
+	resp, err := s.sdkClient.FilterTicketsByStatus(input.Status, sdk.TicketFilter{
+		Query:      input.Query,
+		Labels:     input.Labels,
+		Priorities: input.Priorities,
+		Assignee:   input.Assignee,
+		Sort:       input.Sort,
+	})
 	if err != nil {
 		return nil, ListTicketsOutput{}, wrapSDKError(err)
 	}
@@ -159,10 +200,11 @@ func (s *Server) handleReadTicket(
 			}
 		} else {
 			out.Conclusion = &ConclusionOutput{
-				ID:          conclusion.ID,
-				Body:        conclusion.Body,
-				StartedAt:   conclusion.StartedAt.Format(time.RFC3339),
-				ConcludedAt: conclusion.ConcludedAt.Format(time.RFC3339),
+				ID:            conclusion.ID,
+				Body:          conclusion.Body,
+				StartedAt:     conclusion.StartedAt.Format(time.RFC3339),
+				ConcludedAt:   conclusion.ConcludedAt.Format(time.RFC3339),
+				Verifications: verificationsToOutput(conclusion.Verifications),
 			}
 		}
 	}
@@ -170,17 +212,20 @@ func (s *Server) handleReadTicket(
 	return nil, ReadTicketOutput{Ticket: out}, nil
 }
 
-// handleCreateWorkTicket creates a new work ticket via the daemon HTTP API.
-func (s *Server) handleCreateWorkTicket(
+// handleCreateTicket creates a new ticket of the requested type via the daemon HTTP API.
+func (s *Server) handleCreateTicket(
 	ctx context.Context,
 	req *mcp.CallToolRequest,
-	input CreateWorkTicketInput,
+	input CreateTicketInput,
 ) (*mcp.CallToolResult, CreateTicketOutput, error) {
-	if input.Title == "" {
-		return nil, CreateTicketOutput{}, NewValidationError("title", "is required")
-	}
-	if input.Repo == "" {
-		return nil, CreateTicketOutput{}, NewValidationError("repo", "is required for work tickets")
+	// A template may supply the title and repo; the daemon checks them.
+	if input.Template == "" {
+		if input.Title == "" {
+			return nil, CreateTicketOutput{}, NewValidationError("title", "is required")
+		}
+		if input.Repo == "" {
+			return nil, CreateTicketOutput{}, NewValidationError("repo", "is required")
+		}
 	}
 
 	// Parse dueDate if provided
@@ -193,7 +238,22 @@ func (s *Server) handleCreateWorkTicket(
 		dueDate = &parsed
 	}
 
-	resp, err := s.sdkClient.CreateTicket(input.Title, input.Body, input.Repo, dueDate, input.References)
+	resp, err := s.sdkClient.CreateTicketWithParams(sdk.CreateTicketParams{
+		Template:   input.Template,
+		Vars:       input.Vars,
+		Title:      input.Title,
+		Body:       input.Body,
+		Type:       input.Type,
+		Repo:       input.Repo,
+		Repos:      input.Repos,
+		DueDate:    dueDate,
+		References: input.References,
+		BlockedBy:  input.BlockedBy,
+		Blocks:     input.Blocks,
+		Priority:   input.Priority,
+		Labels:     input.Labels,
+		Assignee:   input.Assignee,
+	})
 	if err != nil {
 		return nil, CreateTicketOutput{}, wrapSDKError(err)
 	}
@@ -218,22 +278,35 @@ func (s *Server) handleUpdateTicket(
 		err  error
 	)
 
-	if input.Title != nil || input.Body != nil || input.References != nil {
-		resp, err = s.sdkClient.UpdateTicket(input.ID, input.Title, input.Body, input.References)
+	params := sdk.UpdateTicketParams{
+		Title:      input.Title,
+		Body:       input.Body,
+		References: input.References,
+		BlockedBy:  input.BlockedBy,
+		Blocks:     input.Blocks,
+		Priority:   input.Priority,
+		Labels:     input.Labels,
+		Assignee:   input.Assignee,
+	}
+	id := input.ID
+	if params != (sdk.UpdateTicketParams{}) {
+		resp, err = s.sdkClient.UpdateTicketWithParams(id, params)
 		if err != nil {
 			return nil, UpdateTicketOutput{}, wrapSDKError(err)
 		}
+		// A new title renames the ticket.
+		id = resp.ID
 	}
 
 	if input.DueDate != nil {
 		if *input.DueDate == "" {
-			resp, err = s.sdkClient.ClearDueDate(input.ID)
+			resp, err = s.sdkClient.ClearDueDate(id)
 		} else {
 			dueDate, parseErr := time.Parse(time.RFC3339, *input.DueDate)
 			if parseErr != nil {
 				return nil, UpdateTicketOutput{}, NewValidationError("dueDate", "must be empty or in RFC3339 format")
 			}
-			resp, err = s.sdkClient.SetDueDate(input.ID, dueDate)
+			resp, err = s.sdkClient.SetDueDate(id, dueDate)
 		}
 		if err != nil {
 			return nil, UpdateTicketOutput{}, wrapSDKError(err)
@@ -299,7 +372,7 @@ func (s *Server) handleDeleteTicket(
 		return nil, DeleteTicketOutput{}, NewValidationError("id", "cannot be empty")
 	}
 
-	err := s.sdkClient.DeleteTicket(input.ID)
+	err := s.sdkClient.DeleteTicket(input.ID, input.CleanupWorktree)
 	if err != nil {
 		return nil, DeleteTicketOutput{}, wrapSDKError(err)
 	}
@@ -323,11 +396,6 @@ func (s *Server) handleMoveTicket(
 		return nil, MoveTicketOutput{}, NewValidationError("status", "cannot be empty")
 	}
 
-	// Validate status
-	if input.Status != "backlog" && input.Status != "progress" && input.Status != "done" {
-		return nil, MoveTicketOutput{}, NewValidationError("status", "must be backlog, progress, or done")
-	}
-
 	_, err := s.sdkClient.MoveTicket(input.ID, input.Status)
 	if err != nil {
 		return nil, MoveTicketOutput{}, wrapSDKError(err)
@@ -368,19 +436,29 @@ func (s *Server) handleSpawnSession(
 	}
 
 	// Build HTTP request to daemon
-	url := fmt.Sprintf("%s/tickets/%s/%s/spawn?variant=%s", s.config.DaemonURL, ticketResp.Status, input.TicketID, input.Variant)
+	client, baseURL := daemonconfig.NewHTTPClient(s.config.DaemonURL, 0)
+	url := fmt.Sprintf("%s/tickets/%s/%s/spawn?variant=%s", baseURL, ticketResp.Status, input.TicketID, input.Variant)
 	if input.Mode != "" {
 		url += "&mode=" + input.Mode
 	}
+	if input.Force {
+		url += "&force=true"
+	}
+	if input.Backend != "" {
+		url += "&backend=" + input.Backend
+	}
 
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
 	if err != nil {
 		return nil, SpawnSessionOutput{}, NewInternalError("failed to create request: " + err.Error())
 	}
 	httpReq.Header.Set("X-Cortex-Architect", projectPath)
+	if s.config.Token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+s.config.Token)
+	}
 
 	// Execute request
-	resp, err := http.DefaultClient.Do(httpReq)
+	resp, err := client.Do(httpReq)
 	if err != nil {
 		return nil, SpawnSessionOutput{}, NewInternalError("failed to contact daemon: " + err.Error())
 	}
@@ -399,14 +477,28 @@ func (s *Server) handleSpawnSession(
 			TmuxWindow: spawnResp.Session.TmuxWindow,
 		}, nil
 
+	case http.StatusAccepted: // 202 - queued behind a max_concurrent limit
+		var spawnResp api.SpawnResponse
+		if err := json.NewDecoder(resp.Body).Decode(&spawnResp); err != nil {
+			return nil, SpawnSessionOutput{}, NewInternalError("failed to decode response: " + err.Error())
+		}
+		out := SpawnSessionOutput{Success: true, TicketID: input.TicketID, State: "queued"}
+		if q := spawnResp.Queue; q != nil {
+			out.Message = fmt.Sprintf("queued at position %d: %s", q.Position, q.Reason)
+		}
+		return nil, out, nil
+
 	case http.StatusOK: // 200 - already active
 		return nil, SpawnSessionOutput{State: "active"}, NewStateConflictError("active", input.Mode, "session is currently active - wait for it to finish or close the tmux window")
 
-	case http.StatusConflict: // 409 - state/orphaned error
+	case http.StatusConflict: // 409 - state/orphaned/blocked error
 		var errResp types.ErrorResponse
 		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
 			return nil, SpawnSessionOutput{}, NewInternalError("failed to decode error response: " + err.Error())
 		}
+		if errResp.Code == "ticket_blocked" {
+			return nil, SpawnSessionOutput{State: "blocked"}, NewStateConflictError("blocked", input.Mode, errResp.Error+" (pass force=true to spawn anyway)")
+		}
 		state := parseStateFromError(errResp.Code, errResp.Error)
 		return nil, SpawnSessionOutput{State: state}, NewStateConflictError(state, input.Mode, errResp.Error)
 
@@ -521,14 +613,60 @@ func (s *Server) handleReadConclusion(
 
 	return nil, ReadConclusionOutput{
 		Conclusion: ConclusionOutput{
-			ID:          resp.ID,
-			Body:        resp.Body,
-			StartedAt:   resp.StartedAt.Format(time.RFC3339),
-			ConcludedAt: resp.ConcludedAt.Format(time.RFC3339),
+			ID:            resp.ID,
+			SessionID:     resp.SessionID,
+			Body:          resp.Body,
+			StartedAt:     resp.StartedAt.Format(time.RFC3339),
+			ConcludedAt:   resp.ConcludedAt.Format(time.RFC3339),
+			Verifications: verificationsToOutput(resp.Verifications),
 		},
 	}, nil
 }
 
+func verificationsToOutput(verifications []types.VerificationResponse) []VerificationOutput {
+	var out []VerificationOutput
+	for _, v := range verifications {
+		vo := VerificationOutput{Repo: v.Repo, Commit: v.Commit, Status: v.Status, Error: v.Error}
+		for _, c := range v.Commands {
+			vo.Commands = append(vo.Commands, VerifyCommandOutput{
+				Command:  c.Command,
+				ExitCode: c.ExitCode,
+				TimedOut: c.TimedOut,
+				Output:   c.Output,
+			})
+		}
+		out = append(out, vo)
+	}
+	return out
+}
+
+// handleReadSessionTimeline reads the recorded hook events of a session.
+func (s *Server) handleReadSessionTimeline(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ReadSessionTimelineInput,
+) (*mcp.CallToolResult, ReadSessionTimelineOutput, error) {
+	if input.SessionID == "" {
+		return nil, ReadSessionTimelineOutput{}, NewValidationError("session_id", "cannot be empty")
+	}
+
+	resp, err := s.sdkClient.GetSessionTimeline(input.SessionID)
+	if err != nil {
+		return nil, ReadSessionTimelineOutput{}, wrapSDKError(err)
+	}
+
+	entries := make([]TimelineEntryOutput, len(resp.Entries))
+	for i, e := range resp.Entries {
+		entries[i] = TimelineEntryOutput{
+			Time:    e.Time.Format(time.RFC3339),
+			Status:  e.Status,
+			Tool:    e.Tool,
+			Message: e.Message,
+		}
+	}
+	return nil, ReadSessionTimelineOutput{SessionID: resp.SessionID, Entries: entries}, nil
+}
+
 // handleSpawnCollabSession spawns a collab session via the daemon HTTP API.
 func (s *Server) handleSpawnCollabSession(
 	ctx context.Context,
@@ -596,6 +734,23 @@ func (s *Server) handleListVariants(
 	return nil, ListVariantsOutput{Variants: variants}, nil
 }
 
+// handleListTemplates lists the architect's ticket templates.
+func (s *Server) handleListTemplates(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ListTemplatesInput,
+) (*mcp.CallToolResult, ListTemplatesOutput, error) {
+	resp, err := s.sdkClient.ListTemplates()
+	if err != nil {
+		return nil, ListTemplatesOutput{}, wrapSDKError(err)
+	}
+	out := ListTemplatesOutput{Templates: make([]TemplateOutput, 0, len(resp.Templates))}
+	for _, t := range resp.Templates {
+		out.Templates = append(out.Templates, TemplateOutput(t))
+	}
+	return nil, out, nil
+}
+
 // handleCreateFollowUpTicket creates a follow-up work ticket.
 // For ticket sessions (s.session.TicketID != ""), it auto-links bidirectionally
 // with the originating ticket. For collab sessions, it creates the ticket with no references.
@@ -604,11 +759,13 @@ func (s *Server) handleCreateFollowUpTicket(
 	req *mcp.CallToolRequest,
 	input CreateFollowUpTicketInput,
 ) (*mcp.CallToolResult, CreateFollowUpTicketOutput, error) {
-	if input.Title == "" {
-		return nil, CreateFollowUpTicketOutput{}, NewValidationError("title", "is required")
-	}
-	if input.Repo == "" {
-		return nil, CreateFollowUpTicketOutput{}, NewValidationError("repo", "is required for work tickets")
+	if input.Template == "" {
+		if input.Title == "" {
+			return nil, CreateFollowUpTicketOutput{}, NewValidationError("title", "is required")
+		}
+		if input.Repo == "" {
+			return nil, CreateFollowUpTicketOutput{}, NewValidationError("repo", "is required for work tickets")
+		}
 	}
 
 	var dueDate *time.Time
@@ -620,43 +777,28 @@ func (s *Server) handleCreateFollowUpTicket(
 		dueDate = &parsed
 	}
 
-	originID := s.session.TicketID
-	var references []string
-	if originID != "" {
-		references = []string{originID}
+	// The daemon links the follow-up and the session's ticket both ways.
+	params := sdk.CreateTicketParams{
+		Template:   input.Template,
+		Vars:       input.Vars,
+		Title:      input.Title,
+		Body:       input.Body,
+		Repo:       input.Repo,
+		DueDate:    dueDate,
+		FollowUpOf: s.session.TicketID,
 	}
-
-	resp, err := s.sdkClient.CreateTicket(input.Title, input.Body, input.Repo, dueDate, references)
+	resp, err := s.sdkClient.CreateTicketWithParams(params)
 	if err != nil {
 		return nil, CreateFollowUpTicketOutput{}, wrapSDKError(err)
 	}
 
-	newID := resp.ID
-
-	if originID != "" {
-		origin, err := s.sdkClient.GetTicketByID(originID)
-		if err != nil {
-			return nil, CreateFollowUpTicketOutput{}, NewInternalError(fmt.Sprintf(
-				"follow-up ticket %s was created but failed to fetch origin ticket %s: %s",
-				newID, originID, err,
-			))
-		}
-
-		updatedRefs := append(origin.References, newID)
-		if _, err := s.sdkClient.UpdateTicket(originID, nil, nil, &updatedRefs); err != nil {
-			return nil, CreateFollowUpTicketOutput{}, NewInternalError(fmt.Sprintf(
-				"follow-up ticket %s was created but failed to update origin ticket %s references: %s",
-				newID, originID, err,
-			))
-		}
-	}
-
 	return nil, CreateFollowUpTicketOutput{
 		Ticket: ticketResponseToOutput(resp),
 	}, nil
 }
 
-// handleSearch searches across all tickets (all statuses) and conclusions.
+// handleSearch runs a ranked full-text search over tickets, conclusions,
+// collabs and notes.
 func (s *Server) handleSearch(
 	ctx context.Context,
 	req *mcp.CallToolRequest,
@@ -671,105 +813,159 @@ func (s *Server) handleSearch(
 		limit = input.Limit
 	}
 
-	// 1. All tickets matching query (title+body, server-side filtered).
-	allTickets, err := s.sdkClient.ListAllTickets(input.Query, nil)
+	resp, err := s.sdkClient.Search(input.Query, limit)
 	if err != nil {
 		return nil, SearchOutput{}, wrapSDKError(err)
 	}
 
-	// 2. All conclusions whose body matches the query (server-side filtered).
-	allConclusions, err := s.sdkClient.ListConclusions(sdk.ListConclusionsParams{Query: input.Query, Limit: 0})
+	results := make([]SearchResultItem, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = SearchResultItem{
+			Kind:     r.Kind,
+			ID:       r.ID,
+			Title:    r.Title,
+			Snippet:  r.Snippet,
+			Score:    r.Score,
+			Repo:     r.Repo,
+			Status:   r.Status,
+			Type:     r.Type,
+			TicketID: r.TicketID,
+			Path:     r.Path,
+			Updated:  r.Updated.Format(time.RFC3339),
+		}
+	}
+
+	return nil, SearchOutput{Results: results, Total: resp.Total}, nil
+}
+
+// handleReadReview reads the review of a ticket.
+func (s *Server) handleReadReview(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input ReadReviewInput,
+) (*mcp.CallToolResult, ReadReviewOutput, error) {
+	if input.TicketID == "" {
+		return nil, ReadReviewOutput{}, NewValidationError("ticket_id", "cannot be empty")
+	}
+
+	resp, err := s.sdkClient.GetReview(input.TicketID)
 	if err != nil {
-		return nil, SearchOutput{}, wrapSDKError(err)
+		return nil, ReadReviewOutput{}, wrapSDKError(err)
 	}
 
-	// 3. Build a unique set of ticket IDs to fetch — start from direct ticket matches.
-	ticketIDSet := make(map[string]bool)
-	for _, t := range allTickets.Backlog {
-		ticketIDSet[t.ID] = true
+	out := ReadReviewOutput{
+		TicketID:       resp.TicketID,
+		State:          resp.State,
+		Round:          resp.Round,
+		Summary:        resp.Summary,
+		Verdict:        resp.Verdict,
+		VerdictSummary: resp.VerdictSummary,
+		Comments:       make([]ReviewCommentOutput, 0, len(resp.Comments)),
+	}
+	for _, c := range resp.Comments {
+		out.Comments = append(out.Comments, reviewCommentToMCP(c))
 	}
-	for _, t := range allTickets.Progress {
-		ticketIDSet[t.ID] = true
+	return nil, out, nil
+}
+
+// handleAddReviewComment comments on a file of a ticket in review.
+func (s *Server) handleAddReviewComment(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AddReviewCommentInput,
+) (*mcp.CallToolResult, AddReviewCommentOutput, error) {
+	if input.TicketID == "" {
+		return nil, AddReviewCommentOutput{}, NewValidationError("ticket_id", "cannot be empty")
 	}
-	for _, t := range allTickets.Done {
-		ticketIDSet[t.ID] = true
+	if input.File == "" {
+		return nil, AddReviewCommentOutput{}, NewValidationError("file", "cannot be empty")
+	}
+	if input.Body == "" {
+		return nil, AddReviewCommentOutput{}, NewValidationError("body", "cannot be empty")
 	}
 
-	// 4. Process conclusion matches: ticketed ones add parent; ticketless are bare conclusions.
-	var bareConclusions []types.ConclusionSummary
-	for _, c := range allConclusions.Conclusions {
-		if c.TicketID != "" {
-			ticketIDSet[c.TicketID] = true
-		} else {
-			bareConclusions = append(bareConclusions, c)
-		}
+	resp, err := s.sdkClient.AddReviewComment(sdk.ReviewCommentParams{
+		TicketID: input.TicketID,
+		Repo:     input.Repo,
+		Commit:   input.Commit,
+		File:     input.File,
+		Line:     input.Line,
+		Body:     input.Body,
+	})
+	if err != nil {
+		return nil, AddReviewCommentOutput{}, wrapSDKError(err)
 	}
+	return nil, AddReviewCommentOutput{Comment: reviewCommentToMCP(*resp)}, nil
+}
 
-	// resultWithTime pairs a SearchResultItem with a timestamp for sorting.
-	type resultWithTime struct {
-		item SearchResultItem
-		t    time.Time
+// handleAcceptReview accepts a ticket in review.
+func (s *Server) handleAcceptReview(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input AcceptReviewInput,
+) (*mcp.CallToolResult, ReviewActionOutput, error) {
+	if input.TicketID == "" {
+		return nil, ReviewActionOutput{}, NewValidationError("ticket_id", "cannot be empty")
 	}
-	var combined []resultWithTime
 
-	// 5. Fetch full ticket details and nest conclusion when available.
-	for ticketID := range ticketIDSet {
-		resp, err := s.sdkClient.GetTicketByID(ticketID)
-		if err != nil {
-			// Ticket may have been deleted between listing and fetching — skip.
-			continue
-		}
-		out := ticketResponseToOutput(resp)
-		if resp.HasConclusion {
-			conclusion, err := s.sdkClient.GetConclusion(resp.ID)
-			if err == nil {
-				out.Conclusion = &ConclusionOutput{
-					ID:          conclusion.ID,
-					Body:        conclusion.Body,
-					StartedAt:   conclusion.StartedAt.Format(time.RFC3339),
-					ConcludedAt: conclusion.ConcludedAt.Format(time.RFC3339),
-				}
-			}
-		}
-		combined = append(combined, resultWithTime{
-			item: SearchResultItem{Ticket: &out},
-			t:    resp.Updated,
-		})
+	resp, err := s.sdkClient.AcceptReview(input.TicketID, input.Summary)
+	if err != nil {
+		return nil, ReviewActionOutput{}, wrapSDKError(err)
 	}
+	return nil, ReviewActionOutput{
+		Success:  true,
+		TicketID: input.TicketID,
+		Status:   resp.Ticket.Status,
+		Message:  resp.Message,
+	}, nil
+}
 
-	// 6. Fetch full bodies for bare ticketless conclusions.
-	for _, bc := range bareConclusions {
-		resp, err := s.sdkClient.GetConclusion(bc.ID)
-		if err != nil {
-			continue
-		}
-		cOut := ConclusionOutput{
-			ID:          resp.ID,
-			Body:        resp.Body,
-			StartedAt:   resp.StartedAt.Format(time.RFC3339),
-			ConcludedAt: resp.ConcludedAt.Format(time.RFC3339),
-		}
-		combined = append(combined, resultWithTime{
-			item: SearchResultItem{Conclusion: &cOut},
-			t:    resp.ConcludedAt,
-		})
+// handleRequestChanges sends a ticket in review back to its worker.
+func (s *Server) handleRequestChanges(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input RequestChangesInput,
+) (*mcp.CallToolResult, ReviewActionOutput, error) {
+	if input.TicketID == "" {
+		return nil, ReviewActionOutput{}, NewValidationError("ticket_id", "cannot be empty")
 	}
 
-	// 7. Sort newest-updated first.
-	sort.Slice(combined, func(i, j int) bool {
-		return combined[i].t.After(combined[j].t)
+	resp, err := s.sdkClient.RequestChanges(sdk.RequestChangesParams{
+		TicketID: input.TicketID,
+		Summary:  input.Summary,
+		Variant:  input.Variant,
+		Backend:  input.Backend,
 	})
-
-	// 8. Apply limit.
-	total := len(combined)
-	if len(combined) > limit {
-		combined = combined[:limit]
+	if err != nil {
+		return nil, ReviewActionOutput{}, wrapSDKError(err)
 	}
+	return nil, ReviewActionOutput{
+		Success:  true,
+		TicketID: input.TicketID,
+		Status:   resp.Ticket.Status,
+		Message:  resp.Message,
+	}, nil
+}
 
-	results := make([]SearchResultItem, len(combined))
-	for i, r := range combined {
-		results[i] = r.item
+// handleSpawnReviewer spawns a reviewer agent for a concluded ticket.
+func (s *Server) handleSpawnReviewer(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SpawnReviewerInput,
+) (*mcp.CallToolResult, SpawnReviewerOutput, error) {
+	if input.TicketID == "" {
+		return nil, SpawnReviewerOutput{}, NewValidationError("ticket_id", "cannot be empty")
 	}
 
-	return nil, SearchOutput{Results: results, Total: total}, nil
+	resp, err := s.sdkClient.SpawnReviewer(input.TicketID, input.Variant)
+	if err != nil {
+		return nil, SpawnReviewerOutput{}, wrapSDKError(err)
+	}
+	return nil, SpawnReviewerOutput{
+		Success:    true,
+		TicketID:   resp.TicketID,
+		Round:      resp.Round,
+		TmuxWindow: resp.TmuxWindow,
+		Variant:    resp.Variant,
+	}, nil
 }
@@ -11,13 +11,18 @@ import (
 // registerCollabTools registers all tools available to collab sessions.
 func (s *Server) registerCollabTools() {
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
-		Name:        "createWorkTicket",
-		Description: "Create a new work ticket in backlog. Requires a repo field — provide a stable repo key from cortex.yaml.",
-	}, s.handleCreateWorkTicket)
+		Name:        "createTicket",
+		Description: "Create a new ticket in backlog. Requires a repo field — provide a stable repo key from cortex.yaml. Optional type selects a ticket type from cortex.yaml (defaults to work); the type may require extra fields. Optional priority (urgent, high, medium, low), labels and assignee (a person or agent variant) help triage. Optional template names a ticket template (see listTemplates) whose defaults fill the fields left empty, rendered with vars.",
+	}, s.handleCreateTicket)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "listTemplates",
+		Description: "List the ticket templates in the architect's templates/ directory, with their defaults and the variables each one needs. Pass a name as the template parameter of createTicket.",
+	}, s.handleListTemplates)
 
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "updateTicket",
-		Description: "Update mutable ticket fields. Accepts: id (required), title, body, dueDate, references. dueDate must be RFC3339 when set, and an explicit empty string clears it. Use editTicketBody for targeted body edits; keep updateTicket for full-body rewrites. Does NOT support updating type, repo, status, or any other fields.",
+		Description: "Update mutable ticket fields. Accepts: id (required), title, body, dueDate, references, priority, labels, assignee. dueDate must be RFC3339 when set, and an explicit empty string clears it, as it does priority and assignee. Use editTicketBody for targeted body edits; keep updateTicket for full-body rewrites. Does NOT support updating type, repo, status, or any other fields.",
 	}, s.handleUpdateTicket)
 
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
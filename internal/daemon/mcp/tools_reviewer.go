@@ -0,0 +1,80 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/kareemaly/cortex/internal/cli/sdk"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// registerReviewerTools registers the tools available to reviewer sessions:
+// read-only access plus submitReview for the reviewed ticket.
+func (s *Server) registerReviewerTools() {
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "readTicket",
+		Description: "Read full ticket details by ID. Use this to get context on the reviewed ticket and the tickets it references.",
+	}, s.handleReadTicket)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "readConclusion",
+		Description: "Read a conclusion record by ID, including the full body.",
+	}, s.handleReadConclusion)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "readReview",
+		Description: "Read the review of a ticket: its state, round and the comments of every round, including those of earlier rounds the worker was asked to address.",
+	}, s.handleReadReview)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "search",
+		Description: "Full-text search across tickets (all statuses), conclusions, collabs and markdown notes in the architect workspace. Supports filters in the query: repo:, status:, type:, after:, before:, updated:FROM..TO.",
+	}, s.handleSearch)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "submitReview",
+		Description: "Submit your verdict on the reviewed ticket and end the session. verdict is 'approve' or 'request_changes'; findings are recorded as review comments for the architect. Call it once, when the review is complete.",
+	}, s.handleSubmitReview)
+}
+
+// handleSubmitReview records the reviewer's verdict via the daemon API.
+// The daemon ends the session and closes its tmux window.
+func (s *Server) handleSubmitReview(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SubmitReviewInput,
+) (*mcp.CallToolResult, ReviewActionOutput, error) {
+	if input.Verdict == "" {
+		return nil, ReviewActionOutput{}, NewValidationError("verdict", "cannot be empty")
+	}
+	if input.Summary == "" {
+		return nil, ReviewActionOutput{}, NewValidationError("summary", "cannot be empty")
+	}
+
+	findings := make([]sdk.ReviewCommentParams, 0, len(input.Findings))
+	for _, f := range input.Findings {
+		findings = append(findings, sdk.ReviewCommentParams{
+			Repo:   f.Repo,
+			Commit: f.Commit,
+			File:   f.File,
+			Line:   f.Line,
+			Body:   f.Body,
+		})
+	}
+
+	ticketID := s.session.TicketID
+	resp, err := s.sdkClient.SubmitReview(sdk.SubmitReviewParams{
+		TicketID: ticketID,
+		Verdict:  input.Verdict,
+		Summary:  input.Summary,
+		Findings: findings,
+	})
+	if err != nil {
+		return nil, ReviewActionOutput{}, wrapSDKError(err)
+	}
+	return nil, ReviewActionOutput{
+		Success:  true,
+		TicketID: ticketID,
+		Status:   resp.Ticket.Status,
+		Message:  resp.Message,
+	}, nil
+}
@@ -111,8 +111,8 @@ func TestHandleListTickets(t *testing.T) {
 	defer cleanup()
 
 	// Create some tickets
-	_, _ = store.Create("Ticket 1", "body 1", nil, nil, "")
-	_, _ = store.Create("Ticket 2", "body 2", nil, nil, "")
+	_, _ = store.Create("Ticket 1", "body 1", nil, nil, "", nil, nil, "")
+	_, _ = store.Create("Ticket 2", "body 2", nil, nil, "", nil, nil, "")
 
 	// List backlog tickets (status is required)
 	_, output, err := server.handleListTickets(context.Background(), nil, ListTicketsInput{
@@ -135,8 +135,8 @@ func TestHandleListTicketsWithStatus(t *testing.T) {
 	defer cleanup()
 
 	// Create tickets in different statuses
-	t1, _ := store.Create("Backlog Ticket", "", nil, nil, "")
-	t2, _ := store.Create("Progress Ticket", "", nil, nil, "")
+	t1, _ := store.Create("Backlog Ticket", "", nil, nil, "", nil, nil, "")
+	t2, _ := store.Create("Progress Ticket", "", nil, nil, "", nil, nil, "")
 	_ = store.Move(t2.ID, ticket.StatusProgress)
 
 	// List only backlog
@@ -160,8 +160,8 @@ func TestHandleListTicketsWithQuery(t *testing.T) {
 	defer cleanup()
 
 	// Create tickets
-	_, _ = store.Create("Fix login bug", "Authentication issue", nil, nil, "")
-	_, _ = store.Create("Add feature", "New feature", nil, nil, "")
+	_, _ = store.Create("Fix login bug", "Authentication issue", nil, nil, "", nil, nil, "")
+	_, _ = store.Create("Add feature", "New feature", nil, nil, "", nil, nil, "")
 
 	// Search for "login" in backlog (status is required)
 	_, output, err := server.handleListTickets(context.Background(), nil, ListTicketsInput{
@@ -182,8 +182,8 @@ func TestHandleListTicketsWithStatusAndQuery(t *testing.T) {
 	defer cleanup()
 
 	// Create tickets
-	t1, _ := store.Create("Fix login bug", "Authentication issue", nil, nil, "")
-	t2, _ := store.Create("Fix login feature", "Another login issue", nil, nil, "")
+	t1, _ := store.Create("Fix login bug", "Authentication issue", nil, nil, "", nil, nil, "")
+	t2, _ := store.Create("Fix login feature", "Another login issue", nil, nil, "", nil, nil, "")
 	_ = store.Move(t2.ID, ticket.StatusProgress)
 
 	// Search for "login" in backlog only
@@ -208,8 +208,8 @@ func TestHandleListTicketsEmptyQuery(t *testing.T) {
 	defer cleanup()
 
 	// Create tickets
-	_, _ = store.Create("Ticket 1", "body 1", nil, nil, "")
-	_, _ = store.Create("Ticket 2", "body 2", nil, nil, "")
+	_, _ = store.Create("Ticket 1", "body 1", nil, nil, "", nil, nil, "")
+	_, _ = store.Create("Ticket 2", "body 2", nil, nil, "", nil, nil, "")
 
 	// Empty query should return all tickets in the specified status (status is required)
 	_, output, err := server.handleListTickets(context.Background(), nil, ListTicketsInput{
@@ -269,7 +269,7 @@ func TestHandleReadTicket(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleReadTicket(context.Background(), nil, ReadTicketInput{
 		ID: created.ID,
@@ -310,7 +310,7 @@ func TestHandleUpdateTicket(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Original", "body", nil, nil, "")
+	created, _ := store.Create("Original", "body", nil, nil, "", nil, nil, "")
 	newTitle := "Updated"
 
 	_, output, err := server.handleUpdateTicket(context.Background(), nil, UpdateTicketInput{
@@ -330,7 +330,7 @@ func TestHandleEditTicketBody(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Original", "alpha\nbeta\nalpha", nil, nil, "")
+	created, _ := store.Create("Original", "alpha\nbeta\nalpha", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleEditTicketBody(context.Background(), nil, EditTicketBodyInput{
 		ID:         created.ID,
@@ -361,17 +361,17 @@ func TestHandleEditTicketBody(t *testing.T) {
 	}
 }
 
-func TestHandleCreateWorkTicketOmitsBody(t *testing.T) {
+func TestHandleCreateTicketOmitsBody(t *testing.T) {
 	server, _, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	_, output, err := server.handleCreateWorkTicket(context.Background(), nil, CreateWorkTicketInput{
+	_, output, err := server.handleCreateTicket(context.Background(), nil, CreateTicketInput{
 		Title: "Created",
 		Body:  "Large body",
 		Repo:  "some-repo",
 	})
 	if err != nil {
-		t.Fatalf("handleCreateWorkTicket failed: %v", err)
+		t.Fatalf("handleCreateTicket failed: %v", err)
 	}
 
 	if output.Ticket.ID == "" {
@@ -391,11 +391,35 @@ func TestHandleCreateWorkTicketOmitsBody(t *testing.T) {
 	}
 }
 
+func TestHandleCreateTicketBlocks(t *testing.T) {
+	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
+	defer cleanup()
+
+	blocked, _ := store.Create("Blocked", "", nil, nil, "some-repo", nil, nil, "")
+
+	_, output, err := server.handleCreateTicket(context.Background(), nil, CreateTicketInput{
+		Title:  "Blocker",
+		Repo:   "some-repo",
+		Blocks: []string{blocked.ID},
+	})
+	if err != nil {
+		t.Fatalf("handleCreateTicket failed: %v", err)
+	}
+
+	blockedIDs, err := store.BlockedIDs()
+	if err != nil {
+		t.Fatalf("BlockedIDs failed: %v", err)
+	}
+	if !blockedIDs[blocked.ID] {
+		t.Errorf("expected %s to be blocked by %s", blocked.ID, output.Ticket.ID)
+	}
+}
+
 func TestHandleEditTicketBodyRejectsAmbiguousMatch(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Original", "alpha\nbeta\nalpha", nil, nil, "")
+	created, _ := store.Create("Original", "alpha\nbeta\nalpha", nil, nil, "", nil, nil, "")
 
 	_, _, err := server.handleEditTicketBody(context.Background(), nil, EditTicketBodyInput{
 		ID:        created.ID,
@@ -419,7 +443,7 @@ func TestHandleDeleteTicket(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("To Delete", "", nil, nil, "")
+	created, _ := store.Create("To Delete", "", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleDeleteTicket(context.Background(), nil, DeleteTicketInput{
 		ID: created.ID,
@@ -443,7 +467,7 @@ func TestHandleMoveTicket(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test", "", nil, nil, "")
+	created, _ := store.Create("Test", "", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleMoveTicket(context.Background(), nil, MoveTicketInput{
 		ID:     created.ID,
@@ -465,7 +489,7 @@ func TestHandleMoveTicketInvalidStatus(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test", "", nil, nil, "")
+	created, _ := store.Create("Test", "", nil, nil, "", nil, nil, "")
 
 	_, _, err := server.handleMoveTicket(context.Background(), nil, MoveTicketInput{
 		ID:     created.ID,
@@ -482,7 +506,7 @@ func TestHandleSpawnSession(t *testing.T) {
 	defer cleanup()
 
 	// Create a ticket first
-	created, err := store.Create("Test Spawn Session", "Test body", nil, nil, "")
+	created, err := store.Create("Test Spawn Session", "Test body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("failed to create ticket: %v", err)
 	}
@@ -542,11 +566,11 @@ func TestHandleSpawnSessionActiveSession(t *testing.T) {
 	defer cleanup()
 
 	// Create a ticket with an active session
-	created, err := store.Create("Test Active Session", "Test body", nil, nil, "")
+	created, err := store.Create("Test Active Session", "Test body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("failed to create ticket: %v", err)
 	}
-	_, err = sessStore.Create(created.ID, "claude", "window")
+	_, err = sessStore.Create(created.ID, "claude", "", "", "window", "")
 	if err != nil {
 		t.Fatalf("failed to set session: %v", err)
 	}
@@ -577,7 +601,7 @@ func TestHandleSpawnSessionAutoMovesToProgress(t *testing.T) {
 	defer cleanup()
 
 	// Create a ticket in backlog
-	created, err := store.Create("Test Auto Move", "Test body", nil, nil, "")
+	created, err := store.Create("Test Auto Move", "Test body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("failed to create ticket: %v", err)
 	}
@@ -639,7 +663,7 @@ func setupTicketSession(t *testing.T) (*Server, string, func()) {
 	}
 
 	// Create a ticket
-	tk, err := store.Create("Test Ticket", "body", nil, nil, "")
+	tk, err := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		_ = os.RemoveAll(tmpDir)
 		t.Fatalf("create ticket: %v", err)
@@ -648,7 +672,7 @@ func setupTicketSession(t *testing.T) (*Server, string, func()) {
 	// Set a session on the ticket
 	sessionsPath := filepath.Join(tmpDir, ".sessions.json")
 	localSessStore := session.NewStore(sessionsPath)
-	_, err = localSessStore.Create(tk.ID, "claude", "window")
+	_, err = localSessStore.Create(tk.ID, "claude", "", "", "window", "")
 	if err != nil {
 		_ = os.RemoveAll(tmpDir)
 		t.Fatalf("set session: %v", err)
@@ -723,7 +747,7 @@ func TestHandleSpawnSession_StateNormal_ModeNormal(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -743,7 +767,7 @@ func TestHandleSpawnSession_StateNormal_ModeResume(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -770,7 +794,7 @@ func TestHandleSpawnSession_StateNormal_ModeFresh(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -802,8 +826,8 @@ func TestHandleSpawnSession_StateActive_AllModes(t *testing.T) {
 			defer cleanup()
 
 			// Create ticket with active session (window exists because mock defaults to true)
-			created, _ := store.Create("Test Ticket", "body", nil, nil, "")
-			_, _ = sessStore.Create(created.ID, "claude", "window")
+			created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
+			_, _ = sessStore.Create(created.ID, "claude", "", "", "window", "")
 
 			_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 				TicketID: created.ID,
@@ -832,8 +856,8 @@ func TestHandleSpawnSession_StateOrphaned_ModeNormal(t *testing.T) {
 	server, store, sessStore, cleanup := setupArchitectWithDaemon(t, false)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
-	_, _ = sessStore.Create(created.ID, "claude", "window")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
+	_, _ = sessStore.Create(created.ID, "claude", "", "", "window", "")
 
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -860,8 +884,8 @@ func TestHandleSpawnSession_StateOrphaned_ModeResume(t *testing.T) {
 	server, store, sessStore, cleanup := setupArchitectWithDaemon(t, false)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
-	_, _ = sessStore.Create(created.ID, "claude", "window")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
+	_, _ = sessStore.Create(created.ID, "claude", "", "", "window", "")
 
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -881,8 +905,8 @@ func TestHandleSpawnSession_StateOrphaned_ModeFresh(t *testing.T) {
 	server, store, sessStore, cleanup := setupArchitectWithDaemon(t, false)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
-	_, _ = sessStore.Create(created.ID, "claude", "window")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
+	_, _ = sessStore.Create(created.ID, "claude", "", "", "window", "")
 
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -1089,7 +1113,7 @@ func TestHandleSpawnSession_InvalidMode(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	_, _, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
 		TicketID: created.ID,
@@ -1113,7 +1137,7 @@ func TestHandleSpawnSession_DefaultMode(t *testing.T) {
 	server, store, _, cleanup := setupArchitectWithDaemon(t, true)
 	defer cleanup()
 
-	created, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	created, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	// Empty mode should default to "normal" and succeed
 	_, output, err := server.handleSpawnSession(context.Background(), nil, SpawnSessionInput{
@@ -23,6 +23,12 @@ func (s *Server) registerTicketTools() {
 		Description: "Create a follow-up work ticket in backlog, automatically linked to the current ticket. The new ticket's references will include the current ticket ID, and the current ticket's references will be updated to include the new ticket ID.",
 	}, s.handleCreateFollowUpTicket)
 
+	// Tick acceptance criteria
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "checkCriterion",
+		Description: "Check an acceptance criterion of the current ticket (a '- [ ]' item under its Acceptance heading) once it is met, with evidence of how you verified it. readTicket lists the criteria with their index. Unchecked criteria are reported, or refused, when you conclude.",
+	}, s.handleCheckCriterion)
+
 	// Conclude session tool
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "concludeSession",
@@ -49,6 +55,8 @@ func (s *Server) handleConcludeSession(
 		Commits:         input.Commits,
 		Rejected:        input.Rejected,
 		RejectionReason: input.RejectionReason,
+		CleanupWorktree: input.CleanupWorktree,
+		Repo:            s.session.Repo,
 	})
 	if err != nil {
 		return nil, ConcludeSessionOutput{}, wrapSDKError(err)
@@ -61,19 +69,60 @@ func (s *Server) handleConcludeSession(
 	}, nil
 }
 
+// handleCheckCriterion checks an acceptance criterion of the session's ticket.
+func (s *Server) handleCheckCriterion(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input CheckCriterionInput,
+) (*mcp.CallToolResult, CheckCriterionOutput, error) {
+	if input.Index < 1 {
+		return nil, CheckCriterionOutput{}, NewValidationError("index", "must be 1 or more")
+	}
+	checked := input.Checked == nil || *input.Checked
+
+	resp, err := s.sdkClient.CheckCriterion(s.session.TicketID, input.Index, checked, input.Evidence)
+	if err != nil {
+		return nil, CheckCriterionOutput{}, wrapSDKError(err)
+	}
+
+	out := CheckCriterionOutput{Criteria: criteriaToOutput(resp.Criteria)}
+	for _, c := range out.Criteria {
+		if c.Checked {
+			out.Done++
+		}
+	}
+	out.Total = len(out.Criteria)
+	return nil, out, nil
+}
+
+func criteriaToOutput(criteria []types.CriterionResponse) []CriterionOutput {
+	out := make([]CriterionOutput, len(criteria))
+	for i, c := range criteria {
+		out[i] = CriterionOutput(c)
+	}
+	return out
+}
+
 // ticketResponseToOutput converts an SDK TicketResponse to an MCP TicketOutput.
 func ticketResponseToOutput(r *types.TicketResponse) TicketOutput {
 	return TicketOutput{
 		ID:            r.ID,
 		Title:         r.Title,
+		Type:          r.Type,
 		Body:          r.Body,
 		Repo:          r.Repo,
 		HasConclusion: r.HasConclusion,
 		References:    r.References,
+		BlockedBy:     r.BlockedBy,
+		Blocks:        r.Blocks,
 		Status:        r.Status,
 		Created:       r.Created,
 		Updated:       r.Updated,
 		Due:           r.Due,
+		Priority:      r.Priority,
+		Labels:        r.Labels,
+		Assignee:      r.Assignee,
+		Criteria:      criteriaToOutput(r.Criteria),
 	}
 }
 
@@ -81,6 +130,7 @@ func ticketResponseToMetadataOutput(r *types.TicketResponse) TicketMetadataOutpu
 	return TicketMetadataOutput{
 		ID:      r.ID,
 		Title:   r.Title,
+		Type:    r.Type,
 		Repo:    r.Repo,
 		Status:  r.Status,
 		Created: r.Created,
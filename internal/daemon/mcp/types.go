@@ -13,12 +13,13 @@ const (
 	SessionTypeArchitect SessionType = "architect"
 	SessionTypeTicket    SessionType = "ticket"
 	SessionTypeCollab    SessionType = "collab"
+	SessionTypeReviewer  SessionType = "reviewer"
 )
 
 // Session holds the current session context.
 type Session struct {
 	Type       SessionType
-	TicketID   string // Only set for ticket sessions
+	TicketID   string // Set for ticket sessions and the ticket a reviewer reviews
 	TicketType string // Only set for ticket sessions
 	CollabID   string // Only set for collab sessions
 	Repo       string // stable repo key from CORTEX_REPO for ticket sessions
@@ -52,8 +53,12 @@ type CollabConcludeOutput struct {
 
 // ListTicketsInput is the input for the listTickets tool.
 type ListTicketsInput struct {
-	Status string `json:"status" jsonschema:"Ticket status to filter by (required). Must be one of: backlog, progress, done"`
-	Query  string `json:"query,omitempty" jsonschema:"Optional search term to filter tickets by title/body (case-insensitive substring match)."`
+	Status     string   `json:"status" jsonschema:"Ticket status to filter by (required). Must be a status configured in cortex.yaml (backlog, progress, done by default)"`
+	Query      string   `json:"query,omitempty" jsonschema:"Optional search term to filter tickets by title/body (case-insensitive substring match)."`
+	Labels     []string `json:"labels,omitempty" jsonschema:"Only tickets carrying every one of these labels."`
+	Priorities []string `json:"priorities,omitempty" jsonschema:"Only tickets with one of these priorities (urgent, high, medium, low)."`
+	Assignee   string   `json:"assignee,omitempty" jsonschema:"Only tickets assigned to this person or agent variant."`
+	Sort       string   `json:"sort,omitempty" jsonschema:"Order: created (default, newest first), updated, priority (most urgent first) or due (soonest first)."`
 }
 
 // ReadTicketInput is the input for the readTicket tool.
@@ -61,21 +66,32 @@ type ReadTicketInput struct {
 	ID string `json:"id" jsonschema:"The ticket ID to read"`
 }
 
-// CreateWorkTicketInput is the input for the createWorkTicket tool.
-type CreateWorkTicketInput struct {
-	Title      string   `json:"title" jsonschema:"The ticket title (required)"`
-	Body       string   `json:"body,omitempty" jsonschema:"The ticket body/description"`
-	Repo       string   `json:"repo" jsonschema:"Stable repo key for this ticket (required). Must be a key from the configured repos map in cortex.yaml."`
-	DueDate    string   `json:"due_date,omitempty" jsonschema:"Optional due date in RFC3339 format (e.g., '2024-12-31T23:59:59Z')."`
-	References []string `json:"references,omitempty" jsonschema:"Ticket IDs to reference (plain ticket IDs only, no prefix scheme)"`
+// CreateTicketInput is the input for the createTicket tool.
+type CreateTicketInput struct {
+	Title      string            `json:"title" jsonschema:"The ticket title (required unless the template sets one)"`
+	Type       string            `json:"type,omitempty" jsonschema:"Ticket type from the types map in cortex.yaml (e.g. bug, spike). Defaults to work."`
+	Body       string            `json:"body,omitempty" jsonschema:"The ticket body/description"`
+	Repo       string            `json:"repo" jsonschema:"Stable repo key for this ticket (required unless the template sets one). Must be a key from the configured repos map in cortex.yaml."`
+	Repos      []string          `json:"repos,omitempty" jsonschema:"Further repo keys for a change spanning several repos. Spawning opens one agent per repo, and the ticket is done once every repo has concluded."`
+	DueDate    string            `json:"due_date,omitempty" jsonschema:"Optional due date in RFC3339 format (e.g., '2024-12-31T23:59:59Z')."`
+	References []string          `json:"references,omitempty" jsonschema:"Ticket IDs to reference (plain ticket IDs only, no prefix scheme)"`
+	BlockedBy  []string          `json:"blocked_by,omitempty" jsonschema:"Ticket IDs that must be done before this ticket can be spawned. Unknown IDs and dependency cycles are rejected."`
+	Blocks     []string          `json:"blocks,omitempty" jsonschema:"Ticket IDs that cannot be spawned until this ticket is done. Unknown IDs and dependency cycles are rejected."`
+	Priority   string            `json:"priority,omitempty" jsonschema:"Optional priority: urgent, high, medium or low."`
+	Labels     []string          `json:"labels,omitempty" jsonschema:"Optional free-form labels, without commas or whitespace."`
+	Assignee   string            `json:"assignee,omitempty" jsonschema:"Optional assignee: a person, or the name of an agent variant."`
+	Template   string            `json:"template,omitempty" jsonschema:"Ticket template name from listTemplates. Its title, body, type, repo, references and due date fill the fields left empty."`
+	Vars       map[string]string `json:"vars,omitempty" jsonschema:"Values for the template's variables. Every variable the template lists is required."`
 }
 
 // CreateFollowUpTicketInput is the input for the createFollowUpTicket tool.
 type CreateFollowUpTicketInput struct {
-	Title   string `json:"title" jsonschema:"The follow-up ticket title (required)"`
-	Body    string `json:"body,omitempty" jsonschema:"The ticket body/description"`
-	Repo    string `json:"repo" jsonschema:"Stable repo key for this ticket (required). Must be in the architect's configured repos map."`
-	DueDate string `json:"due_date,omitempty" jsonschema:"Optional due date in RFC3339 format (e.g., '2024-12-31T23:59:59Z')"`
+	Title    string            `json:"title" jsonschema:"The follow-up ticket title (required unless the template sets one)"`
+	Body     string            `json:"body,omitempty" jsonschema:"The ticket body/description"`
+	Repo     string            `json:"repo" jsonschema:"Stable repo key for this ticket (required unless the template sets one). Must be in the architect's configured repos map."`
+	DueDate  string            `json:"due_date,omitempty" jsonschema:"Optional due date in RFC3339 format (e.g., '2024-12-31T23:59:59Z')"`
+	Template string            `json:"template,omitempty" jsonschema:"Optional ticket template name. Its defaults fill the fields left empty."`
+	Vars     map[string]string `json:"vars,omitempty" jsonschema:"Values for the template's variables"`
 }
 
 // CreateFollowUpTicketOutput is the output for the createFollowUpTicket tool.
@@ -90,6 +106,11 @@ type UpdateTicketInput struct {
 	Body       *string   `json:"body,omitempty" jsonschema:"New body (optional)"`
 	DueDate    *string   `json:"dueDate,omitempty" jsonschema:"Optional RFC3339 due date. Set to an RFC3339 timestamp to update the due date, or to an empty string to clear it."`
 	References *[]string `json:"references,omitempty" jsonschema:"Ticket IDs to reference (optional, full replacement — plain ticket IDs only, no prefix scheme)"`
+	BlockedBy  *[]string `json:"blocked_by,omitempty" jsonschema:"Ticket IDs that block this ticket (optional, full replacement). Unknown IDs and dependency cycles are rejected."`
+	Blocks     *[]string `json:"blocks,omitempty" jsonschema:"Ticket IDs this ticket blocks (optional, full replacement). Unknown IDs and dependency cycles are rejected."`
+	Priority   *string   `json:"priority,omitempty" jsonschema:"New priority: urgent, high, medium or low, or an empty string to clear it (optional)."`
+	Labels     *[]string `json:"labels,omitempty" jsonschema:"Labels (optional, full replacement)."`
+	Assignee   *string   `json:"assignee,omitempty" jsonschema:"New assignee, a person or agent variant name, or an empty string to clear it (optional)."`
 }
 
 // EditTicketBodyInput is the input for the editTicketBody tool.
@@ -102,13 +123,14 @@ type EditTicketBodyInput struct {
 
 // DeleteTicketInput is the input for the deleteTicket tool.
 type DeleteTicketInput struct {
-	ID string `json:"id" jsonschema:"The ticket ID to delete"`
+	ID              string `json:"id" jsonschema:"The ticket ID to delete"`
+	CleanupWorktree bool   `json:"cleanup_worktree,omitempty" jsonschema:"Also remove the ticket's git worktree (repos with isolation: worktree). The branch is kept. Fails if the worktree has uncommitted changes."`
 }
 
 // MoveTicketInput is the input for the moveTicket tool.
 type MoveTicketInput struct {
 	ID     string `json:"id" jsonschema:"The ticket ID to move"`
-	Status string `json:"status" jsonschema:"Target status (backlog/progress/done)"`
+	Status string `json:"status" jsonschema:"Target status. Must be a status configured in cortex.yaml (backlog, progress, done by default)"`
 }
 
 // SpawnSessionInput is the input for the spawnSession tool.
@@ -116,6 +138,8 @@ type SpawnSessionInput struct {
 	TicketID string `json:"ticket_id" jsonschema:"The ticket ID to spawn a session for"`
 	Mode     string `json:"mode,omitempty" jsonschema:"Spawn mode: 'normal' (default), 'resume', or 'fresh'"`
 	Variant  string `json:"variant" jsonschema:"Agent variant name from the agents map in cortex.yaml (required). Use listVariants to see available names."`
+	Force    bool   `json:"force,omitempty" jsonschema:"Spawn even if the ticket is blocked by tickets that are not done yet. Defaults to false."`
+	Backend  string `json:"backend,omitempty" jsonschema:"Session backend: 'tmux' or 'headless'. Defaults to the architect's session_backend, else tmux."`
 }
 
 // ListVariantsInput is the input for the listVariants tool (no parameters needed).
@@ -126,24 +150,52 @@ type ListVariantsOutput struct {
 	Variants []string `json:"variants"`
 }
 
+// ListTemplatesInput is the input for the listTemplates tool (no parameters needed).
+type ListTemplatesInput struct{}
+
+// TemplateOutput describes a ticket template.
+type TemplateOutput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Repo        string   `json:"repo,omitempty"`
+	References  []string `json:"references,omitempty"`
+	Due         string   `json:"due,omitempty"`
+	Variables   []string `json:"variables"`
+	Body        string   `json:"body"`
+}
+
+// ListTemplatesOutput is the output for the listTemplates tool.
+type ListTemplatesOutput struct {
+	Templates []TemplateOutput `json:"templates"`
+}
+
 // ConcludeSessionInput is the input for the concludeSession tool.
 type ConcludeSessionInput struct {
 	Body            string   `json:"body" jsonschema:"Session conclusion summary — outcome, files changed, and follow-up work or blockers (required)."`
 	Commits         []string `json:"commits,omitempty" jsonschema:"List of commit SHAs produced during this session. Required for work ticket sessions unless rejected=true. Optional for collab sessions. Ignored for architect sessions."`
 	Rejected        bool     `json:"rejected,omitempty" jsonschema:"Set to true if the session produced no work and should be marked as rejected. Requires rejection_reason. Work ticket sessions only."`
 	RejectionReason string   `json:"rejection_reason,omitempty" jsonschema:"Required and non-empty when rejected=true. Explain why the session produced no commits. Work ticket sessions only."`
+	CleanupWorktree bool     `json:"cleanup_worktree,omitempty" jsonschema:"Remove this session's git worktree after concluding (repos with isolation: worktree). The branch is kept. Work ticket sessions only."`
 }
 
 // MCP-specific output types (structurally different from shared types)
 
 // TicketSummary is an enriched ticket representation for list views.
 type TicketSummary struct {
-	ID      string     `json:"id"`
-	Title   string     `json:"title"`
-	Repo    string     `json:"repo,omitempty"`
-	Due     *time.Time `json:"due,omitempty"`
-	Created time.Time  `json:"created"`
-	Updated time.Time  `json:"updated"`
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Type      string     `json:"type,omitempty"`
+	Repo      string     `json:"repo,omitempty"`
+	Due       *time.Time `json:"due,omitempty"`
+	Priority  string     `json:"priority,omitempty"`
+	Labels    []string   `json:"labels,omitempty"`
+	Assignee  string     `json:"assignee,omitempty"`
+	BlockedBy []string   `json:"blocked_by,omitempty"`
+	IsBlocked bool       `json:"is_blocked,omitempty"`
+	Created   time.Time  `json:"created"`
+	Updated   time.Time  `json:"updated"`
 }
 
 // SessionOutput represents a work session.
@@ -158,6 +210,7 @@ type SessionOutput struct {
 type TicketMetadataOutput struct {
 	ID      string    `json:"id"`
 	Title   string    `json:"title"`
+	Type    string    `json:"type,omitempty"`
 	Repo    string    `json:"repo,omitempty"`
 	Status  string    `json:"status"`
 	Created time.Time `json:"created"`
@@ -167,17 +220,46 @@ type TicketMetadataOutput struct {
 type TicketOutput struct {
 	ID            string            `json:"id"`
 	Title         string            `json:"title"`
+	Type          string            `json:"type,omitempty"`
 	Body          string            `json:"body"`
 	Repo          string            `json:"repo,omitempty"`
 	HasConclusion bool              `json:"has_conclusion"`
 	References    []string          `json:"references,omitempty"`
+	BlockedBy     []string          `json:"blocked_by,omitempty"`
+	Blocks        []string          `json:"blocks,omitempty"`
 	Status        string            `json:"status"`
 	Created       time.Time         `json:"created"`
 	Updated       time.Time         `json:"updated"`
 	Due           *time.Time        `json:"due,omitempty"`
+	Priority      string            `json:"priority,omitempty"`
+	Labels        []string          `json:"labels,omitempty"`
+	Assignee      string            `json:"assignee,omitempty"`
+	Criteria      []CriterionOutput `json:"criteria,omitempty"`
 	Conclusion    *ConclusionOutput `json:"conclusion,omitempty"`
 }
 
+// CriterionOutput is an acceptance criterion parsed from a ticket body.
+type CriterionOutput struct {
+	Index    int    `json:"index"`
+	Text     string `json:"text"`
+	Checked  bool   `json:"checked"`
+	Evidence string `json:"evidence,omitempty"`
+}
+
+// CheckCriterionInput is the input for the checkCriterion tool.
+type CheckCriterionInput struct {
+	Index    int    `json:"index" jsonschema:"1-based position of the criterion in the ticket's acceptance checklist (required)"`
+	Checked  *bool  `json:"checked,omitempty" jsonschema:"Whether the criterion is met. Defaults to true; pass false to uncheck."`
+	Evidence string `json:"evidence,omitempty" jsonschema:"How the criterion was verified: a test name, command output, commit or file. Recorded under the criterion in the ticket body."`
+}
+
+// CheckCriterionOutput is the output for the checkCriterion tool.
+type CheckCriterionOutput struct {
+	Criteria []CriterionOutput `json:"criteria"`
+	Done     int               `json:"done"`
+	Total    int               `json:"total"`
+}
+
 // Tool output wrappers
 
 // ListTicketsOutput is the output for the listTickets tool.
@@ -191,7 +273,7 @@ type ReadTicketOutput struct {
 	Ticket TicketOutput `json:"ticket"`
 }
 
-// CreateTicketOutput is the output for the createWorkTicket tool.
+// CreateTicketOutput is the output for the createTicket tool.
 type CreateTicketOutput struct {
 	Ticket TicketMetadataOutput `json:"ticket"`
 }
@@ -272,9 +354,49 @@ type ConclusionListItem struct {
 // ConclusionOutput is a full conclusion record including the body.
 type ConclusionOutput struct {
 	ID          string `json:"id"`
+	SessionID   string `json:"session_id,omitempty"`
 	Body        string `json:"body"`
 	StartedAt   string `json:"started_at"`
 	ConcludedAt string `json:"concluded_at"`
+	// Verifications are the runs of the repos' verify commands on the
+	// concluded commits.
+	Verifications []VerificationOutput `json:"verifications,omitempty"`
+}
+
+// VerificationOutput is a run of a repo's verify commands.
+type VerificationOutput struct {
+	Repo     string                `json:"repo"`
+	Commit   string                `json:"commit"`
+	Status   string                `json:"status"`
+	Error    string                `json:"error,omitempty"`
+	Commands []VerifyCommandOutput `json:"commands,omitempty"`
+}
+
+// VerifyCommandOutput is the outcome of one verify command.
+type VerifyCommandOutput struct {
+	Command  string `json:"command"`
+	ExitCode int    `json:"exit_code"`
+	TimedOut bool   `json:"timed_out,omitempty"`
+	Output   string `json:"output,omitempty"`
+}
+
+// ReadSessionTimelineInput is the input for the readSessionTimeline tool.
+type ReadSessionTimelineInput struct {
+	SessionID string `json:"session_id" jsonschema:"The session ID, e.g. from readConclusion"`
+}
+
+// TimelineEntryOutput is one recorded event of a session.
+type TimelineEntryOutput struct {
+	Time    string `json:"time"`
+	Status  string `json:"status,omitempty"`
+	Tool    string `json:"tool,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ReadSessionTimelineOutput is the output for the readSessionTimeline tool.
+type ReadSessionTimelineOutput struct {
+	SessionID string                `json:"session_id"`
+	Entries   []TimelineEntryOutput `json:"entries"`
 }
 
 // ListConclusionsOutput is the output for the listConclusions tool.
@@ -288,16 +410,126 @@ type ReadConclusionOutput struct {
 	Conclusion ConclusionOutput `json:"conclusion"`
 }
 
+// Review types
+
+// ReadReviewInput is the input for the readReview tool.
+type ReadReviewInput struct {
+	TicketID string `json:"ticket_id" jsonschema:"The ticket ID whose review to read"`
+}
+
+// AddReviewCommentInput is the input for the addReviewComment tool.
+type AddReviewCommentInput struct {
+	TicketID string `json:"ticket_id" jsonschema:"The ticket ID in review"`
+	File     string `json:"file" jsonschema:"Path of the changed file, relative to the repo root, as listed in the ticket's diffs (required)"`
+	Line     int    `json:"line,omitempty" jsonschema:"Line in the file after the change. Omit to comment on the whole file."`
+	Commit   string `json:"commit,omitempty" jsonschema:"SHA of the commit the comment refers to"`
+	Repo     string `json:"repo,omitempty" jsonschema:"Repo key of the file. Required for multi-repo tickets."`
+	Body     string `json:"body" jsonschema:"The comment (required)"`
+}
+
+// AcceptReviewInput is the input for the acceptReview tool.
+type AcceptReviewInput struct {
+	TicketID string `json:"ticket_id" jsonschema:"The ticket ID in review"`
+	Summary  string `json:"summary,omitempty" jsonschema:"Optional note recorded with the verdict"`
+}
+
+// RequestChangesInput is the input for the requestChanges tool.
+type RequestChangesInput struct {
+	TicketID string `json:"ticket_id" jsonschema:"The ticket ID in review"`
+	Summary  string `json:"summary,omitempty" jsonschema:"What the worker should change overall. Required when no comments were left this round."`
+	Variant  string `json:"variant,omitempty" jsonschema:"Agent variant for the worker. Defaults to the variant that concluded."`
+	Backend  string `json:"backend,omitempty" jsonschema:"Session backend: 'tmux' or 'headless'. Defaults to the architect's session_backend, else tmux."`
+}
+
+// SpawnReviewerInput is the input for the spawnReviewer tool.
+type SpawnReviewerInput struct {
+	TicketID string `json:"ticket_id" jsonschema:"The concluded ticket to review"`
+	Variant  string `json:"variant,omitempty" jsonschema:"Agent variant for the reviewer. Defaults to reviewer.variant in cortex.yaml."`
+}
+
+// SpawnReviewerOutput is the output for the spawnReviewer tool.
+type SpawnReviewerOutput struct {
+	Success    bool   `json:"success"`
+	TicketID   string `json:"ticket_id"`
+	Round      int    `json:"round"`
+	TmuxWindow string `json:"tmux_window,omitempty"`
+	Variant    string `json:"variant"`
+}
+
+// ReviewFindingInput is one finding of a reviewer agent.
+type ReviewFindingInput struct {
+	File   string `json:"file" jsonschema:"Path of the changed file, relative to the repo root, as listed in the diffs (required)"`
+	Line   int    `json:"line,omitempty" jsonschema:"Line in the file after the change. Omit for a finding about the whole file."`
+	Commit string `json:"commit,omitempty" jsonschema:"SHA of the commit the finding refers to"`
+	Repo   string `json:"repo,omitempty" jsonschema:"Repo key of the file. Required for multi-repo tickets."`
+	Body   string `json:"body" jsonschema:"The finding (required)"`
+}
+
+// SubmitReviewInput is the input for the submitReview tool.
+type SubmitReviewInput struct {
+	Verdict  string               `json:"verdict" jsonschema:"'approve' when the work can ship as is, 'request_changes' otherwise (required)"`
+	Summary  string               `json:"summary" jsonschema:"Short overall assessment (required)"`
+	Findings []ReviewFindingInput `json:"findings,omitempty" jsonschema:"One entry per issue found"`
+}
+
+// ReviewCommentOutput is one review comment.
+type ReviewCommentOutput struct {
+	ID      string `json:"id"`
+	Round   int    `json:"round"`
+	Repo    string `json:"repo,omitempty"`
+	Commit  string `json:"commit,omitempty"`
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Body    string `json:"body"`
+	Author  string `json:"author,omitempty"`
+	Created string `json:"created"`
+}
+
+// ReadReviewOutput is the output for the readReview tool.
+type ReadReviewOutput struct {
+	TicketID string `json:"ticket_id"`
+	State    string `json:"state,omitempty"`
+	Round    int    `json:"round"`
+	Summary  string `json:"summary,omitempty"`
+	// Verdict and VerdictSummary are what a reviewer agent submitted.
+	Verdict        string                `json:"verdict,omitempty"`
+	VerdictSummary string                `json:"verdict_summary,omitempty"`
+	Comments       []ReviewCommentOutput `json:"comments"`
+}
+
+// AddReviewCommentOutput is the output for the addReviewComment tool.
+type AddReviewCommentOutput struct {
+	Comment ReviewCommentOutput `json:"comment"`
+}
+
+// ReviewActionOutput is the output for the acceptReview, requestChanges
+// and submitReview tools.
+type ReviewActionOutput struct {
+	Success  bool   `json:"success"`
+	TicketID string `json:"ticket_id"`
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+}
+
 // SearchInput is the input for the search tool.
 type SearchInput struct {
-	Query string `json:"query" jsonschema:"Search term — case-insensitive substring matched against ticket title+body and conclusion body (required)."`
+	Query string `json:"query" jsonschema:"Search query. Free text is ranked by relevance; filters: repo:KEY, status:STATUS, type:ticket|conclusion|collab|note (or a ticket type), after:YYYY-MM-DD, before:YYYY-MM-DD, updated:FROM..TO (required)."`
 	Limit int    `json:"limit,omitempty" jsonschema:"Max results to return (default 25)."`
 }
 
-// SearchResultItem is one entry in search results — either a ticket (with optional nested conclusion) or a bare ticketless conclusion.
+// SearchResultItem is one ranked search hit.
 type SearchResultItem struct {
-	Ticket     *TicketOutput     `json:"ticket,omitempty"`
-	Conclusion *ConclusionOutput `json:"conclusion,omitempty"`
+	Kind     string  `json:"kind"`
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`
+	Snippet  string  `json:"snippet"`
+	Score    float64 `json:"score,omitempty"`
+	Repo     string  `json:"repo,omitempty"`
+	Status   string  `json:"status,omitempty"`
+	Type     string  `json:"type,omitempty"`
+	TicketID string  `json:"ticket_id,omitempty"`
+	Path     string  `json:"path"`
+	Updated  string  `json:"updated"`
 }
 
 // SearchOutput is the output for the search tool.
@@ -310,10 +542,31 @@ type SearchOutput struct {
 // to the MCP-specific TicketSummary with enriched fields.
 func ticketSummaryResponseToMCP(s *types.TicketSummary) TicketSummary {
 	return TicketSummary{
-		ID:      s.ID,
-		Title:   s.Title,
-		Due:     s.Due,
-		Created: s.Created,
-		Updated: s.Updated,
+		ID:        s.ID,
+		Title:     s.Title,
+		Type:      s.Type,
+		Due:       s.Due,
+		Priority:  s.Priority,
+		Labels:    s.Labels,
+		Assignee:  s.Assignee,
+		BlockedBy: s.BlockedBy,
+		IsBlocked: s.IsBlocked,
+		Created:   s.Created,
+		Updated:   s.Updated,
+	}
+}
+
+// reviewCommentToMCP maps a shared review comment to its MCP output.
+func reviewCommentToMCP(c types.ReviewCommentResponse) ReviewCommentOutput {
+	return ReviewCommentOutput{
+		ID:      c.ID,
+		Round:   c.Round,
+		Repo:    c.Repo,
+		Commit:  c.Commit,
+		File:    c.File,
+		Line:    c.Line,
+		Body:    c.Body,
+		Author:  c.Author,
+		Created: c.Created.Format(time.RFC3339),
 	}
 }
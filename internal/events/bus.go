@@ -3,6 +3,7 @@ package events
 import (
 	"log/slog"
 	"sync"
+	"time"
 )
 
 // EventType represents the type of event emitted by the system.
@@ -13,29 +14,79 @@ const (
 	TicketUpdated     EventType = "ticket_updated"
 	TicketDeleted     EventType = "ticket_deleted"
 	TicketMoved       EventType = "ticket_moved"
+	TicketUnblocked   EventType = "ticket_unblocked"
 	SessionStarted    EventType = "session_started"
 	SessionEnded      EventType = "session_ended"
 	SessionStatus     EventType = "session_status"
 	ConclusionCreated EventType = "conclusion_created"
+
+	// ReviewUpdated reports a comment or verdict on a ticket's review. The
+	// payload holds the review state.
+	ReviewUpdated EventType = "review_updated"
+
+	// VerificationFinished reports the outcome of running a repo's verify
+	// commands against a conclusion's commits. The payload holds the repo,
+	// status and commit.
+	VerificationFinished EventType = "verification_finished"
+
+	// PipelineAction reports what a cortex.yaml pipeline rule did in
+	// response to another event. The payload holds the rule, action,
+	// result and a message.
+	PipelineAction EventType = "pipeline_action"
+
+	// ScheduleRun reports a recurring schedule creating its ticket. The
+	// event's ticket is the one created; the payload holds the schedule,
+	// result and a message, and the spawn result for auto-spawned
+	// schedules.
+	ScheduleRun EventType = "schedule_run"
+
+	// TicketDueSoon and TicketOverdue report an open ticket coming within
+	// the reminders window of its due date, and passing it. Each is
+	// emitted once per due date; the payload holds the title and due date.
+	TicketDueSoon EventType = "ticket_due_soon"
+	TicketOverdue EventType = "ticket_overdue"
+
+	// SpawnQueued and SpawnDequeued report ticket spawns held back by a
+	// concurrency limit, and their removal from the queue. A dequeued
+	// spawn's payload holds its result and a message.
+	SpawnQueued   EventType = "spawn_queued"
+	SpawnDequeued EventType = "spawn_dequeued"
+
+	// ResyncRequired is delivered instead of further events when a
+	// subscriber falls behind. Consumers should reload state (or replay
+	// from the journal) before relying on later events.
+	ResyncRequired EventType = "resync_required"
 )
 
 // Event represents a change in the system.
 type Event struct {
+	// ID is assigned from the architect's journal and increases
+	// monotonically. Zero when the bus has no journal.
+	ID            uint64    `json:"id,omitempty"`
 	Type          EventType `json:"type"`
 	ArchitectPath string    `json:"architect_path"`
 	TicketID      string    `json:"ticket_id"`
 	SessionID     string    `json:"session_id,omitempty"`
 	Payload       any       `json:"payload,omitempty"`
+	Time          time.Time `json:"time,omitzero"`
 }
 
 // Bus is an in-process pub/sub event bus keyed by architect path.
 type Bus struct {
 	mu          sync.RWMutex
 	subscribers map[string]map[*subscriber]struct{}
+
+	// emitMu serializes Emit so journal IDs reach subscribers in order.
+	emitMu  sync.Mutex
+	journal *Journal
 }
 
 type subscriber struct {
 	ch chan Event
+	// overflowed is set once a resync event has been queued; further
+	// events are dropped until the consumer drains its buffer.
+	// Guarded by Bus.emitMu.
+	overflowed bool
 }
 
 // NewBus creates a new event bus.
@@ -45,9 +96,38 @@ func NewBus() *Bus {
 	}
 }
 
+// NewBusWithJournal creates an event bus that journals every event with an
+// architect path, assigning its ID before delivering it.
+func NewBusWithJournal(journal *Journal) *Bus {
+	b := NewBus()
+	b.journal = journal
+	return b
+}
+
+// Journal returns the bus journal, or nil when events are not persisted.
+func (b *Bus) Journal() *Journal {
+	return b.journal
+}
+
 // Emit sends an event to all subscribers for the event's architect path.
-// Non-blocking: slow consumers will miss events.
+// Non-blocking: a subscriber whose buffer fills up receives a single
+// ResyncRequired event and misses events until it catches up.
 func (b *Bus) Emit(e Event) {
+	b.emitMu.Lock()
+	defer b.emitMu.Unlock()
+
+	// Queue assigns the ID and leaves the disk write to the journal's
+	// writer, so emitters do not wait on it.
+	if b.journal != nil && e.ArchitectPath != "" {
+		if err := b.journal.Queue(&e); err != nil {
+			slog.Warn("failed to journal event",
+				"type", string(e.Type),
+				"architect", e.ArchitectPath,
+				"error", err,
+			)
+		}
+	}
+
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
@@ -58,7 +138,6 @@ func (b *Bus) Emit(e Event) {
 			allSubs[sub] = struct{}{}
 		}
 	}
-
 	if e.ArchitectPath != "" {
 		if subs, ok := b.subscribers[""]; ok {
 			for sub := range subs {
@@ -68,15 +147,37 @@ func (b *Bus) Emit(e Event) {
 	}
 
 	for sub := range allSubs {
+		sub.deliver(e)
+	}
+}
+
+// deliver queues e for the subscriber, keeping the last buffer slot for a
+// ResyncRequired event. Callers must hold Bus.emitMu.
+func (sub *subscriber) deliver(e Event) {
+	if sub.overflowed {
+		if len(sub.ch) > 0 {
+			return
+		}
+		sub.overflowed = false
+	}
+
+	if len(sub.ch) < cap(sub.ch)-1 {
 		select {
 		case sub.ch <- e:
+			return
 		default:
-			slog.Warn("event dropped: subscriber buffer full",
-				"type", string(e.Type),
-				"architect", e.ArchitectPath,
-			)
 		}
 	}
+
+	sub.overflowed = true
+	select {
+	case sub.ch <- Event{Type: ResyncRequired, ArchitectPath: e.ArchitectPath, Time: time.Now().UTC()}:
+	default:
+	}
+	slog.Warn("event subscriber overflowed: resync required",
+		"type", string(e.Type),
+		"architect", e.ArchitectPath,
+	)
 }
 
 // Subscribe registers a listener for events on the given architect path.
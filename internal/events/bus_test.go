@@ -151,3 +151,52 @@ func TestConcurrentSafety(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestSlowConsumerGetsResyncEvent(t *testing.T) {
+	bus := NewBus()
+	ch, unsub := bus.Subscribe("/project")
+	defer unsub()
+
+	for range 100 {
+		bus.Emit(Event{Type: TicketCreated, ArchitectPath: "/project", TicketID: "t1"})
+	}
+
+	var last Event
+	for range cap(ch) {
+		last = <-ch
+	}
+	if last.Type != ResyncRequired {
+		t.Fatalf("last buffered event = %q, want %q", last.Type, ResyncRequired)
+	}
+
+	// Once drained, delivery resumes.
+	bus.Emit(Event{Type: TicketUpdated, ArchitectPath: "/project", TicketID: "t2"})
+	select {
+	case e := <-ch:
+		if e.Type != TicketUpdated {
+			t.Errorf("type = %q, want %q", e.Type, TicketUpdated)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event after resync")
+	}
+}
+
+func TestEmitWithJournalAssignsIDs(t *testing.T) {
+	dir := t.TempDir()
+	bus := NewBusWithJournal(NewJournal())
+	ch, unsub := bus.Subscribe(dir)
+	defer unsub()
+
+	bus.Emit(Event{Type: TicketCreated, ArchitectPath: dir, TicketID: "t1"})
+	bus.Emit(Event{Type: TicketMoved, ArchitectPath: dir, TicketID: "t1"})
+
+	for want := uint64(1); want <= 2; want++ {
+		e := <-ch
+		if e.ID != want {
+			t.Errorf("ID = %d, want %d", e.ID, want)
+		}
+		if e.Time.IsZero() {
+			t.Error("expected journaled event to carry a timestamp")
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+// journalFileName is the per-architect event journal, one JSON event per line.
+const journalFileName = ".events.jsonl"
+
+// maxJournalEvents bounds the journal size. When exceeded, the oldest half is
+// dropped; replays older than the retained window report a gap.
+const maxJournalEvents = 10000
+
+// JournalPath returns the event journal path for an architect.
+func JournalPath(architectPath string) string {
+	return filepath.Join(architectPath, journalFileName)
+}
+
+// journalQueueSize bounds the appends waiting for the writer. Emitters
+// block only once this many are pending.
+const journalQueueSize = 1024
+
+type journalState struct {
+	loaded  bool
+	firstID uint64 // oldest retained ID, 0 when empty
+	lastID  uint64 // last ID assigned, possibly not yet written
+	count   int
+	// torn is set when the file ends mid-line (a crash during a write);
+	// the next append starts on a fresh line.
+	torn bool
+
+	// index holds the file offset of every retained event, oldest first,
+	// so replays seek to their first event instead of reading the whole
+	// journal. size is the file's length.
+	index []journalOffset
+	size  int64
+
+	// fileMu is held by trim while it rewrites the file, which moves
+	// every offset, and by replays while they read.
+	fileMu sync.RWMutex
+}
+
+type journalOffset struct {
+	id     uint64
+	offset int64
+}
+
+// journalWrite is a line queued for the writer. A write without a line
+// only signals done once the writes queued before it are on disk.
+type journalWrite struct {
+	architectPath string
+	id            uint64
+	line          []byte
+	done          chan error
+}
+
+// Journal persists events per architect in an append-only file and assigns
+// them monotonically increasing IDs. IDs are assigned as events are queued;
+// a single writer goroutine appends them to the files in that order.
+type Journal struct {
+	mu     sync.Mutex // guards states
+	states map[string]*journalState
+
+	// queueMu keeps queued writes in ID order.
+	queueMu sync.Mutex
+	writes  chan journalWrite
+	start   sync.Once
+}
+
+// NewJournal creates a new Journal.
+func NewJournal() *Journal {
+	return &Journal{
+		states: make(map[string]*journalState),
+		writes: make(chan journalWrite, journalQueueSize),
+	}
+}
+
+// Append assigns the next ID and timestamp to e and appends it to the
+// architect's journal, returning once it is written.
+func (j *Journal) Append(e *Event) error {
+	done := make(chan error, 1)
+	if err := j.queue(e, done); err != nil {
+		return err
+	}
+	return <-done
+}
+
+// Queue assigns the next ID and timestamp to e and queues it for the
+// writer without waiting for the disk. Write failures are logged.
+func (j *Journal) Queue(e *Event) error {
+	return j.queue(e, nil)
+}
+
+func (j *Journal) queue(e *Event, done chan error) error {
+	if e.ArchitectPath == "" {
+		return fmt.Errorf("event has no architect path")
+	}
+	j.start.Do(func() { go j.writeLoop() })
+
+	j.queueMu.Lock()
+	defer j.queueMu.Unlock()
+
+	j.mu.Lock()
+	st, err := j.state(e.ArchitectPath)
+	if err != nil {
+		j.mu.Unlock()
+		return err
+	}
+	id := st.lastID + 1
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+	e.ID = id
+	line, err := json.Marshal(e)
+	if err != nil {
+		e.ID = 0
+		j.mu.Unlock()
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	st.lastID = id
+	if st.firstID == 0 {
+		st.firstID = id
+	}
+	j.mu.Unlock()
+
+	j.writes <- journalWrite{architectPath: e.ArchitectPath, id: id, line: line, done: done}
+	return nil
+}
+
+// Flush waits until every event queued so far is written.
+func (j *Journal) Flush() {
+	j.start.Do(func() { go j.writeLoop() })
+	done := make(chan error, 1)
+	j.queueMu.Lock()
+	j.writes <- journalWrite{done: done}
+	j.queueMu.Unlock()
+	<-done
+}
+
+// writeLoop appends queued lines to their journals, in queue order.
+func (j *Journal) writeLoop() {
+	for w := range j.writes {
+		var err error
+		if w.line != nil {
+			err = j.write(w)
+			if err != nil && w.done == nil {
+				slog.Warn("failed to journal event", "architect", w.architectPath, "id", w.id, "error", err)
+			}
+		}
+		if w.done != nil {
+			w.done <- err
+		}
+	}
+}
+
+// write appends a queued line to its journal and indexes it, trimming the
+// journal once it grows past maxJournalEvents.
+func (j *Journal) write(w journalWrite) error {
+	path := JournalPath(w.architectPath)
+
+	j.mu.Lock()
+	st := j.states[w.architectPath]
+	torn := st.torn
+	j.mu.Unlock()
+
+	line := append(w.line, '\n')
+	if torn {
+		line = append([]byte{'\n'}, line...)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("open event journal: %w", err)
+	}
+	_, err = f.Write(line)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	j.mu.Lock()
+	if err != nil {
+		// Part of the line may have landed; re-read the file's end.
+		st.torn = endsMidLine(path)
+		st.size = fileSize(path)
+		j.mu.Unlock()
+		return fmt.Errorf("write event journal: %w", err)
+	}
+	offset := st.size
+	if torn {
+		offset++
+	}
+	st.index = append(st.index, journalOffset{id: w.id, offset: offset})
+	st.size += int64(len(line))
+	st.torn = false
+	st.count++
+	over := st.count > maxJournalEvents
+	j.mu.Unlock()
+
+	if over {
+		return j.trim(w.architectPath, st)
+	}
+	return nil
+}
+
+// Since returns the journaled events with IDs greater than since, oldest
+// first, up to limit (zero means no limit). complete is false when events
+// after since have already been trimmed from the journal.
+func (j *Journal) Since(architectPath string, since uint64, limit int) (events []Event, complete bool, err error) {
+	j.Flush()
+
+	j.mu.Lock()
+	st, err := j.state(architectPath)
+	j.mu.Unlock()
+	if err != nil {
+		return nil, false, err
+	}
+
+	st.fileMu.RLock()
+	defer st.fileMu.RUnlock()
+
+	j.mu.Lock()
+	complete = st.firstID == 0 || since+1 >= st.firstID
+	i := sort.Search(len(st.index), func(i int) bool { return st.index[i].id > since })
+	var offset int64
+	if i < len(st.index) {
+		offset = st.index[i].offset
+	}
+	found := i < len(st.index)
+	j.mu.Unlock()
+	if !found {
+		return nil, complete, nil
+	}
+
+	f, err := os.Open(JournalPath(architectPath))
+	if err != nil {
+		return nil, false, fmt.Errorf("open event journal: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, false, fmt.Errorf("seek event journal: %w", err)
+	}
+	err = scanJournal(f, offset, func(e Event, _ int64) bool {
+		if e.ID <= since {
+			return true
+		}
+		events = append(events, e)
+		return limit == 0 || len(events) < limit
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return events, complete, nil
+}
+
+// LastID returns the most recent event ID for an architect, or 0.
+func (j *Journal) LastID(architectPath string) (uint64, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	st, err := j.state(architectPath)
+	if err != nil {
+		return 0, err
+	}
+	return st.lastID, nil
+}
+
+// state returns the cached journal state, loading it from disk on first use.
+// Callers must hold j.mu.
+func (j *Journal) state(architectPath string) (*journalState, error) {
+	st, ok := j.states[architectPath]
+	if ok && st.loaded {
+		return st, nil
+	}
+
+	path := JournalPath(architectPath)
+	st = &journalState{loaded: true, torn: endsMidLine(path), size: fileSize(path)}
+	f, err := os.Open(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("open event journal: %w", err)
+	}
+	if err == nil {
+		defer func() { _ = f.Close() }()
+		err = scanJournal(f, 0, func(e Event, offset int64) bool {
+			st.index = append(st.index, journalOffset{id: e.ID, offset: offset})
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	st.count = len(st.index)
+	if st.count > 0 {
+		st.firstID = st.index[0].id
+		st.lastID = st.index[st.count-1].id
+	}
+	j.states[architectPath] = st
+	return st, nil
+}
+
+// trim rewrites the journal keeping the newest half of maxJournalEvents.
+// Only the writer calls it.
+func (j *Journal) trim(architectPath string, st *journalState) error {
+	st.fileMu.Lock()
+	defer st.fileMu.Unlock()
+
+	path := JournalPath(architectPath)
+	all, err := readJournal(path)
+	if err != nil {
+		return err
+	}
+	keep := all[max(0, len(all)-maxJournalEvents/2):]
+
+	var data []byte
+	index := make([]journalOffset, 0, len(keep))
+	for _, e := range keep {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		index = append(index, journalOffset{id: e.ID, offset: int64(len(data))})
+		data = append(append(data, line...), '\n')
+	}
+	if err := storage.AtomicWriteFile(path, data); err != nil {
+		return fmt.Errorf("trim event journal: %w", err)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	st.index = index
+	st.size = int64(len(data))
+	st.count = len(keep)
+	st.firstID = 0
+	if len(keep) > 0 {
+		st.firstID = keep[0].ID
+	}
+	return nil
+}
+
+// endsMidLine reports whether a non-empty file lacks a trailing newline.
+func endsMidLine(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil || info.Size() == 0 {
+		return false
+	}
+	last := make([]byte, 1)
+	if _, err := f.ReadAt(last, info.Size()-1); err != nil {
+		return false
+	}
+	return last[0] != '\n'
+}
+
+// fileSize returns the size of the file at path, or 0.
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// readJournal reads every event in a journal file. A missing file is empty.
+func readJournal(path string) ([]Event, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open event journal: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var all []Event
+	err = scanJournal(f, 0, func(e Event, _ int64) bool {
+		all = append(all, e)
+		return true
+	})
+	return all, err
+}
+
+// scanJournal calls fn with each event read from r and its offset, given
+// that r starts at offset start, until fn returns false. Unparseable lines
+// (e.g. a torn final write) are skipped.
+func scanJournal(r io.Reader, start int64, fn func(e Event, offset int64) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	offset := start
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		lineOffset := offset
+		offset += int64(len(line)) + 1
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil || e.ID == 0 {
+			continue
+		}
+		if !fn(e, lineOffset) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read event journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package events
+
+import (
+	"os"
+	"testing"
+)
+
+func TestJournalAppendAndSince(t *testing.T) {
+	dir := t.TempDir()
+	j := NewJournal()
+
+	for _, typ := range []EventType{TicketCreated, TicketUpdated, TicketMoved} {
+		e := Event{Type: typ, ArchitectPath: dir, TicketID: "t1"}
+		if err := j.Append(&e); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+	}
+
+	got, complete, err := j.Since(dir, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !complete {
+		t.Error("expected complete replay")
+	}
+	if len(got) != 2 || got[0].ID != 2 || got[1].Type != TicketMoved {
+		t.Fatalf("unexpected events: %+v", got)
+	}
+
+	limited, _, _ := j.Since(dir, 0, 1)
+	if len(limited) != 1 || limited[0].ID != 1 {
+		t.Errorf("limit not applied: %+v", limited)
+	}
+}
+
+func TestJournalResumesIDsAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	e := Event{Type: TicketCreated, ArchitectPath: dir}
+	if err := NewJournal().Append(&e); err != nil {
+		t.Fatal(err)
+	}
+
+	// A fresh journal (daemon restart) continues from the file.
+	next := Event{Type: TicketUpdated, ArchitectPath: dir}
+	if err := NewJournal().Append(&next); err != nil {
+		t.Fatal(err)
+	}
+	if next.ID != 2 {
+		t.Errorf("ID after restart = %d, want 2", next.ID)
+	}
+}
+
+func TestJournalTrimReportsGap(t *testing.T) {
+	dir := t.TempDir()
+	j := NewJournal()
+
+	for range maxJournalEvents + 1 {
+		e := Event{Type: SessionStatus, ArchitectPath: dir}
+		if err := j.Append(&e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := readJournal(JournalPath(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != maxJournalEvents/2 {
+		t.Errorf("retained %d events, want %d", len(all), maxJournalEvents/2)
+	}
+
+	if _, complete, _ := j.Since(dir, 1, 0); complete {
+		t.Error("expected replay from a trimmed ID to be incomplete")
+	}
+	last, _ := j.LastID(dir)
+	if _, complete, _ := j.Since(dir, last-1, 0); !complete {
+		t.Error("expected recent replay to be complete")
+	}
+}
+
+func TestJournalSkipsTornLines(t *testing.T) {
+	dir := t.TempDir()
+	data := `{"id":1,"type":"ticket_created","architect_path":"x","ticket_id":"a"}` + "\n" + `{"id":2,"ty`
+	if err := os.WriteFile(JournalPath(dir), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	e := Event{Type: TicketUpdated, ArchitectPath: dir}
+	if err := NewJournal().Append(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != 2 {
+		t.Errorf("ID = %d, want 2", e.ID)
+	}
+
+	got, _, err := NewJournal().Since(dir, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Type != TicketUpdated {
+		t.Errorf("event appended after a torn line was lost: %+v", got)
+	}
+}
+
+func TestJournalQueueAndIndexedSince(t *testing.T) {
+	dir := t.TempDir()
+	j := NewJournal()
+
+	for range maxJournalEvents + 10 {
+		e := Event{Type: SessionStatus, ArchitectPath: dir}
+		if err := j.Queue(&e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	last, _ := j.LastID(dir)
+	if last != maxJournalEvents+10 {
+		t.Fatalf("LastID = %d, want %d", last, maxJournalEvents+10)
+	}
+
+	// Since waits for the queued writes, then seeks past the trimmed head.
+	got, complete, err := j.Since(dir, last-3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !complete || len(got) != 2 || got[0].ID != last-2 || got[1].ID != last-1 {
+		t.Fatalf("unexpected replay: complete=%v %+v", complete, got)
+	}
+
+	// A restarted journal rebuilds the index from the file.
+	got, _, err = NewJournal().Since(dir, last-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != last {
+		t.Errorf("unexpected replay after restart: %+v", got)
+	}
+}
@@ -0,0 +1,275 @@
+// Package headless runs agent sessions as supervised child processes
+// instead of tmux windows, for hosts without a terminal multiplexer such as
+// CI runners and servers under systemd.
+//
+// A Manager mirrors the window-oriented API of tmux.Manager: a session is
+// addressed by (session, window) name, its output goes to a per-session log
+// file, and a pid file lets a restarted daemon still check and kill it.
+package headless
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// killGracePeriod is how long KillWindow waits after SIGTERM before it
+// sends SIGKILL.
+const killGracePeriod = 5 * time.Second
+
+// NotRunningError indicates no headless process runs under the given name.
+type NotRunningError struct {
+	Session string
+	Window  string
+}
+
+func (e *NotRunningError) Error() string {
+	return fmt.Sprintf("headless session not running: %s:%s", e.Session, e.Window)
+}
+
+// IsNotRunning returns true if err is a NotRunningError.
+func IsNotRunning(err error) bool {
+	var e *NotRunningError
+	return errors.As(err, &e)
+}
+
+// process is a child started by this Manager.
+type process struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	done  chan struct{}
+}
+
+// Manager starts and tracks headless agent processes.
+type Manager struct {
+	logDir string
+
+	mu    sync.Mutex
+	procs map[string]*process
+}
+
+// NewManager creates a Manager that keeps logs and pid files under logDir.
+func NewManager(logDir string) *Manager {
+	return &Manager{
+		logDir: logDir,
+		procs:  make(map[string]*process),
+	}
+}
+
+// LogPath returns the file a session's stdout and stderr are written to.
+func (m *Manager) LogPath(session, windowName string) string {
+	return filepath.Join(m.logDir, safeName(session), safeName(windowName)+".log")
+}
+
+func (m *Manager) pidPath(session, windowName string) string {
+	return filepath.Join(m.logDir, safeName(session), safeName(windowName)+".pid")
+}
+
+// SpawnAgent runs agentCommand with bash in workingDir. Headless sessions
+// have no companion pane, so companionCommand is ignored. The returned
+// index is always 0.
+func (m *Manager) SpawnAgent(session, windowName, agentCommand, companionCommand, workingDir, companionWorkingDir string) (int, error) {
+	return 0, m.start(session, windowName, agentCommand, workingDir)
+}
+
+// SpawnArchitect runs an architect agent the same way as SpawnAgent.
+func (m *Manager) SpawnArchitect(session, windowName, agentCommand, companionCommand, workingDir, companionWorkingDir string) error {
+	return m.start(session, windowName, agentCommand, workingDir)
+}
+
+func (m *Manager) start(session, windowName, command, workingDir string) error {
+	if exists, err := m.WindowExists(session, windowName); err != nil {
+		return err
+	} else if exists {
+		return fmt.Errorf("headless session already running: %s:%s", session, windowName)
+	}
+
+	logPath := m.LogPath(session, windowName)
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return fmt.Errorf("create log directory: %w", err)
+	}
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("open session log: %w", err)
+	}
+	_, _ = fmt.Fprintf(logFile, "--- %s started %s ---\n", windowName, time.Now().UTC().Format(time.RFC3339))
+
+	cmd := exec.Command("bash", "-c", command)
+	cmd.Dir = workingDir
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	setProcessGroup(cmd)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		_ = logFile.Close()
+		return fmt.Errorf("open stdin: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		_ = logFile.Close()
+		return fmt.Errorf("start agent: %w", err)
+	}
+
+	pidPath := m.pidPath(session, windowName)
+	if err := os.WriteFile(pidPath, []byte(strconv.Itoa(cmd.Process.Pid)), 0644); err != nil {
+		_ = killProcessGroup(cmd.Process.Pid, true)
+		_ = logFile.Close()
+		return fmt.Errorf("write pid file: %w", err)
+	}
+
+	p := &process{cmd: cmd, stdin: stdin, done: make(chan struct{})}
+	key := procKey(session, windowName)
+	m.mu.Lock()
+	m.procs[key] = p
+	m.mu.Unlock()
+
+	go func() {
+		err := cmd.Wait()
+		if err != nil {
+			_, _ = fmt.Fprintf(logFile, "--- %s exited: %v ---\n", windowName, err)
+		} else {
+			_, _ = fmt.Fprintf(logFile, "--- %s exited ---\n", windowName)
+		}
+		_ = logFile.Close()
+		_ = os.Remove(pidPath)
+
+		m.mu.Lock()
+		if m.procs[key] == p {
+			delete(m.procs, key)
+		}
+		m.mu.Unlock()
+		close(p.done)
+	}()
+	return nil
+}
+
+// WindowExists reports whether the session's process is still running,
+// including processes started before a daemon restart.
+func (m *Manager) WindowExists(session, windowName string) (bool, error) {
+	m.mu.Lock()
+	_, ok := m.procs[procKey(session, windowName)]
+	m.mu.Unlock()
+	if ok {
+		return true, nil
+	}
+
+	pid, err := m.readPid(session, windowName)
+	if err != nil || pid == 0 {
+		return false, err
+	}
+	if processAlive(pid) {
+		return true, nil
+	}
+	_ = os.Remove(m.pidPath(session, windowName))
+	return false, nil
+}
+
+// KillWindow terminates the session's process group, escalating to
+// SIGKILL if it is still running after a grace period.
+func (m *Manager) KillWindow(session, windowName string) error {
+	m.mu.Lock()
+	p, ok := m.procs[procKey(session, windowName)]
+	m.mu.Unlock()
+
+	if ok {
+		if err := killProcessGroup(p.cmd.Process.Pid, false); err != nil {
+			return err
+		}
+		select {
+		case <-p.done:
+			return nil
+		case <-time.After(killGracePeriod):
+			return killProcessGroup(p.cmd.Process.Pid, true)
+		}
+	}
+
+	pid, err := m.readPid(session, windowName)
+	if err != nil {
+		return err
+	}
+	if pid == 0 || !processAlive(pid) {
+		return &NotRunningError{Session: session, Window: windowName}
+	}
+	defer func() { _ = os.Remove(m.pidPath(session, windowName)) }()
+	return killProcessGroup(pid, false)
+}
+
+// SendInput writes a line of text to the agent's stdin. Processes started
+// before a daemon restart have no attached stdin and return an error.
+func (m *Manager) SendInput(session, windowName, text string) error {
+	m.mu.Lock()
+	p, ok := m.procs[procKey(session, windowName)]
+	m.mu.Unlock()
+	if !ok {
+		if exists, _ := m.WindowExists(session, windowName); exists {
+			return fmt.Errorf("stdin of %s:%s is not attached (started before the daemon restarted)", session, windowName)
+		}
+		return &NotRunningError{Session: session, Window: windowName}
+	}
+
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	if _, err := io.WriteString(p.stdin, text); err != nil {
+		return fmt.Errorf("write to agent stdin: %w", err)
+	}
+	return nil
+}
+
+// ReadLog returns the last n lines of a session's log, or the whole log
+// when n <= 0.
+func (m *Manager) ReadLog(session, windowName string, n int) (string, error) {
+	data, err := os.ReadFile(m.LogPath(session, windowName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", &NotRunningError{Session: session, Window: windowName}
+		}
+		return "", fmt.Errorf("read session log: %w", err)
+	}
+	if n <= 0 {
+		return string(data), nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, ""), nil
+}
+
+// readPid returns the recorded pid of a session, or 0 if there is none.
+func (m *Manager) readPid(session, windowName string) (int, error) {
+	data, err := os.ReadFile(m.pidPath(session, windowName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("read pid file: %w", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, nil
+	}
+	return pid, nil
+}
+
+func procKey(session, windowName string) string {
+	return session + "\x00" + windowName
+}
+
+// safeName keeps a session or window name usable as a single path element.
+func safeName(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_", "\x00", "_").Replace(name)
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return name
+}
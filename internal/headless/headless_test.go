@@ -0,0 +1,84 @@
+package headless
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitFor polls cond until it holds or a second has passed.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestManager_SpawnInputAndExit(t *testing.T) {
+	m := NewManager(t.TempDir())
+
+	// The agent echoes one line of input, then exits.
+	if _, err := m.SpawnAgent("proj", "ticket-1", `read line; echo "got $line"; echo oops >&2`, "lazygit", t.TempDir(), ""); err != nil {
+		t.Fatalf("SpawnAgent: %v", err)
+	}
+	if exists, err := m.WindowExists("proj", "ticket-1"); err != nil || !exists {
+		t.Fatalf("WindowExists = %v, %v; want true", exists, err)
+	}
+	if _, err := m.SpawnAgent("proj", "ticket-1", "true", "", t.TempDir(), ""); err == nil {
+		t.Error("expected error spawning a running session twice")
+	}
+
+	if err := m.SendInput("proj", "ticket-1", "hello"); err != nil {
+		t.Fatalf("SendInput: %v", err)
+	}
+	waitFor(t, "process exit", func() bool {
+		exists, _ := m.WindowExists("proj", "ticket-1")
+		return !exists
+	})
+
+	data, err := os.ReadFile(m.LogPath("proj", "ticket-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"got hello", "oops", "ticket-1 exited"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("log missing %q:\n%s", want, data)
+		}
+	}
+	if tail, err := m.ReadLog("proj", "ticket-1", 1); err != nil || !strings.Contains(tail, "ticket-1 exited") || strings.Contains(tail, "got hello") {
+		t.Errorf("ReadLog(1) = %q, %v; want only the exit line", tail, err)
+	}
+	if err := m.SendInput("proj", "ticket-1", "again"); !IsNotRunning(err) {
+		t.Errorf("SendInput after exit = %v, want NotRunningError", err)
+	}
+}
+
+func TestManager_KillWindow(t *testing.T) {
+	dir := t.TempDir()
+	m := NewManager(dir)
+
+	if _, err := m.SpawnAgent("proj", "ticket-2", "sleep 30", "", t.TempDir(), ""); err != nil {
+		t.Fatalf("SpawnAgent: %v", err)
+	}
+
+	// A manager created after a daemon restart still sees the process.
+	restarted := NewManager(dir)
+	if exists, _ := restarted.WindowExists("proj", "ticket-2"); !exists {
+		t.Fatal("expected restarted manager to find the running process")
+	}
+
+	if err := m.KillWindow("proj", "ticket-2"); err != nil {
+		t.Fatalf("KillWindow: %v", err)
+	}
+	if exists, _ := m.WindowExists("proj", "ticket-2"); exists {
+		t.Error("expected process to be gone after KillWindow")
+	}
+	if err := m.KillWindow("proj", "ticket-2"); !IsNotRunning(err) {
+		t.Errorf("second KillWindow = %v, want NotRunningError", err)
+	}
+}
@@ -26,6 +26,7 @@ func TestCopyEmbeddedDefaults(t *testing.T) {
 		"prompts/architect/SYSTEM.md",
 		"prompts/architect/KICKOFF.md",
 		"prompts/work/KICKOFF.md",
+		"prompts/review/KICKOFF.md",
 	}
 
 	for _, file := range expectedFiles {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -17,6 +18,7 @@ type MigrationResult struct {
 	ArchitectPath string
 	ArchitectName string
 	DetectedAgent string
+	Detail        string
 	Migrated      bool
 	Skipped       bool
 	SkipReason    string
@@ -59,6 +61,12 @@ func DetectAgentFromExtend(extendPath string) string {
 // from the legacy format (with extend, ticket, git fields) to the new format
 // with top-level agents map.
 func MigrateProjectConfig(projectPath string) *MigrationResult {
+	return migrateProjectConfig(projectPath, false)
+}
+
+// migrateProjectConfig is MigrateProjectConfig. With dryRun it reports the
+// migration without writing cortex.yaml.
+func migrateProjectConfig(projectPath string, dryRun bool) *MigrationResult {
 	result := &MigrationResult{ArchitectPath: projectPath}
 
 	configPath := filepath.Join(projectPath, "cortex.yaml")
@@ -109,6 +117,9 @@ func MigrateProjectConfig(projectPath string) *MigrationResult {
 		result.Error = err
 		return result
 	}
+	if dryRun {
+		return result
+	}
 
 	// Write the migrated config
 	if err := os.WriteFile(configPath, []byte(newConfig), 0644); err != nil {
@@ -199,8 +210,115 @@ func deriveRepoMap(paths []string) (map[string]string, error) {
 	return repoMap, nil
 }
 
-// MigrateAllProjects loads the global config and migrates all registered projects.
-func MigrateAllProjects() ([]MigrationResult, error) {
+// Summary describes what a successful migration changed.
+func (r *MigrationResult) Summary() string {
+	if r.Detail != "" {
+		return r.Detail
+	}
+	return "agent: " + r.DetectedAgent
+}
+
+// defaultStatuses mirrors the built-in kanban statuses used when cortex.yaml declares none.
+var defaultStatuses = []string{"backlog", "progress", "done"}
+
+// MigrateTicketStatuses writes an explicit statuses list into a project's
+// cortex.yaml so the kanban columns can be edited. Status directories already
+// present under tickets/ are carried over, ordered before done.
+func MigrateTicketStatuses(projectPath string) *MigrationResult {
+	return migrateTicketStatuses(projectPath, false)
+}
+
+// migrateTicketStatuses is MigrateTicketStatuses. With dryRun it reports
+// the statuses it would write without writing them.
+func migrateTicketStatuses(projectPath string, dryRun bool) *MigrationResult {
+	result := &MigrationResult{ArchitectPath: projectPath}
+
+	configPath := filepath.Join(projectPath, "cortex.yaml")
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			result.Skipped = true
+			result.SkipReason = "no cortex.yaml found"
+			return result
+		}
+		result.Error = err
+		return result
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		result.Error = err
+		return result
+	}
+	if name, ok := raw["name"].(string); ok {
+		result.ArchitectName = name
+	}
+	if result.ArchitectName == "" {
+		result.ArchitectName = DetectArchitectName(projectPath)
+	}
+	if _, ok := raw["statuses"]; ok {
+		result.Skipped = true
+		result.SkipReason = "statuses already configured"
+		return result
+	}
+
+	extra, err := existingStatusDirs(filepath.Join(projectPath, "tickets"))
+	if err != nil {
+		result.Error = err
+		return result
+	}
+	statuses := append(append(slices.Clone(defaultStatuses[:2]), extra...), defaultStatuses[2])
+	result.Detail = "statuses: " + strings.Join(statuses, ", ")
+	if dryRun {
+		return result
+	}
+
+	var sb strings.Builder
+	sb.Write(data)
+	if len(data) > 0 && !strings.HasSuffix(string(data), "\n") {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\nstatuses:\n")
+	for _, status := range statuses {
+		sb.WriteString("  - name: ")
+		sb.WriteString(status)
+		sb.WriteString("\n")
+	}
+
+	if err := os.WriteFile(configPath, []byte(sb.String()), 0644); err != nil {
+		result.Error = err
+		return result
+	}
+
+	result.Migrated = true
+	return result
+}
+
+// existingStatusDirs returns the non-default status directories under ticketsDir, sorted.
+func existingStatusDirs(ticketsDir string) ([]string, error) {
+	entries, err := os.ReadDir(ticketsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var extra []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") || slices.Contains(defaultStatuses, name) {
+			continue
+		}
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	return extra, nil
+}
+
+// MigrateAllProjects loads the global config and migrates all registered
+// projects. With dryRun nothing is written; results that are neither
+// skipped nor failed are the migrations an apply would make.
+func MigrateAllProjects(dryRun bool) ([]MigrationResult, error) {
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, err
@@ -208,8 +326,11 @@ func MigrateAllProjects() ([]MigrationResult, error) {
 
 	var results []MigrationResult
 	for _, project := range cfg.Architects {
-		r := MigrateProjectConfig(project.Path)
+		r := migrateProjectConfig(project.Path, dryRun)
 		results = append(results, *r)
+		if s := migrateTicketStatuses(project.Path, dryRun); !s.Skipped {
+			results = append(results, *s)
+		}
 	}
 	return results, nil
 }
@@ -253,3 +253,100 @@ git:
 		t.Errorf("expected agent 'opencode', got %q", result.DetectedAgent)
 	}
 }
+
+func TestMigrateTicketStatuses_AddsDefaults(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "cortex.yaml"), []byte("name: myproject\nrepos: {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := MigrateTicketStatuses(dir)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if !result.Migrated {
+		t.Fatalf("expected migration, got skip: %s", result.SkipReason)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "cortex.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	if !strings.Contains(content, "name: myproject\nrepos: {}\n") {
+		t.Error("migrated config should preserve existing content")
+	}
+	if !strings.Contains(content, "statuses:\n  - name: backlog\n  - name: progress\n  - name: done\n") {
+		t.Errorf("expected default statuses, got:\n%s", content)
+	}
+}
+
+func TestMigrateTicketStatuses_CarriesExistingDirs(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "cortex.yaml"), []byte("name: myproject\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range []string{"backlog", "progress", "review", "done"} {
+		if err := os.MkdirAll(filepath.Join(dir, "tickets", status), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result := MigrateTicketStatuses(dir)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "cortex.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "  - name: progress\n  - name: review\n  - name: done\n") {
+		t.Errorf("expected review carried over before done, got:\n%s", data)
+	}
+}
+
+func TestMigrateTicketStatuses_AlreadyConfigured(t *testing.T) {
+	dir := t.TempDir()
+	config := "name: myproject\nstatuses:\n  - name: backlog\n  - name: progress\n  - name: done\n"
+	if err := os.WriteFile(filepath.Join(dir, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := MigrateTicketStatuses(dir)
+	if !result.Skipped {
+		t.Fatal("expected migration to be skipped")
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "cortex.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != config {
+		t.Error("config should not be modified")
+	}
+}
+
+func TestMigrateTicketStatuses_DryRun(t *testing.T) {
+	dir := t.TempDir()
+	config := "name: myproject\n"
+	if err := os.WriteFile(filepath.Join(dir, "cortex.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := migrateTicketStatuses(dir, true)
+	if result.Error != nil || result.Skipped || result.Migrated {
+		t.Fatalf("expected a pending migration, got %+v", result)
+	}
+	if result.Detail != "statuses: backlog, progress, done" {
+		t.Errorf("unexpected detail %q", result.Detail)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "cortex.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != config {
+		t.Error("dry run should not modify the config")
+	}
+}
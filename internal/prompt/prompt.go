@@ -28,6 +28,9 @@ func TicketPromptPath(projectRoot, ticketType, stage string) string {
 	return filepath.Join(PromptsDir(projectRoot), ticketType, stage+".md")
 }
 
+// ReviewPromptDir is the prompts directory of reviewer sessions.
+const ReviewPromptDir = "review"
+
 // BasePromptsDir returns the prompts directory for a cortex config directory.
 // Cortex config directories (like extend targets) have prompts directly under them.
 func BasePromptsDir(cortexConfigDir string) string {
@@ -111,6 +111,13 @@ func (r *PromptResolver) ResolveTicketPromptWithPath(ticketType, stage string) (
 		}
 	}
 
+	// Fall back to the work prompts for types without their own directory
+	if ticketType != "work" {
+		if resolved, err := r.ResolveTicketPromptWithPath("work", stage); err == nil {
+			return resolved, nil
+		}
+	}
+
 	// Not found in any location
 	return nil, &NotFoundError{
 		Role:        ticketType,
@@ -120,6 +127,41 @@ func (r *PromptResolver) ResolveTicketPromptWithPath(ticketType, stage string) (
 	}
 }
 
+// ResolveReviewPrompt finds and loads a reviewer prompt from
+// prompts/review/. Unlike ticket prompts it never falls back to the work
+// prompts, which tell the agent to change code.
+func (r *PromptResolver) ResolveReviewPrompt(stage string) (string, error) {
+	var searchPaths []string
+
+	projectPath := TicketPromptPath(r.ProjectRoot, ReviewPromptDir, stage)
+	searchPaths = append(searchPaths, projectPath)
+	content, err := r.loadIfExists(projectPath)
+	if err != nil {
+		return "", err
+	}
+	if content != "" {
+		return content, nil
+	}
+
+	if r.BaseRoot != "" {
+		basePath := BaseTicketPromptPath(r.BaseRoot, ReviewPromptDir, stage)
+		searchPaths = append(searchPaths, basePath)
+		content, err = r.loadIfExists(basePath)
+		if err != nil {
+			return "", err
+		}
+		if content != "" {
+			return content, nil
+		}
+	}
+
+	return "", &NotFoundError{
+		Role:        ReviewPromptDir,
+		Stage:       stage,
+		SearchPaths: searchPaths,
+	}
+}
+
 // loadIfExists loads a file if it exists, returns empty string if not found.
 // Returns error only for read errors (not missing files).
 func (r *PromptResolver) loadIfExists(path string) (string, error) {
@@ -104,6 +104,35 @@ func TestPromptResolver_ResolveTicketPrompt(t *testing.T) {
 		}
 	})
 
+	t.Run("uses type-specific prompt when present", func(t *testing.T) {
+		projectRoot := t.TempDir()
+		createTicketPromptFile(t, projectRoot, "work", "KICKOFF.md", "work kickoff")
+		createTicketPromptFile(t, projectRoot, "bug", "KICKOFF.md", "bug kickoff")
+
+		resolver := NewPromptResolver(projectRoot, "")
+		content, err := resolver.ResolveTicketPrompt("bug", StageKickoff)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if content != "bug kickoff" {
+			t.Errorf("expected 'bug kickoff', got %q", content)
+		}
+	})
+
+	t.Run("falls back to work type when type has no prompt", func(t *testing.T) {
+		projectRoot := t.TempDir()
+		baseRoot := t.TempDir()
+		createBaseTicketPromptFile(t, baseRoot, "work", "KICKOFF.md", "base work kickoff")
+
+		resolver := NewPromptResolver(projectRoot, baseRoot)
+		content, err := resolver.ResolveTicketPrompt("spike", StageKickoff)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if content != "base work kickoff" {
+			t.Errorf("expected 'base work kickoff', got %q", content)
+		}
+	})
 }
 
 // createPromptFile creates a prompt file for architect prompts in a project root.
@@ -265,3 +294,32 @@ func TestPromptResolver_ResolveTicketPromptWithPath(t *testing.T) {
 		}
 	})
 }
+
+func TestPromptResolver_ResolveReviewPrompt(t *testing.T) {
+	t.Run("project overrides base", func(t *testing.T) {
+		projectRoot := t.TempDir()
+		baseRoot := t.TempDir()
+		createBaseTicketPromptFile(t, baseRoot, "review", "KICKOFF.md", "base review")
+		createTicketPromptFile(t, projectRoot, "review", "KICKOFF.md", "project review")
+
+		resolver := NewPromptResolver(projectRoot, baseRoot)
+		content, err := resolver.ResolveReviewPrompt(StageKickoff)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if content != "project review" {
+			t.Errorf("expected 'project review', got %q", content)
+		}
+	})
+
+	t.Run("does not fall back to work prompts", func(t *testing.T) {
+		projectRoot := t.TempDir()
+		createTicketPromptFile(t, projectRoot, "work", "KICKOFF.md", "work kickoff")
+
+		resolver := NewPromptResolver(projectRoot, "")
+		_, err := resolver.ResolveReviewPrompt(StageKickoff)
+		if _, ok := err.(*NotFoundError); !ok {
+			t.Fatalf("expected *NotFoundError, got %T", err)
+		}
+	})
+}
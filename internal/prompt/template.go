@@ -15,7 +15,30 @@ type TicketVars struct {
 	Repo          string // stable repo key for the ticket
 	RepoPath      string // resolved local path for the ticket repo key
 	ArchitectName string // architect name from config
-	Repos         string // formatted list of other repos in the ecosystem (excluding current repo)
+	Repos         string // formatted list of other repos in the ecosystem (excluding the ticket's repos)
+	SiblingRepos  string // formatted list of the ticket's other repos, each worked by its own session
+	Criteria      string // numbered list of the acceptance criteria in the ticket body
+
+	// Set when a review sent the ticket back with requested changes.
+	ReviewSummary  string // the reviewer's summary
+	ReviewComments string // formatted list of the review comments for this worker's repo
+
+	// Set when the verify commands failed on this repo's last conclusion.
+	VerifyFailure string // the failing command and the tail of its output
+}
+
+// ReviewerVars contains variables available for reviewer prompt templates.
+type ReviewerVars struct {
+	ProjectPath   string
+	ArchitectName string
+	TicketID      string
+	TicketTitle   string
+	TicketBody    string
+	Repo          string // the ticket's repo key; the first one for multi-repo tickets
+	RepoPath      string // resolved local path the reviewer starts in
+	Round         int    // review round being audited
+	Conclusion    string // the worker's conclusion body
+	Diffs         string // the concluded commits rendered as unified diffs
 }
 
 // ArchitectKickoffVars contains variables for the architect kickoff template.
@@ -27,6 +50,7 @@ type ArchitectKickoffVars struct {
 	Repos            string // configured repo list
 	LastConclusionID string // ID of most recent architect conclusion, empty if none
 	Variants         string // comma-separated agent variant names, empty if none configured
+	Overdue          string // open tickets past their due date, empty if none
 }
 
 // TicketsVars contains status-specific ticket lists for architect templates.
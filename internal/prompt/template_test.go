@@ -96,3 +96,31 @@ func TestRenderTemplate_TicketKickoff_EmptyReferences(t *testing.T) {
 		t.Error("expected references section to be omitted when empty")
 	}
 }
+
+func TestRenderTemplate_ArchitectKickoff_Overdue(t *testing.T) {
+	tmpl := `{{.TicketList}}
+{{- if .Overdue}}
+
+# Overdue Tickets
+
+{{.Overdue}}
+{{- end}}`
+
+	vars := ArchitectKickoffVars{TicketList: "## Backlog\n- [t1] Task 1\n"}
+	result, err := RenderTemplate(tmpl, vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(result, "# Overdue Tickets") {
+		t.Error("expected overdue section to be omitted when empty")
+	}
+
+	vars.Overdue = "- [t1] Task 1 (due: 2025-06-01, backlog)\n"
+	result, err = RenderTemplate(tmpl, vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(result, "# Overdue Tickets") || !strings.Contains(result, "due: 2025-06-01") {
+		t.Errorf("expected overdue section, got:\n%s", result)
+	}
+}
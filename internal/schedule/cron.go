@@ -0,0 +1,203 @@
+// Package schedule holds recurring ticket definitions: the cron expressions
+// that time them and the schedules/ files of an architect workspace.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronHorizon bounds the search for a cron expression's next time. Every
+// valid expression matches at least once in five years (February 29th
+// included).
+const cronHorizon = 5 * 366 * 24 * time.Hour
+
+// Macros accepted in place of a five-field expression.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes one field of an expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	// 7 is accepted for Sunday and folded onto 0.
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+// Cron is a parsed cron expression: minute, hour, day of month, month and
+// day of week, with *, lists, ranges, steps and month or weekday names. It
+// is evaluated in the location of the time passed to Next.
+type Cron struct {
+	expr                          string
+	minute, hour, dom, month, dow uint64
+	// As in cron(8), when both day fields are restricted a day matching
+	// either one matches.
+	domAny, dowAny bool
+}
+
+// ParseCron parses a five-field cron expression or one of the macros
+// @hourly, @daily, @weekly, @monthly and @yearly.
+func ParseCron(expr string) (*Cron, error) {
+	spec := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields (minute hour day-of-month month day-of-week) or a macro such as @daily", expr)
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		b, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		bits[i] = b
+	}
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	c := &Cron{
+		expr:   strings.TrimSpace(expr),
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: parts[2] == "*" || parts[2] == "?",
+		dowAny: parts[4] == "*" || parts[4] == "?",
+	}
+	if c.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("invalid cron expression %q: never matches", expr)
+	}
+	return c, nil
+}
+
+// parseCronField returns the bit set of values a field matches.
+func parseCronField(s string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(s, ",") {
+		rangePart, step := part, 1
+		if before, after, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(after)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", after, f.name)
+			}
+			rangePart, step = before, n
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = cronValue(a, f); err != nil {
+				return 0, err
+			}
+			if hi, err = cronValue(b, f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+			}
+		default:
+			v, err := cronValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// A bare value with a step, such as 5/15, runs to the maximum.
+			if step == 1 {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// cronValue parses a single number or name within a field's bounds.
+func cronValue(s string, f cronField) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// String returns the expression as written.
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Next returns the first time after t, to the minute, that the expression
+// matches, or the zero time if it matches none within five years.
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(cronHorizon)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
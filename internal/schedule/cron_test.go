@@ -0,0 +1,59 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * foo *",
+		"0 0 30 2 *",
+		"@fortnightly",
+	} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// Wednesday.
+	from := time.Date(2026, 10, 14, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 10, 14, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 10, 14, 10, 45, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 9 * * mon", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)},
+		{"30 10 * * 3", time.Date(2026, 10, 21, 10, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"0 8 1,15 * *", time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either matches.
+		{"0 0 1 * fri", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := c.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// stateFileName holds the last run of each schedule in the schedules
+// directory. As a dotfile it is left out of architect exports.
+const stateFileName = ".state.json"
+
+// ErrExists is returned by Create for a name already in use.
+var ErrExists = errors.New("schedule already exists")
+
+// Meta is the frontmatter of a schedule definition.
+type Meta struct {
+	// Cron is when tickets are created, in the daemon's local time.
+	Cron       string   `yaml:"cron"`
+	Title      string   `yaml:"title"`
+	Type       string   `yaml:"type,omitempty"`
+	Repo       string   `yaml:"repo,omitempty"`
+	References []string `yaml:"references,omitempty"`
+	// Due is an offset from each run such as 3d, 2w or 48h.
+	Due string `yaml:"due,omitempty"`
+	// Variant, when set, spawns every created ticket with that agent
+	// variant.
+	Variant string `yaml:"variant,omitempty"`
+	// Paused schedules create no tickets.
+	Paused bool `yaml:"paused,omitempty"`
+}
+
+// Schedule is a recurring ticket definition, stored as
+// schedules/<name>.md in the architect workspace. Each run creates a
+// backlog ticket from its title and body, which may use the {{.date}}
+// (YYYY-MM-DD) and {{.schedule}} variables.
+type Schedule struct {
+	Name string
+	Meta
+	Body string
+	// Modified is when the definition was last written. Runs due before
+	// it are not made up.
+	Modified time.Time
+}
+
+// Validate checks the definition, returning a ValidationError.
+func (s *Schedule) Validate() error {
+	if err := validName(s.Name); err != nil {
+		return err
+	}
+	if _, err := ParseCron(s.Cron); err != nil {
+		return &storage.ValidationError{Field: "cron", Message: err.Error()}
+	}
+	if strings.TrimSpace(s.Title) == "" {
+		return &storage.ValidationError{Field: "title", Message: "cannot be empty"}
+	}
+	for _, v := range s.Template().Variables() {
+		if v != "date" && v != "schedule" {
+			return &storage.ValidationError{Field: "variables", Message: fmt.Sprintf("unknown variable %q: only date and schedule are set", v)}
+		}
+	}
+	if _, err := s.Instantiate(time.Now()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Next returns the first run after t, or the zero time for an invalid
+// expression.
+func (s *Schedule) Next(t time.Time) time.Time {
+	c, err := ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return c.Next(t)
+}
+
+// Template returns the definition as a ticket template.
+func (s *Schedule) Template() *ticket.Template {
+	return &ticket.Template{
+		Name: s.Name,
+		TemplateMeta: ticket.TemplateMeta{
+			Title:      s.Title,
+			Type:       s.Type,
+			Repo:       s.Repo,
+			References: s.References,
+			Due:        s.Due,
+		},
+		Body: s.Body,
+	}
+}
+
+// Instantiate returns the ticket a run at now creates, to pass to
+// ticket.WithTemplate.
+func (s *Schedule) Instantiate(now time.Time) (*ticket.Ticket, error) {
+	return s.Template().Instantiate(map[string]string{
+		"date":     now.Format(time.DateOnly),
+		"schedule": s.Name,
+	}, now)
+}
+
+// List loads every schedule in dir, sorted by name. A missing directory
+// has no schedules. Definitions that fail to load, validate or pass check,
+// when non-nil, are returned as invalid instead of failing the whole list.
+func List(dir string, check func(*Schedule) error) ([]*Schedule, []*InvalidSchedule, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("read schedules: %w", err)
+	}
+
+	var schedules []*Schedule
+	var invalid []*InvalidSchedule
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".md" {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), ".md")
+		s, err := Load(dir, name)
+		if err == nil {
+			err = s.Validate()
+		}
+		if err == nil && check != nil {
+			err = check(s)
+		}
+		if err != nil {
+			invalid = append(invalid, &InvalidSchedule{Name: name, Err: err})
+			continue
+		}
+		schedules = append(schedules, s)
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Name < schedules[j].Name })
+	return schedules, invalid, nil
+}
+
+// InvalidSchedule is a definition List could not load or check. It is
+// left out of the schedules so the others still run.
+type InvalidSchedule struct {
+	Name string
+	Err  error
+}
+
+func (e *InvalidSchedule) Error() string {
+	return fmt.Sprintf("schedule %s: %v", e.Name, e.Err)
+}
+
+func (e *InvalidSchedule) Unwrap() error {
+	return e.Err
+}
+
+// Load loads schedule name from dir.
+func Load(dir, name string) (*Schedule, error) {
+	if err := validName(name); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, name+".md")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &storage.NotFoundError{Resource: "schedule", ID: name}
+		}
+		return nil, fmt.Errorf("read schedule %s: %w", name, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("stat schedule %s: %w", name, err)
+	}
+
+	meta, body, err := storage.ParseFrontmatter[Meta](data)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s: %w", name, err)
+	}
+	return &Schedule{Name: name, Meta: *meta, Body: body, Modified: info.ModTime()}, nil
+}
+
+// Create validates s and writes it to dir. It fails if the schedule exists.
+func Create(dir string, s *Schedule) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, s.Name+".md")
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%w: %s", ErrExists, s.Name)
+	}
+	data, err := storage.SerializeFrontmatter(&s.Meta, s.Body)
+	if err != nil {
+		return fmt.Errorf("serialize schedule %s: %w", s.Name, err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create schedules directory: %w", err)
+	}
+	if err := storage.AtomicWriteFile(path, data); err != nil {
+		return fmt.Errorf("write schedule %s: %w", s.Name, err)
+	}
+	s.Modified = time.Now()
+	return nil
+}
+
+// Remove deletes schedule name from dir, along with its run state.
+func Remove(dir, name string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(dir, name+".md")); err != nil {
+		if os.IsNotExist(err) {
+			return &storage.NotFoundError{Resource: "schedule", ID: name}
+		}
+		return fmt.Errorf("remove schedule %s: %w", name, err)
+	}
+	state, err := LoadState(dir)
+	if err != nil {
+		return err
+	}
+	if _, ok := state[name]; !ok {
+		return nil
+	}
+	delete(state, name)
+	return SaveState(dir, state)
+}
+
+func validName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") || strings.TrimSpace(name) != name {
+		return &storage.ValidationError{Field: "name", Message: fmt.Sprintf("invalid schedule name %q", name)}
+	}
+	return nil
+}
+
+// Run records a schedule's last run.
+type Run struct {
+	Time     time.Time `json:"time"`
+	TicketID string    `json:"ticket_id,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// State maps schedule names to their last run.
+type State map[string]Run
+
+// LoadState reads the run state of the schedules in dir.
+func LoadState(dir string) (State, error) {
+	data, err := os.ReadFile(filepath.Join(dir, stateFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return State{}, nil
+		}
+		return nil, fmt.Errorf("read schedule state: %w", err)
+	}
+	state := State{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse schedule state: %w", err)
+	}
+	return state, nil
+}
+
+// SaveState writes the run state of the schedules in dir.
+func SaveState(dir string, state State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create schedules directory: %w", err)
+	}
+	return storage.AtomicWriteFile(filepath.Join(dir, stateFileName), data)
+}
+
+// ShouldRun reports whether s should run at now given its last run: a run fell
+// due since then, or since the definition was written if it never ran.
+// Runs missed while the daemon was down are made up once.
+func (s *Schedule) ShouldRun(last *Run, now time.Time) bool {
+	if s.Paused {
+		return false
+	}
+	since := s.Modified
+	if last != nil && last.Time.After(since) {
+		since = last.Time
+	}
+	next := s.Next(since.In(now.Location()))
+	return !next.IsZero() && !next.After(now)
+}
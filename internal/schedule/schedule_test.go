@@ -0,0 +1,148 @@
+package schedule
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+func TestCreateListRemove(t *testing.T) {
+	dir := t.TempDir()
+	s := &Schedule{
+		Name: "dep-audit",
+		Meta: Meta{Cron: "0 9 * * mon", Title: "Dependency audit {{.date}}", Repo: "api", Due: "2d", Variant: "fast"},
+		Body: "Run the audit.\n",
+	}
+	if err := Create(dir, s); err != nil {
+		t.Fatal(err)
+	}
+	if err := Create(dir, s); !errors.Is(err, ErrExists) {
+		t.Fatalf("expected ErrExists for a duplicate, got %v", err)
+	}
+
+	schedules, invalid, err := List(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invalid) != 0 {
+		t.Fatalf("unexpected invalid schedules: %v", invalid)
+	}
+	if len(schedules) != 1 || schedules[0].Meta.Title != s.Title || schedules[0].Variant != "fast" || schedules[0].Body != s.Body {
+		t.Fatalf("unexpected schedules: %+v", schedules)
+	}
+
+	if err := SaveState(dir, State{"dep-audit": {Time: time.Now(), TicketID: "t1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Remove(dir, "dep-audit"); err != nil {
+		t.Fatal(err)
+	}
+	if state, _ := LoadState(dir); len(state) != 0 {
+		t.Errorf("expected the run state to be removed, got %v", state)
+	}
+	if err := Remove(dir, "dep-audit"); !storage.IsNotFound(err) {
+		t.Errorf("expected NotFoundError, got %v", err)
+	}
+}
+
+func TestListSkipsInvalid(t *testing.T) {
+	dir := t.TempDir()
+	if err := Create(dir, &Schedule{Name: "good", Meta: Meta{Cron: "@daily", Title: "Good"}}); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"broken.md":   "---\ncron: [unclosed\n---\n",
+		"bad-cron.md": "---\ncron: daily\ntitle: Bad cron\n---\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	schedules, invalid, err := List(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 1 || schedules[0].Name != "good" {
+		t.Errorf("expected only the good schedule, got %+v", schedules)
+	}
+	var names []string
+	for _, bad := range invalid {
+		names = append(names, bad.Name)
+	}
+	slices.Sort(names)
+	if !slices.Equal(names, []string{"bad-cron", "broken"}) {
+		t.Errorf("invalid = %v, want bad-cron and broken", names)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     Schedule
+		field string
+	}{
+		{"bad name", Schedule{Name: "../x", Meta: Meta{Cron: "@daily", Title: "x"}}, "name"},
+		{"bad cron", Schedule{Name: "a", Meta: Meta{Cron: "daily", Title: "x"}}, "cron"},
+		{"no title", Schedule{Name: "a", Meta: Meta{Cron: "@daily"}}, "title"},
+		{"unknown variable", Schedule{Name: "a", Meta: Meta{Cron: "@daily", Title: "{{.owner}}"}}, "variables"},
+		{"bad due", Schedule{Name: "a", Meta: Meta{Cron: "@daily", Title: "x", Due: "soon"}}, "due"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.s.Validate()
+			var valErr *storage.ValidationError
+			if !errors.As(err, &valErr) || valErr.Field != tt.field {
+				t.Fatalf("expected ValidationError for %s, got %v", tt.field, err)
+			}
+		})
+	}
+}
+
+func TestShouldRun(t *testing.T) {
+	written := time.Date(2026, 10, 14, 10, 30, 0, 0, time.UTC)
+	s := &Schedule{Name: "a", Meta: Meta{Cron: "@daily", Title: "x"}, Modified: written}
+
+	if s.ShouldRun(nil, written.Add(time.Hour)) {
+		t.Error("ran before the first occurrence after the definition was written")
+	}
+	if !s.ShouldRun(nil, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Error("did not run at the first occurrence")
+	}
+
+	// Several missed runs are made up once.
+	last := &Run{Time: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	if !s.ShouldRun(last, now) {
+		t.Error("did not make up missed runs")
+	}
+	if s.ShouldRun(&Run{Time: now}, now.Add(time.Minute)) {
+		t.Error("ran again before the next occurrence")
+	}
+
+	s.Paused = true
+	if s.ShouldRun(last, now) {
+		t.Error("paused schedule ran")
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	s := &Schedule{Name: "triage", Meta: Meta{Cron: "@weekly", Title: "Flaky-test triage {{.date}}", Type: "chore", Repo: "api", Due: "3d"}, Body: "From {{.schedule}}."}
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tk, err := s.Instantiate(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk.Title != "Flaky-test triage 2026-10-18" || tk.Body != "From triage." || tk.Type != "chore" || tk.Repo != "api" {
+		t.Errorf("unexpected ticket: %+v", tk)
+	}
+	if tk.Due == nil || !tk.Due.Equal(now.AddDate(0, 0, 3)) {
+		t.Errorf("unexpected due date: %v", tk.Due)
+	}
+}
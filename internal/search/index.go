@@ -0,0 +1,209 @@
+// Package search maintains a ranked full-text index over an architect
+// workspace: tickets, conclusions, collabs and free-form notes.
+package search
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Kind identifies what a document was built from.
+type Kind string
+
+const (
+	KindTicket     Kind = "ticket"
+	KindConclusion Kind = "conclusion"
+	KindCollab     Kind = "collab"
+	KindNote       Kind = "note"
+)
+
+// Document is a single searchable unit.
+type Document struct {
+	Key      string // unique within an index (the source file path)
+	Kind     Kind
+	ID       string
+	Title    string
+	Body     string
+	Repo     string
+	Status   string
+	Type     string // ticket type, or conclusion type (work, collab, architect)
+	TicketID string
+	Path     string
+	Updated  time.Time
+}
+
+// Result is a ranked match with a highlighted snippet.
+type Result struct {
+	Document
+	Score   float64
+	Snippet string
+}
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// titleBoost counts each title term this many times, so title hits
+	// outrank body hits of the same term.
+	titleBoost = 3
+)
+
+type indexedDoc struct {
+	doc    Document
+	length int
+	terms  map[string]int
+}
+
+// Index is an in-memory inverted index ranked with BM25.
+// It is safe for concurrent use.
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[string]*indexedDoc
+	postings map[string]map[string]int // term -> doc key -> term frequency
+	totalLen int
+}
+
+// NewIndex creates an empty index.
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]*indexedDoc),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// Len returns the number of indexed documents.
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Put adds or replaces a document.
+func (x *Index) Put(doc Document) {
+	terms := make(map[string]int)
+	length := 0
+	for _, tok := range tokenize(doc.Title) {
+		terms[tok.term] += titleBoost
+		length += titleBoost
+	}
+	for _, tok := range tokenize(doc.Body) {
+		terms[tok.term]++
+		length++
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(doc.Key)
+	x.docs[doc.Key] = &indexedDoc{doc: doc, length: length, terms: terms}
+	x.totalLen += length
+	for term, tf := range terms {
+		p, ok := x.postings[term]
+		if !ok {
+			p = make(map[string]int)
+			x.postings[term] = p
+		}
+		p[doc.Key] = tf
+	}
+}
+
+// Remove drops a document by key. Unknown keys are ignored.
+func (x *Index) Remove(key string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(key)
+}
+
+func (x *Index) remove(key string) {
+	d, ok := x.docs[key]
+	if !ok {
+		return
+	}
+	for term := range d.terms {
+		p := x.postings[term]
+		delete(p, key)
+		if len(p) == 0 {
+			delete(x.postings, term)
+		}
+	}
+	x.totalLen -= d.length
+	delete(x.docs, key)
+}
+
+// Search returns up to limit matches for q, best first, along with the total
+// number of matches. A query without terms lists every document passing the
+// filters, newest first. A limit of zero or less returns every match.
+func (x *Index) Search(q Query, limit int) ([]Result, int) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var results []Result
+	if len(q.Terms) == 0 {
+		for _, d := range x.docs {
+			if q.matches(d.doc) {
+				results = append(results, Result{Document: d.doc})
+			}
+		}
+	} else {
+		scores := x.score(q.Terms)
+		for key, score := range scores {
+			d := x.docs[key]
+			if q.matches(d.doc) {
+				results = append(results, Result{Document: d.doc, Score: score})
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if !results[i].Updated.Equal(results[j].Updated) {
+			return results[i].Updated.After(results[j].Updated)
+		}
+		return results[i].Key < results[j].Key
+	})
+
+	total := len(results)
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	for i := range results {
+		results[i].Snippet = snippet(results[i].Body, q.Terms)
+	}
+	return results, total
+}
+
+// score computes BM25 scores for every document containing a query term.
+func (x *Index) score(terms []string) map[string]float64 {
+	scores := make(map[string]float64)
+	n := float64(len(x.docs))
+	if n == 0 {
+		return scores
+	}
+	avgLen := float64(x.totalLen) / n
+
+	seen := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+
+		p := x.postings[term]
+		if len(p) == 0 {
+			continue
+		}
+		df := float64(len(p))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for key, tf := range p {
+			dl := float64(x.docs[key].length)
+			f := float64(tf)
+			scores[key] += idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*dl/avgLen))
+		}
+	}
+	return scores
+}
@@ -0,0 +1,127 @@
+package search
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustParse(t *testing.T, s string) Query {
+	t.Helper()
+	q, err := ParseQuery(s)
+	if err != nil {
+		t.Fatalf("ParseQuery(%q): %v", s, err)
+	}
+	return q
+}
+
+func TestSearchRanksTitleAndFrequency(t *testing.T) {
+	idx := NewIndex()
+	idx.Put(Document{Key: "a", Kind: KindTicket, Title: "Fix login timeout", Body: "Users are logged out."})
+	idx.Put(Document{Key: "b", Kind: KindTicket, Title: "Refactor auth", Body: "The login page has a timeout bug somewhere."})
+	idx.Put(Document{Key: "c", Kind: KindNote, Title: "Notes", Body: "Nothing relevant here."})
+
+	results, total := idx.Search(mustParse(t, "login timeout"), 10)
+	if total != 2 {
+		t.Fatalf("total = %d, want 2", total)
+	}
+	if results[0].Key != "a" {
+		t.Errorf("top result = %q, want title match %q", results[0].Key, "a")
+	}
+	if results[0].Score <= results[1].Score {
+		t.Errorf("expected descending scores, got %v then %v", results[0].Score, results[1].Score)
+	}
+}
+
+func TestSearchFilters(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+
+	idx := NewIndex()
+	idx.Put(Document{Key: "t1", Kind: KindTicket, Type: "work", Repo: "api", Status: "done", Body: "cache", Updated: day(1)})
+	idx.Put(Document{Key: "t2", Kind: KindTicket, Type: "bug", Repo: "web", Status: "backlog", Body: "cache", Updated: day(5)})
+	idx.Put(Document{Key: "c1", Kind: KindConclusion, Type: "work", Repo: "api", Status: "done", Body: "cache", Updated: day(10)})
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"cache repo:api", []string{"c1", "t1"}},
+		{"cache status:backlog", []string{"t2"}},
+		{"cache type:conclusion", []string{"c1"}},
+		{"cache type:bug", []string{"t2"}},
+		{"cache after:2026-03-05", []string{"c1", "t2"}},
+		{"cache before:2026-03-05", []string{"t1"}},
+		{"cache updated:2026-03-01..2026-03-05", []string{"t2", "t1"}},
+		{"repo:api", []string{"c1", "t1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			results, _ := idx.Search(mustParse(t, tt.query), 0)
+			var got []string
+			for _, r := range results {
+				got = append(got, r.Key)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexPutReplacesAndRemove(t *testing.T) {
+	idx := NewIndex()
+	idx.Put(Document{Key: "a", Body: "alpha"})
+	idx.Put(Document{Key: "a", Body: "beta"})
+
+	if _, total := idx.Search(mustParse(t, "alpha"), 10); total != 0 {
+		t.Errorf("stale term still matches after replace")
+	}
+	if _, total := idx.Search(mustParse(t, "beta"), 10); total != 1 {
+		t.Errorf("replaced document not found")
+	}
+
+	idx.Remove("a")
+	if idx.Len() != 0 {
+		t.Errorf("Len() = %d after remove, want 0", idx.Len())
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	idx := NewIndex()
+	for _, key := range []string{"a", "b", "c"} {
+		idx.Put(Document{Key: key, Body: "shared"})
+	}
+	results, total := idx.Search(mustParse(t, "shared"), 2)
+	if len(results) != 2 || total != 3 {
+		t.Errorf("got %d results, total %d; want 2 and 3", len(results), total)
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	q := mustParse(t, "Login note:x repo:api updated:2026-01-01..")
+	if strings.Join(q.Terms, " ") != "login note x" {
+		t.Errorf("Terms = %v", q.Terms)
+	}
+	if q.Repo != "api" {
+		t.Errorf("Repo = %q", q.Repo)
+	}
+	if !q.After.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || !q.Before.IsZero() {
+		t.Errorf("After = %v, Before = %v", q.After, q.Before)
+	}
+
+	if _, err := ParseQuery("after:yesterday"); err == nil {
+		t.Error("expected error for invalid date")
+	}
+}
+
+func TestSnippetHighlights(t *testing.T) {
+	body := strings.Repeat("filler words ", 30) + "the Login\nflow times out " + strings.Repeat("more text ", 30)
+	got := snippet(body, []string{"login"})
+
+	if !strings.Contains(got, "the **Login** flow") {
+		t.Errorf("snippet missing highlighted match: %q", got)
+	}
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") {
+		t.Errorf("expected ellipses around trimmed snippet: %q", got)
+	}
+}
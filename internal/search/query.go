@@ -0,0 +1,122 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Query is a parsed search query: free-text terms plus field filters.
+type Query struct {
+	Terms  []string
+	Repo   string
+	Status string
+	// Type matches either the document kind (ticket, conclusion, collab,
+	// note) or its ticket/conclusion type (work, bug, architect, ...).
+	Type   string
+	After  time.Time // inclusive
+	Before time.Time // exclusive
+}
+
+// ParseQuery parses a query string such as
+//
+//	login timeout repo:api status:done type:conclusion after:2026-01-01
+//
+// Supported filters are repo:, status:, type:, after:, before: and
+// updated:FROM..TO (inclusive, either side optional). Dates are YYYY-MM-DD
+// or RFC3339. Unknown prefixes are searched as plain text.
+func ParseQuery(s string) (Query, error) {
+	var q Query
+	for _, field := range strings.Fields(s) {
+		name, value, ok := strings.Cut(field, ":")
+		if !ok || value == "" {
+			q.addTerms(field)
+			continue
+		}
+
+		var err error
+		switch strings.ToLower(name) {
+		case "repo":
+			q.Repo = value
+		case "status":
+			q.Status = value
+		case "type":
+			q.Type = value
+		case "after":
+			q.After, err = parseDate(value)
+		case "before":
+			q.Before, err = parseDate(value)
+		case "updated":
+			err = q.parseRange(value)
+		default:
+			q.addTerms(field)
+		}
+		if err != nil {
+			return Query{}, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return q, nil
+}
+
+func (q *Query) addTerms(text string) {
+	for _, tok := range tokenize(text) {
+		q.Terms = append(q.Terms, tok.term)
+	}
+}
+
+// parseRange parses FROM..TO where TO is inclusive of the whole day.
+func (q *Query) parseRange(value string) error {
+	from, to, ok := strings.Cut(value, "..")
+	if !ok {
+		from, to = value, value
+	}
+	if from != "" {
+		t, err := parseDate(from)
+		if err != nil {
+			return err
+		}
+		q.After = t
+	}
+	if to != "" {
+		t, err := parseDate(to)
+		if err != nil {
+			return err
+		}
+		if len(to) == len(time.DateOnly) {
+			t = t.AddDate(0, 0, 1)
+		}
+		q.Before = t
+	}
+	return nil
+}
+
+func parseDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD or RFC3339)", value)
+	}
+	return t, nil
+}
+
+// matches reports whether doc passes the query's field filters.
+func (q Query) matches(doc Document) bool {
+	if q.Repo != "" && !strings.EqualFold(doc.Repo, q.Repo) {
+		return false
+	}
+	if q.Status != "" && !strings.EqualFold(doc.Status, q.Status) {
+		return false
+	}
+	if q.Type != "" && !strings.EqualFold(string(doc.Kind), q.Type) && !strings.EqualFold(doc.Type, q.Type) {
+		return false
+	}
+	if !q.After.IsZero() && doc.Updated.Before(q.After) {
+		return false
+	}
+	if !q.Before.IsZero() && !doc.Updated.Before(q.Before) {
+		return false
+	}
+	return true
+}
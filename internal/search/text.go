@@ -0,0 +1,94 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// token is a normalized term and its byte span in the source text.
+type token struct {
+	term       string
+	start, end int
+}
+
+// tokenize splits text into lowercase runs of letters and digits.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
+
+// snippetWidth is the approximate length of a snippet in bytes.
+const snippetWidth = 160
+
+// snippet returns a short excerpt of body around the first query term, with
+// every matched term wrapped in ** markers. Without a match it returns the
+// start of the body.
+func snippet(body string, terms []string) string {
+	want := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		want[t] = true
+	}
+
+	tokens := tokenize(body)
+	first := -1
+	for i, tok := range tokens {
+		if want[tok.term] {
+			first = i
+			break
+		}
+	}
+
+	from := 0
+	if first >= 0 {
+		from = max(0, tokens[first].start-snippetWidth/3)
+	}
+	to := min(len(body), from+snippetWidth)
+	from = alignRune(body, from)
+	to = alignRune(body, to)
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, tok := range tokens {
+		if tok.start < from || tok.end > to || !want[tok.term] {
+			continue
+		}
+		b.WriteString(body[pos:tok.start])
+		b.WriteString("**")
+		b.WriteString(body[tok.start:tok.end])
+		b.WriteString("**")
+		pos = tok.end
+	}
+	b.WriteString(body[pos:to])
+	if to < len(body) {
+		b.WriteString("…")
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// alignRune moves i back to the start of the rune containing it.
+func alignRune(s string, i int) int {
+	for i > 0 && i < len(s) && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
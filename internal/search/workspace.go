@@ -0,0 +1,303 @@
+package search
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/architectsession"
+	"github.com/kareemaly/cortex/internal/collab"
+	"github.com/kareemaly/cortex/internal/storage"
+	"github.com/kareemaly/cortex/internal/ticket"
+)
+
+// maxNoteSize caps the size of free-form markdown files that get indexed.
+const maxNoteSize = 1 << 20
+
+// Conclusion types, matching the ones reported by the conclusions API.
+const (
+	conclusionTypeWork      = "work"
+	conclusionTypeCollab    = "collab"
+	conclusionTypeArchitect = "architect"
+)
+
+// Workspace describes the directories of an architect that get indexed.
+type Workspace struct {
+	Root       string
+	TicketsDir string
+	// SkipDirs are never scanned for notes (prompts, worktrees, ...).
+	SkipDirs []string
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+	// extra covers a second file the document depends on, such as the
+	// ticket.md a conclusion takes its title from.
+	extra time.Time
+}
+
+// Syncer keeps an Index in step with the markdown files of a workspace.
+// Only files whose size or modification time changed since the previous
+// Sync are re-read.
+type Syncer struct {
+	ws    Workspace
+	idx   *Index
+	state map[string]fileState
+}
+
+// NewSyncer creates a syncer that fills idx from ws.
+func NewSyncer(ws Workspace, idx *Index) *Syncer {
+	return &Syncer{ws: ws, idx: idx, state: make(map[string]fileState)}
+}
+
+// Sync walks the workspace, re-indexing changed files and dropping
+// documents whose files are gone.
+func (s *Syncer) Sync() error {
+	seen := make(map[string]bool, len(s.state))
+
+	err := filepath.WalkDir(s.ws.Root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == s.ws.Root {
+				return err
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if path != s.ws.Root && (strings.HasPrefix(d.Name(), ".") || s.skipped(path)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".md" {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		st := fileState{modTime: info.ModTime(), size: info.Size()}
+		if filepath.Base(path) == "conclusion.md" {
+			if ti, err := os.Stat(filepath.Join(filepath.Dir(path), "ticket.md")); err == nil {
+				st.extra = ti.ModTime()
+			}
+		}
+
+		seen[path] = true
+		if prev, ok := s.state[path]; ok && prev == st {
+			return nil
+		}
+		s.state[path] = st
+
+		doc, ok := s.load(path, info)
+		if !ok {
+			s.idx.Remove(path)
+			return nil
+		}
+		s.idx.Put(doc)
+		return nil
+	})
+
+	for path := range s.state {
+		if !seen[path] {
+			delete(s.state, path)
+			s.idx.Remove(path)
+		}
+	}
+	return err
+}
+
+func (s *Syncer) skipped(dir string) bool {
+	for _, skip := range s.ws.SkipDirs {
+		if dir == skip {
+			return true
+		}
+	}
+	return false
+}
+
+// load builds the document for a markdown file, reporting false for files
+// that should not be indexed.
+func (s *Syncer) load(path string, info fs.FileInfo) (Document, bool) {
+	if parts, ok := relParts(s.ws.TicketsDir, path); ok {
+		// <status>/<id>/<file>
+		if len(parts) != 3 {
+			return Document{}, false
+		}
+		switch parts[2] {
+		case "ticket.md":
+			return loadTicket(path, parts[0], parts[1])
+		case "conclusion.md":
+			return loadTicketConclusion(path, parts[0], parts[1])
+		}
+		return Document{}, false
+	}
+
+	if parts, ok := relParts(collab.Dir(s.ws.Root), path); ok {
+		// <id>/<file>
+		if len(parts) != 2 {
+			return Document{}, false
+		}
+		switch parts[1] {
+		case "prompt.md":
+			return loadCollabPrompt(path, parts[0])
+		case "conclusion.md":
+			return loadConclusion(path, parts[0], conclusionTypeCollab)
+		}
+		return Document{}, false
+	}
+
+	if parts, ok := relParts(architectsession.Dir(s.ws.Root), path); ok {
+		if len(parts) != 2 || parts[1] != "conclusion.md" {
+			return Document{}, false
+		}
+		return loadConclusion(path, parts[0], conclusionTypeArchitect)
+	}
+
+	return s.loadNote(path, info)
+}
+
+func loadTicket(path, status, id string) (Document, bool) {
+	meta, body, ok := readFrontmatter[ticket.TicketMeta](path)
+	if !ok {
+		return Document{}, false
+	}
+	typ := meta.Type
+	if typ == "" {
+		typ = ticket.DefaultTicketType
+	}
+	return Document{
+		Key:      path,
+		Kind:     KindTicket,
+		ID:       id,
+		Title:    meta.Title,
+		Body:     body,
+		Repo:     meta.Repo,
+		Status:   status,
+		Type:     typ,
+		TicketID: id,
+		Path:     path,
+		Updated:  meta.Updated,
+	}, true
+}
+
+func loadTicketConclusion(path, status, id string) (Document, bool) {
+	meta, body, ok := readFrontmatter[ticket.TicketConclusionMeta](path)
+	if !ok {
+		return Document{}, false
+	}
+	doc := Document{
+		Key:      path,
+		Kind:     KindConclusion,
+		ID:       id,
+		Title:    id,
+		Body:     body,
+		Status:   status,
+		Type:     conclusionTypeWork,
+		TicketID: id,
+		Path:     path,
+		Updated:  meta.ConcludedAt,
+	}
+	if t, _, ok := readFrontmatter[ticket.TicketMeta](filepath.Join(filepath.Dir(path), "ticket.md")); ok {
+		doc.Title = t.Title
+		doc.Repo = t.Repo
+	}
+	return doc, true
+}
+
+func loadCollabPrompt(path, id string) (Document, bool) {
+	meta, body, ok := readFrontmatter[collab.PromptMeta](path)
+	if !ok {
+		return Document{}, false
+	}
+	return Document{
+		Key:     path,
+		Kind:    KindCollab,
+		ID:      id,
+		Title:   headingOr(body, id),
+		Body:    body,
+		Path:    path,
+		Updated: meta.Created,
+	}, true
+}
+
+// loadConclusion loads a collab or architect session conclusion. Both share
+// the same frontmatter shape.
+func loadConclusion(path, id, typ string) (Document, bool) {
+	meta, body, ok := readFrontmatter[architectsession.ConclusionMeta](path)
+	if !ok {
+		return Document{}, false
+	}
+	return Document{
+		Key:     path,
+		Kind:    KindConclusion,
+		ID:      id,
+		Title:   headingOr(body, id),
+		Body:    body,
+		Type:    typ,
+		Path:    path,
+		Updated: meta.ConcludedAt,
+	}, true
+}
+
+func (s *Syncer) loadNote(path string, info fs.FileInfo) (Document, bool) {
+	if info.Size() > maxNoteSize {
+		return Document{}, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Document{}, false
+	}
+	rel, err := filepath.Rel(s.ws.Root, path)
+	if err != nil {
+		rel = path
+	}
+	body := string(data)
+	return Document{
+		Key:     path,
+		Kind:    KindNote,
+		ID:      filepath.ToSlash(rel),
+		Title:   headingOr(body, filepath.ToSlash(rel)),
+		Body:    body,
+		Path:    path,
+		Updated: info.ModTime(),
+	}, true
+}
+
+func readFrontmatter[T any](path string) (*T, string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", false
+	}
+	meta, body, err := storage.ParseFrontmatter[T](data)
+	if err != nil {
+		return nil, "", false
+	}
+	return meta, body, true
+}
+
+// relParts splits path relative to dir, reporting false when path is not
+// inside dir.
+func relParts(dir, path string) ([]string, bool) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return nil, false
+	}
+	return strings.Split(filepath.ToSlash(rel), "/"), true
+}
+
+// headingOr returns the first markdown heading in body, or fallback.
+func headingOr(body, fallback string) string {
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			if title := strings.TrimSpace(strings.TrimLeft(line, "#")); title != "" {
+				return title
+			}
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,111 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func kinds(t *testing.T, idx *Index, query string) map[Kind][]Result {
+	t.Helper()
+	results, _ := idx.Search(mustParse(t, query), 0)
+	byKind := make(map[Kind][]Result)
+	for _, r := range results {
+		byKind[r.Kind] = append(byKind[r.Kind], r)
+	}
+	return byKind
+}
+
+func TestSyncerIndexesWorkspace(t *testing.T) {
+	root := t.TempDir()
+	ticketDir := filepath.Join(root, "tickets", "done", "2026-01-01-0900-fix-cache")
+	writeFile(t, filepath.Join(ticketDir, "ticket.md"),
+		"---\ntitle: Fix cache\nrepo: api\ncreated: 2026-01-01T09:00:00Z\nupdated: 2026-01-02T09:00:00Z\n---\nThe cache is stale.\n")
+	writeFile(t, filepath.Join(ticketDir, "conclusion.md"),
+		"---\nstarted_at: 2026-01-02T09:00:00Z\nconcluded_at: 2026-01-02T10:00:00Z\nagent: claude\n---\nInvalidated the cache on write.\n")
+	writeFile(t, filepath.Join(root, "collabs", "2026-01-03-research", "prompt.md"),
+		"---\ncreated: 2026-01-03T09:00:00Z\nagent: claude\n---\n# Cache research\nCompare cache libraries.\n")
+	writeFile(t, filepath.Join(root, "architect-sessions", "s1", "conclusion.md"),
+		"---\nstarted_at: 2026-01-04T09:00:00Z\nconcluded_at: 2026-01-04T10:00:00Z\nagent: claude\n---\nPlanned cache work.\n")
+	writeFile(t, filepath.Join(root, "docs", "design.md"), "# Design\nCache layers.\n")
+	writeFile(t, filepath.Join(root, "prompts", "architect", "SYSTEM.md"), "cache prompt\n")
+	writeFile(t, filepath.Join(root, ".hidden", "x.md"), "cache hidden\n")
+
+	idx := NewIndex()
+	s := NewSyncer(Workspace{
+		Root:       root,
+		TicketsDir: filepath.Join(root, "tickets"),
+		SkipDirs:   []string{filepath.Join(root, "prompts")},
+	}, idx)
+	if err := s.Sync(); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	got := kinds(t, idx, "cache")
+	if n := len(got[KindTicket]); n != 1 {
+		t.Fatalf("tickets = %d, want 1", n)
+	}
+	tk := got[KindTicket][0]
+	if tk.ID != "2026-01-01-0900-fix-cache" || tk.Status != "done" || tk.Repo != "api" || tk.Type != "work" {
+		t.Errorf("unexpected ticket document: %+v", tk.Document)
+	}
+	if n := len(got[KindConclusion]); n != 2 {
+		t.Fatalf("conclusions = %d, want 2", n)
+	}
+	for _, c := range got[KindConclusion] {
+		if c.Type == conclusionTypeWork && (c.Title != "Fix cache" || c.Repo != "api") {
+			t.Errorf("work conclusion should inherit ticket fields: %+v", c.Document)
+		}
+	}
+	if n := len(got[KindCollab]); n != 1 || got[KindCollab][0].Title != "Cache research" {
+		t.Errorf("unexpected collabs: %+v", got[KindCollab])
+	}
+	if n := len(got[KindNote]); n != 1 || got[KindNote][0].ID != "docs/design.md" {
+		t.Errorf("unexpected notes: %+v", got[KindNote])
+	}
+}
+
+func TestSyncerPicksUpChanges(t *testing.T) {
+	root := t.TempDir()
+	note := filepath.Join(root, "notes.md")
+	writeFile(t, note, "alpha\n")
+
+	idx := NewIndex()
+	s := NewSyncer(Workspace{Root: root, TicketsDir: filepath.Join(root, "tickets")}, idx)
+	if err := s.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, note, "beta gamma\n")
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(note, future, future); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if _, total := idx.Search(mustParse(t, "beta"), 0); total != 1 {
+		t.Error("expected modified note to be re-indexed")
+	}
+
+	if err := os.Remove(note); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if idx.Len() != 0 {
+		t.Errorf("Len() = %d after delete, want 0", idx.Len())
+	}
+}
@@ -0,0 +1,141 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+// QueuedSpawn is a ticket spawn waiting for a concurrency limit.
+type QueuedSpawn struct {
+	TicketID string `json:"ticket_id"`
+	Variant  string `json:"variant"`
+	Mode     string `json:"mode,omitempty"`
+	// Force spawns even if the ticket has open blockers.
+	Force bool `json:"force,omitempty"`
+	// Backend is the session backend to spawn on; "" means tmux.
+	Backend string `json:"backend,omitempty"`
+	// Repo limits a multi-repo ticket's spawn to one of its repos.
+	Repo string `json:"repo,omitempty"`
+	// Rule is the pipeline rule that requested the spawn, if any.
+	Rule string `json:"rule,omitempty"`
+	// Reason is the limit the spawn last waited for.
+	Reason   string    `json:"reason,omitempty"`
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+// Queue is an architect's spawn queue backed by a single JSON file, oldest
+// entry first. A ticket is queued at most once per repo: a multi-repo
+// ticket may wait for each of its repos separately.
+type Queue struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewQueue creates a spawn queue backed by the given file path.
+func NewQueue(path string) *Queue {
+	return &Queue{path: path}
+}
+
+// List returns the queued spawns in queue order.
+func (q *Queue) List() ([]QueuedSpawn, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.load()
+}
+
+// Enqueue adds a spawn to the back of the queue, or updates the entry for
+// the same ticket and repo in place. Returns its 1-based position.
+func (q *Queue) Enqueue(e QueuedSpawn) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.load()
+	if err != nil {
+		return 0, err
+	}
+	if e.QueuedAt.IsZero() {
+		e.QueuedAt = time.Now().UTC()
+	}
+	for i := range entries {
+		if entries[i].TicketID == e.TicketID && entries[i].Repo == e.Repo {
+			e.QueuedAt = entries[i].QueuedAt
+			entries[i] = e
+			return i + 1, q.save(entries)
+		}
+	}
+	entries = append(entries, e)
+	return len(entries), q.save(entries)
+}
+
+// Remove drops the entry for a ticket and repo and reports whether it was
+// queued.
+func (q *Queue) Remove(ticketID, repo string) (bool, error) {
+	return q.removeWhere(func(e QueuedSpawn) bool { return e.TicketID == ticketID && e.Repo == repo })
+}
+
+// RemoveTicket drops every entry of a ticket and reports whether any was
+// queued.
+func (q *Queue) RemoveTicket(ticketID string) (bool, error) {
+	return q.removeWhere(func(e QueuedSpawn) bool { return e.TicketID == ticketID })
+}
+
+func (q *Queue) removeWhere(match func(QueuedSpawn) bool) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.load()
+	if err != nil {
+		return false, err
+	}
+	kept := entries[:0]
+	for _, e := range entries {
+		if !match(e) {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) == len(entries) {
+		return false, nil
+	}
+	return true, q.save(kept)
+}
+
+// load reads the queue file. Returns an empty queue if the file doesn't
+// exist or is empty.
+func (q *Queue) load() ([]QueuedSpawn, error) {
+	data, err := os.ReadFile(q.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read spawn queue: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var entries []QueuedSpawn
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("unmarshal spawn queue: %w", err)
+	}
+	return entries, nil
+}
+
+// save writes the queue file atomically, removing it once empty.
+func (q *Queue) save(entries []QueuedSpawn) error {
+	if len(entries) == 0 {
+		if err := os.Remove(q.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove spawn queue: %w", err)
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal spawn queue: %w", err)
+	}
+	return storage.AtomicWriteFile(q.path, data)
+}
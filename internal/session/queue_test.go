@@ -0,0 +1,94 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestQueue_EnqueueAndRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.json")
+	q := NewQueue(path)
+
+	for i, id := range []string{"a", "b", "c"} {
+		pos, err := q.Enqueue(QueuedSpawn{TicketID: id, Variant: "fast"})
+		if err != nil {
+			t.Fatalf("enqueue %s: %v", id, err)
+		}
+		if pos != i+1 {
+			t.Errorf("position of %s = %d, want %d", id, pos, i+1)
+		}
+	}
+
+	// Re-queueing a ticket updates its entry but keeps its place.
+	pos, err := q.Enqueue(QueuedSpawn{TicketID: "b", Variant: "slow", Reason: "limit"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos != 2 {
+		t.Errorf("re-queued position = %d, want 2", pos)
+	}
+
+	// The queue survives a restart.
+	entries, err := NewQueue(path).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[1].Variant != "slow" || entries[1].Reason != "limit" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	if entries[0].QueuedAt.IsZero() {
+		t.Error("expected queued_at to be set")
+	}
+
+	removed, err := q.Remove("a", "")
+	if err != nil || !removed {
+		t.Fatalf("Remove(a) = %v, %v", removed, err)
+	}
+	if removed, _ := q.Remove("a", ""); removed {
+		t.Error("expected second Remove(a) to report nothing removed")
+	}
+	entries, _ = q.List()
+	if len(entries) != 2 || entries[0].TicketID != "b" {
+		t.Errorf("unexpected entries after remove: %+v", entries)
+	}
+
+	_, _ = q.Remove("b", "")
+	_, _ = q.Remove("c", "")
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected queue file to be removed once empty, got %v", err)
+	}
+}
+
+func TestQueue_EntriesPerRepo(t *testing.T) {
+	q := NewQueue(filepath.Join(t.TempDir(), "queue.json"))
+
+	// Each repo of a multi-repo ticket keeps its own entry.
+	for _, repo := range []string{"api", "web"} {
+		if _, err := q.Enqueue(QueuedSpawn{TicketID: "a", Repo: repo, Variant: "fast"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if pos, _ := q.Enqueue(QueuedSpawn{TicketID: "a", Repo: "web", Variant: "slow"}); pos != 2 {
+		t.Errorf("re-queued web position = %d, want 2", pos)
+	}
+	entries, _ := q.List()
+	if len(entries) != 2 || entries[0].Repo != "api" || entries[1].Variant != "slow" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	if removed, _ := q.Remove("a", "api"); !removed {
+		t.Error("expected Remove(a, api) to remove the api entry")
+	}
+	if entries, _ := q.List(); len(entries) != 1 || entries[0].Repo != "web" {
+		t.Errorf("unexpected entries after remove: %+v", entries)
+	}
+
+	_, _ = q.Enqueue(QueuedSpawn{TicketID: "a", Repo: "api"})
+	if removed, _ := q.RemoveTicket("a"); !removed {
+		t.Error("expected RemoveTicket(a) to remove its entries")
+	}
+	if entries, _ := q.List(); len(entries) != 0 {
+		t.Errorf("expected empty queue, got %+v", entries)
+	}
+}
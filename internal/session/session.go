@@ -21,6 +21,16 @@ const (
 	SessionTypeArchitect SessionType = "architect"
 	SessionTypeTicket    SessionType = "ticket"
 	SessionTypeCollab    SessionType = "collab"
+	// SessionTypeReviewer audits a concluded ticket's commits. It carries
+	// the ticket's ID but is not one of its worker sessions.
+	SessionTypeReviewer SessionType = "reviewer"
+)
+
+// Session backends. Ticket sessions run in a tmux window unless spawned
+// headless, as a supervised child process of the daemon.
+const (
+	BackendTmux     = "tmux"
+	BackendHeadless = "headless"
 )
 
 // ArchitectSessionKey is the session store key for the architect session.
@@ -36,15 +46,33 @@ const ArchitectSessionKey = "architect"
 // so collab and architect sessions (which don't have a TicketID) can be
 // addressed uniformly.
 type Session struct {
-	SessionID  string      `json:"session_id"`
-	Type       SessionType `json:"type"`
-	TicketID   string      `json:"ticket_id,omitempty"`
-	CollabID   string      `json:"collab_id,omitempty"`
-	Prompt     string      `json:"prompt,omitempty"`
-	Agent      string      `json:"agent"`
+	SessionID string      `json:"session_id"`
+	Type      SessionType `json:"type"`
+	TicketID  string      `json:"ticket_id,omitempty"`
+	CollabID  string      `json:"collab_id,omitempty"`
+	Prompt    string      `json:"prompt,omitempty"`
+	Agent     string      `json:"agent"`
+	// Variant is the agent variant a ticket session was spawned with.
+	Variant string `json:"variant,omitempty"`
+	// Backend runs the session: empty for tmux, or BackendHeadless. Headless
+	// sessions keep TmuxWindow as their name.
+	Backend    string      `json:"backend,omitempty"`
 	TmuxWindow string      `json:"tmux_window"`
 	StartedAt  time.Time   `json:"started_at"`
 	Status     AgentStatus `json:"status"`
 	Tool       *string     `json:"tool,omitempty"`
 	Work       *string     `json:"work,omitempty"`
+
+	// WorktreePath is the git worktree a ticket session runs in when its
+	// repo uses isolation: worktree.
+	WorktreePath string `json:"worktree_path,omitempty"`
+
+	// Repo is the repo a multi-repo ticket's session works in. It is empty
+	// for tickets with a single repo, which have one session.
+	Repo string `json:"repo,omitempty"`
+}
+
+// IsHeadless reports whether the session runs without tmux.
+func (s *Session) IsHeadless() bool {
+	return s.Backend == BackendHeadless
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -15,8 +16,8 @@ import (
 // Store manages session state backed by a single JSON file.
 //
 // The on-disk map is keyed by the canonical SessionID UUID, minted at
-// creation time. Architect, ticket and collab sessions share the same
-// routing key so callers can address every session uniformly via
+// creation time. Architect, ticket, collab and reviewer sessions share the
+// same routing key so callers can address every session uniformly via
 // /agent/status?session_id=<uuid>.
 type Store struct {
 	path string
@@ -41,7 +42,16 @@ func NewSessionID() string {
 
 // Create adds a new ticket session and returns the created session. The
 // session's SessionID field holds the canonical UUID routing key.
-func (s *Store) Create(ticketID, agent, tmuxWindow string) (*Session, error) {
+// variant is the agent variant it was spawned with, if known, and backend
+// is empty for tmux. worktreePath is empty unless the ticket runs in an
+// isolated git worktree.
+func (s *Store) Create(ticketID, agent, variant, backend, tmuxWindow, worktreePath string) (*Session, error) {
+	return s.CreateForRepo(ticketID, "", agent, variant, backend, tmuxWindow, worktreePath)
+}
+
+// CreateForRepo is Create for one repo of a multi-repo ticket, which has a
+// session per repo. An empty repo creates the ticket's only session.
+func (s *Store) CreateForRepo(ticketID, repo, agent, variant, backend, tmuxWindow, worktreePath string) (*Session, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -51,13 +61,17 @@ func (s *Store) Create(ticketID, agent, tmuxWindow string) (*Session, error) {
 	}
 
 	sess := &Session{
-		SessionID:  NewSessionID(),
-		Type:       SessionTypeTicket,
-		TicketID:   ticketID,
-		Agent:      agent,
-		TmuxWindow: tmuxWindow,
-		StartedAt:  time.Now().UTC(),
-		Status:     AgentStatusStarting,
+		SessionID:    NewSessionID(),
+		Type:         SessionTypeTicket,
+		TicketID:     ticketID,
+		Agent:        agent,
+		Variant:      variant,
+		Backend:      backend,
+		TmuxWindow:   tmuxWindow,
+		StartedAt:    time.Now().UTC(),
+		Status:       AgentStatusStarting,
+		WorktreePath: worktreePath,
+		Repo:         repo,
 	}
 
 	sessions[sess.SessionID] = sess
@@ -132,6 +146,77 @@ func (s *Store) EndCollab(collabID string) error {
 	return &storage.NotFoundError{Resource: "session", ID: collabID}
 }
 
+// CreateReviewer adds the reviewer session of a ticket. A ticket has at
+// most one reviewer; any existing one is replaced.
+func (s *Store) CreateReviewer(ticketID, agent, variant, backend, tmuxWindow string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	for id, sess := range sessions {
+		if sess.Type == SessionTypeReviewer && sess.TicketID == ticketID {
+			delete(sessions, id)
+		}
+	}
+
+	sess := &Session{
+		SessionID:  NewSessionID(),
+		Type:       SessionTypeReviewer,
+		TicketID:   ticketID,
+		Agent:      agent,
+		Variant:    variant,
+		Backend:    backend,
+		TmuxWindow: tmuxWindow,
+		StartedAt:  time.Now().UTC(),
+		Status:     AgentStatusStarting,
+	}
+
+	sessions[sess.SessionID] = sess
+
+	if err := s.save(sessions); err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+// GetReviewer retrieves the reviewer session of a ticket.
+func (s *Store) GetReviewer(ticketID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, sess := range sessions {
+		if sess.Type == SessionTypeReviewer && sess.TicketID == ticketID {
+			return sess, nil
+		}
+	}
+	return nil, &storage.NotFoundError{Resource: "session", ID: "review/" + ticketID}
+}
+
+// EndReviewer removes the reviewer session of a ticket.
+func (s *Store) EndReviewer(ticketID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+	for id, sess := range sessions {
+		if sess.Type == SessionTypeReviewer && sess.TicketID == ticketID {
+			delete(sessions, id)
+			return s.save(sessions)
+		}
+	}
+	return &storage.NotFoundError{Resource: "session", ID: "review/" + ticketID}
+}
+
 // CreateArchitect adds the architect session with the given sessionID.
 // There is at most one architect session per store; any existing architect
 // is replaced. sessionID must be a Hiveryn-compatible timestamp ID
@@ -203,23 +288,60 @@ func (s *Store) EndArchitect() error {
 	return &storage.NotFoundError{Resource: "session", ID: ArchitectSessionKey}
 }
 
-// GetByTicketID retrieves a ticket session by full ticket ID.
+// GetByTicketID retrieves a ticket session by full ticket ID. For a
+// multi-repo ticket it returns the earliest started of its sessions.
 func (s *Store) GetByTicketID(ticketID string) (*Session, error) {
+	list, err := s.ListByTicketID(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, &storage.NotFoundError{Resource: "session", ID: ticketID}
+	}
+	return list[0], nil
+}
+
+// ListByTicketID returns every session of a ticket, earliest started first.
+func (s *Store) ListByTicketID(ticketID string) ([]*Session, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	sessions, err := s.load()
 	if err != nil {
 		return nil, err
 	}
+	var list []*Session
 	for _, sess := range sessions {
 		if sess.Type == SessionTypeTicket && sess.TicketID == ticketID {
+			list = append(list, sess)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].StartedAt.Equal(list[j].StartedAt) {
+			return list[i].StartedAt.Before(list[j].StartedAt)
+		}
+		return list[i].Repo < list[j].Repo
+	})
+	return list, nil
+}
+
+// GetByTicketRepo retrieves the session of a ticket working in repo. An
+// empty repo matches the session of a single-repo ticket.
+func (s *Store) GetByTicketRepo(ticketID, repo string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, sess := range sessions {
+		if sess.Type == SessionTypeTicket && sess.TicketID == ticketID && sess.Repo == repo {
 			return sess, nil
 		}
 	}
-	return nil, &storage.NotFoundError{Resource: "session", ID: ticketID}
+	return nil, &storage.NotFoundError{Resource: "session", ID: ticketRepoKey(ticketID, repo)}
 }
 
-// EndByTicketID removes a ticket session identified by its ticket ID.
+// EndByTicketID removes every session of a ticket.
 func (s *Store) EndByTicketID(ticketID string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -227,13 +349,41 @@ func (s *Store) EndByTicketID(ticketID string) error {
 	if err != nil {
 		return err
 	}
+	found := false
 	for id, sess := range sessions {
 		if sess.Type == SessionTypeTicket && sess.TicketID == ticketID {
+			delete(sessions, id)
+			found = true
+		}
+	}
+	if !found {
+		return &storage.NotFoundError{Resource: "session", ID: ticketID}
+	}
+	return s.save(sessions)
+}
+
+// EndByTicketRepo removes the session of a ticket working in repo.
+func (s *Store) EndByTicketRepo(ticketID, repo string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+	for id, sess := range sessions {
+		if sess.Type == SessionTypeTicket && sess.TicketID == ticketID && sess.Repo == repo {
 			delete(sessions, id)
 			return s.save(sessions)
 		}
 	}
-	return &storage.NotFoundError{Resource: "session", ID: ticketID}
+	return &storage.NotFoundError{Resource: "session", ID: ticketRepoKey(ticketID, repo)}
+}
+
+func ticketRepoKey(ticketID, repo string) string {
+	if repo == "" {
+		return ticketID
+	}
+	return ticketID + "/" + repo
 }
 
 // List returns all active sessions keyed by SessionID UUID.
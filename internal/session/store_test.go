@@ -26,7 +26,7 @@ func TestCreate(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	sess, err := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "fix-auth-bug")
+	sess, err := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "", "", "fix-auth-bug", "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -55,7 +55,7 @@ func TestGetBySessionID(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	sess, err := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "window")
+	sess, err := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "", "", "window", "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -75,7 +75,7 @@ func TestGetByTicketID(t *testing.T) {
 	defer cleanup()
 
 	ticketID := "a1b2c3d4-e5f6-7890-abcd-ef0123456789"
-	if _, err := store.Create(ticketID, "claude", "window"); err != nil {
+	if _, err := store.Create(ticketID, "claude", "", "", "window", ""); err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
 
@@ -103,7 +103,7 @@ func TestUpdateStatusBySessionID(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	sess, _ := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "window")
+	sess, _ := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "", "", "window", "")
 
 	tool := "Edit"
 	if err := store.UpdateStatusBySessionID(sess.SessionID, AgentStatusWorking, &tool, nil); err != nil {
@@ -133,7 +133,7 @@ func TestEndBySessionID(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	sess, _ := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "window")
+	sess, _ := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "", "", "window", "")
 
 	if err := store.EndBySessionID(sess.SessionID); err != nil {
 		t.Fatalf("End failed: %v", err)
@@ -150,7 +150,7 @@ func TestEndByTicketID(t *testing.T) {
 	defer cleanup()
 
 	ticketID := "a1b2c3d4-e5f6-7890-abcd-ef0123456789"
-	if _, err := store.Create(ticketID, "claude", "window"); err != nil {
+	if _, err := store.Create(ticketID, "claude", "", "", "window", ""); err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
 
@@ -166,8 +166,8 @@ func TestList(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	_, _ = store.Create("a1b2c3d4-0000-0000-0000-000000000001", "claude", "window1")
-	_, _ = store.Create("b2c3d4e5-0000-0000-0000-000000000002", "opencode", "window2")
+	_, _ = store.Create("a1b2c3d4-0000-0000-0000-000000000001", "claude", "", "", "window1", "")
+	_, _ = store.Create("b2c3d4e5-0000-0000-0000-000000000002", "opencode", "", "", "window2", "")
 
 	sessions, err := store.List()
 	if err != nil {
@@ -215,7 +215,7 @@ func TestCreateSetsTicketType(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	sess, err := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "window")
+	sess, err := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "", "", "window", "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -269,7 +269,7 @@ func TestConcurrentAccess(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	sess, _ := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "window")
+	sess, _ := store.Create("a1b2c3d4-e5f6-7890-abcd-ef0123456789", "claude", "", "", "window", "")
 
 	const goroutines = 10
 	var wg sync.WaitGroup
@@ -293,3 +293,82 @@ func TestConcurrentAccess(t *testing.T) {
 		t.Errorf("status = %q, want %q", got.Status, AgentStatusWorking)
 	}
 }
+
+func TestMultiRepoTicketSessions(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	const ticketID = "a1b2c3d4-e5f6-7890-abcd-ef0123456789"
+	api, err := store.CreateForRepo(ticketID, "api", "claude", "", "", "rename-api", "")
+	if err != nil {
+		t.Fatalf("CreateForRepo failed: %v", err)
+	}
+	if _, err := store.CreateForRepo(ticketID, "web", "claude", "", "", "rename-web", ""); err != nil {
+		t.Fatalf("CreateForRepo failed: %v", err)
+	}
+
+	got, err := store.GetByTicketRepo(ticketID, "web")
+	if err != nil || got.TmuxWindow != "rename-web" || got.Repo != "web" {
+		t.Fatalf("GetByTicketRepo(web) = %+v, %v", got, err)
+	}
+	if _, err := store.GetByTicketRepo(ticketID, ""); !storage.IsNotFound(err) {
+		t.Errorf("GetByTicketRepo(\"\") err = %v, want not found", err)
+	}
+	if first, _ := store.GetByTicketID(ticketID); first == nil || first.SessionID != api.SessionID {
+		t.Errorf("GetByTicketID should return the earliest session")
+	}
+
+	if err := store.EndByTicketRepo(ticketID, "api"); err != nil {
+		t.Fatalf("EndByTicketRepo failed: %v", err)
+	}
+	list, _ := store.ListByTicketID(ticketID)
+	if len(list) != 1 || list[0].Repo != "web" {
+		t.Fatalf("after ending api: %+v", list)
+	}
+
+	if _, err := store.CreateForRepo(ticketID, "api", "claude", "", "", "rename-api", ""); err != nil {
+		t.Fatalf("CreateForRepo failed: %v", err)
+	}
+	if err := store.EndByTicketID(ticketID); err != nil {
+		t.Fatalf("EndByTicketID failed: %v", err)
+	}
+	if list, _ := store.ListByTicketID(ticketID); len(list) != 0 {
+		t.Errorf("EndByTicketID left %d sessions", len(list))
+	}
+}
+
+func TestReviewerSessionIsSeparateFromWorker(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	const ticketID = "a1b2c3d4-e5f6-7890-abcd-ef0123456789"
+	if _, err := store.Create(ticketID, "claude", "", "", "worker", ""); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	_, _ = store.CreateReviewer(ticketID, "claude", "fast", "", "review-1")
+	reviewer, err := store.CreateReviewer(ticketID, "codex", "deep", BackendHeadless, "review-2")
+	if err != nil {
+		t.Fatalf("CreateReviewer failed: %v", err)
+	}
+	if reviewer.Type != SessionTypeReviewer || reviewer.Variant != "deep" || !reviewer.IsHeadless() {
+		t.Errorf("unexpected reviewer session: %+v", reviewer)
+	}
+
+	workers, _ := store.ListByTicketID(ticketID)
+	if len(workers) != 1 || workers[0].TmuxWindow != "worker" {
+		t.Errorf("reviewer must not count as a worker session, got %+v", workers)
+	}
+	if got, err := store.GetReviewer(ticketID); err != nil || got.SessionID != reviewer.SessionID {
+		t.Errorf("GetReviewer = %+v, %v; want the replacing reviewer", got, err)
+	}
+
+	if err := store.EndReviewer(ticketID); err != nil {
+		t.Fatalf("EndReviewer failed: %v", err)
+	}
+	if _, err := store.GetReviewer(ticketID); !storage.IsNotFound(err) {
+		t.Errorf("expected NotFound after EndReviewer, got %v", err)
+	}
+	if _, err := store.GetByTicketID(ticketID); err != nil {
+		t.Errorf("worker session should survive EndReviewer: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package session
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// TimelinesDir is the directory, next to a conclusion, that holds the
+// timelines of the sessions that worked on it: one <session-id>.jsonl each.
+const TimelinesDir = "timelines"
+
+// TimelineEntry is one normalized hook event recorded for a session.
+type TimelineEntry struct {
+	Time    time.Time   `json:"time"`
+	Agent   string      `json:"agent,omitempty"`
+	Status  AgentStatus `json:"status,omitempty"`
+	Tool    string      `json:"tool,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// TimelinePath returns the timeline file for a session under dir, the
+// directory holding the session's conclusion.
+func TimelinePath(dir, sessionID string) string {
+	return filepath.Join(dir, TimelinesDir, sessionID+".jsonl")
+}
+
+// ValidSessionID reports whether id is safe to use as a file name, and
+// free of glob metacharacters.
+func ValidSessionID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\*?[`)
+}
+
+// AppendTimeline appends entries to a timeline file, creating it if needed.
+func AppendTimeline(path string, entries ...TimelineEntry) error {
+	var data []byte
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal timeline entry: %w", err)
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create timelines dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("open timeline: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write timeline: %w", err)
+	}
+	return f.Close()
+}
+
+// ReadTimeline reads a timeline file, oldest entry first. Unparseable lines
+// (e.g. a torn final write) are skipped.
+func ReadTimeline(path string) ([]TimelineEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []TimelineEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var e TimelineEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read timeline: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,56 @@
+package session
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestTimelineAppendAndRead(t *testing.T) {
+	dir := t.TempDir()
+	path := TimelinePath(dir, "abc123")
+
+	if _, err := ReadTimeline(path); !os.IsNotExist(err) {
+		t.Fatalf("expected not-exist error for missing timeline, got %v", err)
+	}
+
+	at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	if err := AppendTimeline(path, TimelineEntry{Time: at, Status: AgentStatusWorking, Tool: "Bash"}); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if err := AppendTimeline(path, TimelineEntry{Time: at.Add(time.Minute), Status: AgentStatusIdle}); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+
+	// A torn final write is skipped.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"time":"2026-03`)
+	_ = f.Close()
+
+	entries, err := ReadTimeline(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Tool != "Bash" || entries[1].Status != AgentStatusIdle {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestValidSessionID(t *testing.T) {
+	for _, id := range []string{"abc123", "2026-03-01-1000"} {
+		if !ValidSessionID(id) {
+			t.Errorf("expected %q to be valid", id)
+		}
+	}
+	for _, id := range []string{"", ".", "..", "../x", `a\b`, "*", "a?", "[ab]"} {
+		if ValidSessionID(id) {
+			t.Errorf("expected %q to be invalid", id)
+		}
+	}
+}
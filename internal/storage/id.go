@@ -51,9 +51,9 @@ func resolveCollision(base string, exists func(string) bool) (string, error) {
 	return "", fmt.Errorf("unable to resolve collision after 10000 attempts for %q", base)
 }
 
-func NewTicketIDFromCreated(created time.Time, title string, rootDir string) (string, error) {
+func NewTicketIDFromCreated(created time.Time, title string, rootDir string, statuses []string) (string, error) {
 	checker := func(folder string) bool {
-		for _, status := range []string{"backlog", "progress", "done"} {
+		for _, status := range statuses {
 			if _, err := os.Stat(filepath.Join(rootDir, status, folder)); err == nil {
 				return true
 			}
@@ -0,0 +1,181 @@
+package ticket
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+)
+
+// Criterion is an acceptance criterion: a "- [ ]" checklist item under a
+// heading starting with "Acceptance" in the ticket body.
+type Criterion struct {
+	// Index is the 1-based position of the item in the checklist.
+	Index   int
+	Text    string
+	Checked bool
+	// Evidence is the text of an indented "- Evidence:" line directly
+	// under the item.
+	Evidence string
+
+	line int
+}
+
+var (
+	criteriaHeadingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	criteriaItemRe     = regexp.MustCompile(`^(\s*)[-*+] \[([ xX])\]\s+(.*)$`)
+	criteriaEvidenceRe = regexp.MustCompile(`^(\s+)[-*+] Evidence:\s*(.*)$`)
+)
+
+// ParseCriteria returns the acceptance criteria in body, in order.
+func ParseCriteria(body string) []Criterion {
+	var criteria []Criterion
+	lines := strings.Split(body, "\n")
+	inFence := false
+	level := 0 // heading level of the acceptance section; 0 outside it
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := criteriaHeadingRe.FindStringSubmatch(line); m != nil {
+			switch {
+			case strings.HasPrefix(strings.ToLower(m[2]), "acceptance"):
+				level = len(m[1])
+			case level > 0 && len(m[1]) <= level:
+				level = 0
+			}
+			continue
+		}
+		if level == 0 {
+			continue
+		}
+		m := criteriaItemRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		c := Criterion{
+			Index:   len(criteria) + 1,
+			Text:    strings.TrimSpace(m[3]),
+			Checked: m[2] != " ",
+			line:    i,
+		}
+		if i+1 < len(lines) {
+			if e := criteriaEvidenceRe.FindStringSubmatch(lines[i+1]); e != nil && len(e[1]) > len(m[1]) {
+				c.Evidence = strings.TrimSpace(e[2])
+			}
+		}
+		criteria = append(criteria, c)
+	}
+	return criteria
+}
+
+// Criteria returns the acceptance criteria in the ticket body.
+func (t *Ticket) Criteria() []Criterion {
+	return ParseCriteria(t.Body)
+}
+
+// CriteriaProgress counts the checked criteria.
+func CriteriaProgress(criteria []Criterion) (done, total int) {
+	for _, c := range criteria {
+		if c.Checked {
+			done++
+		}
+	}
+	return done, len(criteria)
+}
+
+// UncheckedCriteria returns the criteria not yet checked.
+func UncheckedCriteria(criteria []Criterion) []Criterion {
+	var out []Criterion
+	for _, c := range criteria {
+		if !c.Checked {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// SetCriterion rewrites body with criterion index checked or unchecked.
+// A non-empty evidence replaces the item's evidence line, or adds one.
+func SetCriterion(body string, index int, checked bool, evidence string) (string, error) {
+	criteria := ParseCriteria(body)
+	if index < 1 || index > len(criteria) {
+		if len(criteria) == 0 {
+			return "", &ValidationError{Field: "index", Message: "the ticket has no acceptance criteria"}
+		}
+		return "", &ValidationError{Field: "index", Message: fmt.Sprintf("must be between 1 and %d", len(criteria))}
+	}
+	c := criteria[index-1]
+
+	lines := strings.Split(body, "\n")
+	m := criteriaItemRe.FindStringSubmatch(lines[c.line])
+	box := " "
+	if checked {
+		box = "x"
+	}
+	lines[c.line] = strings.Replace(lines[c.line], "["+m[2]+"]", "["+box+"]", 1)
+
+	evidence = strings.Join(strings.Fields(evidence), " ")
+	if evidence != "" {
+		evidenceLine := m[1] + "  - Evidence: " + evidence
+		if c.Evidence != "" || (c.line+1 < len(lines) && criteriaEvidenceRe.MatchString(lines[c.line+1])) {
+			lines[c.line+1] = evidenceLine
+		} else {
+			lines = append(lines[:c.line+1], append([]string{evidenceLine}, lines[c.line+1:]...)...)
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// CheckCriterion checks or unchecks an acceptance criterion of a ticket.
+func (s *Store) CheckCriterion(id string, index int, checked bool, evidence string) (*Ticket, error) {
+	return s.CheckCriterionAs(DaemonActor, id, index, checked, evidence)
+}
+
+// CheckCriterionAs is CheckCriterion with the change attributed to actor
+// in the ticket history.
+func (s *Store) CheckCriterionAs(actor Actor, id string, index int, checked bool, evidence string) (*Ticket, error) {
+	mu := s.ticketMu(id)
+	mu.Lock()
+	defer mu.Unlock()
+
+	entityDir, status, err := s.findEntityDirAllStatuses(id)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := s.loadFromDir(entityDir)
+	if err != nil {
+		return nil, err
+	}
+	ticket.ID = id
+	ticket.Status = status
+
+	body, err := SetCriterion(ticket.Body, index, checked, evidence)
+	if err != nil {
+		return nil, err
+	}
+	if body == ticket.Body {
+		return ticket, nil
+	}
+
+	before := *ticket
+	ticket.Body = body
+	ticket.Updated = time.Now().UTC()
+
+	if err := s.writeFile(entityDir, ticket); err != nil {
+		return nil, fmt.Errorf("save ticket: %w", err)
+	}
+	if err := s.recordRevision(entityDir, &before, ticket, actor, ActionCriterionChecked, 0); err != nil {
+		return nil, err
+	}
+
+	s.Emit(events.TicketUpdated, ticket.ID, nil)
+	return ticket, nil
+}
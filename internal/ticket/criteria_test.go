@@ -0,0 +1,92 @@
+package ticket
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const criteriaBody = `## Context
+
+- [ ] not a criterion
+
+## Acceptance criteria
+
+- [x] parser handles empty input
+  - Evidence: TestParseEmpty
+- [ ] errors carry line numbers
+
+### Notes
+
+- [ ] nested heading stays in the section
+
+` + "```" + `
+- [ ] inside a code fence
+` + "```" + `
+
+## Out of scope
+
+- [ ] not a criterion either
+`
+
+func TestParseCriteria(t *testing.T) {
+	criteria := ParseCriteria(criteriaBody)
+	if len(criteria) != 3 {
+		t.Fatalf("expected 3 criteria, got %+v", criteria)
+	}
+	if c := criteria[0]; c.Index != 1 || !c.Checked || c.Text != "parser handles empty input" || c.Evidence != "TestParseEmpty" {
+		t.Errorf("unexpected first criterion: %+v", c)
+	}
+	if c := criteria[2]; c.Index != 3 || c.Checked || c.Text != "nested heading stays in the section" {
+		t.Errorf("unexpected third criterion: %+v", c)
+	}
+	if done, total := CriteriaProgress(criteria); done != 1 || total != 3 {
+		t.Errorf("progress = %d/%d, want 1/3", done, total)
+	}
+	if len(ParseCriteria("no checklist here")) != 0 {
+		t.Error("expected no criteria without an acceptance heading")
+	}
+}
+
+func TestCheckCriterion(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	tk, err := store.Create("Criteria", criteriaBody, nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	var vErr *ValidationError
+	if _, err := store.CheckCriterion(tk.ID, 4, true, ""); !errors.As(err, &vErr) {
+		t.Fatalf("expected ValidationError for an out-of-range index, got %v", err)
+	}
+
+	updated, err := store.CheckCriterionAs(Actor{Kind: ActorWorker}, tk.ID, 2, true, "TestLineNumbers\npasses")
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !strings.Contains(updated.Body, "- [x] errors carry line numbers\n  - Evidence: TestLineNumbers passes\n") {
+		t.Errorf("criterion not checked with evidence:\n%s", updated.Body)
+	}
+
+	updated, err = store.CheckCriterion(tk.ID, 1, false, "reverted")
+	if err != nil {
+		t.Fatalf("uncheck: %v", err)
+	}
+	criteria := updated.Criteria()
+	if criteria[0].Checked || criteria[0].Evidence != "reverted" || !criteria[1].Checked {
+		t.Errorf("unexpected criteria after uncheck: %+v", criteria)
+	}
+	if strings.Count(updated.Body, "Evidence:") != 2 {
+		t.Errorf("expected the evidence line to be replaced, got:\n%s", updated.Body)
+	}
+
+	revs, err := store.History(tk.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if last := revs[len(revs)-1]; last.Action != ActionCriterionChecked {
+		t.Errorf("expected last revision %q, got %q", ActionCriterionChecked, last.Action)
+	}
+}
@@ -0,0 +1,149 @@
+package ticket
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 2
+
+// maxDiffCells bounds the LCS table; larger bodies fall back to a whole-body
+// replacement diff.
+const maxDiffCells = 4 << 20
+
+type diffOp struct {
+	kind byte // ' ', '-', '+'
+	line string
+}
+
+// UnifiedDiff returns a unified line diff from old to new, or "" when they
+// are equal.
+func UnifiedDiff(old, new string) string {
+	if old == new {
+		return ""
+	}
+	a, b := splitDiffLines(old), splitDiffLines(new)
+	ops := diffOps(a, b)
+
+	var out strings.Builder
+	for start := 0; start < len(ops); {
+		// Find the next change.
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		// Extend the hunk while changes are within 2*context lines.
+		end := first
+		for i := first; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				end = i + 1
+				continue
+			}
+			if i-end >= 2*diffContext {
+				break
+			}
+		}
+		from := max(start, first-diffContext)
+		to := min(len(ops), end+diffContext)
+
+		oldLine, newLine := 1, 1
+		for _, op := range ops[:from] {
+			if op.kind != '+' {
+				oldLine++
+			}
+			if op.kind != '-' {
+				newLine++
+			}
+		}
+		oldCount, newCount := 0, 0
+		for _, op := range ops[from:to] {
+			if op.kind != '+' {
+				oldCount++
+			}
+			if op.kind != '-' {
+				newCount++
+			}
+		}
+
+		// An empty range names the line before it, as in GNU diff.
+		if oldCount == 0 {
+			oldLine--
+		}
+		if newCount == 0 {
+			newLine--
+		}
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", oldLine, oldCount, newLine, newCount)
+		for _, op := range ops[from:to] {
+			out.WriteByte(op.kind)
+			out.WriteString(op.line)
+			out.WriteByte('\n')
+		}
+		start = to
+	}
+	return out.String()
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffOps computes an edit script via the longest common subsequence.
+func diffOps(a, b []string) []diffOp {
+	if len(a)*len(b) > maxDiffCells {
+		ops := make([]diffOp, 0, len(a)+len(b))
+		for _, l := range a {
+			ops = append(ops, diffOp{'-', l})
+		}
+		for _, l := range b {
+			ops = append(ops, diffOp{'+', l})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
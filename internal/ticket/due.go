@@ -0,0 +1,28 @@
+package ticket
+
+import "time"
+
+// DueState describes how close an open ticket is to its due date.
+type DueState string
+
+const (
+	DueStateNone    DueState = ""
+	DueStateSoon    DueState = "due_soon"
+	DueStateOverdue DueState = "overdue"
+)
+
+// DueStateAt returns the due state of t at now, where window is how long
+// before its due date a ticket counts as due soon. Done tickets and
+// tickets without a due date are never due.
+func (t *Ticket) DueStateAt(now time.Time, window time.Duration) DueState {
+	if t.Due == nil || t.Status == StatusDone {
+		return DueStateNone
+	}
+	if t.Due.Before(now) {
+		return DueStateOverdue
+	}
+	if t.Due.Before(now.Add(window)) {
+		return DueStateSoon
+	}
+	return DueStateNone
+}
@@ -0,0 +1,36 @@
+package ticket
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDueStateAt(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		due := now.Add(d)
+		return &due
+	}
+
+	tests := []struct {
+		name   string
+		due    *time.Time
+		status Status
+		want   DueState
+	}{
+		{"no due date", nil, StatusBacklog, DueStateNone},
+		{"past due", at(-time.Hour), StatusBacklog, DueStateOverdue},
+		{"past due in progress", at(-time.Hour), StatusProgress, DueStateOverdue},
+		{"past due but done", at(-time.Hour), StatusDone, DueStateNone},
+		{"within window", at(3 * time.Hour), StatusReview, DueStateSoon},
+		{"beyond window", at(48 * time.Hour), StatusBacklog, DueStateNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk := &Ticket{Status: tt.status, TicketMeta: TicketMeta{Due: tt.due}}
+			if got := tk.DueStateAt(now, 24*time.Hour); got != tt.want {
+				t.Errorf("DueStateAt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
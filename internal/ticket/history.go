@@ -0,0 +1,323 @@
+package ticket
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+)
+
+// historyFileName holds a ticket's revisions, one JSON object per line,
+// next to ticket.md in the entity directory.
+const historyFileName = "history.jsonl"
+
+// deletedHistoryDir keeps the history of deleted tickets under the tickets
+// root, as <id>.jsonl.
+const deletedHistoryDir = ".deleted"
+
+// Actor kinds recorded on revisions.
+const (
+	ActorArchitect = "architect"
+	ActorWorker    = "worker"
+	ActorCollab    = "collab"
+	ActorReviewer  = "reviewer"
+	ActorCLI       = "cli"
+	ActorTUI       = "tui"
+	ActorDaemon    = "daemon"
+	// ActorPipeline marks changes made by a cortex.yaml pipeline rule.
+	ActorPipeline = "pipeline"
+	// ActorScheduler marks tickets created by a recurring schedule.
+	ActorScheduler = "scheduler"
+	// ActorExternal marks changes made to ticket.md outside cortex, such as
+	// in an editor, detected when the next revision is recorded.
+	ActorExternal = "external"
+)
+
+// Revision actions.
+const (
+	ActionCreated          = "created"
+	ActionUpdated          = "updated"
+	ActionBodyEdited       = "body_edited"
+	ActionMoved            = "moved"
+	ActionDueDateSet       = "due_date_set"
+	ActionDueDateCleared   = "due_date_cleared"
+	ActionDeleted          = "deleted"
+	ActionRestored         = "restored"
+	ActionEditedExternally = "edited_externally"
+	ActionCriterionChecked = "criterion_checked"
+)
+
+// Actor identifies who made a change.
+type Actor struct {
+	Kind      string `json:"kind"`
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// DaemonActor is used for changes made by cortex itself or by callers that
+// did not identify themselves.
+var DaemonActor = Actor{Kind: ActorDaemon}
+
+// FieldChange is a single frontmatter field change.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// Revision is one recorded ticket mutation.
+type Revision struct {
+	Seq      int           `json:"seq"`
+	Time     time.Time     `json:"time"`
+	TicketID string        `json:"ticket_id"`
+	Actor    Actor         `json:"actor"`
+	Action   string        `json:"action"`
+	Changes  []FieldChange `json:"changes,omitempty"`
+	BodyDiff string        `json:"body_diff,omitempty"`
+	// Body is the full body after this revision, stored on the first
+	// revision and whenever the body changed so older bodies can be restored.
+	Body *string `json:"body,omitempty"`
+	// RestoredFrom is the revision whose body was restored.
+	RestoredFrom int `json:"restored_from,omitempty"`
+}
+
+// History returns a ticket's revisions, oldest first. The history of a
+// deleted ticket stays readable by its last ID.
+func (s *Store) History(id string) ([]Revision, error) {
+	entityDir, _, err := s.findEntityDirAllStatuses(id)
+	if err == nil {
+		return readHistory(filepath.Join(entityDir, historyFileName))
+	}
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	path := s.deletedHistoryPath(id)
+	if _, statErr := os.Stat(path); statErr != nil {
+		return nil, err
+	}
+	return readHistory(path)
+}
+
+// RestoreBody sets the ticket body back to how it was at revision seq.
+func (s *Store) RestoreBody(actor Actor, id string, seq int) (*Ticket, error) {
+	mu := s.ticketMu(id)
+	mu.Lock()
+	defer mu.Unlock()
+
+	entityDir, status, err := s.findEntityDirAllStatuses(id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := readHistory(filepath.Join(entityDir, historyFileName))
+	if err != nil {
+		return nil, err
+	}
+	body, ok := bodyAt(revisions, seq)
+	if !ok {
+		return nil, &NotFoundError{Resource: "revision", ID: fmt.Sprintf("%s#%d", id, seq)}
+	}
+
+	ticket, err := s.loadFromDir(entityDir)
+	if err != nil {
+		return nil, err
+	}
+	ticket.ID = id
+	ticket.Status = status
+	before := *ticket
+
+	ticket.Body = body
+	ticket.Updated = time.Now().UTC()
+
+	if err := s.writeFile(entityDir, ticket); err != nil {
+		return nil, fmt.Errorf("save ticket: %w", err)
+	}
+	if err := s.recordRevision(entityDir, &before, ticket, actor, ActionRestored, seq); err != nil {
+		return nil, err
+	}
+
+	s.Emit(events.TicketUpdated, ticket.ID, nil)
+	return ticket, nil
+}
+
+// bodyAt returns the body as of revision seq: the latest body snapshot at
+// or before it.
+func bodyAt(revisions []Revision, seq int) (string, bool) {
+	found := false
+	var body string
+	for _, rev := range revisions {
+		if rev.Seq > seq {
+			break
+		}
+		if rev.Seq == seq {
+			found = true
+		}
+		if rev.Body != nil {
+			body = *rev.Body
+		}
+	}
+	return body, found
+}
+
+// recordRevision appends a revision describing the change from before to
+// after. A nil before records a creation. If ticket.md was changed outside
+// cortex since the last revision, that change is recorded first.
+func (s *Store) recordRevision(entityDir string, before, after *Ticket, actor Actor, action string, restoredFrom int) error {
+	path := filepath.Join(entityDir, historyFileName)
+	revisions, err := readHistory(path)
+	if err != nil {
+		return err
+	}
+	seq := len(revisions)
+	now := time.Now().UTC()
+
+	var pending []Revision
+	if before != nil && len(revisions) > 0 {
+		if last, _ := bodyAt(revisions, revisions[len(revisions)-1].Seq); last != before.Body {
+			seq++
+			body := before.Body
+			pending = append(pending, Revision{
+				Seq:      seq,
+				Time:     before.Updated,
+				TicketID: before.ID,
+				Actor:    Actor{Kind: ActorExternal},
+				Action:   ActionEditedExternally,
+				BodyDiff: UnifiedDiff(last, before.Body),
+				Body:     &body,
+			})
+		}
+	}
+
+	seq++
+	rev := Revision{
+		Seq:          seq,
+		Time:         now,
+		TicketID:     after.ID,
+		Actor:        actor,
+		Action:       action,
+		RestoredFrom: restoredFrom,
+	}
+	if before == nil || len(revisions) == 0 {
+		// The first revision always carries a body snapshot so every
+		// revision has a body to restore.
+		body := after.Body
+		rev.Body = &body
+	}
+	if before != nil {
+		rev.Changes = fieldChanges(before, after)
+		if before.Body != after.Body {
+			body := after.Body
+			rev.Body = &body
+			rev.BodyDiff = UnifiedDiff(before.Body, after.Body)
+		}
+	}
+	pending = append(pending, rev)
+
+	return appendHistory(path, pending)
+}
+
+// archiveHistory records the deletion and moves the history out of the
+// entity directory so it survives the delete.
+func (s *Store) archiveHistory(entityDir string, t *Ticket, actor Actor) error {
+	if err := s.recordRevision(entityDir, t, t, actor, ActionDeleted, 0); err != nil {
+		return err
+	}
+	dest := s.deletedHistoryPath(t.ID)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return fmt.Errorf("create deleted history dir: %w", err)
+	}
+	if err := os.Rename(filepath.Join(entityDir, historyFileName), dest); err != nil {
+		return fmt.Errorf("archive history: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) deletedHistoryPath(id string) string {
+	return filepath.Join(s.RootDir(), deletedHistoryDir, id+".jsonl")
+}
+
+// fieldChanges lists the frontmatter differences between two versions of a
+// ticket. The body is diffed separately.
+func fieldChanges(before, after *Ticket) []FieldChange {
+	var changes []FieldChange
+	add := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, FieldChange{Field: field, Old: old, New: new})
+		}
+	}
+	add("id", before.ID, after.ID)
+	add("title", before.Title, after.Title)
+	add("type", before.Type, after.Type)
+	add("repo", before.Repo, after.Repo)
+	add("repos", strings.Join(before.Repos, ", "), strings.Join(after.Repos, ", "))
+	add("status", string(before.Status), string(after.Status))
+	add("due", formatDue(before.Due), formatDue(after.Due))
+	add("priority", string(before.Priority), string(after.Priority))
+	add("labels", strings.Join(before.Labels, ", "), strings.Join(after.Labels, ", "))
+	add("assignee", before.Assignee, after.Assignee)
+	add("references", strings.Join(before.References, ", "), strings.Join(after.References, ", "))
+	add("blocked_by", strings.Join(before.BlockedBy, ", "), strings.Join(after.BlockedBy, ", "))
+	add("blocks", strings.Join(before.Blocks, ", "), strings.Join(after.Blocks, ", "))
+	return changes
+}
+
+func formatDue(due *time.Time) string {
+	if due == nil {
+		return ""
+	}
+	return due.UTC().Format(time.RFC3339)
+}
+
+func readHistory(path string) ([]Revision, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open history: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var revisions []Revision
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var rev Revision
+		if err := json.Unmarshal(scanner.Bytes(), &rev); err != nil {
+			continue
+		}
+		revisions = append(revisions, rev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read history: %w", err)
+	}
+	slices.SortStableFunc(revisions, func(a, b Revision) int { return a.Seq - b.Seq })
+	return revisions, nil
+}
+
+func appendHistory(path string, revisions []Revision) error {
+	var data []byte
+	for _, rev := range revisions {
+		line, err := json.Marshal(rev)
+		if err != nil {
+			return fmt.Errorf("marshal revision: %w", err)
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("open history: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write history: %w", err)
+	}
+	return f.Close()
+}
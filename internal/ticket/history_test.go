@@ -0,0 +1,224 @@
+package ticket
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistoryRecordsMutations(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	architect := Actor{Kind: ActorArchitect, SessionID: "sess-1"}
+	created, err := store.CreateAs(architect, "Title", "line one\nline two\n", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	newTitle := "New Title"
+	renamed, err := store.UpdateAs(Actor{Kind: ActorCLI}, created.ID, &newTitle, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	created = renamed
+	if _, err := store.EditBodyAs(Actor{Kind: ActorWorker}, created.ID, "line two", "line 2", false); err != nil {
+		t.Fatalf("edit body: %v", err)
+	}
+	if err := store.MoveAs(Actor{Kind: ActorTUI}, created.ID, StatusProgress); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	due := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := store.SetDueDate(created.ID, &due); err != nil {
+		t.Fatalf("set due date: %v", err)
+	}
+	if _, err := store.ClearDueDate(created.ID); err != nil {
+		t.Fatalf("clear due date: %v", err)
+	}
+
+	revisions, err := store.History(created.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+
+	wantActions := []string{ActionCreated, ActionUpdated, ActionBodyEdited, ActionMoved, ActionDueDateSet, ActionDueDateCleared}
+	if len(revisions) != len(wantActions) {
+		t.Fatalf("got %d revisions, want %d: %+v", len(revisions), len(wantActions), revisions)
+	}
+	for i, want := range wantActions {
+		if revisions[i].Action != want {
+			t.Errorf("revision %d action = %q, want %q", i+1, revisions[i].Action, want)
+		}
+		if revisions[i].Seq != i+1 {
+			t.Errorf("revision %d seq = %d", i+1, revisions[i].Seq)
+		}
+	}
+
+	if revisions[0].Actor != architect {
+		t.Errorf("created actor = %+v, want %+v", revisions[0].Actor, architect)
+	}
+	if revisions[0].Body == nil || *revisions[0].Body != "line one\nline two\n" {
+		t.Errorf("created revision should snapshot the body, got %v", revisions[0].Body)
+	}
+
+	var titleChanged bool
+	for _, c := range revisions[1].Changes {
+		if c == (FieldChange{Field: "title", Old: "Title", New: "New Title"}) {
+			titleChanged = true
+		}
+	}
+	if !titleChanged {
+		t.Errorf("update changes = %+v, want title change", revisions[1].Changes)
+	}
+	if !strings.Contains(revisions[2].BodyDiff, "-line two\n+line 2\n") {
+		t.Errorf("body diff = %q", revisions[2].BodyDiff)
+	}
+	if c := revisions[3].Changes; len(c) != 1 || c[0].Field != "status" || c[0].New != string(StatusProgress) {
+		t.Errorf("move changes = %+v", c)
+	}
+	if revisions[4].Actor.Kind != ActorDaemon {
+		t.Errorf("unattributed change actor = %q, want %q", revisions[4].Actor.Kind, ActorDaemon)
+	}
+	if c := revisions[5].Changes; len(c) != 1 || c[0].Field != "due" || c[0].New != "" {
+		t.Errorf("clear due changes = %+v", c)
+	}
+}
+
+func TestHistoryDetectsExternalEdits(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	created, err := store.Create("Title", "original body", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	entityDir, _, err := store.findEntityDirAllStatuses(created.ID)
+	if err != nil {
+		t.Fatalf("find ticket: %v", err)
+	}
+	path := filepath.Join(entityDir, "ticket.md")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read ticket: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Replace(string(data), "original body", "edited body", 1)), 0644); err != nil {
+		t.Fatalf("write ticket: %v", err)
+	}
+
+	if err := store.Move(created.ID, StatusProgress); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+
+	revisions, err := store.History(created.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(revisions) != 3 {
+		t.Fatalf("got %d revisions, want 3: %+v", len(revisions), revisions)
+	}
+	ext := revisions[1]
+	if ext.Action != ActionEditedExternally || ext.Actor.Kind != ActorExternal {
+		t.Errorf("revision 2 = %s by %s, want external edit", ext.Action, ext.Actor.Kind)
+	}
+	if !strings.Contains(ext.BodyDiff, "-original body\n+edited body\n") {
+		t.Errorf("external body diff = %q", ext.BodyDiff)
+	}
+	if revisions[2].Action != ActionMoved || revisions[2].BodyDiff != "" {
+		t.Errorf("revision 3 = %+v, want move without body diff", revisions[2])
+	}
+}
+
+func TestRestoreBody(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	created, err := store.Create("Title", "first", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	second := "second"
+	if _, err := store.Update(created.ID, nil, &second, nil, nil, nil); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	newTitle := "Renamed"
+	created, err = store.Update(created.ID, &newTitle, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	restored, err := store.RestoreBody(Actor{Kind: ActorCLI}, created.ID, 1)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.Body != "first" || restored.Title != "Renamed" {
+		t.Errorf("restored ticket = %q / %q, want body restored and title kept", restored.Title, restored.Body)
+	}
+
+	// Revision 3 only renamed the ticket; its body is revision 2's.
+	restored, err = store.RestoreBody(Actor{Kind: ActorCLI}, created.ID, 3)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.Body != "second" {
+		t.Errorf("body = %q, want %q", restored.Body, "second")
+	}
+
+	revisions, err := store.History(created.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	last := revisions[len(revisions)-1]
+	if last.Action != ActionRestored || last.RestoredFrom != 3 {
+		t.Errorf("last revision = %+v, want restore from 3", last)
+	}
+
+	_, err = store.RestoreBody(Actor{Kind: ActorCLI}, created.ID, 99)
+	if !IsNotFound(err) {
+		t.Errorf("restore unknown revision: err = %v, want not found", err)
+	}
+}
+
+func TestHistorySurvivesDelete(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	created, err := store.Create("Title", "body", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := store.DeleteAs(Actor{Kind: ActorArchitect}, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	revisions, err := store.History(created.ID)
+	if err != nil {
+		t.Fatalf("history after delete: %v", err)
+	}
+	if len(revisions) != 2 || revisions[1].Action != ActionDeleted {
+		t.Errorf("revisions = %+v, want created then deleted", revisions)
+	}
+
+	if _, err := store.History("missing"); !IsNotFound(err) {
+		t.Errorf("history of unknown ticket: err = %v, want not found", err)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	if got := UnifiedDiff("same\n", "same\n"); got != "" {
+		t.Errorf("equal inputs: got %q", got)
+	}
+
+	old := "a\nb\nc\nd\ne\nf\ng\nh\n"
+	new := "a\nB\nc\nd\ne\nf\ng\nh\ni\n"
+	want := "@@ -1,4 +1,4 @@\n a\n-b\n+B\n c\n d\n@@ -7,2 +7,3 @@\n g\n h\n+i\n"
+	if got := UnifiedDiff(old, new); got != want {
+		t.Errorf("UnifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := UnifiedDiff("", "x\n"); got != "@@ -0,0 +1,1 @@\n+x\n" {
+		t.Errorf("from empty: got %q", got)
+	}
+}
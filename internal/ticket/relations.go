@@ -0,0 +1,374 @@
+package ticket
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kareemaly/cortex/internal/events"
+)
+
+// GraphNode is a ticket participating in a dependency graph.
+type GraphNode struct {
+	ID      string
+	Title   string
+	Status  Status
+	Blocked bool
+}
+
+// GraphEdge records that From must be done before To can start.
+type GraphEdge struct {
+	From string
+	To   string
+}
+
+// Graph is the connected dependency graph around a single ticket.
+type Graph struct {
+	Root  string
+	Nodes []GraphNode
+	Edges []GraphEdge
+}
+
+// normalizeRelations trims, de-duplicates and drops empty IDs while preserving order.
+func normalizeRelations(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(ids))
+	var out []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// loadAllByID loads every ticket across all statuses keyed by ID.
+func (s *Store) loadAllByID() (map[string]*Ticket, error) {
+	all, err := s.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Ticket)
+	for _, tickets := range all {
+		for _, t := range tickets {
+			byID[t.ID] = t
+		}
+	}
+	return byID, nil
+}
+
+// blockEdges builds the blocker -> blocked adjacency from both sides of every
+// relation. IDs that no longer resolve to a ticket are ignored.
+func blockEdges(byID map[string]*Ticket) map[string][]string {
+	edges := make(map[string][]string)
+	seen := make(map[GraphEdge]bool)
+	add := func(from, to string) {
+		if _, ok := byID[from]; !ok {
+			return
+		}
+		if _, ok := byID[to]; !ok {
+			return
+		}
+		e := GraphEdge{From: from, To: to}
+		if seen[e] {
+			return
+		}
+		seen[e] = true
+		edges[from] = append(edges[from], to)
+	}
+	for id, t := range byID {
+		for _, blocker := range t.BlockedBy {
+			add(blocker, id)
+		}
+		for _, blocked := range t.Blocks {
+			add(id, blocked)
+		}
+	}
+	for from := range edges {
+		sort.Strings(edges[from])
+	}
+	return edges
+}
+
+// reverseEdges inverts a blocker -> blocked adjacency.
+func reverseEdges(edges map[string][]string) map[string][]string {
+	rev := make(map[string][]string)
+	for from, tos := range edges {
+		for _, to := range tos {
+			rev[to] = append(rev[to], from)
+		}
+	}
+	for to := range rev {
+		sort.Strings(rev[to])
+	}
+	return rev
+}
+
+// validateRelations checks that blockedBy and blocks reference existing tickets
+// and that applying them to ticket id does not introduce a dependency cycle.
+// id may refer to a ticket that does not exist yet (during Create).
+func (s *Store) validateRelations(id string, blockedBy, blocks []string) error {
+	if len(blockedBy) == 0 && len(blocks) == 0 {
+		return nil
+	}
+
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return fmt.Errorf("load tickets: %w", err)
+	}
+
+	for _, rel := range []struct {
+		field string
+		ids   []string
+	}{{"blocked_by", blockedBy}, {"blocks", blocks}} {
+		for _, ref := range rel.ids {
+			if ref == id {
+				return &ValidationError{Field: rel.field, Message: "ticket cannot depend on itself"}
+			}
+			if _, ok := byID[ref]; !ok {
+				return &ValidationError{Field: rel.field, Message: fmt.Sprintf("unknown ticket %q", ref)}
+			}
+		}
+	}
+
+	// Substitute the candidate relations for the stored ones before walking.
+	candidate := &Ticket{ID: id}
+	if existing, ok := byID[id]; ok {
+		copied := *existing
+		candidate = &copied
+	}
+	candidate.BlockedBy = blockedBy
+	candidate.Blocks = blocks
+	byID[id] = candidate
+
+	edges := blockEdges(byID)
+	if path := findCycleFrom(id, edges); path != nil {
+		return &ValidationError{
+			Field:   "blocked_by",
+			Message: "dependency cycle: " + strings.Join(path, " -> "),
+		}
+	}
+	return nil
+}
+
+// findCycleFrom returns a path from start back to itself, or nil if none exists.
+func findCycleFrom(start string, edges map[string][]string) []string {
+	visited := make(map[string]bool)
+	var path []string
+	var walk func(node string) bool
+	walk = func(node string) bool {
+		path = append(path, node)
+		for _, next := range edges[node] {
+			if next == start {
+				path = append(path, next)
+				return true
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			if walk(next) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if walk(start) {
+		return path
+	}
+	return nil
+}
+
+// OpenBlockers returns the tickets blocking id that are not yet done.
+func (s *Store) OpenBlockers(id string) ([]*Ticket, error) {
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := byID[id]; !ok {
+		return nil, &NotFoundError{Resource: "ticket", ID: id}
+	}
+	return openBlockers(id, byID, reverseEdges(blockEdges(byID))), nil
+}
+
+// BlockedIDs returns the set of tickets that are not done and still have at
+// least one open blocker.
+func (s *Store) BlockedIDs() (map[string]bool, error) {
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return nil, err
+	}
+	rev := reverseEdges(blockEdges(byID))
+	blocked := make(map[string]bool)
+	for id, t := range byID {
+		if t.Status != StatusDone && len(openBlockers(id, byID, rev)) > 0 {
+			blocked[id] = true
+		}
+	}
+	return blocked, nil
+}
+
+func openBlockers(id string, byID map[string]*Ticket, rev map[string][]string) []*Ticket {
+	var open []*Ticket
+	for _, blocker := range rev[id] {
+		if t := byID[blocker]; t.Status != StatusDone {
+			open = append(open, t)
+		}
+	}
+	return open
+}
+
+// Graph returns every ticket transitively connected to id through
+// blocked_by/blocks relations, along with the edges between them.
+func (s *Store) Graph(id string) (*Graph, error) {
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := byID[id]; !ok {
+		return nil, &NotFoundError{Resource: "ticket", ID: id}
+	}
+
+	edges := blockEdges(byID)
+	rev := reverseEdges(edges)
+
+	component := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, next := range append(append([]string{}, edges[node]...), rev[node]...) {
+			if !component[next] {
+				component[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(component))
+	for nodeID := range component {
+		ids = append(ids, nodeID)
+	}
+	sort.Strings(ids)
+
+	g := &Graph{Root: id}
+	for _, nodeID := range ids {
+		t := byID[nodeID]
+		g.Nodes = append(g.Nodes, GraphNode{
+			ID:      t.ID,
+			Title:   t.Title,
+			Status:  t.Status,
+			Blocked: t.Status != StatusDone && len(openBlockers(nodeID, byID, rev)) > 0,
+		})
+		for _, to := range edges[nodeID] {
+			g.Edges = append(g.Edges, GraphEdge{From: nodeID, To: to})
+		}
+	}
+	return g, nil
+}
+
+// emitUnblocked emits TicketUnblocked for every ticket blocked by doneID that
+// no longer has any open blockers.
+func (s *Store) emitUnblocked(doneID string) {
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return
+	}
+	s.emitUnblockedFrom(byID, doneID, blockEdges(byID)[doneID])
+}
+
+// openDependents returns the tickets blocked by id, or nil when id is
+// already done and so was not holding anything back.
+func (s *Store) openDependents(id string) []string {
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return nil
+	}
+	if t, ok := byID[id]; !ok || t.Status == StatusDone {
+		return nil
+	}
+	return blockEdges(byID)[id]
+}
+
+// emitUnblockedFrom emits TicketUnblocked for each of dependents that is
+// still open and no longer has any open blockers.
+func (s *Store) emitUnblockedFrom(byID map[string]*Ticket, blockerID string, dependents []string) {
+	rev := reverseEdges(blockEdges(byID))
+	for _, dependent := range dependents {
+		t, ok := byID[dependent]
+		if !ok || t.Status == StatusDone {
+			continue
+		}
+		if len(openBlockers(dependent, byID, rev)) == 0 {
+			s.Emit(events.TicketUnblocked, dependent, map[string]any{"blocker": blockerID})
+		}
+	}
+}
+
+// rewriteRelations replaces oldID with newID in every other ticket's
+// blocked_by/blocks lists. An empty newID removes the reference instead.
+func (s *Store) rewriteRelations(actor Actor, oldID, newID string) error {
+	byID, err := s.loadAllByID()
+	if err != nil {
+		return err
+	}
+	for id, t := range byID {
+		if id == newID || (!slices.Contains(t.BlockedBy, oldID) && !slices.Contains(t.Blocks, oldID)) {
+			continue
+		}
+		if err := s.rewriteTicketRelations(actor, id, oldID, newID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) rewriteTicketRelations(actor Actor, id, oldID, newID string) error {
+	mu := s.ticketMu(id)
+	mu.Lock()
+	defer mu.Unlock()
+
+	entityDir, status, err := s.findEntityDirAllStatuses(id)
+	if err != nil {
+		return err
+	}
+	ticket, err := s.loadFromDir(entityDir)
+	if err != nil {
+		return err
+	}
+	ticket.ID = id
+	ticket.Status = status
+	before := *ticket
+	ticket.BlockedBy = replaceID(ticket.BlockedBy, oldID, newID)
+	ticket.Blocks = replaceID(ticket.Blocks, oldID, newID)
+
+	if err := s.writeFile(entityDir, ticket); err != nil {
+		return fmt.Errorf("save ticket: %w", err)
+	}
+	if err := s.recordRevision(entityDir, &before, ticket, actor, ActionUpdated, 0); err != nil {
+		return err
+	}
+	s.Emit(events.TicketUpdated, id, nil)
+	return nil
+}
+
+func replaceID(ids []string, oldID, newID string) []string {
+	var out []string
+	for _, v := range ids {
+		if v == oldID {
+			if newID == "" {
+				continue
+			}
+			v = newID
+		}
+		out = append(out, v)
+	}
+	return normalizeRelations(out)
+}
@@ -0,0 +1,259 @@
+package ticket
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+)
+
+func TestCreateWithBlockedBy(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	blocker, _ := store.Create("Blocker", "", nil, nil, "", nil, nil, "")
+	blocked, err := store.Create("Blocked", "", nil, nil, "", []string{blocker.ID, " ", blocker.ID}, nil, "")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if len(blocked.BlockedBy) != 1 || blocked.BlockedBy[0] != blocker.ID {
+		t.Errorf("BlockedBy = %v, want [%s]", blocked.BlockedBy, blocker.ID)
+	}
+
+	reloaded, _, err := store.Get(blocked.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(reloaded.BlockedBy) != 1 || reloaded.BlockedBy[0] != blocker.ID {
+		t.Errorf("persisted BlockedBy = %v, want [%s]", reloaded.BlockedBy, blocker.ID)
+	}
+}
+
+func TestCreateWithUnknownBlocker(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	_, err := store.Create("Blocked", "", nil, nil, "", []string{"missing"}, nil, "")
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if vErr.Field != "blocked_by" {
+		t.Errorf("field = %q, want blocked_by", vErr.Field)
+	}
+}
+
+func TestUpdateRejectsCycle(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	a, _ := store.Create("A", "", nil, nil, "", nil, nil, "")
+	b, _ := store.Create("B", "", nil, nil, "", []string{a.ID}, nil, "")
+	c, _ := store.Create("C", "", nil, nil, "", nil, []string{a.ID}, "")
+
+	// a -> b already; making a blocked by b closes the loop.
+	_, err := store.Update(a.ID, nil, nil, nil, &[]string{b.ID}, nil)
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if !strings.Contains(vErr.Message, "cycle") {
+		t.Errorf("message = %q, want cycle", vErr.Message)
+	}
+
+	// c -> a (declared on c via blocks); a blocks c would be a 2-cycle.
+	if _, err := store.Update(a.ID, nil, nil, nil, nil, &[]string{c.ID}); err == nil {
+		t.Error("expected cycle error for a blocks c")
+	}
+
+	// Self-reference is rejected.
+	if _, err := store.Update(a.ID, nil, nil, nil, &[]string{a.ID}, nil); err == nil {
+		t.Error("expected error for self-reference")
+	}
+}
+
+func TestConcurrentUpdatesCannotCloseCycle(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	for i := range 50 {
+		a, _ := store.Create(fmt.Sprintf("A%d", i), "", nil, nil, "", nil, nil, "")
+		b, _ := store.Create(fmt.Sprintf("B%d", i), "", nil, nil, "", nil, nil, "")
+
+		// Each update alone is valid; together they would form a cycle.
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		errs := make([]error, 2)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[0] = store.Update(a.ID, nil, nil, nil, &[]string{b.ID}, nil)
+		}()
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[1] = store.Update(b.ID, nil, nil, nil, &[]string{a.ID}, nil)
+		}()
+		close(start)
+		wg.Wait()
+
+		if (errs[0] == nil) == (errs[1] == nil) {
+			t.Fatalf("round %d: want exactly one update rejected, got %v and %v", i, errs[0], errs[1])
+		}
+	}
+}
+
+func TestOpenBlockersAndGraph(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	a, _ := store.Create("A", "", nil, nil, "", nil, nil, "")
+	b, _ := store.Create("B", "", nil, nil, "", nil, nil, "")
+	c, _ := store.Create("C", "", nil, nil, "", []string{a.ID, b.ID}, nil, "")
+
+	open, err := store.OpenBlockers(c.ID)
+	if err != nil {
+		t.Fatalf("OpenBlockers failed: %v", err)
+	}
+	if len(open) != 2 {
+		t.Fatalf("open blockers = %d, want 2", len(open))
+	}
+
+	_ = store.Move(a.ID, StatusDone)
+	open, _ = store.OpenBlockers(c.ID)
+	if len(open) != 1 || open[0].ID != b.ID {
+		t.Errorf("open blockers = %v, want [%s]", open, b.ID)
+	}
+
+	g, err := store.Graph(a.ID)
+	if err != nil {
+		t.Fatalf("Graph failed: %v", err)
+	}
+	if len(g.Nodes) != 3 {
+		t.Errorf("nodes = %d, want 3", len(g.Nodes))
+	}
+	if len(g.Edges) != 2 {
+		t.Errorf("edges = %d, want 2", len(g.Edges))
+	}
+	for _, n := range g.Nodes {
+		if n.ID == c.ID && !n.Blocked {
+			t.Error("C should still be blocked")
+		}
+	}
+
+	blocked, err := store.BlockedIDs()
+	if err != nil {
+		t.Fatalf("BlockedIDs failed: %v", err)
+	}
+	if !blocked[c.ID] || len(blocked) != 1 {
+		t.Errorf("BlockedIDs = %v, want only %s", blocked, c.ID)
+	}
+}
+
+func TestMoveToDoneEmitsUnblocked(t *testing.T) {
+	bus := events.NewBus()
+	store, err := NewStore(t.TempDir(), bus, "/project")
+	if err != nil {
+		t.Fatalf("create store: %v", err)
+	}
+
+	a, _ := store.Create("A", "", nil, nil, "", nil, nil, "")
+	b, _ := store.Create("B", "", nil, nil, "", nil, nil, "")
+	c, _ := store.Create("C", "", nil, nil, "", []string{a.ID, b.ID}, nil, "")
+
+	ch, unsubscribe := bus.Subscribe("/project")
+	defer unsubscribe()
+
+	_ = store.Move(a.ID, StatusDone)
+	if ev, ok := nextEventOfType(ch, events.TicketUnblocked); ok {
+		t.Fatalf("unexpected unblocked event for %s while B is open", ev.TicketID)
+	}
+
+	_ = store.Move(b.ID, StatusDone)
+	ev, ok := nextEventOfType(ch, events.TicketUnblocked)
+	if !ok {
+		t.Fatal("expected TicketUnblocked event")
+	}
+	if ev.TicketID != c.ID {
+		t.Errorf("unblocked ticket = %s, want %s", ev.TicketID, c.ID)
+	}
+}
+
+func TestDeleteBlockerEmitsUnblocked(t *testing.T) {
+	bus := events.NewBus()
+	store, err := NewStore(t.TempDir(), bus, "/project")
+	if err != nil {
+		t.Fatalf("create store: %v", err)
+	}
+
+	a, _ := store.Create("A", "", nil, nil, "", nil, nil, "")
+	b, _ := store.Create("B", "", nil, nil, "", nil, nil, "")
+	c, _ := store.Create("C", "", nil, nil, "", []string{a.ID, b.ID}, nil, "")
+
+	ch, unsubscribe := bus.Subscribe("/project")
+	defer unsubscribe()
+
+	if err := store.Delete(a.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if ev, ok := nextEventOfType(ch, events.TicketUnblocked); ok {
+		t.Fatalf("unexpected unblocked event for %s while B is open", ev.TicketID)
+	}
+
+	if err := store.Delete(b.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	ev, ok := nextEventOfType(ch, events.TicketUnblocked)
+	if !ok {
+		t.Fatal("expected TicketUnblocked event")
+	}
+	if ev.TicketID != c.ID {
+		t.Errorf("unblocked ticket = %s, want %s", ev.TicketID, c.ID)
+	}
+}
+
+func TestRenameAndDeleteRewriteRelations(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	a, _ := store.Create("A", "", nil, nil, "", nil, nil, "")
+	b, _ := store.Create("B", "", nil, nil, "", []string{a.ID}, nil, "")
+
+	newTitle := "A renamed"
+	renamed, err := store.Update(a.ID, &newTitle, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	reloaded, _, _ := store.Get(b.ID)
+	if len(reloaded.BlockedBy) != 1 || reloaded.BlockedBy[0] != renamed.ID {
+		t.Errorf("BlockedBy after rename = %v, want [%s]", reloaded.BlockedBy, renamed.ID)
+	}
+
+	if err := store.Delete(renamed.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	reloaded, _, _ = store.Get(b.ID)
+	if len(reloaded.BlockedBy) != 0 {
+		t.Errorf("BlockedBy after delete = %v, want empty", reloaded.BlockedBy)
+	}
+}
+
+// nextEventOfType drains ch until an event of type want arrives or the channel is quiet.
+func nextEventOfType(ch <-chan events.Event, want events.EventType) (events.Event, bool) {
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type == want {
+				return ev, true
+			}
+		case <-time.After(50 * time.Millisecond):
+			return events.Event{}, false
+		}
+	}
+}
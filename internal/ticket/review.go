@@ -0,0 +1,233 @@
+package ticket
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+const reviewFileName = "review.md"
+
+// ReviewState is where a ticket's review stands.
+type ReviewState string
+
+const (
+	// ReviewOpen means a conclusion is waiting for review.
+	ReviewOpen ReviewState = "open"
+	// ReviewChangesRequested means the worker was sent back with the
+	// comments of the current round.
+	ReviewChangesRequested ReviewState = "changes_requested"
+	// ReviewAccepted means the reviewer accepted the work.
+	ReviewAccepted ReviewState = "accepted"
+)
+
+// ReviewVerdict is a reviewer agent's recommendation on a review round.
+// It informs the architect's decision; recording it does not accept or
+// send back the ticket.
+type ReviewVerdict string
+
+const (
+	VerdictApprove        ReviewVerdict = "approve"
+	VerdictRequestChanges ReviewVerdict = "request_changes"
+)
+
+// Review is a ticket's review, stored as review.md next to its conclusion.
+// Every conclusion that enters review starts a new round; comments keep the
+// round they were left in.
+type Review struct {
+	State    ReviewState     `yaml:"state"`
+	Round    int             `yaml:"round"`
+	Updated  time.Time       `yaml:"updated"`
+	Comments []ReviewComment `yaml:"comments,omitempty"`
+	// Verdict and VerdictSummary are the reviewer agent's verdict on the
+	// current round, once one is submitted.
+	Verdict        ReviewVerdict `yaml:"verdict,omitempty"`
+	VerdictSummary string        `yaml:"verdict_summary,omitempty"`
+	// Summary is the reviewer's note on the last verdict, kept as the
+	// file body.
+	Summary string `yaml:"-"`
+}
+
+// ReviewComment is a reviewer comment anchored to a file of the ticket's
+// diffs. Line 0 comments on the file as a whole.
+type ReviewComment struct {
+	ID      string    `yaml:"id"`
+	Round   int       `yaml:"round"`
+	Repo    string    `yaml:"repo,omitempty"`
+	Commit  string    `yaml:"commit,omitempty"`
+	File    string    `yaml:"file"`
+	Line    int       `yaml:"line,omitempty"`
+	Body    string    `yaml:"body"`
+	Author  string    `yaml:"author,omitempty"`
+	Created time.Time `yaml:"created"`
+}
+
+// RoundComments returns the comments left in the review's current round.
+func (r *Review) RoundComments() []ReviewComment {
+	var comments []ReviewComment
+	for _, c := range r.Comments {
+		if c.Round == r.Round {
+			comments = append(comments, c)
+		}
+	}
+	return comments
+}
+
+// ReadReview returns the review of a ticket. A ticket that was never
+// reviewed has an empty review in round 0.
+func (s *Store) ReadReview(ticketID string) (*Review, error) {
+	entityDir, _, err := s.findEntityDirAllStatuses(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return readReview(entityDir)
+}
+
+// StartReview opens a new review round for the ticket's latest conclusion.
+func (s *Store) StartReview(ticketID string) (*Review, error) {
+	return s.updateReview(ticketID, func(r *Review) error {
+		r.Round++
+		r.State = ReviewOpen
+		r.Summary = ""
+		r.Verdict = ""
+		r.VerdictSummary = ""
+		return nil
+	})
+}
+
+// AddReviewComment adds c to the current round of the ticket's review,
+// attributed to actor, and returns it with its ID assigned.
+func (s *Store) AddReviewComment(actor Actor, ticketID string, c ReviewComment) (*ReviewComment, error) {
+	if err := validateReviewComment(c); err != nil {
+		return nil, err
+	}
+
+	var added ReviewComment
+	_, err := s.updateReview(ticketID, func(r *Review) error {
+		if r.State != ReviewOpen {
+			return &ValidationError{Field: "review", Message: "ticket has no open review"}
+		}
+		added = r.addComment(actor, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &added, nil
+}
+
+// SubmitVerdict records a reviewer agent's verdict on the open review round
+// together with its findings, which are added as comments of the round.
+func (s *Store) SubmitVerdict(actor Actor, ticketID string, verdict ReviewVerdict, summary string, findings []ReviewComment) (*Review, error) {
+	switch verdict {
+	case VerdictApprove, VerdictRequestChanges:
+	default:
+		return nil, &ValidationError{Field: "verdict", Message: "must be 'approve' or 'request_changes'"}
+	}
+	for i, c := range findings {
+		if err := validateReviewComment(c); err != nil {
+			var vErr *ValidationError
+			if errors.As(err, &vErr) {
+				vErr.Field = fmt.Sprintf("findings[%d].%s", i, vErr.Field)
+			}
+			return nil, err
+		}
+	}
+
+	return s.updateReview(ticketID, func(r *Review) error {
+		if r.State != ReviewOpen {
+			return &ValidationError{Field: "review", Message: "ticket has no open review"}
+		}
+		for _, c := range findings {
+			r.addComment(actor, c)
+		}
+		r.Verdict = verdict
+		r.VerdictSummary = summary
+		return nil
+	})
+}
+
+// FinishReview records the verdict on the ticket's open review.
+func (s *Store) FinishReview(ticketID string, state ReviewState, summary string) (*Review, error) {
+	return s.updateReview(ticketID, func(r *Review) error {
+		if r.State != ReviewOpen {
+			return &ValidationError{Field: "review", Message: "ticket has no open review"}
+		}
+		r.State = state
+		r.Summary = summary
+		return nil
+	})
+}
+
+func validateReviewComment(c ReviewComment) error {
+	if strings.TrimSpace(c.File) == "" {
+		return &ValidationError{Field: "file", Message: "cannot be empty"}
+	}
+	if strings.TrimSpace(c.Body) == "" {
+		return &ValidationError{Field: "body", Message: "cannot be empty"}
+	}
+	if c.Line < 0 {
+		return &ValidationError{Field: "line", Message: "cannot be negative"}
+	}
+	return nil
+}
+
+// addComment appends c to the current round, attributed to actor.
+func (r *Review) addComment(actor Actor, c ReviewComment) ReviewComment {
+	c.ID = fmt.Sprintf("c%d", len(r.Comments)+1)
+	c.Round = r.Round
+	c.Author = actor.Kind
+	c.Created = time.Now().UTC()
+	r.Comments = append(r.Comments, c)
+	return c
+}
+
+func (s *Store) updateReview(ticketID string, fn func(*Review) error) (*Review, error) {
+	mu := s.ticketMu(ticketID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	entityDir, _, err := s.findEntityDirAllStatuses(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	review, err := readReview(entityDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(review); err != nil {
+		return nil, err
+	}
+	review.Updated = time.Now().UTC()
+
+	data, err := storage.SerializeFrontmatter(review, review.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("serialize review: %w", err)
+	}
+	if err := storage.AtomicWriteFile(filepath.Join(entityDir, reviewFileName), data); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func readReview(entityDir string) (*Review, error) {
+	data, err := os.ReadFile(filepath.Join(entityDir, reviewFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Review{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	review, body, err := storage.ParseFrontmatter[Review](data)
+	if err != nil {
+		return nil, fmt.Errorf("parse review: %w", err)
+	}
+	review.Summary = strings.TrimSpace(body)
+	return review, nil
+}
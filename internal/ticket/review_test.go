@@ -0,0 +1,111 @@
+package ticket
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReviewRounds(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	tk, err := store.Create("Review me", "", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	review, err := store.ReadReview(tk.ID)
+	if err != nil {
+		t.Fatalf("read empty review: %v", err)
+	}
+	if review.Round != 0 || review.State != "" {
+		t.Fatalf("expected an empty review, got %+v", review)
+	}
+
+	var vErr *ValidationError
+	if _, err := store.AddReviewComment(DaemonActor, tk.ID, ReviewComment{File: "a.go", Body: "early"}); !errors.As(err, &vErr) {
+		t.Fatalf("expected ValidationError before the review starts, got %v", err)
+	}
+
+	if _, err := store.StartReview(tk.ID); err != nil {
+		t.Fatalf("start review: %v", err)
+	}
+	c, err := store.AddReviewComment(Actor{Kind: ActorArchitect}, tk.ID, ReviewComment{File: "a.go", Line: 12, Body: "handle the error"})
+	if err != nil {
+		t.Fatalf("add comment: %v", err)
+	}
+	if c.ID != "c1" || c.Round != 1 || c.Author != ActorArchitect {
+		t.Errorf("unexpected comment: %+v", c)
+	}
+	if _, err := store.FinishReview(tk.ID, ReviewChangesRequested, "one fix"); err != nil {
+		t.Fatalf("request changes: %v", err)
+	}
+
+	review, err = store.StartReview(tk.ID)
+	if err != nil {
+		t.Fatalf("start second round: %v", err)
+	}
+	if review.Round != 2 || review.State != ReviewOpen || review.Summary != "" {
+		t.Errorf("unexpected second round: %+v", review)
+	}
+	if len(review.RoundComments()) != 0 {
+		t.Errorf("expected no comments in round 2, got %+v", review.RoundComments())
+	}
+	if _, err := store.FinishReview(tk.ID, ReviewAccepted, "looks good"); err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+
+	review, err = store.ReadReview(tk.ID)
+	if err != nil {
+		t.Fatalf("read review: %v", err)
+	}
+	if review.State != ReviewAccepted || review.Summary != "looks good" || len(review.Comments) != 1 {
+		t.Errorf("unexpected stored review: %+v", review)
+	}
+	if _, err := store.FinishReview(tk.ID, ReviewAccepted, ""); !errors.As(err, &vErr) {
+		t.Errorf("expected ValidationError finishing a closed review, got %v", err)
+	}
+}
+
+func TestSubmitVerdict(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	tk, err := store.Create("Audit me", "", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := store.StartReview(tk.ID); err != nil {
+		t.Fatalf("start review: %v", err)
+	}
+
+	reviewer := Actor{Kind: ActorReviewer}
+	var vErr *ValidationError
+	if _, err := store.SubmitVerdict(reviewer, tk.ID, "maybe", "", nil); !errors.As(err, &vErr) || vErr.Field != "verdict" {
+		t.Fatalf("expected verdict ValidationError, got %v", err)
+	}
+	bad := []ReviewComment{{File: "a.go", Body: "ok"}, {File: "b.go"}}
+	if _, err := store.SubmitVerdict(reviewer, tk.ID, VerdictRequestChanges, "", bad); !errors.As(err, &vErr) || vErr.Field != "findings[1].body" {
+		t.Fatalf("expected findings[1].body ValidationError, got %v", err)
+	}
+
+	findings := []ReviewComment{{File: "a.go", Line: 3, Body: "nil map write"}}
+	review, err := store.SubmitVerdict(reviewer, tk.ID, VerdictRequestChanges, "one bug", findings)
+	if err != nil {
+		t.Fatalf("submit verdict: %v", err)
+	}
+	if review.State != ReviewOpen || review.Verdict != VerdictRequestChanges || review.VerdictSummary != "one bug" {
+		t.Errorf("unexpected review after verdict: %+v", review)
+	}
+	if len(review.Comments) != 1 || review.Comments[0].Author != ActorReviewer || review.Comments[0].Round != 1 {
+		t.Errorf("unexpected findings: %+v", review.Comments)
+	}
+
+	review, err = store.StartReview(tk.ID)
+	if err != nil {
+		t.Fatalf("start second round: %v", err)
+	}
+	if review.Verdict != "" || review.VerdictSummary != "" {
+		t.Errorf("a new round should clear the verdict, got %+v", review)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -19,71 +20,158 @@ const conclusionFileName = "conclusion.md"
 type Store struct {
 	*entity.BaseStore
 	locks sync.Map
+	// relationsMu serializes blocked_by/blocks changes so the cycle check
+	// and the write that follows see the same dependency graph. It is
+	// taken after the ticket's own lock.
+	relationsMu sync.Mutex
+
+	statusMu    sync.RWMutex
+	statuses    []Status
+	moveCheck   MoveCheck
+	renameCheck RenameCheck
 }
 
+// MoveCheck decides whether a ticket may move between statuses. A non-nil
+// error refuses the move and is returned by MoveAs as is.
+type MoveCheck func(t *Ticket, from, to Status) error
+
+// RenameCheck decides whether a ticket may take a new ID when its title
+// changes. A non-nil error refuses the update and is returned by UpdateAs
+// as is.
+type RenameCheck func(t *Ticket) error
+
 func (s *Store) ticketMu(id string) *sync.Mutex {
 	v, _ := s.locks.LoadOrStore(id, &sync.Mutex{})
 	return v.(*sync.Mutex)
 }
 
 func NewStore(ticketsDir string, bus *events.Bus, projectPath string) (*Store, error) {
+	return NewStoreWithStatuses(ticketsDir, bus, projectPath, DefaultStatuses)
+}
+
+// NewStoreWithStatuses creates a store whose status directories follow the
+// given board order, as configured in cortex.yaml.
+func NewStoreWithStatuses(ticketsDir string, bus *events.Bus, projectPath string, statuses []Status) (*Store, error) {
 	base, err := entity.NewBaseStore(ticketsDir, bus, projectPath)
 	if err != nil {
 		return nil, err
 	}
 
 	s := &Store{BaseStore: base}
-
-	for _, status := range []Status{StatusBacklog, StatusProgress, StatusDone} {
-		dir := filepath.Join(ticketsDir, string(status))
-		if err := os.MkdirAll(dir, 0755); err != nil {
-			return nil, fmt.Errorf("create directory %s: %w", dir, err)
-		}
+	if err := s.SetStatuses(statuses); err != nil {
+		return nil, err
 	}
 
 	return s, nil
 }
 
-func (s *Store) Create(title, body string, dueDate *time.Time, references []string, repo string) (*Ticket, error) {
-	if title == "" {
-		return nil, &ValidationError{Field: "title", Message: "cannot be empty"}
+// Statuses returns the store's statuses in board order.
+func (s *Store) Statuses() []Status {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+	return slices.Clone(s.statuses)
+}
+
+// HasStatus reports whether status is one of the store's statuses.
+func (s *Store) HasStatus(status Status) bool {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+	return slices.Contains(s.statuses, status)
+}
+
+// SetStatuses replaces the store's status list, creating a directory for
+// every status that does not have one yet.
+func (s *Store) SetStatuses(statuses []Status) error {
+	for _, status := range statuses {
+		dir := filepath.Join(s.RootDir(), string(status))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("create directory %s: %w", dir, err)
+		}
 	}
 
-	now := time.Now().UTC()
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+	s.statuses = slices.Clone(statuses)
+	return nil
+}
 
-	ticketID, err := storage.NewTicketIDFromCreated(now, title, s.RootDir())
-	if err != nil {
-		return nil, fmt.Errorf("generate ticket ID: %w", err)
+func (s *Store) statusDirs() []string {
+	statuses := s.Statuses()
+	dirs := make([]string, len(statuses))
+	for i, status := range statuses {
+		dirs[i] = string(status)
 	}
+	return dirs
+}
 
+func (s *Store) Create(title, body string, dueDate *time.Time, references []string, repo string, blockedBy, blocks []string, ticketType string, opts ...CreateOption) (*Ticket, error) {
+	return s.CreateAs(DaemonActor, title, body, dueDate, references, repo, blockedBy, blocks, ticketType, opts...)
+}
+
+// CreateAs is Create with the change attributed to actor in the ticket history.
+func (s *Store) CreateAs(actor Actor, title, body string, dueDate *time.Time, references []string, repo string, blockedBy, blocks []string, ticketType string, opts ...CreateOption) (*Ticket, error) {
 	ticket := &Ticket{
-		ID:     ticketID,
 		Status: StatusBacklog,
 		TicketMeta: TicketMeta{
 			Title:      title,
+			Type:       ticketType,
 			Repo:       repo,
 			References: references,
 			Due:        dueDate,
-			Created:    now,
-			Updated:    now,
 		},
 		Body: body,
 	}
+	// Options run first so a template can supply the title and type.
+	for _, opt := range opts {
+		opt(ticket)
+	}
+	if ticket.Title == "" {
+		return nil, &ValidationError{Field: "title", Message: "cannot be empty"}
+	}
+	if err := normalizeTriage(ticket); err != nil {
+		return nil, err
+	}
+	if ticket.Type == "" {
+		ticket.Type = DefaultTicketType
+	}
+
+	now := time.Now().UTC()
+	ticket.Created = now
+	ticket.Updated = now
+
+	ticketID, err := storage.NewTicketIDFromCreated(now, ticket.Title, s.RootDir(), s.statusDirs())
+	if err != nil {
+		return nil, fmt.Errorf("generate ticket ID: %w", err)
+	}
+	ticket.ID = ticketID
 
 	mu := s.ticketMu(ticket.ID)
 	mu.Lock()
 	defer mu.Unlock()
 
+	ticket.BlockedBy = normalizeRelations(blockedBy)
+	ticket.Blocks = normalizeRelations(blocks)
+	if len(ticket.BlockedBy) > 0 || len(ticket.Blocks) > 0 {
+		s.relationsMu.Lock()
+		defer s.relationsMu.Unlock()
+		if err := s.validateRelations(ticketID, ticket.BlockedBy, ticket.Blocks); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.saveTicket(ticket); err != nil {
 		return nil, fmt.Errorf("save ticket: %w", err)
 	}
+	if err := s.recordRevision(s.entityDir(ticket), nil, ticket, actor, ActionCreated, 0); err != nil {
+		return nil, err
+	}
 
 	s.Emit(events.TicketCreated, ticket.ID, nil)
 	return ticket, nil
 }
 
 func (s *Store) Get(id string) (*Ticket, Status, error) {
-	for _, status := range []Status{StatusBacklog, StatusProgress, StatusDone} {
+	for _, status := range s.Statuses() {
 		entityDir := filepath.Join(s.RootDir(), string(status), id)
 		if info, statErr := os.Stat(entityDir); statErr != nil || !info.IsDir() {
 			continue
@@ -100,7 +188,28 @@ func (s *Store) Get(id string) (*Ticket, Status, error) {
 	return nil, "", &NotFoundError{Resource: "ticket", ID: id}
 }
 
-func (s *Store) Update(id string, title, body *string, references *[]string) (*Ticket, error) {
+// Update applies the non-nil fields and the options to the ticket.
+// Renaming a ticket goes through the rename check and also rewrites
+// blocked_by/blocks references held by other tickets.
+func (s *Store) Update(id string, title, body *string, references, blockedBy, blocks *[]string, opts ...UpdateOption) (*Ticket, error) {
+	return s.UpdateAs(DaemonActor, id, title, body, references, blockedBy, blocks, opts...)
+}
+
+// UpdateAs is Update with the change attributed to actor in the ticket history.
+func (s *Store) UpdateAs(actor Actor, id string, title, body *string, references, blockedBy, blocks *[]string, opts ...UpdateOption) (*Ticket, error) {
+	ticket, err := s.update(actor, id, title, body, references, blockedBy, blocks, opts)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.ID != id {
+		if err := s.rewriteRelations(actor, id, ticket.ID); err != nil {
+			return nil, fmt.Errorf("rewrite relations: %w", err)
+		}
+	}
+	return ticket, nil
+}
+
+func (s *Store) update(actor Actor, id string, title, body *string, references, blockedBy, blocks *[]string, opts []UpdateOption) (*Ticket, error) {
 	mu := s.ticketMu(id)
 	mu.Lock()
 	defer mu.Unlock()
@@ -116,6 +225,7 @@ func (s *Store) Update(id string, title, body *string, references *[]string) (*T
 	}
 	ticket.ID = id
 	ticket.Status = status
+	before := *ticket
 
 	titleChanged := false
 	if title != nil {
@@ -133,11 +243,38 @@ func (s *Store) Update(id string, title, body *string, references *[]string) (*T
 	if references != nil {
 		ticket.References = *references
 	}
+	if blockedBy != nil || blocks != nil {
+		s.relationsMu.Lock()
+		defer s.relationsMu.Unlock()
+		if blockedBy != nil {
+			ticket.BlockedBy = normalizeRelations(*blockedBy)
+		}
+		if blocks != nil {
+			ticket.Blocks = normalizeRelations(*blocks)
+		}
+		if err := s.validateRelations(id, ticket.BlockedBy, ticket.Blocks); err != nil {
+			return nil, err
+		}
+	}
+	for _, opt := range opts {
+		opt(ticket)
+	}
+	if err := normalizeTriage(ticket); err != nil {
+		return nil, err
+	}
 
 	ticket.Updated = time.Now().UTC()
 
 	if titleChanged {
-		newID, err := storage.NewTicketIDFromCreated(ticket.Created, ticket.Title, s.RootDir())
+		s.statusMu.RLock()
+		check := s.renameCheck
+		s.statusMu.RUnlock()
+		if check != nil {
+			if err := check(&before); err != nil {
+				return nil, err
+			}
+		}
+		newID, err := storage.NewTicketIDFromCreated(ticket.Created, ticket.Title, s.RootDir(), s.statusDirs())
 		if err != nil {
 			return nil, fmt.Errorf("generate new ticket ID: %w", err)
 		}
@@ -152,12 +289,20 @@ func (s *Store) Update(id string, title, body *string, references *[]string) (*T
 	if err := s.writeFile(entityDir, ticket); err != nil {
 		return nil, fmt.Errorf("save ticket: %w", err)
 	}
+	if err := s.recordRevision(entityDir, &before, ticket, actor, ActionUpdated, 0); err != nil {
+		return nil, err
+	}
 
 	s.Emit(events.TicketUpdated, ticket.ID, nil)
 	return ticket, nil
 }
 
 func (s *Store) EditBody(id, oldString, newString string, replaceAll bool) (*Ticket, error) {
+	return s.EditBodyAs(DaemonActor, id, oldString, newString, replaceAll)
+}
+
+// EditBodyAs is EditBody with the change attributed to actor in the ticket history.
+func (s *Store) EditBodyAs(actor Actor, id, oldString, newString string, replaceAll bool) (*Ticket, error) {
 	if oldString == "" {
 		return nil, &ValidationError{Field: "oldString", Message: "cannot be empty"}
 	}
@@ -189,12 +334,16 @@ func (s *Store) EditBody(id, oldString, newString string, replaceAll bool) (*Tic
 		return nil, &ValidationError{Field: "oldString", Message: "matched multiple locations; use replaceAll=true or provide more surrounding context"}
 	}
 
+	before := *ticket
 	ticket.Body = applyBodyEditMatches(ticket.Body, matches, newString, replaceAll)
 	ticket.Updated = time.Now().UTC()
 
 	if err := s.writeFile(entityDir, ticket); err != nil {
 		return nil, fmt.Errorf("save ticket: %w", err)
 	}
+	if err := s.recordRevision(entityDir, &before, ticket, actor, ActionBodyEdited, 0); err != nil {
+		return nil, err
+	}
 
 	s.Emit(events.TicketUpdated, ticket.ID, nil)
 	return ticket, nil
@@ -393,6 +542,12 @@ func equalStrings(a, b []string) bool {
 }
 
 func (s *Store) SetDueDate(id string, dueDate *time.Time) (*Ticket, error) {
+	return s.SetDueDateAs(DaemonActor, id, dueDate)
+}
+
+// SetDueDateAs is SetDueDate with the change attributed to actor in the
+// ticket history. A nil dueDate clears it.
+func (s *Store) SetDueDateAs(actor Actor, id string, dueDate *time.Time) (*Ticket, error) {
 	mu := s.ticketMu(id)
 	mu.Lock()
 	defer mu.Unlock()
@@ -409,12 +564,20 @@ func (s *Store) SetDueDate(id string, dueDate *time.Time) (*Ticket, error) {
 	ticket.ID = id
 	ticket.Status = status
 
+	before := *ticket
 	ticket.Due = dueDate
 	ticket.Updated = time.Now().UTC()
 
 	if err := s.writeFile(entityDir, ticket); err != nil {
 		return nil, fmt.Errorf("save ticket: %w", err)
 	}
+	action := ActionDueDateSet
+	if dueDate == nil {
+		action = ActionDueDateCleared
+	}
+	if err := s.recordRevision(entityDir, &before, ticket, actor, action, 0); err != nil {
+		return nil, err
+	}
 
 	s.Emit(events.TicketUpdated, ticket.ID, nil)
 	return ticket, nil
@@ -424,16 +587,50 @@ func (s *Store) ClearDueDate(id string) (*Ticket, error) {
 	return s.SetDueDate(id, nil)
 }
 
+// Delete removes the ticket and drops it from other tickets' relations.
+// Dependents it was the last open blocker of get TicketUnblocked.
 func (s *Store) Delete(id string) error {
+	return s.DeleteAs(DaemonActor, id)
+}
+
+// DeleteAs is Delete with the change attributed to actor. The ticket's
+// history is kept and stays readable through History.
+func (s *Store) DeleteAs(actor Actor, id string) error {
+	dependents := s.openDependents(id)
+	if err := s.delete(actor, id); err != nil {
+		return err
+	}
+	if err := s.rewriteRelations(actor, id, ""); err != nil {
+		return fmt.Errorf("rewrite relations: %w", err)
+	}
+	if len(dependents) > 0 {
+		if byID, err := s.loadAllByID(); err == nil {
+			s.emitUnblockedFrom(byID, id, dependents)
+		}
+	}
+	return nil
+}
+
+func (s *Store) delete(actor Actor, id string) error {
 	mu := s.ticketMu(id)
 	mu.Lock()
 	defer mu.Unlock()
 
-	entityDir, _, err := s.findEntityDirAllStatuses(id)
+	entityDir, status, err := s.findEntityDirAllStatuses(id)
 	if err != nil {
 		return err
 	}
 
+	ticket, err := s.loadFromDir(entityDir)
+	if err != nil {
+		return err
+	}
+	ticket.ID = id
+	ticket.Status = status
+	if err := s.archiveHistory(entityDir, ticket, actor); err != nil {
+		return err
+	}
+
 	if err := os.RemoveAll(entityDir); err != nil {
 		return fmt.Errorf("remove entity directory: %w", err)
 	}
@@ -467,7 +664,7 @@ func (s *Store) List(status Status) ([]*Ticket, error) {
 func (s *Store) ListAll() (map[Status][]*Ticket, error) {
 	result := make(map[Status][]*Ticket)
 
-	for _, status := range []Status{StatusBacklog, StatusProgress, StatusDone} {
+	for _, status := range s.Statuses() {
 		tickets, err := s.List(status)
 		if err != nil {
 			return nil, err
@@ -478,7 +675,32 @@ func (s *Store) ListAll() (map[Status][]*Ticket, error) {
 	return result, nil
 }
 
+// SetMoveCheck sets the check every status change goes through. A nil
+// check allows any move to a known status.
+func (s *Store) SetMoveCheck(check MoveCheck) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+	s.moveCheck = check
+}
+
+// SetRenameCheck sets the check every title change that renames a ticket
+// goes through. A nil check allows any rename.
+func (s *Store) SetRenameCheck(check RenameCheck) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+	s.renameCheck = check
+}
+
 func (s *Store) Move(id string, to Status) error {
+	return s.MoveAs(DaemonActor, id, to)
+}
+
+// MoveAs is Move with the change attributed to actor in the ticket history.
+func (s *Store) MoveAs(actor Actor, id string, to Status) error {
+	if !s.HasStatus(to) {
+		return &ValidationError{Field: "status", Message: fmt.Sprintf("unknown status %q", to)}
+	}
+
 	mu := s.ticketMu(id)
 	mu.Lock()
 	defer mu.Unlock()
@@ -498,7 +720,18 @@ func (s *Store) Move(id string, to Status) error {
 	}
 	ticket.ID = id
 	ticket.Status = from
+	before := *ticket
+
+	s.statusMu.RLock()
+	check := s.moveCheck
+	s.statusMu.RUnlock()
+	if check != nil {
+		if err := check(ticket, from, to); err != nil {
+			return err
+		}
+	}
 
+	ticket.Status = to
 	ticket.Updated = time.Now().UTC()
 
 	toDir := filepath.Join(s.RootDir(), string(to))
@@ -511,8 +744,14 @@ func (s *Store) Move(id string, to Status) error {
 	if err := s.writeFile(newDir, ticket); err != nil {
 		return fmt.Errorf("save ticket: %w", err)
 	}
+	if err := s.recordRevision(newDir, &before, ticket, actor, ActionMoved, 0); err != nil {
+		return err
+	}
 
 	s.Emit(events.TicketMoved, ticket.ID, nil)
+	if to == StatusDone {
+		s.emitUnblocked(ticket.ID)
+	}
 	return nil
 }
 
@@ -524,6 +763,12 @@ func (s *Store) FilePath(id string) (string, error) {
 	return filepath.Join(entityDir, ticketFileName), nil
 }
 
+// Dir returns the entity directory of a ticket in any status.
+func (s *Store) Dir(id string) (string, error) {
+	entityDir, _, err := s.findEntityDirAllStatuses(id)
+	return entityDir, err
+}
+
 func (s *Store) HasConclusion(id string) (bool, error) {
 	entityDir, _, err := s.findEntityDirAllStatuses(id)
 	if err != nil {
@@ -551,6 +796,40 @@ func (s *Store) WriteConclusion(ticketID string, meta *TicketConclusionMeta, bod
 	return storage.AtomicWriteFile(filepath.Join(entityDir, conclusionFileName), data)
 }
 
+// ConcludeRepo records rc in the conclusion of a ticket spanning repos and
+// appends content to its body as a section for the repo. A conclusion left
+// over from a finished earlier round is replaced rather than merged into.
+// update, when non-nil, adjusts the merged conclusion before it is written.
+// The ticket's lock is held from read to write, so repos concluding at the
+// same time keep each other's results. The merged conclusion is returned
+// even when writing it fails.
+func (s *Store) ConcludeRepo(ticketID string, repos []string, rc RepoConclusion, content string, update func(*TicketConclusionMeta)) (*TicketConclusionMeta, error) {
+	mu := s.ticketMu(ticketID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	meta := &TicketConclusionMeta{}
+	body := ""
+	if existing, existingBody, err := s.ReadConclusion(ticketID); err == nil && len(existing.Repos) > 0 && !existing.ConcludedAll(repos) {
+		meta, body = existing, strings.TrimRight(existingBody, "\n")+"\n\n"
+	}
+	meta.MergeRepo(rc)
+	if update != nil {
+		update(meta)
+	}
+	body += "## " + rc.Repo + "\n\n" + content
+
+	entityDir, _, err := s.findEntityDirAllStatuses(ticketID)
+	if err != nil {
+		return meta, fmt.Errorf("find ticket dir: %w", err)
+	}
+	data, err := storage.SerializeFrontmatter(meta, body)
+	if err != nil {
+		return meta, fmt.Errorf("serialize conclusion: %w", err)
+	}
+	return meta, storage.AtomicWriteFile(filepath.Join(entityDir, conclusionFileName), data)
+}
+
 func (s *Store) ReadConclusion(ticketID string) (*TicketConclusionMeta, string, error) {
 	entityDir, _, err := s.findEntityDirAllStatuses(ticketID)
 	if err != nil {
@@ -573,10 +852,92 @@ type TicketConclusionMeta struct {
 	Rejected        bool      `yaml:"rejected,omitempty"`
 	RejectionReason string    `yaml:"rejection_reason,omitempty"`
 	Commits         []string  `yaml:"commits,omitempty"`
+	// SessionID is the worker session that concluded, whose timeline is
+	// stored next to the conclusion.
+	SessionID string `yaml:"session_id,omitempty"`
+	// Variant is the agents map entry the concluding session ran, reused
+	// when a review requests changes.
+	Variant string `yaml:"variant,omitempty"`
+	// Repos holds one entry per concluded repo of a multi-repo ticket.
+	// Commits then lists every repo's commits, and Rejected is set only when
+	// all repos were rejected.
+	Repos []RepoConclusion `yaml:"repos,omitempty"`
+	// Verifications holds one run of the verify commands per concluded
+	// repo that configures them.
+	Verifications []Verification `yaml:"verifications,omitempty"`
+}
+
+// RepoConclusion is how one worker of a multi-repo ticket concluded.
+type RepoConclusion struct {
+	Repo            string    `yaml:"repo"`
+	SessionID       string    `yaml:"session_id,omitempty"`
+	Agent           string    `yaml:"agent,omitempty"`
+	StartedAt       time.Time `yaml:"started_at"`
+	ConcludedAt     time.Time `yaml:"concluded_at"`
+	Rejected        bool      `yaml:"rejected,omitempty"`
+	RejectionReason string    `yaml:"rejection_reason,omitempty"`
+	Commits         []string  `yaml:"commits,omitempty"`
+}
+
+// RepoConclusion returns the conclusion recorded for repo, or nil.
+func (m *TicketConclusionMeta) RepoConclusion(repo string) *RepoConclusion {
+	for i := range m.Repos {
+		if m.Repos[i].Repo == repo {
+			return &m.Repos[i]
+		}
+	}
+	return nil
+}
+
+// ConcludedAll reports whether every one of repos has concluded.
+func (m *TicketConclusionMeta) ConcludedAll(repos []string) bool {
+	for _, repo := range repos {
+		if m.RepoConclusion(repo) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeRepo records rc, replacing any earlier conclusion of the same repo,
+// and recomputes the ticket-level fields from every repo.
+func (m *TicketConclusionMeta) MergeRepo(rc RepoConclusion) {
+	if existing := m.RepoConclusion(rc.Repo); existing != nil {
+		*existing = rc
+	} else {
+		m.Repos = append(m.Repos, rc)
+	}
+
+	m.Commits = nil
+	m.Rejected = true
+	var reasons []string
+	for i, r := range m.Repos {
+		if i == 0 || r.StartedAt.Before(m.StartedAt) {
+			m.StartedAt = r.StartedAt
+		}
+		if r.ConcludedAt.After(m.ConcludedAt) {
+			m.ConcludedAt = r.ConcludedAt
+		}
+		m.Commits = append(m.Commits, r.Commits...)
+		m.Rejected = m.Rejected && r.Rejected
+		if r.RejectionReason != "" {
+			reasons = append(reasons, r.Repo+": "+r.RejectionReason)
+		}
+	}
+	m.RejectionReason = ""
+	if m.Rejected {
+		m.RejectionReason = strings.Join(reasons, "; ")
+	}
+	m.Agent = m.Repos[0].Agent
+	m.SessionID = rc.SessionID
+}
+
+func (s *Store) entityDir(ticket *Ticket) string {
+	return filepath.Join(s.RootDir(), string(ticket.Status), ticket.ID)
 }
 
 func (s *Store) saveTicket(ticket *Ticket) error {
-	entityDir := filepath.Join(s.RootDir(), string(ticket.Status), ticket.ID)
+	entityDir := s.entityDir(ticket)
 
 	if err := os.MkdirAll(entityDir, 0755); err != nil {
 		return fmt.Errorf("create entity dir: %w", err)
@@ -612,6 +973,9 @@ func (s *Store) loadFromDir(entityDir string) (*Ticket, error) {
 	if err != nil {
 		return nil, fmt.Errorf("parse %s: %w", ticketFileName, err)
 	}
+	if meta.Type == "" {
+		meta.Type = DefaultTicketType
+	}
 
 	return &Ticket{
 		TicketMeta: *meta,
@@ -620,7 +984,7 @@ func (s *Store) loadFromDir(entityDir string) (*Ticket, error) {
 }
 
 func (s *Store) findEntityDirAllStatuses(id string) (string, Status, error) {
-	for _, status := range []Status{StatusBacklog, StatusProgress, StatusDone} {
+	for _, status := range s.Statuses() {
 		dir := filepath.Join(s.RootDir(), string(status), id)
 		if info, err := os.Stat(dir); err == nil && info.IsDir() {
 			return dir, status, nil
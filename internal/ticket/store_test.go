@@ -1,12 +1,14 @@
 package ticket
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 func setupTestStore(t *testing.T) (*Store, func()) {
@@ -49,7 +51,7 @@ func TestStoreCreate(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, err := store.Create("Test Ticket", "Test body", nil, nil, "")
+	ticket, err := store.Create("Test Ticket", "Test body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -75,7 +77,7 @@ func TestStoreCreateEmptyTitle(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	_, err := store.Create("", "body", nil, nil, "")
+	_, err := store.Create("", "body", nil, nil, "", nil, nil, "")
 	if err == nil {
 		t.Error("expected error for empty title")
 	}
@@ -89,7 +91,7 @@ func TestStoreCreateWithReferences(t *testing.T) {
 	defer cleanup()
 
 	refs := []string{"doc:abc123"}
-	ticket, err := store.Create("Test", "body", nil, refs, "")
+	ticket, err := store.Create("Test", "body", nil, refs, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -99,11 +101,151 @@ func TestStoreCreateWithReferences(t *testing.T) {
 	}
 }
 
+func TestStoreCreateWithType(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	bug, err := store.Create("Crash", "body", nil, nil, "", nil, nil, "bug")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	work, err := store.Create("Feature", "body", nil, nil, "", nil, nil, "")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	reloaded, _, err := store.Get(bug.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if reloaded.Type != "bug" {
+		t.Errorf("type = %q, want bug", reloaded.Type)
+	}
+	if work.Type != DefaultTicketType {
+		t.Errorf("type = %q, want %q", work.Type, DefaultTicketType)
+	}
+}
+
+func TestStoreCreateWithRepos(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	multi, err := store.Create("Rename field", "body", nil, nil, "api", nil, nil, "", WithRepos("api", "web", "", "api"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	reloaded, _, err := store.Get(multi.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if reloaded.Repo != "api" || strings.Join(reloaded.Repos, ",") != "api,web" || !reloaded.IsMultiRepo() {
+		t.Errorf("repo = %q, repos = %v, want api and [api web]", reloaded.Repo, reloaded.Repos)
+	}
+
+	// A single repo is stored as Repo alone.
+	single, err := store.Create("Fix", "body", nil, nil, "api", nil, nil, "", WithRepos("api"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if single.IsMultiRepo() || single.Repos != nil || strings.Join(single.RepoKeys(), ",") != "api" {
+		t.Errorf("single-repo ticket: repos = %v, keys = %v", single.Repos, single.RepoKeys())
+	}
+}
+
+func TestConclusionMergeRepo(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 0, 0, 0, 0, time.UTC) }
+	meta := &TicketConclusionMeta{}
+
+	meta.MergeRepo(RepoConclusion{Repo: "api", SessionID: "s1", Agent: "claude", StartedAt: day(2), ConcludedAt: day(3), Commits: []string{"a1"}})
+	meta.MergeRepo(RepoConclusion{Repo: "web", SessionID: "s2", Agent: "codex", StartedAt: day(1), ConcludedAt: day(4), Rejected: true, RejectionReason: "no change needed"})
+
+	if !meta.ConcludedAll([]string{"api", "web"}) || meta.ConcludedAll([]string{"api", "web", "cli"}) {
+		t.Error("ConcludedAll mismatch")
+	}
+	if !meta.StartedAt.Equal(day(1)) || !meta.ConcludedAt.Equal(day(4)) {
+		t.Errorf("span = %v..%v, want May 1..May 4", meta.StartedAt, meta.ConcludedAt)
+	}
+	if strings.Join(meta.Commits, ",") != "a1" || meta.Rejected || meta.RejectionReason != "" {
+		t.Errorf("commits = %v, rejected = %v (%q)", meta.Commits, meta.Rejected, meta.RejectionReason)
+	}
+	if meta.Agent != "claude" || meta.SessionID != "s2" {
+		t.Errorf("agent = %q, session = %q", meta.Agent, meta.SessionID)
+	}
+
+	// Concluding a repo again replaces its entry.
+	meta.MergeRepo(RepoConclusion{Repo: "api", StartedAt: day(2), ConcludedAt: day(5), Rejected: true, RejectionReason: "superseded"})
+	if len(meta.Repos) != 2 || len(meta.Commits) != 0 || !meta.Rejected {
+		t.Errorf("after re-conclude: repos = %d, commits = %v, rejected = %v", len(meta.Repos), meta.Commits, meta.Rejected)
+	}
+	if meta.RejectionReason != "api: superseded; web: no change needed" {
+		t.Errorf("rejection reason = %q", meta.RejectionReason)
+	}
+}
+
+func TestConcludeRepoConcurrently(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	repos := make([]string, 20)
+	for i := range repos {
+		repos[i] = fmt.Sprintf("repo%d", i)
+	}
+	tk, _ := store.Create("Fan out", "", nil, nil, "", nil, nil, "")
+
+	var wg sync.WaitGroup
+	for _, repo := range repos {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := store.ConcludeRepo(tk.ID, repos, RepoConclusion{Repo: repo}, repo+" done", nil); err != nil {
+				t.Errorf("ConcludeRepo %s: %v", repo, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	meta, body, err := store.ReadConclusion(tk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !meta.ConcludedAll(repos) {
+		t.Errorf("conclusion holds %d of %d repos", len(meta.Repos), len(repos))
+	}
+	for _, repo := range repos {
+		if !strings.Contains(body, "## "+repo+"\n\n"+repo+" done") {
+			t.Errorf("body lacks the %s section", repo)
+		}
+	}
+}
+
+func TestStoreGetDefaultsMissingType(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	id := "2026-05-11-1200-untyped-ticket"
+	dir := filepath.Join(store.RootDir(), string(StatusBacklog), id)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	data := []byte("---\ntitle: Untyped\ncreated: 2026-05-11T12:00:00Z\nupdated: 2026-05-11T12:00:00Z\n---\nbody\n")
+	if err := os.WriteFile(filepath.Join(dir, ticketFileName), data, 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	tk, _, err := store.Get(id)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if tk.Type != DefaultTicketType {
+		t.Errorf("type = %q, want %q", tk.Type, DefaultTicketType)
+	}
+}
+
 func TestStoreGet(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	created, err := store.Create("Test Ticket", "body", nil, nil, "")
+	created, err := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -168,11 +310,11 @@ func TestStoreUpdate(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "Original body", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "Original body", nil, nil, "", nil, nil, "")
 
 	newTitle := "Updated Title"
 	newBody := "Updated body"
-	updated, err := store.Update(ticket.ID, &newTitle, &newBody, nil)
+	updated, err := store.Update(ticket.ID, &newTitle, &newBody, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -189,10 +331,10 @@ func TestStoreUpdatePartial(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "Original body", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "Original body", nil, nil, "", nil, nil, "")
 
 	newTitle := "Updated Title"
-	updated, err := store.Update(ticket.ID, &newTitle, nil, nil)
+	updated, err := store.Update(ticket.ID, &newTitle, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -209,7 +351,7 @@ func TestStoreEditBodyExactMatch(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "alpha\nbeta\ngamma", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "alpha\nbeta\ngamma", nil, nil, "", nil, nil, "")
 
 	updated, err := store.EditBody(ticket.ID, "beta", "delta", false)
 	if err != nil {
@@ -225,7 +367,7 @@ func TestStoreEditBodyWhitespaceNormalized(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "alpha\n  beta   gamma  \ndelta", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "alpha\n  beta   gamma  \ndelta", nil, nil, "", nil, nil, "")
 
 	updated, err := store.EditBody(ticket.ID, "beta gamma", "beta zeta", false)
 	if err != nil {
@@ -241,7 +383,7 @@ func TestStoreEditBodyReplaceAll(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "repeat\nx\nrepeat", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "repeat\nx\nrepeat", nil, nil, "", nil, nil, "")
 
 	updated, err := store.EditBody(ticket.ID, "repeat", "done", true)
 	if err != nil {
@@ -257,7 +399,7 @@ func TestStoreEditBodyAmbiguousWithoutReplaceAll(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "repeat\nx\nrepeat", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "repeat\nx\nrepeat", nil, nil, "", nil, nil, "")
 
 	_, err := store.EditBody(ticket.ID, "repeat", "done", false)
 	if err == nil {
@@ -277,7 +419,7 @@ func TestStoreEditBodyNotFound(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Original Title", "alpha\nbeta\ngamma", nil, nil, "")
+	ticket, _ := store.Create("Original Title", "alpha\nbeta\ngamma", nil, nil, "", nil, nil, "")
 
 	_, err := store.EditBody(ticket.ID, "missing", "delta", false)
 	if err == nil {
@@ -297,7 +439,7 @@ func TestStoreUpdateTitleRename(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Old Title", "body", nil, nil, "")
+	ticket, _ := store.Create("Old Title", "body", nil, nil, "", nil, nil, "")
 	oldID := ticket.ID
 
 	// Verify old directory exists (new format: YYYY-MM-DD-HHMM-old-title)
@@ -307,7 +449,7 @@ func TestStoreUpdateTitleRename(t *testing.T) {
 	}
 
 	newTitle := "New Title"
-	updated, err := store.Update(oldID, &newTitle, nil, nil)
+	updated, err := store.Update(oldID, &newTitle, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -337,7 +479,7 @@ func TestStoreDelete(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	if err := store.Delete(ticket.ID); err != nil {
 		t.Fatalf("Delete failed: %v", err)
@@ -353,8 +495,8 @@ func TestStoreList(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	_, _ = store.Create("Ticket 1", "", nil, nil, "")
-	_, _ = store.Create("Ticket 2", "", nil, nil, "")
+	_, _ = store.Create("Ticket 1", "", nil, nil, "", nil, nil, "")
+	_, _ = store.Create("Ticket 2", "", nil, nil, "", nil, nil, "")
 
 	tickets, err := store.List(StatusBacklog)
 	if err != nil {
@@ -370,8 +512,8 @@ func TestStoreListAll(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	t1, _ := store.Create("Backlog Ticket", "", nil, nil, "")
-	t2, _ := store.Create("Progress Ticket", "", nil, nil, "")
+	t1, _ := store.Create("Backlog Ticket", "", nil, nil, "", nil, nil, "")
+	t2, _ := store.Create("Progress Ticket", "", nil, nil, "", nil, nil, "")
 	_ = store.Move(t2.ID, StatusProgress)
 
 	all, err := store.ListAll()
@@ -390,11 +532,63 @@ func TestStoreListAll(t *testing.T) {
 	}
 }
 
+func TestStoreCustomStatuses(t *testing.T) {
+	statuses := []Status{StatusBacklog, StatusProgress, "review", StatusDone}
+	store, err := NewStoreWithStatuses(t.TempDir(), nil, "", statuses)
+	if err != nil {
+		t.Fatalf("create store: %v", err)
+	}
+
+	created, _ := store.Create("Needs review", "", nil, nil, "", nil, nil, "")
+	if err := store.Move(created.ID, "review"); err != nil {
+		t.Fatalf("Move to review failed: %v", err)
+	}
+
+	_, status, err := store.Get(created.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if status != "review" {
+		t.Errorf("status = %q, want review", status)
+	}
+
+	all, err := store.ListAll()
+	if err != nil {
+		t.Fatalf("ListAll failed: %v", err)
+	}
+	if len(all["review"]) != 1 {
+		t.Errorf("review count = %d, want 1", len(all["review"]))
+	}
+
+	var vErr *ValidationError
+	if err := store.Move(created.ID, "blocked"); !errors.As(err, &vErr) {
+		t.Errorf("expected ValidationError for unknown status, got %v", err)
+	}
+}
+
+func TestStoreSetStatuses(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	if store.HasStatus("review") {
+		t.Fatal("default store should not have review status")
+	}
+	if err := store.SetStatuses([]Status{StatusBacklog, StatusProgress, "review", StatusDone}); err != nil {
+		t.Fatalf("SetStatuses failed: %v", err)
+	}
+	if !store.HasStatus("review") {
+		t.Error("expected review status after SetStatuses")
+	}
+	if got := store.Statuses(); len(got) != 4 || got[2] != "review" {
+		t.Errorf("Statuses() = %v", got)
+	}
+}
+
 func TestStoreMove(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	if err := store.Move(ticket.ID, StatusProgress); err != nil {
 		t.Fatalf("Move failed: %v", err)
@@ -414,7 +608,7 @@ func TestStoreMoveSameStatus(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "")
+	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
 
 	if err := store.Move(ticket.ID, StatusBacklog); err != nil {
 		t.Fatalf("Move to same status failed: %v", err)
@@ -429,11 +623,58 @@ func TestStoreMoveSameStatus(t *testing.T) {
 	}
 }
 
+func TestStoreMoveCheck(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	refused := errors.New("refused")
+	store.SetMoveCheck(func(t *Ticket, from, to Status) error {
+		if to == StatusDone {
+			return refused
+		}
+		return nil
+	})
+
+	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
+	if err := store.Move(ticket.ID, StatusDone); err != refused {
+		t.Fatalf("Move to done = %v, want the check's error", err)
+	}
+	if _, status, _ := store.Get(ticket.ID); status != StatusBacklog {
+		t.Errorf("status = %q, want %q", status, StatusBacklog)
+	}
+	if err := store.Move(ticket.ID, StatusProgress); err != nil {
+		t.Fatalf("Move to progress failed: %v", err)
+	}
+}
+
+func TestStoreRenameCheck(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	refused := errors.New("refused")
+	store.SetRenameCheck(func(t *Ticket) error { return refused })
+
+	ticket, _ := store.Create("Test Ticket", "body", nil, nil, "", nil, nil, "")
+	title := "Renamed Ticket"
+	if _, err := store.Update(ticket.ID, &title, nil, nil, nil, nil); err != refused {
+		t.Fatalf("Update with new title = %v, want the check's error", err)
+	}
+	if got, _, err := store.Get(ticket.ID); err != nil || got.Title != "Test Ticket" {
+		t.Fatalf("ticket after refused rename = %v, %v", got, err)
+	}
+
+	// Updates that keep the title keep the ID and skip the check.
+	body := "new body"
+	if _, err := store.Update(ticket.ID, nil, &body, nil, nil, nil); err != nil {
+		t.Fatalf("Update body failed: %v", err)
+	}
+}
+
 func TestStoreConcurrentUpdates(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	tk, err := store.Create("Concurrent Ticket", "initial body", nil, nil, "")
+	tk, err := store.Create("Concurrent Ticket", "initial body", nil, nil, "", nil, nil, "")
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -450,7 +691,7 @@ func TestStoreConcurrentUpdates(t *testing.T) {
 			defer wg.Done()
 			for i := 0; i < updatesPerGoroutine; i++ {
 				body := fmt.Sprintf("Body-%d-%d", g, i)
-				_, updateErr := store.Update(currentID, nil, &body, nil)
+				_, updateErr := store.Update(currentID, nil, &body, nil, nil, nil)
 				if updateErr != nil {
 					t.Errorf("Update goroutine %d iter %d failed: %v", g, i, updateErr)
 					return
@@ -477,7 +718,7 @@ func TestStoreDirLayout(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Fix Auth Bug", "body", nil, nil, "")
+	ticket, _ := store.Create("Fix Auth Bug", "body", nil, nil, "", nil, nil, "")
 
 	// Verify directory structure with new ticket.md file
 	entityDir := filepath.Join(store.RootDir(), "backlog", ticket.ID)
@@ -495,7 +736,7 @@ func TestStoreSetDueDate(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Test", "body", nil, nil, "")
+	ticket, _ := store.Create("Test", "body", nil, nil, "", nil, nil, "")
 
 	due := ticket.Created.AddDate(0, 0, 7)
 	updated, err := store.SetDueDate(ticket.ID, &due)
@@ -512,7 +753,7 @@ func TestStoreClearDueDate(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	ticket, _ := store.Create("Test", "body", nil, nil, "")
+	ticket, _ := store.Create("Test", "body", nil, nil, "", nil, nil, "")
 
 	d := ticket.Created.AddDate(0, 0, 7)
 	_, _ = store.SetDueDate(ticket.ID, &d)
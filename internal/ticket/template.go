@@ -0,0 +1,247 @@
+package ticket
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+// TemplateMeta is the frontmatter of a ticket template. Every field is a
+// default for the tickets created from it.
+type TemplateMeta struct {
+	Description string   `yaml:"description,omitempty"`
+	Title       string   `yaml:"title,omitempty"`
+	Type        string   `yaml:"type,omitempty"`
+	Repo        string   `yaml:"repo,omitempty"`
+	References  []string `yaml:"references,omitempty"`
+	// Due is an offset from creation such as 3d, 2w or 48h, or a fixed
+	// date (2006-01-02 or RFC3339).
+	Due string `yaml:"due,omitempty"`
+}
+
+// Template is a ticket template, stored as templates/<name>.md in the
+// architect workspace. Its title and body may use {{.var}} variables.
+type Template struct {
+	Name string
+	TemplateMeta
+	Body string
+}
+
+// ListTemplates loads every template in dir, sorted by name. A missing
+// directory has no templates.
+func ListTemplates(dir string) ([]*Template, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read templates: %w", err)
+	}
+
+	var templates []*Template
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".md" {
+			continue
+		}
+		tmpl, err := LoadTemplate(dir, strings.TrimSuffix(e.Name(), ".md"))
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, tmpl)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+// LoadTemplate loads template name from dir.
+func LoadTemplate(dir, name string) (*Template, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return nil, &ValidationError{Field: "template", Message: fmt.Sprintf("invalid template name %q", name)}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, name+".md"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &NotFoundError{Resource: "template", ID: name}
+		}
+		return nil, fmt.Errorf("read template %s: %w", name, err)
+	}
+
+	// Frontmatter is optional; a plain markdown file is all body.
+	meta, body := &TemplateMeta{}, string(data)
+	if strings.HasPrefix(body, "---") {
+		meta, body, err = storage.ParseFrontmatter[TemplateMeta](data)
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", name, err)
+		}
+	}
+	return &Template{Name: name, TemplateMeta: *meta, Body: body}, nil
+}
+
+// Variables returns the variables the template's title and body use,
+// sorted and without duplicates.
+func (t *Template) Variables() []string {
+	seen := map[string]bool{}
+	for _, text := range []string{t.Title, t.Body} {
+		tree, err := parse.Parse("t", text, "", "")
+		if err != nil {
+			continue
+		}
+		for _, tr := range tree {
+			collectFields(tr.Root, seen)
+		}
+	}
+	vars := make([]string, 0, len(seen))
+	for v := range seen {
+		vars = append(vars, v)
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+// Instantiate renders the template with vars and returns the ticket it
+// describes, to pass to WithTemplate. Every variable the template uses
+// must be set.
+func (t *Template) Instantiate(vars map[string]string, now time.Time) (*Ticket, error) {
+	title, err := t.render("title", t.Title, vars)
+	if err != nil {
+		return nil, err
+	}
+	body, err := t.render("body", t.Body, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Ticket{
+		TicketMeta: TicketMeta{
+			Title:      strings.TrimSpace(title),
+			Type:       t.Type,
+			Repo:       t.Repo,
+			References: slices.Clone(t.References),
+		},
+		Body: body,
+	}
+	if t.Due != "" {
+		due, err := parseDue(t.Due, now)
+		if err != nil {
+			return nil, &ValidationError{Field: "due", Message: fmt.Sprintf("template %s: %v", t.Name, err)}
+		}
+		out.Due = &due
+	}
+	return out, nil
+}
+
+func (t *Template) render(field, text string, vars map[string]string) (string, error) {
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", &ValidationError{Field: "template", Message: fmt.Sprintf("template %s %s: %v", t.Name, field, err)}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		var execErr template.ExecError
+		if errors.As(err, &execErr) {
+			err = execErr.Err
+		}
+		return "", &ValidationError{Field: "vars", Message: fmt.Sprintf("template %s: %v", t.Name, err)}
+	}
+	return buf.String(), nil
+}
+
+// WithTemplate fills the fields a create call left empty from a ticket
+// returned by Template.Instantiate. References are merged, the template's
+// first.
+func WithTemplate(tmpl *Ticket) CreateOption {
+	return func(t *Ticket) {
+		if t.Title == "" {
+			t.Title = tmpl.Title
+		}
+		if strings.TrimSpace(t.Body) == "" {
+			t.Body = tmpl.Body
+		}
+		if t.Type == "" {
+			t.Type = tmpl.Type
+		}
+		if t.Repo == "" && len(t.Repos) == 0 {
+			t.Repo = tmpl.Repo
+		}
+		if t.Due == nil {
+			t.Due = tmpl.Due
+		}
+		t.References = uniqueNonEmpty(append(slices.Clone(tmpl.References), t.References...))
+	}
+}
+
+// parseDue resolves a template due value against now.
+func parseDue(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+
+	days := map[byte]int{'d': 1, 'w': 7}
+	if n := len(s); n > 1 && days[s[n-1]] > 0 {
+		count, err := strconv.Atoi(s[:n-1])
+		if err != nil || count < 0 {
+			return time.Time{}, fmt.Errorf("invalid due %q", s)
+		}
+		return now.AddDate(0, 0, count*days[s[n-1]]), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid due %q: use an offset like 3d, 2w or 48h, or a date", s)
+	}
+	return now.Add(d), nil
+}
+
+// collectFields records the top-level field names referenced under node.
+func collectFields(node parse.Node, seen map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			collectFields(c, seen)
+		}
+	case *parse.ActionNode:
+		collectFields(n.Pipe, seen)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				collectFields(arg, seen)
+			}
+		}
+	case *parse.FieldNode:
+		seen[n.Ident[0]] = true
+	case *parse.IfNode:
+		collectFields(n.Pipe, seen)
+		collectFields(n.List, seen)
+		collectFields(n.ElseList, seen)
+	case *parse.RangeNode:
+		collectFields(n.Pipe, seen)
+		collectFields(n.List, seen)
+		collectFields(n.ElseList, seen)
+	case *parse.WithNode:
+		collectFields(n.Pipe, seen)
+		collectFields(n.List, seen)
+		collectFields(n.ElseList, seen)
+	}
+}
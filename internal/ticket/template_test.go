@@ -0,0 +1,108 @@
+package ticket
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemplateInstantiate(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "bug", `---
+description: Bug report
+title: "Fix {{.component}} crash"
+type: bug
+repo: api
+references: [ref-a]
+due: 3d
+---
+## Context
+
+{{.component}} crashes.
+
+{{if .steps}}## Steps
+{{.steps}}{{end}}
+`)
+	writeTemplate(t, dir, "plain", "## Acceptance criteria\n")
+
+	templates, err := ListTemplates(dir)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(templates) != 2 || templates[0].Name != "bug" || templates[1].Name != "plain" {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+	if templates[1].Body != "## Acceptance criteria\n" {
+		t.Errorf("plain template body = %q", templates[1].Body)
+	}
+
+	tmpl := templates[0]
+	if got := tmpl.Variables(); !reflect.DeepEqual(got, []string{"component", "steps"}) {
+		t.Errorf("Variables() = %v", got)
+	}
+
+	var vErr *ValidationError
+	if _, err := tmpl.Instantiate(map[string]string{"component": "parser"}, time.Now()); !errors.As(err, &vErr) || vErr.Field != "vars" {
+		t.Fatalf("expected a vars ValidationError for a missing variable, got %v", err)
+	}
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	inst, err := tmpl.Instantiate(map[string]string{"component": "parser", "steps": "run it"}, now)
+	if err != nil {
+		t.Fatalf("instantiate: %v", err)
+	}
+	if inst.Title != "Fix parser crash" || inst.Type != "bug" || inst.Repo != "api" {
+		t.Errorf("unexpected meta: %+v", inst.TicketMeta)
+	}
+	if inst.Due == nil || !inst.Due.Equal(now.AddDate(0, 0, 3)) {
+		t.Errorf("expected due in 3 days, got %v", inst.Due)
+	}
+	if want := "## Context\n\nparser crashes.\n\n## Steps\nrun it\n"; inst.Body != want {
+		t.Errorf("body = %q, want %q", inst.Body, want)
+	}
+
+	if _, err := LoadTemplate(dir, "missing"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError, got %v", err)
+	}
+}
+
+func TestCreateWithTemplate(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	due := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	tmpl := &Ticket{
+		TicketMeta: TicketMeta{Title: "From template", Type: "bug", Repo: "api", References: []string{"ref-a"}, Due: &due},
+		Body:       "## Context\n",
+	}
+
+	created, err := store.Create("", "", nil, []string{"ref-b"}, "", nil, nil, "", WithTemplate(tmpl))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Title != "From template" || created.Type != "bug" || created.Repo != "api" || created.Body != "## Context\n" {
+		t.Errorf("template defaults not applied: %+v", created)
+	}
+	if !reflect.DeepEqual(created.References, []string{"ref-a", "ref-b"}) {
+		t.Errorf("references = %v", created.References)
+	}
+
+	// Explicit arguments win over the template.
+	created, err = store.Create("Own title", "own body", nil, nil, "web", nil, nil, "work", WithTemplate(tmpl))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Title != "Own title" || created.Body != "own body" || created.Repo != "web" || created.Type != "work" {
+		t.Errorf("explicit values overridden: %+v", created)
+	}
+}
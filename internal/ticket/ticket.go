@@ -1,6 +1,7 @@
 package ticket
 
 import (
+	"slices"
 	"time"
 
 	"github.com/kareemaly/cortex/internal/storage"
@@ -12,8 +13,15 @@ const (
 	StatusBacklog  Status = "backlog"
 	StatusProgress Status = "progress"
 	StatusDone     Status = "done"
+
+	// StatusReview is optional. When cortex.yaml configures it, concluded
+	// tickets wait there until their review is accepted.
+	StatusReview Status = "review"
 )
 
+// DefaultStatuses is the status list used when cortex.yaml declares none.
+var DefaultStatuses = []Status{StatusBacklog, StatusProgress, StatusDone}
+
 const DefaultTicketType = "work"
 
 type (
@@ -24,12 +32,23 @@ type (
 var IsNotFound = storage.IsNotFound
 
 type TicketMeta struct {
-	Title      string     `yaml:"title"`
-	Repo       string     `yaml:"repo,omitempty"`
+	Title string `yaml:"title"`
+	Type  string `yaml:"type,omitempty"`
+	Repo  string `yaml:"repo,omitempty"`
+	// Repos lists every repo of a ticket that spans several; spawning opens
+	// one worker per repo. Repo holds the first of them.
+	Repos      []string   `yaml:"repos,omitempty"`
 	References []string   `yaml:"references,omitempty"`
+	BlockedBy  []string   `yaml:"blocked_by,omitempty"`
+	Blocks     []string   `yaml:"blocks,omitempty"`
 	Due        *time.Time `yaml:"due,omitempty"`
-	Created    time.Time  `yaml:"created"`
-	Updated    time.Time  `yaml:"updated"`
+	Priority   Priority   `yaml:"priority,omitempty"`
+	Labels     []string   `yaml:"labels,omitempty"`
+	// Assignee is who picks the ticket up: a person, or the name of an
+	// agent variant.
+	Assignee string    `yaml:"assignee,omitempty"`
+	Created  time.Time `yaml:"created"`
+	Updated  time.Time `yaml:"updated"`
 }
 
 type Ticket struct {
@@ -38,3 +57,65 @@ type Ticket struct {
 	TicketMeta
 	Body string
 }
+
+// IsMultiRepo reports whether the ticket spans more than one repo.
+func (m *TicketMeta) IsMultiRepo() bool {
+	return len(m.Repos) > 1
+}
+
+// RepoKeys returns the repos the ticket's workers run in: Repos for a
+// multi-repo ticket, else Repo when set.
+func (m *TicketMeta) RepoKeys() []string {
+	if m.IsMultiRepo() {
+		return m.Repos
+	}
+	if m.Repo != "" {
+		return []string{m.Repo}
+	}
+	return nil
+}
+
+// CreateOption sets optional fields on a ticket being created.
+type CreateOption func(*Ticket)
+
+// UpdateOption changes optional fields on a ticket being updated.
+type UpdateOption func(*Ticket)
+
+// AddReference lists id in the ticket's references unless it already is.
+func AddReference(id string) UpdateOption {
+	return func(t *Ticket) {
+		if !slices.Contains(t.References, id) {
+			t.References = append(t.References, id)
+		}
+	}
+}
+
+// WithRepos makes the ticket span repos. Repo is set to the first; a single
+// repo is stored as Repo alone.
+func WithRepos(repos ...string) CreateOption {
+	return func(t *Ticket) {
+		repos = uniqueNonEmpty(repos)
+		if len(repos) == 0 {
+			return
+		}
+		t.Repo = repos[0]
+		t.Repos = nil
+		if len(repos) > 1 {
+			t.Repos = repos
+		}
+	}
+}
+
+// uniqueNonEmpty drops empty and repeated entries, keeping order.
+func uniqueNonEmpty(values []string) []string {
+	var out []string
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package ticket
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Priority ranks how urgently a ticket should be picked up. Tickets
+// without one sort after every priority.
+type Priority string
+
+const (
+	PriorityUrgent Priority = "urgent"
+	PriorityHigh   Priority = "high"
+	PriorityMedium Priority = "medium"
+	PriorityLow    Priority = "low"
+)
+
+// Priorities lists the valid priorities, most urgent first.
+var Priorities = []Priority{PriorityUrgent, PriorityHigh, PriorityMedium, PriorityLow}
+
+// Rank orders priorities for sorting: 0 is the most urgent, and a ticket
+// without a priority ranks last.
+func (p Priority) Rank() int {
+	if i := slices.Index(Priorities, p); i >= 0 {
+		return i
+	}
+	return len(Priorities)
+}
+
+// ParsePriority validates a priority name. The empty string is no priority.
+func ParsePriority(s string) (Priority, error) {
+	p := Priority(strings.ToLower(strings.TrimSpace(s)))
+	if p == "" || slices.Contains(Priorities, p) {
+		return p, nil
+	}
+	return "", &ValidationError{Field: "priority", Message: fmt.Sprintf("unknown priority %q: must be urgent, high, medium or low", s)}
+}
+
+// HasLabel reports whether the ticket carries label.
+func (m *TicketMeta) HasLabel(label string) bool {
+	return slices.Contains(m.Labels, label)
+}
+
+// WithPriority sets the ticket's priority.
+func WithPriority(p Priority) CreateOption {
+	return func(t *Ticket) {
+		t.Priority = p
+	}
+}
+
+// WithLabels sets the ticket's labels.
+func WithLabels(labels ...string) CreateOption {
+	return func(t *Ticket) {
+		t.Labels = labels
+	}
+}
+
+// WithAssignee sets who the ticket is assigned to: a person, or the name
+// of an agent variant.
+func WithAssignee(assignee string) CreateOption {
+	return func(t *Ticket) {
+		t.Assignee = assignee
+	}
+}
+
+// SetPriority changes the ticket's priority; empty clears it.
+func SetPriority(p Priority) UpdateOption {
+	return UpdateOption(WithPriority(p))
+}
+
+// SetLabels replaces the ticket's labels.
+func SetLabels(labels ...string) UpdateOption {
+	return UpdateOption(WithLabels(labels...))
+}
+
+// SetAssignee changes the ticket's assignee; empty clears it.
+func SetAssignee(assignee string) UpdateOption {
+	return UpdateOption(WithAssignee(assignee))
+}
+
+// normalizeTriage validates the priority and cleans up the labels and
+// assignee of a ticket being saved.
+func normalizeTriage(t *Ticket) error {
+	p, err := ParsePriority(string(t.Priority))
+	if err != nil {
+		return err
+	}
+	t.Priority = p
+
+	labels := make([]string, 0, len(t.Labels))
+	for _, l := range t.Labels {
+		l = strings.TrimSpace(l)
+		if strings.ContainsAny(l, ", \t\n") {
+			return &ValidationError{Field: "labels", Message: fmt.Sprintf("label %q cannot contain commas or whitespace", l)}
+		}
+		labels = append(labels, l)
+	}
+	t.Labels = uniqueNonEmpty(labels)
+	t.Assignee = strings.TrimSpace(t.Assignee)
+	return nil
+}
@@ -0,0 +1,72 @@
+package ticket
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStoreTriage(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	created, err := store.Create("Fix login", "", nil, nil, "", nil, nil, "",
+		WithPriority("High"), WithLabels("auth", " bug", "auth", ""), WithAssignee(" claude-plan "))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Priority != PriorityHigh || !slices.Equal(created.Labels, []string{"auth", "bug"}) || created.Assignee != "claude-plan" {
+		t.Fatalf("unexpected triage: %q %v %q", created.Priority, created.Labels, created.Assignee)
+	}
+
+	got, _, err := store.Get(created.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Priority != PriorityHigh || !got.HasLabel("bug") || got.Assignee != "claude-plan" {
+		t.Errorf("triage not persisted: %q %v %q", got.Priority, got.Labels, got.Assignee)
+	}
+
+	updated, err := store.Update(created.ID, nil, nil, nil, nil, nil, SetPriority(""), SetLabels("ui"), SetAssignee("dana"))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Priority != "" || !slices.Equal(updated.Labels, []string{"ui"}) || updated.Assignee != "dana" {
+		t.Errorf("unexpected triage after update: %q %v %q", updated.Priority, updated.Labels, updated.Assignee)
+	}
+
+	revisions, err := store.History(created.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	last := revisions[len(revisions)-1]
+	var fields []string
+	for _, c := range last.Changes {
+		fields = append(fields, c.Field)
+	}
+	if !slices.Equal(fields, []string{"priority", "labels", "assignee"}) {
+		t.Errorf("expected triage changes in history, got %v", fields)
+	}
+}
+
+func TestStoreTriageValidation(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	if _, err := store.Create("Bad", "", nil, nil, "", nil, nil, "", WithPriority("someday")); !isValidationField(err, "priority") {
+		t.Errorf("expected priority ValidationError, got %v", err)
+	}
+	if _, err := store.Create("Bad", "", nil, nil, "", nil, nil, "", WithLabels("two words")); !isValidationField(err, "labels") {
+		t.Errorf("expected labels ValidationError, got %v", err)
+	}
+}
+
+func TestPriorityRank(t *testing.T) {
+	if !(PriorityUrgent.Rank() < PriorityLow.Rank() && PriorityLow.Rank() < Priority("").Rank()) {
+		t.Errorf("expected urgent < low < none, got %d %d %d", PriorityUrgent.Rank(), PriorityLow.Rank(), Priority("").Rank())
+	}
+}
+
+func isValidationField(err error, field string) bool {
+	valErr, ok := err.(*ValidationError)
+	return ok && valErr.Field == field
+}
@@ -0,0 +1,132 @@
+package ticket
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/events"
+	"github.com/kareemaly/cortex/internal/storage"
+)
+
+// VerifyStatus is the state of a conclusion's verification run.
+type VerifyStatus string
+
+const (
+	VerifyPending VerifyStatus = "pending"
+	VerifyPassed  VerifyStatus = "passed"
+	VerifyFailed  VerifyStatus = "failed"
+)
+
+// Verification is the run of a repo's cortex.yaml verify commands against
+// the last commit a worker concluded with.
+type Verification struct {
+	Repo      string       `yaml:"repo"`
+	Commit    string       `yaml:"commit"`
+	Status    VerifyStatus `yaml:"status"`
+	StartedAt time.Time    `yaml:"started_at"`
+	// FinishedAt is nil while the run is pending.
+	FinishedAt *time.Time `yaml:"finished_at,omitempty"`
+	// Error reports a run that could not start, such as a failed checkout.
+	Error    string          `yaml:"error,omitempty"`
+	Commands []VerifyCommand `yaml:"commands,omitempty"`
+}
+
+// VerifyCommand is the outcome of one verify command. Output keeps the
+// tail of long logs.
+type VerifyCommand struct {
+	Command    string `yaml:"command"`
+	ExitCode   int    `yaml:"exit_code"`
+	TimedOut   bool   `yaml:"timed_out,omitempty"`
+	DurationMS int64  `yaml:"duration_ms"`
+	Output     string `yaml:"output,omitempty"`
+}
+
+// Failed returns the command that failed the run, or nil.
+func (v *Verification) Failed() *VerifyCommand {
+	for i := range v.Commands {
+		if c := &v.Commands[i]; c.ExitCode != 0 || c.TimedOut {
+			return c
+		}
+	}
+	return nil
+}
+
+// Verification returns the verification recorded for repo, or nil.
+func (m *TicketConclusionMeta) Verification(repo string) *Verification {
+	for i := range m.Verifications {
+		if m.Verifications[i].Repo == repo {
+			return &m.Verifications[i]
+		}
+	}
+	return nil
+}
+
+// PutVerification records v, replacing the entry for its repo.
+func (m *TicketConclusionMeta) PutVerification(v Verification) {
+	if existing := m.Verification(v.Repo); existing != nil {
+		*existing = v
+		return
+	}
+	m.Verifications = append(m.Verifications, v)
+}
+
+// VerifyStatus summarises the verifications of the conclusion: failed if
+// any failed, pending if any is still running, passed when all passed, and
+// "" without verifications.
+func (m *TicketConclusionMeta) VerifyStatus() VerifyStatus {
+	status := VerifyStatus("")
+	for _, v := range m.Verifications {
+		switch {
+		case v.Status == VerifyFailed:
+			return VerifyFailed
+		case v.Status == VerifyPending:
+			status = VerifyPending
+		case status == "":
+			status = VerifyPassed
+		}
+	}
+	return status
+}
+
+// SetVerification stores the finished verification v on the ticket's
+// conclusion. It reports false without writing when the conclusion no
+// longer holds a pending run of v's commit for its repo, because the
+// worker concluded again in the meantime.
+func (s *Store) SetVerification(id string, v Verification) (bool, error) {
+	mu := s.ticketMu(id)
+	mu.Lock()
+	defer mu.Unlock()
+
+	entityDir, _, err := s.findEntityDirAllStatuses(id)
+	if err != nil {
+		return false, err
+	}
+	path := filepath.Join(entityDir, conclusionFileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("read conclusion: %w", err)
+	}
+	meta, body, err := storage.ParseFrontmatter[TicketConclusionMeta](data)
+	if err != nil {
+		return false, err
+	}
+
+	current := meta.Verification(v.Repo)
+	if current == nil || current.Commit != v.Commit || current.Status != VerifyPending {
+		return false, nil
+	}
+	*current = v
+
+	data, err = storage.SerializeFrontmatter(meta, body)
+	if err != nil {
+		return false, fmt.Errorf("serialize conclusion: %w", err)
+	}
+	if err := storage.AtomicWriteFile(path, data); err != nil {
+		return false, err
+	}
+
+	s.Emit(events.TicketUpdated, id, nil)
+	return true, nil
+}
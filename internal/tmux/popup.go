@@ -1,6 +1,9 @@
 package tmux
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // DisplayPopup opens a tmux popup window that closes when command exits.
 // The popup is displayed in the current tmux session.
@@ -17,3 +20,10 @@ func (m *Manager) DisplayPopup(session, workingDir, command string) error {
 	args = append(args, command)
 	return m.runBackground(args...)
 }
+
+// DisplayMessage shows message in the status line of the clients attached
+// to session. tmux expands display-message text as a format, where #(...)
+// runs a shell command, so every # is escaped to show literally.
+func (m *Manager) DisplayMessage(session, message string) error {
+	return m.runSilent("display-message", "-t", sessionTarget(session)+":", strings.ReplaceAll(message, "#", "##"))
+}
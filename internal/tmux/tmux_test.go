@@ -2,6 +2,7 @@ package tmux
 
 import (
 	"errors"
+	"slices"
 	"testing"
 )
 
@@ -152,3 +153,31 @@ func TestAvailable(t *testing.T) {
 	// The actual result depends on whether tmux is installed.
 	_ = Available()
 }
+
+func TestDisplayMessage(t *testing.T) {
+	runner := NewMockRunner()
+	mgr := NewManagerWithRunner(runner)
+
+	if err := mgr.DisplayMessage("myproject", "ticket overdue"); err != nil {
+		t.Fatalf("DisplayMessage() returned error: %v", err)
+	}
+
+	want := []string{"display-message", "-t", "=myproject:", "ticket overdue"}
+	if len(runner.Calls) != 1 || !slices.Equal(runner.Calls[0], want) {
+		t.Errorf("expected call %v, got calls: %v", want, runner.Calls)
+	}
+}
+
+func TestDisplayMessageEscapesFormats(t *testing.T) {
+	runner := NewMockRunner()
+	mgr := NewManagerWithRunner(runner)
+
+	if err := mgr.DisplayMessage("myproject", "cortex: #(touch /tmp/pwned) #{pane_id} is overdue"); err != nil {
+		t.Fatalf("DisplayMessage() returned error: %v", err)
+	}
+
+	want := []string{"display-message", "-t", "=myproject:", "cortex: ##(touch /tmp/pwned) ##{pane_id} is overdue"}
+	if len(runner.Calls) != 1 || !slices.Equal(runner.Calls[0], want) {
+		t.Errorf("expected call %v, got calls: %v", want, runner.Calls)
+	}
+}
@@ -11,43 +11,109 @@ type TmuxChecker interface {
 
 func ToSessionResponse(s *session.Session) SessionResponse {
 	return SessionResponse{
-		Type:       string(s.Type),
-		TicketID:   s.TicketID,
-		CollabID:   s.CollabID,
-		Agent:      s.Agent,
-		TmuxWindow: s.TmuxWindow,
-		StartedAt:  s.StartedAt,
-		Status:     string(s.Status),
-		Tool:       s.Tool,
+		Type:         string(s.Type),
+		TicketID:     s.TicketID,
+		CollabID:     s.CollabID,
+		Agent:        s.Agent,
+		TmuxWindow:   s.TmuxWindow,
+		StartedAt:    s.StartedAt,
+		Status:       string(s.Status),
+		Tool:         s.Tool,
+		WorktreePath: s.WorktreePath,
+		Variant:      s.Variant,
+		Backend:      s.Backend,
+		Repo:         s.Repo,
+	}
+}
+
+// ToQueuedSpawnResponse converts a queue entry at the given 1-based
+// position.
+func ToQueuedSpawnResponse(e session.QueuedSpawn, position int) *QueuedSpawnResponse {
+	return &QueuedSpawnResponse{
+		TicketID: e.TicketID,
+		Repo:     e.Repo,
+		Variant:  e.Variant,
+		Position: position,
+		Reason:   e.Reason,
+		QueuedAt: e.QueuedAt,
 	}
 }
 
 func ToTicketResponse(t *ticket.Ticket, status ticket.Status, hasConclusion bool) TicketResponse {
-	return TicketResponse{
+	resp := TicketResponse{
 		ID:            t.ID,
 		Title:         t.Title,
+		Type:          t.Type,
 		Body:          t.Body,
 		Repo:          t.Repo,
+		Repos:         t.Repos,
 		HasConclusion: hasConclusion,
 		References:    t.References,
+		BlockedBy:     t.BlockedBy,
+		Blocks:        t.Blocks,
 		Status:        string(status),
 		Created:       t.Created,
 		Updated:       t.Updated,
 		Due:           t.Due,
+		Priority:      string(t.Priority),
+		Labels:        t.Labels,
+		Assignee:      t.Assignee,
+	}
+	for _, c := range t.Criteria() {
+		resp.Criteria = append(resp.Criteria, CriterionResponse{
+			Index:    c.Index,
+			Text:     c.Text,
+			Checked:  c.Checked,
+			Evidence: c.Evidence,
+		})
+	}
+	return resp
+}
+
+// ToVerificationResponses converts the verifications of a conclusion.
+func ToVerificationResponses(vs []ticket.Verification) []VerificationResponse {
+	var out []VerificationResponse
+	for _, v := range vs {
+		resp := VerificationResponse{
+			Repo:       v.Repo,
+			Commit:     v.Commit,
+			Status:     string(v.Status),
+			StartedAt:  v.StartedAt,
+			FinishedAt: v.FinishedAt,
+			Error:      v.Error,
+		}
+		for _, c := range v.Commands {
+			resp.Commands = append(resp.Commands, VerifyCommandResponse{
+				Command:    c.Command,
+				ExitCode:   c.ExitCode,
+				TimedOut:   c.TimedOut,
+				DurationMS: c.DurationMS,
+				Output:     c.Output,
+			})
+		}
+		out = append(out, resp)
 	}
+	return out
 }
 
 func ToTicketSummary(t *ticket.Ticket, status ticket.Status, sess *session.Session, tmuxSession string, checker TmuxChecker) TicketSummary {
 	summary := TicketSummary{
 		ID:               t.ID,
 		Title:            t.Title,
+		Type:             t.Type,
 		Repo:             t.Repo,
+		Repos:            t.Repos,
 		Status:           string(status),
 		Created:          t.Created,
 		Updated:          t.Updated,
 		Due:              t.Due,
+		Priority:         string(t.Priority),
+		Labels:           t.Labels,
+		Assignee:         t.Assignee,
+		BlockedBy:        t.BlockedBy,
 		HasActiveSession: sess != nil,
 	}
+	summary.CriteriaDone, summary.CriteriaTotal = ticket.CriteriaProgress(t.Criteria())
 
 	if sess != nil {
 		statusStr := string(sess.Status)
@@ -55,6 +121,9 @@ func ToTicketSummary(t *ticket.Ticket, status ticket.Status, sess *session.Sessi
 		summary.AgentTool = sess.Tool
 		summary.Agent = sess.Agent
 		summary.SessionStartedAt = &sess.StartedAt
+		summary.SessionID = sess.SessionID
+		summary.WorktreePath = sess.WorktreePath
+		summary.SessionBackend = sess.Backend
 	}
 
 	if sess != nil && tmuxSession != "" && checker != nil && sess.TmuxWindow != "" {
@@ -66,3 +135,77 @@ func ToTicketSummary(t *ticket.Ticket, status ticket.Status, sess *session.Sessi
 
 	return summary
 }
+
+func ToTicketGraphResponse(g *ticket.Graph) TicketGraphResponse {
+	resp := TicketGraphResponse{
+		TicketID: g.Root,
+		Nodes:    make([]TicketGraphNode, 0, len(g.Nodes)),
+		Edges:    make([]TicketGraphEdge, 0, len(g.Edges)),
+	}
+	for _, n := range g.Nodes {
+		resp.Nodes = append(resp.Nodes, TicketGraphNode{
+			ID:        n.ID,
+			Title:     n.Title,
+			Status:    string(n.Status),
+			IsBlocked: n.Blocked,
+		})
+	}
+	for _, e := range g.Edges {
+		resp.Edges = append(resp.Edges, TicketGraphEdge{From: e.From, To: e.To})
+	}
+	return resp
+}
+
+func ToTicketHistoryResponse(id string, revisions []ticket.Revision) TicketHistoryResponse {
+	resp := TicketHistoryResponse{
+		TicketID:  id,
+		Revisions: make([]TicketRevisionResponse, 0, len(revisions)),
+	}
+	for _, rev := range revisions {
+		r := TicketRevisionResponse{
+			Seq:            rev.Seq,
+			Time:           rev.Time,
+			Actor:          rev.Actor.Kind,
+			ActorSessionID: rev.Actor.SessionID,
+			Action:         rev.Action,
+			BodyDiff:       rev.BodyDiff,
+			RestoredFrom:   rev.RestoredFrom,
+		}
+		for _, c := range rev.Changes {
+			r.Changes = append(r.Changes, FieldChangeResponse{Field: c.Field, Old: c.Old, New: c.New})
+		}
+		resp.Revisions = append(resp.Revisions, r)
+	}
+	return resp
+}
+
+func ToReviewResponse(id string, r *ticket.Review) ReviewResponse {
+	resp := ReviewResponse{
+		TicketID:       id,
+		State:          string(r.State),
+		Round:          r.Round,
+		Summary:        r.Summary,
+		Verdict:        string(r.Verdict),
+		VerdictSummary: r.VerdictSummary,
+		Updated:        r.Updated,
+		Comments:       make([]ReviewCommentResponse, 0, len(r.Comments)),
+	}
+	for _, c := range r.Comments {
+		resp.Comments = append(resp.Comments, ToReviewCommentResponse(c))
+	}
+	return resp
+}
+
+func ToReviewCommentResponse(c ticket.ReviewComment) ReviewCommentResponse {
+	return ReviewCommentResponse{
+		ID:      c.ID,
+		Round:   c.Round,
+		Repo:    c.Repo,
+		Commit:  c.Commit,
+		File:    c.File,
+		Line:    c.Line,
+		Body:    c.Body,
+		Author:  c.Author,
+		Created: c.Created,
+	}
+}
@@ -11,40 +11,101 @@ type ErrorResponse struct {
 
 // SessionResponse is a standalone session representation.
 type SessionResponse struct {
-	Type       string    `json:"type"`
-	TicketID   string    `json:"ticket_id,omitempty"`
-	CollabID   string    `json:"collab_id,omitempty"`
-	Agent      string    `json:"agent"`
-	TmuxWindow string    `json:"tmux_window"`
-	StartedAt  time.Time `json:"started_at"`
-	Status     string    `json:"status"`
-	Tool       *string   `json:"tool,omitempty"`
+	Type         string    `json:"type"`
+	TicketID     string    `json:"ticket_id,omitempty"`
+	CollabID     string    `json:"collab_id,omitempty"`
+	Agent        string    `json:"agent"`
+	TmuxWindow   string    `json:"tmux_window"`
+	StartedAt    time.Time `json:"started_at"`
+	Status       string    `json:"status"`
+	Tool         *string   `json:"tool,omitempty"`
+	WorktreePath string    `json:"worktree_path,omitempty"`
+	Variant      string    `json:"variant,omitempty"`
+	Backend      string    `json:"backend,omitempty"`
+	Repo         string    `json:"repo,omitempty"`
+}
+
+// QueuedSpawnResponse describes a ticket spawn waiting for a concurrency
+// limit.
+type QueuedSpawnResponse struct {
+	TicketID string    `json:"ticket_id"`
+	Repo     string    `json:"repo,omitempty"`
+	Variant  string    `json:"variant"`
+	Position int       `json:"position"`
+	Reason   string    `json:"reason,omitempty"`
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+// TimelineEntryResponse is one recorded hook event of a session.
+type TimelineEntryResponse struct {
+	Time    time.Time `json:"time"`
+	Agent   string    `json:"agent,omitempty"`
+	Status  string    `json:"status,omitempty"`
+	Tool    string    `json:"tool,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+// SessionTimelineResponse is the response for GET /sessions/{id}/timeline.
+type SessionTimelineResponse struct {
+	SessionID string                  `json:"session_id"`
+	Entries   []TimelineEntryResponse `json:"entries"`
+}
+
+// SessionLogResponse is the response for GET /sessions/{id}/log.
+type SessionLogResponse struct {
+	SessionID string `json:"session_id"`
+	Path      string `json:"path"`
+	Content   string `json:"content"`
 }
 
 // TicketResponse is the full ticket response with status.
 type TicketResponse struct {
 	ID            string     `json:"id"`
 	Title         string     `json:"title"`
+	Type          string     `json:"type,omitempty"`
 	Body          string     `json:"body"`
 	Repo          string     `json:"repo,omitempty"`
+	Repos         []string   `json:"repos,omitempty"`
 	FilePath      string     `json:"file_path,omitempty"`
 	HasConclusion bool       `json:"has_conclusion"`
 	References    []string   `json:"references,omitempty"`
+	BlockedBy     []string   `json:"blocked_by,omitempty"`
+	Blocks        []string   `json:"blocks,omitempty"`
 	Status        string     `json:"status"`
 	Created       time.Time  `json:"created"`
 	Updated       time.Time  `json:"updated"`
 	Due           *time.Time `json:"due,omitempty"`
+	Priority      string     `json:"priority,omitempty"`
+	Labels        []string   `json:"labels,omitempty"`
+	Assignee      string     `json:"assignee,omitempty"`
+	// Criteria are the acceptance criteria checklist parsed from Body.
+	Criteria []CriterionResponse `json:"criteria,omitempty"`
+}
+
+// CriterionResponse is an acceptance criterion of a ticket.
+type CriterionResponse struct {
+	Index    int    `json:"index"`
+	Text     string `json:"text"`
+	Checked  bool   `json:"checked"`
+	Evidence string `json:"evidence,omitempty"`
 }
 
 // TicketSummary is a brief view of a ticket for lists.
 type TicketSummary struct {
 	ID               string     `json:"id"`
 	Title            string     `json:"title"`
+	Type             string     `json:"type,omitempty"`
 	Repo             string     `json:"repo,omitempty"`
+	Repos            []string   `json:"repos,omitempty"`
 	Status           string     `json:"status"`
 	Created          time.Time  `json:"created"`
 	Updated          time.Time  `json:"updated"`
 	Due              *time.Time `json:"due,omitempty"`
+	Priority         string     `json:"priority,omitempty"`
+	Labels           []string   `json:"labels,omitempty"`
+	Assignee         string     `json:"assignee,omitempty"`
+	BlockedBy        []string   `json:"blocked_by,omitempty"`
+	IsBlocked        bool       `json:"is_blocked,omitempty"`
 	HasActiveSession bool       `json:"has_active_session"`
 	HasConclusion    bool       `json:"has_conclusion,omitempty"`
 	AgentStatus      *string    `json:"agent_status,omitempty"`
@@ -52,6 +113,17 @@ type TicketSummary struct {
 	Agent            string     `json:"agent,omitempty"`
 	IsOrphaned       bool       `json:"is_orphaned,omitempty"`
 	SessionStartedAt *time.Time `json:"session_started_at,omitempty"`
+	SessionID        string     `json:"session_id,omitempty"`
+	WorktreePath     string     `json:"worktree_path,omitempty"`
+	SessionBackend   string     `json:"session_backend,omitempty"`
+	CriteriaDone     int        `json:"criteria_done,omitempty"`
+	CriteriaTotal    int        `json:"criteria_total,omitempty"`
+	// Verification is the verdict of the verify commands run on the
+	// ticket's last conclusion: pending, passed or failed.
+	Verification string `json:"verification,omitempty"`
+	// DueState is due_soon or overdue for an open ticket near or past its
+	// due date, by the architect's reminders window.
+	DueState string `json:"due_state,omitempty"`
 }
 
 // ListTicketsResponse is a list of tickets with a single status.
@@ -59,11 +131,66 @@ type ListTicketsResponse struct {
 	Tickets []TicketSummary `json:"tickets"`
 }
 
+// TicketColumn holds the tickets in a single status.
+type TicketColumn struct {
+	Status  string          `json:"status"`
+	Tickets []TicketSummary `json:"tickets"`
+}
+
 // ListAllTicketsResponse groups tickets by status.
+// Columns lists every configured status in board order; Backlog, Progress
+// and Done repeat the built-in columns for older clients.
 type ListAllTicketsResponse struct {
 	Backlog  []TicketSummary `json:"backlog"`
 	Progress []TicketSummary `json:"progress"`
 	Done     []TicketSummary `json:"done"`
+	Columns  []TicketColumn  `json:"columns,omitempty"`
+}
+
+// TicketGraphNode is a ticket in a dependency graph.
+type TicketGraphNode struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	IsBlocked bool   `json:"is_blocked"`
+}
+
+// TicketGraphEdge means From must be done before To can start.
+type TicketGraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// TicketGraphResponse is the response for GET /tickets/{id}/graph.
+type TicketGraphResponse struct {
+	TicketID string            `json:"ticket_id"`
+	Nodes    []TicketGraphNode `json:"nodes"`
+	Edges    []TicketGraphEdge `json:"edges"`
+}
+
+// FieldChangeResponse is a single frontmatter field change in a revision.
+type FieldChangeResponse struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// TicketRevisionResponse is one recorded ticket mutation.
+type TicketRevisionResponse struct {
+	Seq            int                   `json:"seq"`
+	Time           time.Time             `json:"time"`
+	Actor          string                `json:"actor"`
+	ActorSessionID string                `json:"actor_session_id,omitempty"`
+	Action         string                `json:"action"`
+	Changes        []FieldChangeResponse `json:"changes,omitempty"`
+	BodyDiff       string                `json:"body_diff,omitempty"`
+	RestoredFrom   int                   `json:"restored_from,omitempty"`
+}
+
+// TicketHistoryResponse is the response for GET /tickets/{id}/history.
+type TicketHistoryResponse struct {
+	TicketID  string                   `json:"ticket_id"`
+	Revisions []TicketRevisionResponse `json:"revisions"`
 }
 
 // DiffFileResponse describes one file changed by a commit.
@@ -89,11 +216,57 @@ type CommitDiffResponse struct {
 	Files       []DiffFileResponse `json:"files"`
 }
 
-// DiffsResponse is the response for GET /tickets/{id}/diffs.
+// DiffsResponse is the response for GET /tickets/{id}/diffs. Repos groups
+// the diffs by repo; Repo and Commits repeat the single group of a ticket
+// with one repo.
 type DiffsResponse struct {
 	TicketID string               `json:"ticket_id"`
-	Repo     string               `json:"repo"`
-	Commits  []CommitDiffResponse `json:"commits"`
+	Repo     string               `json:"repo,omitempty"`
+	Commits  []CommitDiffResponse `json:"commits,omitempty"`
+	Repos    []RepoDiffsResponse  `json:"repos"`
+}
+
+// RepoDiffsResponse is the diffs of one repo of a ticket.
+type RepoDiffsResponse struct {
+	Repo    string               `json:"repo"`
+	Path    string               `json:"path"`
+	Commits []CommitDiffResponse `json:"commits"`
+}
+
+// ReviewCommentResponse is a review comment anchored to a file of a
+// ticket's diffs.
+type ReviewCommentResponse struct {
+	ID      string    `json:"id"`
+	Round   int       `json:"round"`
+	Repo    string    `json:"repo,omitempty"`
+	Commit  string    `json:"commit,omitempty"`
+	File    string    `json:"file"`
+	Line    int       `json:"line,omitempty"`
+	Body    string    `json:"body"`
+	Author  string    `json:"author,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+// ReviewResponse is the response for GET /tickets/{id}/review.
+type ReviewResponse struct {
+	TicketID string `json:"ticket_id"`
+	State    string `json:"state,omitempty"`
+	Round    int    `json:"round"`
+	Summary  string `json:"summary,omitempty"`
+	// Verdict and VerdictSummary are what a reviewer agent submitted for
+	// the current round.
+	Verdict        string                  `json:"verdict,omitempty"`
+	VerdictSummary string                  `json:"verdict_summary,omitempty"`
+	Updated        time.Time               `json:"updated,omitzero"`
+	Comments       []ReviewCommentResponse `json:"comments"`
+}
+
+// ReviewActionResponse is the response for accepting a review or
+// requesting changes.
+type ReviewActionResponse struct {
+	Review  ReviewResponse `json:"review"`
+	Ticket  TicketResponse `json:"ticket"`
+	Message string         `json:"message"`
 }
 
 // ConclusionResponse is the full conclusion response.
@@ -107,8 +280,34 @@ type ConclusionResponse struct {
 	Commits         []string  `json:"commits,omitempty"`
 	Rejected        bool      `json:"rejected,omitempty"`
 	RejectionReason string    `json:"rejection_reason,omitempty"`
+	SessionID       string    `json:"session_id,omitempty"`
 	StartedAt       time.Time `json:"started_at"`
 	ConcludedAt     time.Time `json:"concluded_at"`
+	// Verifications are the runs of the verify commands cortex.yaml
+	// declares for the concluded repos.
+	Verifications []VerificationResponse `json:"verifications,omitempty"`
+}
+
+// VerificationResponse is a run of a repo's verify commands against the
+// last commit of a conclusion.
+type VerificationResponse struct {
+	Repo       string                  `json:"repo"`
+	Commit     string                  `json:"commit"`
+	Status     string                  `json:"status"`
+	StartedAt  time.Time               `json:"started_at"`
+	FinishedAt *time.Time              `json:"finished_at,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+	Commands   []VerifyCommandResponse `json:"commands,omitempty"`
+}
+
+// VerifyCommandResponse is the outcome of one verify command. Output
+// holds the tail of its combined stdout and stderr.
+type VerifyCommandResponse struct {
+	Command    string `json:"command"`
+	ExitCode   int    `json:"exit_code"`
+	TimedOut   bool   `json:"timed_out,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+	Output     string `json:"output,omitempty"`
 }
 
 // ConclusionSummary is metadata-only (no body) for list responses.
@@ -132,12 +331,94 @@ type SpawnCollabResponse struct {
 	Session     SessionResponse `json:"session"`
 }
 
+// SpawnReviewerResponse is the response for spawning a reviewer session.
+type SpawnReviewerResponse struct {
+	TicketID    string `json:"ticket_id"`
+	Round       int    `json:"round"`
+	TmuxWindow  string `json:"tmux_window"`
+	TmuxSession string `json:"tmux_session"`
+	Variant     string `json:"variant"`
+}
+
+// TemplateResponse describes a ticket template.
+type TemplateResponse struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Repo        string   `json:"repo,omitempty"`
+	References  []string `json:"references,omitempty"`
+	Due         string   `json:"due,omitempty"`
+	Variables   []string `json:"variables"`
+	Body        string   `json:"body"`
+}
+
+// ListTemplatesResponse is the response for GET /templates.
+type ListTemplatesResponse struct {
+	Templates []TemplateResponse `json:"templates"`
+	Dir       string             `json:"dir"`
+}
+
+// ScheduleResponse describes a recurring ticket schedule and its last run.
+type ScheduleResponse struct {
+	Name       string   `json:"name"`
+	Cron       string   `json:"cron"`
+	Title      string   `json:"title"`
+	Type       string   `json:"type,omitempty"`
+	Repo       string   `json:"repo,omitempty"`
+	References []string `json:"references,omitempty"`
+	Due        string   `json:"due,omitempty"`
+	Variant    string   `json:"variant,omitempty"`
+	Paused     bool     `json:"paused"`
+	Body       string   `json:"body"`
+	// NextRun is unset for a paused schedule.
+	NextRun      *time.Time `json:"next_run,omitempty"`
+	LastRun      *time.Time `json:"last_run,omitempty"`
+	LastTicketID string     `json:"last_ticket_id,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+}
+
+// InvalidScheduleResponse is a schedule definition that failed to load or
+// validate, and does not run.
+type InvalidScheduleResponse struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// ListSchedulesResponse is the response for GET /schedules.
+type ListSchedulesResponse struct {
+	Schedules []ScheduleResponse        `json:"schedules"`
+	Invalid   []InvalidScheduleResponse `json:"invalid,omitempty"`
+	Dir       string                    `json:"dir"`
+}
+
 // ListConclusionsResponse is a paginated list of conclusions (metadata only).
 type ListConclusionsResponse struct {
 	Conclusions []ConclusionSummary `json:"conclusions"`
 	Total       int                 `json:"total"`
 }
 
+// SearchResult is a single ranked hit from the full-text search index.
+type SearchResult struct {
+	Kind     string    `json:"kind"` // ticket, conclusion, collab, or note
+	ID       string    `json:"id"`
+	Title    string    `json:"title"`
+	Snippet  string    `json:"snippet"`
+	Score    float64   `json:"score"`
+	Repo     string    `json:"repo,omitempty"`
+	Status   string    `json:"status,omitempty"`
+	Type     string    `json:"type,omitempty"`
+	TicketID string    `json:"ticket_id,omitempty"`
+	Path     string    `json:"path"`
+	Updated  time.Time `json:"updated"`
+}
+
+// SearchResponse is the response for GET /search.
+type SearchResponse struct {
+	Results []SearchResult `json:"results"`
+	Total   int            `json:"total"`
+}
+
 // ArchitectSessionResponse is the session details in an architect response.
 type ArchitectSessionResponse struct {
 	ID          string     `json:"id"`
@@ -222,3 +503,23 @@ type ListPromptsResponse struct {
 	ConfigPath    string            `json:"config_path"`
 	ConfigContent string            `json:"config_content"`
 }
+
+// EventResponse is a journaled daemon event.
+type EventResponse struct {
+	ID            uint64    `json:"id"`
+	Type          string    `json:"type"`
+	ArchitectPath string    `json:"architect_path"`
+	TicketID      string    `json:"ticket_id,omitempty"`
+	SessionID     string    `json:"session_id,omitempty"`
+	Payload       any       `json:"payload,omitempty"`
+	Time          time.Time `json:"time"`
+}
+
+// EventHistoryResponse is the response for GET /events/history.
+// Complete is false when events after the requested ID have been trimmed
+// from the journal.
+type EventHistoryResponse struct {
+	Events   []EventResponse `json:"events"`
+	LastID   uint64          `json:"last_id"`
+	Complete bool            `json:"complete"`
+}
@@ -0,0 +1,141 @@
+// Package verify runs the verification commands a repo declares in
+// cortex.yaml against a concluded commit, in a scratch git worktree.
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/kareemaly/cortex/internal/worktree"
+)
+
+// MaxOutput caps the output kept per command. Longer output keeps its
+// tail, where failures are usually reported.
+const MaxOutput = 16 * 1024
+
+// waitDelay bounds how long a timed-out command's children may hold its
+// output open after the shell is killed.
+const waitDelay = 5 * time.Second
+
+// Result is the outcome of one verification command.
+type Result struct {
+	Command  string
+	ExitCode int
+	TimedOut bool
+	Output   string
+	Duration time.Duration
+}
+
+// Passed reports whether the command exited zero in time.
+func (r Result) Passed() bool {
+	return r.ExitCode == 0 && !r.TimedOut
+}
+
+// Run checks commit out in a scratch worktree of repoPath at scratchPath
+// and runs commands there in order through sh -c, stopping at the first
+// failure. timeout bounds the whole run. The worktree is removed
+// afterwards. The error reports a failure to set the worktree up; failing
+// commands are reported in the results.
+func Run(ctx context.Context, repoPath, scratchPath, commit string, commands []string, timeout time.Duration) ([]Result, error) {
+	if err := worktree.AddDetached(repoPath, scratchPath, commit); err != nil {
+		return nil, fmt.Errorf("check out %s: %w", commit, err)
+	}
+	defer func() { _ = worktree.Remove(repoPath, scratchPath, true) }()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var results []Result
+	for _, command := range commands {
+		res := runCommand(ctx, scratchPath, command)
+		results = append(results, res)
+		if !res.Passed() {
+			break
+		}
+	}
+	return results, nil
+}
+
+// Tip returns the commit of commits that comes last in history, the one
+// to verify when a conclusion reports several.
+func Tip(repoPath string, commits []string) (string, error) {
+	if len(commits) == 1 {
+		return commits[0], nil
+	}
+	args := append([]string{"rev-list", "--no-walk", "--topo-order", "-n", "1"}, commits...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git rev-list: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func runCommand(ctx context.Context, dir, command string) Result {
+	start := time.Now()
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = dir
+	cmd.WaitDelay = waitDelay
+	setProcessGroup(cmd)
+	// One writer for both streams: exec then calls Write from a single
+	// goroutine at a time.
+	out := &tailWriter{}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	err := cmd.Run()
+
+	res := Result{Command: command, Output: out.String(), Duration: time.Since(start)}
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case ctx.Err() != nil:
+		res.TimedOut = true
+		res.ExitCode = -1
+	case errors.As(err, &exitErr):
+		res.ExitCode = exitErr.ExitCode()
+	default:
+		res.ExitCode = -1
+		res.Output += err.Error()
+	}
+	return res
+}
+
+// tailWriter keeps the last MaxOutput bytes written to it, so a command
+// with endless output cannot grow the daemon's memory.
+type tailWriter struct {
+	buf       []byte
+	truncated bool
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(p) >= MaxOutput {
+		w.truncated = w.truncated || len(p) > MaxOutput || len(w.buf) > 0
+		w.buf = append(w.buf[:0], p[len(p)-MaxOutput:]...)
+		return n, nil
+	}
+	if over := len(w.buf) + len(p) - MaxOutput; over > 0 {
+		w.buf = append(w.buf[:0], w.buf[over:]...)
+		w.truncated = true
+	}
+	w.buf = append(w.buf, p...)
+	return n, nil
+}
+
+// String returns the output kept. Truncated output starts at the first
+// full line and is marked as truncated.
+func (w *tailWriter) String() string {
+	s := string(w.buf)
+	if !w.truncated {
+		return s
+	}
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[i+1:]
+	}
+	return "[output truncated]\n" + s
+}
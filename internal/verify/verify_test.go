@@ -0,0 +1,107 @@
+package verify
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func gitRun(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func commitFile(t *testing.T, repo, name, content string) string {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitRun(t, repo, "add", name)
+	gitRun(t, repo, "commit", "-q", "-m", "add "+name)
+	return gitRun(t, repo, "rev-parse", "HEAD")
+}
+
+func TestRun(t *testing.T) {
+	repo := t.TempDir()
+	gitRun(t, repo, "init", "-q")
+	first := commitFile(t, repo, "status", "ok\n")
+	second := commitFile(t, repo, "status", "broken\n")
+
+	tip, err := Tip(repo, []string{second, first})
+	if err != nil || tip != second {
+		t.Fatalf("Tip = %q, %v; want %s", tip, err, second)
+	}
+
+	scratch := filepath.Join(t.TempDir(), "verify", "repo")
+	commands := []string{"cat status", "grep -qx ok status", "echo unreachable"}
+
+	// The first commit passes every command.
+	results, err := Run(context.Background(), repo, scratch, first, commands[:2], time.Minute)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(results) != 2 || !results[0].Passed() || !results[1].Passed() || results[0].Output != "ok\n" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if _, err := os.Stat(scratch); !os.IsNotExist(err) {
+		t.Error("expected the scratch worktree to be removed")
+	}
+
+	// The second fails the grep, and the run stops there.
+	results, err = Run(context.Background(), repo, scratch, second, commands, time.Minute)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(results) != 2 || results[1].Passed() || results[1].ExitCode != 1 {
+		t.Fatalf("expected the run to stop at the failing command, got %+v", results)
+	}
+
+	results, err = Run(context.Background(), repo, scratch, first, []string{"sleep 5"}, 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if !results[0].TimedOut || results[0].Passed() {
+		t.Errorf("expected a timeout, got %+v", results[0])
+	}
+
+	if _, err := Run(context.Background(), repo, scratch, "0000000", commands, time.Minute); err == nil {
+		t.Error("expected an error for an unknown commit")
+	}
+}
+
+func TestTailWriter(t *testing.T) {
+	w := &tailWriter{}
+	_, _ = w.Write([]byte("short\n"))
+	if got := w.String(); got != "short\n" {
+		t.Errorf("String() = %q, want the output as written", got)
+	}
+
+	line := strings.Repeat("x", 99) + "\n"
+	for range 3 * MaxOutput / len(line) {
+		_, _ = w.Write([]byte(line))
+	}
+	_, _ = w.Write([]byte("last\n"))
+	if len(w.buf) > MaxOutput {
+		t.Errorf("kept %d bytes, want at most %d", len(w.buf), MaxOutput)
+	}
+	got := w.String()
+	if !strings.HasPrefix(got, "[output truncated]\n"+line) || !strings.HasSuffix(got, line+"last\n") {
+		t.Errorf("expected the marked tail of the output, got %q...", got[:40])
+	}
+
+	big := &tailWriter{}
+	_, _ = big.Write([]byte(strings.Repeat(line, 2*MaxOutput/len(line))))
+	if len(big.buf) != MaxOutput || !big.truncated {
+		t.Errorf("expected one large write capped at %d bytes, got %d", MaxOutput, len(big.buf))
+	}
+}
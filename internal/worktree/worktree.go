@@ -0,0 +1,100 @@
+// Package worktree manages the git worktrees cortex creates for repos
+// configured with isolation: worktree.
+package worktree
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// BranchPrefix namespaces the branches cortex creates for ticket worktrees.
+const BranchPrefix = "cortex/"
+
+// Path returns where the worktree for a ticket lives under the managed root.
+func Path(root, repoKey, ticketID string) string {
+	return filepath.Join(root, repoKey, ticketID)
+}
+
+// Branch returns the branch name used for a ticket's worktree.
+func Branch(ticketID string) string {
+	return BranchPrefix + ticketID
+}
+
+// Ensure creates a worktree of repoPath at path on branch, creating the
+// branch from the current HEAD when it does not exist yet. An existing
+// worktree at path is reused as-is.
+func Ensure(repoPath, path, branch string) error {
+	if Exists(path) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create worktree directory: %w", err)
+	}
+
+	args := []string{"worktree", "add", path, branch}
+	if !branchExists(repoPath, branch) {
+		args = []string{"worktree", "add", "-b", branch, path}
+	}
+	if _, err := git(repoPath, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddDetached creates a scratch worktree of repoPath at path with commit
+// checked out on a detached HEAD. A worktree left over at path from an
+// earlier run is replaced.
+func AddDetached(repoPath, path, commit string) error {
+	if Exists(path) {
+		if err := Remove(repoPath, path, true); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create worktree directory: %w", err)
+	}
+	if _, err := git(repoPath, "worktree", "add", "--detach", path, commit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Remove deletes the worktree at path. The branch is kept so commits made in
+// the worktree stay reachable. Without force, git refuses to remove a
+// worktree with uncommitted changes.
+func Remove(repoPath, path string, force bool) error {
+	args := []string{"worktree", "remove", path}
+	if force {
+		args = []string{"worktree", "remove", "--force", path}
+	}
+	if _, err := git(repoPath, args...); err != nil {
+		return err
+	}
+	_, _ = git(repoPath, "worktree", "prune")
+	return nil
+}
+
+// Exists reports whether path holds a linked git worktree.
+func Exists(path string) bool {
+	// Linked worktrees have a .git file pointing back at the main repo.
+	info, err := os.Stat(filepath.Join(path, ".git"))
+	return err == nil && !info.IsDir()
+}
+
+func branchExists(repoPath, branch string) bool {
+	_, err := git(repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	return err == nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %s", strings.Join(args[:2], " "), strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,86 @@
+package worktree
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func initRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	return dir
+}
+
+func TestEnsureAndRemove(t *testing.T) {
+	repo := initRepo(t)
+	path := Path(filepath.Join(t.TempDir(), "worktrees"), "service", "2026-01-01-0900-fix-login")
+	branch := Branch("2026-01-01-0900-fix-login")
+
+	if err := Ensure(repo, path, branch); err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if !Exists(path) {
+		t.Fatal("expected worktree to exist")
+	}
+
+	head, err := git(path, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != branch {
+		t.Errorf("HEAD = %q, want %q", head, branch)
+	}
+
+	// A second call reuses the existing worktree.
+	if err := Ensure(repo, path, branch); err != nil {
+		t.Fatalf("Ensure on existing worktree failed: %v", err)
+	}
+
+	if err := Remove(repo, path, false); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected worktree directory to be removed")
+	}
+	if !branchExists(repo, branch) {
+		t.Error("expected branch to be kept after removal")
+	}
+
+	// Re-creating checks out the existing branch.
+	if err := Ensure(repo, path, branch); err != nil {
+		t.Fatalf("Ensure with existing branch failed: %v", err)
+	}
+}
+
+func TestRemoveDirtyWorktree(t *testing.T) {
+	repo := initRepo(t)
+	path := Path(t.TempDir(), "service", "ticket")
+
+	if err := Ensure(repo, path, Branch("ticket")); err != nil {
+		t.Fatalf("Ensure failed: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "wip.txt"), []byte("wip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := Remove(repo, path, false)
+	if err == nil || !strings.Contains(err.Error(), "git worktree") {
+		t.Fatalf("expected git worktree error for dirty worktree, got %v", err)
+	}
+	if err := Remove(repo, path, true); err != nil {
+		t.Fatalf("forced Remove failed: %v", err)
+	}
+}